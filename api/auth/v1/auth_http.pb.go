@@ -19,13 +19,25 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationAuthRefreshToken = "/api.auth.v1.Auth/RefreshToken"
+const OperationAuthRevokeUserTokens = "/api.auth.v1.Auth/RevokeUserTokens"
 const OperationAuthSignIn = "/api.auth.v1.Auth/SignIn"
+const OperationAuthSignOut = "/api.auth.v1.Auth/SignOut"
 const OperationAuthSignUp = "/api.auth.v1.Auth/SignUp"
 
 type AuthHTTPServer interface {
+	// RefreshToken Exchange a refresh token for new tokens; the refresh token is rotated
+	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenReply, error)
+	// RevokeUserTokens Revoke every access token issued to the user so far, e.g. after a
+	// password or role change; the user's refresh tokens then issue new ones.
+	// Users can revoke their own tokens, admins anyone's
+	RevokeUserTokens(context.Context, *RevokeUserTokensRequest) (*RevokeUserTokensReply, error)
 	// SignIn Login existing user
 	SignIn(context.Context, *SignInRequest) (*SignInReply, error)
-	// SignUp Register new user
+	// SignOut Revoke a refresh token and every token rotated from the same sign-in,
+	// and the access token sent in the Authorization header
+	SignOut(context.Context, *SignOutRequest) (*SignOutReply, error)
+	// SignUp Register new user and sign them in
 	SignUp(context.Context, *SignUpRequest) (*SignUpReply, error)
 }
 
@@ -33,6 +45,9 @@ func RegisterAuthHTTPServer(s *http.Server, srv AuthHTTPServer) {
 	r := s.Route("/")
 	r.POST("/v1/auth/sign-up", _Auth_SignUp0_HTTP_Handler(srv))
 	r.POST("/v1/auth/sign-in", _Auth_SignIn0_HTTP_Handler(srv))
+	r.POST("/v1/auth/refresh", _Auth_RefreshToken0_HTTP_Handler(srv))
+	r.POST("/v1/auth/sign-out", _Auth_SignOut0_HTTP_Handler(srv))
+	r.POST("/v1/auth/users/{user_id}/revoke-tokens", _Auth_RevokeUserTokens0_HTTP_Handler(srv))
 }
 
 func _Auth_SignUp0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
@@ -79,10 +94,88 @@ func _Auth_SignIn0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error
 	}
 }
 
+func _Auth_RefreshToken0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RefreshTokenRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthRefreshToken)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RefreshToken(ctx, req.(*RefreshTokenRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RefreshTokenReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_SignOut0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SignOutRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthSignOut)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SignOut(ctx, req.(*SignOutRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SignOutReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_RevokeUserTokens0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RevokeUserTokensRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthRevokeUserTokens)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RevokeUserTokens(ctx, req.(*RevokeUserTokensRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RevokeUserTokensReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type AuthHTTPClient interface {
+	// RefreshToken Exchange a refresh token for new tokens; the refresh token is rotated
+	RefreshToken(ctx context.Context, req *RefreshTokenRequest, opts ...http.CallOption) (rsp *RefreshTokenReply, err error)
+	// RevokeUserTokens Revoke every access token issued to the user so far, e.g. after a
+	// password or role change; the user's refresh tokens then issue new ones.
+	// Users can revoke their own tokens, admins anyone's
+	RevokeUserTokens(ctx context.Context, req *RevokeUserTokensRequest, opts ...http.CallOption) (rsp *RevokeUserTokensReply, err error)
 	// SignIn Login existing user
 	SignIn(ctx context.Context, req *SignInRequest, opts ...http.CallOption) (rsp *SignInReply, err error)
-	// SignUp Register new user
+	// SignOut Revoke a refresh token and every token rotated from the same sign-in,
+	// and the access token sent in the Authorization header
+	SignOut(ctx context.Context, req *SignOutRequest, opts ...http.CallOption) (rsp *SignOutReply, err error)
+	// SignUp Register new user and sign them in
 	SignUp(ctx context.Context, req *SignUpRequest, opts ...http.CallOption) (rsp *SignUpReply, err error)
 }
 
@@ -94,6 +187,36 @@ func NewAuthHTTPClient(client *http.Client) AuthHTTPClient {
 	return &AuthHTTPClientImpl{client}
 }
 
+// RefreshToken Exchange a refresh token for new tokens; the refresh token is rotated
+func (c *AuthHTTPClientImpl) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...http.CallOption) (*RefreshTokenReply, error) {
+	var out RefreshTokenReply
+	pattern := "/v1/auth/refresh"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthRefreshToken))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RevokeUserTokens Revoke every access token issued to the user so far, e.g. after a
+// password or role change; the user's refresh tokens then issue new ones.
+// Users can revoke their own tokens, admins anyone's
+func (c *AuthHTTPClientImpl) RevokeUserTokens(ctx context.Context, in *RevokeUserTokensRequest, opts ...http.CallOption) (*RevokeUserTokensReply, error) {
+	var out RevokeUserTokensReply
+	pattern := "/v1/auth/users/{user_id}/revoke-tokens"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthRevokeUserTokens))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // SignIn Login existing user
 func (c *AuthHTTPClientImpl) SignIn(ctx context.Context, in *SignInRequest, opts ...http.CallOption) (*SignInReply, error) {
 	var out SignInReply
@@ -108,7 +231,22 @@ func (c *AuthHTTPClientImpl) SignIn(ctx context.Context, in *SignInRequest, opts
 	return &out, nil
 }
 
-// SignUp Register new user
+// SignOut Revoke a refresh token and every token rotated from the same sign-in,
+// and the access token sent in the Authorization header
+func (c *AuthHTTPClientImpl) SignOut(ctx context.Context, in *SignOutRequest, opts ...http.CallOption) (*SignOutReply, error) {
+	var out SignOutReply
+	pattern := "/v1/auth/sign-out"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthSignOut))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SignUp Register new user and sign them in
 func (c *AuthHTTPClientImpl) SignUp(ctx context.Context, in *SignUpRequest, opts ...http.CallOption) (*SignUpReply, error) {
 	var out SignUpReply
 	pattern := "/v1/auth/sign-up"
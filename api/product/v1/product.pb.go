@@ -12,6 +12,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -25,6 +26,104 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type AttributeFilter_Match int32
+
+const (
+	// At least one of the values
+	AttributeFilter_ANY AttributeFilter_Match = 0
+	// Every value
+	AttributeFilter_ALL AttributeFilter_Match = 1
+	// None of the values
+	AttributeFilter_NONE AttributeFilter_Match = 2
+)
+
+// Enum value maps for AttributeFilter_Match.
+var (
+	AttributeFilter_Match_name = map[int32]string{
+		0: "ANY",
+		1: "ALL",
+		2: "NONE",
+	}
+	AttributeFilter_Match_value = map[string]int32{
+		"ANY":  0,
+		"ALL":  1,
+		"NONE": 2,
+	}
+)
+
+func (x AttributeFilter_Match) Enum() *AttributeFilter_Match {
+	p := new(AttributeFilter_Match)
+	*p = x
+	return p
+}
+
+func (x AttributeFilter_Match) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AttributeFilter_Match) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_product_v1_product_proto_enumTypes[0].Descriptor()
+}
+
+func (AttributeFilter_Match) Type() protoreflect.EnumType {
+	return &file_api_product_v1_product_proto_enumTypes[0]
+}
+
+func (x AttributeFilter_Match) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AttributeFilter_Match.Descriptor instead.
+func (AttributeFilter_Match) EnumDescriptor() ([]byte, []int) {
+	return file_api_product_v1_product_proto_rawDescGZIP(), []int{6, 0}
+}
+
+type ExportProductsRequest_Format int32
+
+const (
+	ExportProductsRequest_JSONL ExportProductsRequest_Format = 0
+	ExportProductsRequest_CSV   ExportProductsRequest_Format = 1
+)
+
+// Enum value maps for ExportProductsRequest_Format.
+var (
+	ExportProductsRequest_Format_name = map[int32]string{
+		0: "JSONL",
+		1: "CSV",
+	}
+	ExportProductsRequest_Format_value = map[string]int32{
+		"JSONL": 0,
+		"CSV":   1,
+	}
+)
+
+func (x ExportProductsRequest_Format) Enum() *ExportProductsRequest_Format {
+	p := new(ExportProductsRequest_Format)
+	*p = x
+	return p
+}
+
+func (x ExportProductsRequest_Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportProductsRequest_Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_product_v1_product_proto_enumTypes[1].Descriptor()
+}
+
+func (ExportProductsRequest_Format) Type() protoreflect.EnumType {
+	return &file_api_product_v1_product_proto_enumTypes[1]
+}
+
+func (x ExportProductsRequest_Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportProductsRequest_Format.Descriptor instead.
+func (ExportProductsRequest_Format) EnumDescriptor() ([]byte, []int) {
+	return file_api_product_v1_product_proto_rawDescGZIP(), []int{14, 0}
+}
+
 type GetProductRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -69,6 +168,151 @@ func (x *GetProductRequest) GetId() int64 {
 	return 0
 }
 
+type CreateProductRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Output-only fields (id, timestamps, engagement metrics) are ignored
+	Product       *ProductInfo `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProductRequest) Reset() {
+	*x = CreateProductRequest{}
+	mi := &file_api_product_v1_product_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductRequest) ProtoMessage() {}
+
+func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_v1_product_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductRequest.ProtoReflect.Descriptor instead.
+func (*CreateProductRequest) Descriptor() ([]byte, []int) {
+	return file_api_product_v1_product_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateProductRequest) GetProduct() *ProductInfo {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type UpdateProductRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Product *ProductInfo           `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	// Fields to overwrite, e.g. "title,description". Fields listed here are
+	// written even when empty, which clears them. When omitted, only non-empty
+	// fields of product are applied, so out_of_stock and featured can only be
+	// set to false through the mask.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductRequest) Reset() {
+	*x = UpdateProductRequest{}
+	mi := &file_api_product_v1_product_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductRequest) ProtoMessage() {}
+
+func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_v1_product_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProductRequest) Descriptor() ([]byte, []int) {
+	return file_api_product_v1_product_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UpdateProductRequest) GetProduct() *ProductInfo {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *UpdateProductRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductRequest) Reset() {
+	*x = DeleteProductRequest{}
+	mi := &file_api_product_v1_product_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductRequest) ProtoMessage() {}
+
+func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_v1_product_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductRequest) Descriptor() ([]byte, []int) {
+	return file_api_product_v1_product_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DeleteProductRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
 type GetProductByPIDRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Pid           string                 `protobuf:"bytes,1,opt,name=pid,proto3" json:"pid,omitempty"`
@@ -78,7 +322,7 @@ type GetProductByPIDRequest struct {
 
 func (x *GetProductByPIDRequest) Reset() {
 	*x = GetProductByPIDRequest{}
-	mi := &file_api_product_v1_product_proto_msgTypes[1]
+	mi := &file_api_product_v1_product_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -90,7 +334,7 @@ func (x *GetProductByPIDRequest) String() string {
 func (*GetProductByPIDRequest) ProtoMessage() {}
 
 func (x *GetProductByPIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_product_v1_product_proto_msgTypes[1]
+	mi := &file_api_product_v1_product_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -103,7 +347,7 @@ func (x *GetProductByPIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProductByPIDRequest.ProtoReflect.Descriptor instead.
 func (*GetProductByPIDRequest) Descriptor() ([]byte, []int) {
-	return file_api_product_v1_product_proto_rawDescGZIP(), []int{1}
+	return file_api_product_v1_product_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetProductByPIDRequest) GetPid() string {
@@ -114,28 +358,42 @@ func (x *GetProductByPIDRequest) GetPid() string {
 }
 
 type ListProductsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
-	Brand         string                 `protobuf:"bytes,4,opt,name=brand,proto3" json:"brand,omitempty"`
-	SubCategory   string                 `protobuf:"bytes,5,opt,name=sub_category,json=subCategory,proto3" json:"sub_category,omitempty"`
-	MinPrice      int32                  `protobuf:"varint,6,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`
-	MaxPrice      int32                  `protobuf:"varint,7,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
-	MinRating     float32                `protobuf:"fixed32,8,opt,name=min_rating,json=minRating,proto3" json:"min_rating,omitempty"`
-	InStock       bool                   `protobuf:"varint,9,opt,name=in_stock,json=inStock,proto3" json:"in_stock,omitempty"`
-	FeaturedOnly  bool                   `protobuf:"varint,10,opt,name=featured_only,json=featuredOnly,proto3" json:"featured_only,omitempty"`
-	Seller        string                 `protobuf:"bytes,11,opt,name=seller,proto3" json:"seller,omitempty"`
-	SortBy        string                 `protobuf:"bytes,12,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`          // "price", "rating", "newest", "popular"
-	SortOrder     string                 `protobuf:"bytes,13,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"` // "asc", "desc"
-	SearchQuery   string                 `protobuf:"bytes,14,opt,name=search_query,json=searchQuery,proto3" json:"search_query,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Page         int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize     int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Category     string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	Brand        string                 `protobuf:"bytes,4,opt,name=brand,proto3" json:"brand,omitempty"`
+	SubCategory  string                 `protobuf:"bytes,5,opt,name=sub_category,json=subCategory,proto3" json:"sub_category,omitempty"`
+	MinPrice     int32                  `protobuf:"varint,6,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`
+	MaxPrice     int32                  `protobuf:"varint,7,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
+	MinRating    float32                `protobuf:"fixed32,8,opt,name=min_rating,json=minRating,proto3" json:"min_rating,omitempty"`
+	InStock      bool                   `protobuf:"varint,9,opt,name=in_stock,json=inStock,proto3" json:"in_stock,omitempty"`
+	FeaturedOnly bool                   `protobuf:"varint,10,opt,name=featured_only,json=featuredOnly,proto3" json:"featured_only,omitempty"`
+	Seller       string                 `protobuf:"bytes,11,opt,name=seller,proto3" json:"seller,omitempty"`
+	SortBy       string                 `protobuf:"bytes,12,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`          // "price", "rating", "newest", "popular"
+	SortOrder    string                 `protobuf:"bytes,13,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"` // "asc", "desc"
+	SearchQuery  string                 `protobuf:"bytes,14,opt,name=search_query,json=searchQuery,proto3" json:"search_query,omitempty"`
+	// Return facet counts for the filter sidebar
+	IncludeFacets bool `protobuf:"varint,15,opt,name=include_facets,json=includeFacets,proto3" json:"include_facets,omitempty"`
+	// next_page_token of the previous page. When set, page is ignored and the
+	// listing continues after the last product returned, so rows inserted
+	// meanwhile do not shift the pages. Filters and sorting must not change.
+	PageToken string `protobuf:"bytes,16,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Count the total on pages fetched with a page_token as well
+	IncludeTotal bool `protobuf:"varint,17,opt,name=include_total,json=includeTotal,proto3" json:"include_total,omitempty"`
+	// Attribute filters, e.g. attributes[color]=blue; values match
+	// case-insensitively
+	Attributes map[string]string `protobuf:"bytes,18,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Attribute filters with several values or a match mode; they combine
+	// with attributes and with each other by AND
+	AttributeFilters []*AttributeFilter `protobuf:"bytes,19,rep,name=attribute_filters,json=attributeFilters,proto3" json:"attribute_filters,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *ListProductsRequest) Reset() {
 	*x = ListProductsRequest{}
-	mi := &file_api_product_v1_product_proto_msgTypes[2]
+	mi := &file_api_product_v1_product_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -147,7 +405,7 @@ func (x *ListProductsRequest) String() string {
 func (*ListProductsRequest) ProtoMessage() {}
 
 func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_product_v1_product_proto_msgTypes[2]
+	mi := &file_api_product_v1_product_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -160,7 +418,7 @@ func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListProductsRequest.ProtoReflect.Descriptor instead.
 func (*ListProductsRequest) Descriptor() ([]byte, []int) {
-	return file_api_product_v1_product_proto_rawDescGZIP(), []int{2}
+	return file_api_product_v1_product_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ListProductsRequest) GetPage() int32 {
@@ -261,19 +519,117 @@ func (x *ListProductsRequest) GetSearchQuery() string {
 	return ""
 }
 
-type SearchProductsRequest struct {
+func (x *ListProductsRequest) GetIncludeFacets() bool {
+	if x != nil {
+		return x.IncludeFacets
+	}
+	return false
+}
+
+func (x *ListProductsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetIncludeTotal() bool {
+	if x != nil {
+		return x.IncludeTotal
+	}
+	return false
+}
+
+func (x *ListProductsRequest) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *ListProductsRequest) GetAttributeFilters() []*AttributeFilter {
+	if x != nil {
+		return x.AttributeFilters
+	}
+	return nil
+}
+
+// AttributeFilter keeps products by one attribute, e.g. color in (blue, red).
+type AttributeFilter struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
-	PriceRange    *PriceRange            `protobuf:"bytes,4,opt,name=price_range,json=priceRange,proto3" json:"price_range,omitempty"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Values        []string               `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	Match         AttributeFilter_Match  `protobuf:"varint,3,opt,name=match,proto3,enum=api.product.v1.AttributeFilter_Match" json:"match,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttributeFilter) Reset() {
+	*x = AttributeFilter{}
+	mi := &file_api_product_v1_product_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttributeFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeFilter) ProtoMessage() {}
+
+func (x *AttributeFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_v1_product_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeFilter.ProtoReflect.Descriptor instead.
+func (*AttributeFilter) Descriptor() ([]byte, []int) {
+	return file_api_product_v1_product_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AttributeFilter) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *AttributeFilter) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *AttributeFilter) GetMatch() AttributeFilter_Match {
+	if x != nil {
+		return x.Match
+	}
+	return AttributeFilter_ANY
+}
+
+type SearchProductsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Query      string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Limit      int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Category   string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	PriceRange *PriceRange            `protobuf:"bytes,4,opt,name=price_range,json=priceRange,proto3" json:"price_range,omitempty"`
+	// Return facet counts for the filter sidebar
+	IncludeFacets bool `protobuf:"varint,5,opt,name=include_facets,json=includeFacets,proto3" json:"include_facets,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SearchProductsRequest) Reset() {
 	*x = SearchProductsRequest{}
-	mi := &file_api_product_v1_product_proto_msgTypes[3]
+	mi := &file_api_product_v1_product_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -285,7 +641,7 @@ func (x *SearchProductsRequest) String() string {
 func (*SearchProductsRequest) ProtoMessage() {}
 
 func (x *SearchProductsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_product_v1_product_proto_msgTypes[3]
+	mi := &file_api_product_v1_product_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -298,7 +654,7 @@ func (x *SearchProductsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchProductsRequest.ProtoReflect.Descriptor instead.
 func (*SearchProductsRequest) Descriptor() ([]byte, []int) {
-	return file_api_product_v1_product_proto_rawDescGZIP(), []int{3}
+	return file_api_product_v1_product_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *SearchProductsRequest) GetQuery() string {
@@ -329,6 +685,13 @@ func (x *SearchProductsRequest) GetPriceRange() *PriceRange {
 	return nil
 }
 
+func (x *SearchProductsRequest) GetIncludeFacets() bool {
+	if x != nil {
+		return x.IncludeFacets
+	}
+	return false
+}
+
 type GetFeaturedProductsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
@@ -339,7 +702,7 @@ type GetFeaturedProductsRequest struct {
 
 func (x *GetFeaturedProductsRequest) Reset() {
 	*x = GetFeaturedProductsRequest{}
-	mi := &file_api_product_v1_product_proto_msgTypes[4]
+	mi := &file_api_product_v1_product_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -351,7 +714,7 @@ func (x *GetFeaturedProductsRequest) String() string {
 func (*GetFeaturedProductsRequest) ProtoMessage() {}
 
 func (x *GetFeaturedProductsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_product_v1_product_proto_msgTypes[4]
+	mi := &file_api_product_v1_product_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -364,7 +727,7 @@ func (x *GetFeaturedProductsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFeaturedProductsRequest.ProtoReflect.Descriptor instead.
 func (*GetFeaturedProductsRequest) Descriptor() ([]byte, []int) {
-	return file_api_product_v1_product_proto_rawDescGZIP(), []int{4}
+	return file_api_product_v1_product_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetFeaturedProductsRequest) GetLimit() int32 {
@@ -389,21 +752,1755 @@ type GetSimilarProductsRequest struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSimilarProductsRequest) Reset() {
-	*x = GetSimilarProductsRequest{}
-	mi := &file_api_product_v1_product_proto_msgTypes[5]
+func (x *GetSimilarProductsRequest) Reset() {
+	*x = GetSimilarProductsRequest{}
+	mi := &file_api_product_v1_product_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSimilarProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSimilarProductsRequest) ProtoMessage() {}
+
+func (x *GetSimilarProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_v1_product_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSimilarProductsRequest.ProtoReflect.Descriptor instead.
+func (*GetSimilarProductsRequest) Descriptor() ([]byte, []int) {
+	return file_api_product_v1_product_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetSimilarProductsRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetSimilarProductsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SemanticSearchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	PriceRange    *PriceRange            `protobuf:"bytes,4,opt,name=price_range,json=priceRange,proto3" json:"price_range,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SemanticSearchRequest) Reset() {
+	*x = SemanticSearchRequest{}
+	mi := &file_api_product_v1_product_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SemanticSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SemanticSearchRequest) ProtoMessage() {}
+
+func (x *SemanticSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_v1_product_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SemanticSearchRequest.ProtoReflect.Descriptor instead.
+func (*SemanticSearchRequest) Descriptor() ([]byte, []int) {
+	return file_api_product_v1_product_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SemanticSearchRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SemanticSearchRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SemanticSearchRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SemanticSearchRequest) GetPriceRange() *PriceRange {
+	if x != nil {
+		return x.PriceRange
+	}
+	return nil
+}
+
+type HybridSearchRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Query      string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Limit      int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Category   string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	PriceRange *PriceRange            `protobuf:"bytes,4,opt,name=price_range,json=priceRange,proto3" json:"price_range,omitempty"`
+	// Per-request overrides of the configured fusion weights; 0 keeps the
+	// configured weight
+	LexicalWeight float32 `protobuf:"fixed32,5,opt,name=lexical_weight,json=lexicalWeight,proto3" json:"lexical_weight,omitempty"`
+	VectorWeight  float32 `protobuf:"fixed32,6,opt,name=vector_weight,json=vectorWeight,proto3" json:"vector_weight,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HybridSearchRequest) Reset() {
+	*x = HybridSearchRequest{}
+	mi := &file_api_product_v1_product_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HybridSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HybridSearchRequest) ProtoMessage() {}
+
+func (x *HybridSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_v1_product_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HybridSearchRequest.ProtoReflect.Descriptor instead.
+func (*HybridSearchRequest) Descriptor() ([]byte, []int) {
+	return file_api_product_v1_product_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *HybridSearchRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *HybridSearchRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *HybridSearchRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *HybridSearchRequest) GetPriceRange() *PriceRange {
+	if x != nil {
+		return x.PriceRange
+	}
+	return nil
+}
+
+func (x *HybridSearchRequest) GetLexicalWeight() float32 {
+	if x != nil {
+		return x.LexicalWeight
+	}
+	return 0
+}
+
+func (x *HybridSearchRequest) GetVectorWeight() float32 {
+	if x != nil {
+		return x.VectorWeight
+	}
+	return 0
+}
+
+type SuggestQueriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prefix        string                 `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestQueriesRequest) Reset() {
+	*x = SuggestQueriesRequest{}
+	mi := &file_api_product_v1_product_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestQueriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestQueriesRequest) ProtoMessage() {}
+
+func (x *SuggestQueriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_v1_product_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestQueriesRequest.ProtoReflect.Descriptor instead.
+func (*SuggestQueriesRequest) Descriptor() ([]byte, []int) {
+	return file_api_product_v1_product_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SuggestQueriesRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *SuggestQueriesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type AskCatalogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	PriceRange    *PriceRange            `protobuf:"bytes,4,opt,name=price_range,json=priceRange,proto3" json:"price_range,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AskCatalogRequest) Reset() {
+	*x = AskCatalogRequest{}
+	mi := &file_api_product_v1_product_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AskCatalogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AskCatalogRequest) ProtoMessage() {}
+
+func (x *AskCatalogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_v1_product_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AskCatalogRequest.ProtoReflect.Descriptor instead.
+func (*AskCatalogRequest) Descriptor() ([]byte, []int) {
+	return file_api_product_v1_product_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AskCatalogRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *AskCatalogRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *AskCatalogRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *AskCatalogRequest) GetPriceRange() *PriceRange {
+	if x != nil {
+		return x.PriceRange
+	}
+	return nil
+}
+
+type ExportProductsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Filters as in ListProducts; paging and sorting are ignored and products
+	// are exported in id order
+	Filter *ListProductsRequest         `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	Format ExportProductsRequest_Format `protobuf:"varint,2,opt,name=format,proto3,enum=api.product.v1.ExportProductsRequest_Format" json:"format,omitempty"`
+	// Columns to write, in order; all but the embeddings by default
+	Fields []string `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
+	// Add the embedding and embedding_model columns
+	IncludeEmbeddings bool `protobuf:"varint,4,opt,name=include_embeddings,json=includeEmbeddings,proto3" json:"include_embeddings,omitempty"`
+	// Export at most this many products, 0 for all
+	Limit         int32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportProductsRequest) Reset() {
+	*x = ExportProductsRequest{}
+	mi := &file_api_product_v1_product_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportProductsRequest) ProtoMessage() {}
+
+func (x *ExportProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_v1_product_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportProductsRequest.ProtoReflect.Descriptor instead.
+func (*ExportProductsRequest) Descriptor() ([]byte, []int) {
+	return file_api_product_v1_product_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ExportProductsRequest) GetFilter() *ListProductsRequest {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ExportProductsRequest) GetFormat() ExportProductsRequest_Format {
+	if x != nil {
+		return x.Format
+	}
+	return ExportProductsRequest_JSONL
+}
+
+func (x *ExportProductsRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *ExportProductsRequest) GetIncludeEmbeddings() bool {
+	if x != nil {
+		return x.IncludeEmbeddings
+	}
+	return false
+}
+
+func (x *ExportProductsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type StartEmbeddingBackfillRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Start from the first product instead of the saved cursor
+	Restart       bool `protobuf:"varint,1,opt,name=restart,proto3" json:"restart,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartEmbeddingBackfillRequest) Reset() {
+	*x = StartEmbeddingBackfillRequest{}
+	mi := &file_api_product_v1_product_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartEmbeddingBackfillRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartEmbeddingBackfillRequest) ProtoMessage() {}
+
+func (x *StartEmbeddingBackfillRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_v1_product_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartEmbeddingBackfillRequest.ProtoReflect.Descriptor instead.
+func (*StartEmbeddingBackfillRequest) Descriptor() ([]byte, []int) {
+	return file_api_product_v1_product_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *StartEmbeddingBackfillRequest) GetRestart() bool {
+	if x != nil {
+		return x.Restart
+	}
+	return false
+}
+
+type StopEmbeddingBackfillRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopEmbeddingBackfillRequest) Reset() {
+	*x = StopEmbeddingBackfillRequest{}
+	mi := &file_api_product_v1_product_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopEmbeddingBackfillRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopEmbeddingBackfillRequest) ProtoMessage() {}
+
+func (x *StopEmbeddingBackfillRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_v1_product_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopEmbeddingBackfillRequest.ProtoReflect.Descriptor instead.
+func (*StopEmbeddingBackfillRequest) Descriptor() ([]byte, []int) {
+	return file_api_product_v1_product_proto_rawDescGZIP(), []int{16}
+}
+
+type GetEmbeddingBackfillStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEmbeddingBackfillStatusRequest) Reset() {
+	*x = GetEmbeddingBackfillStatusRequest{}
+	mi := &file_api_product_v1_product_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEmbeddingBackfillStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEmbeddingBackfillStatusRequest) ProtoMessage() {}
+
+func (x *GetEmbeddingBackfillStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_v1_product_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEmbeddingBackfillStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetEmbeddingBackfillStatusRequest) Descriptor() ([]byte, []int) {
+	return file_api_product_v1_product_proto_rawDescGZIP(), []int{17}
+}
+
+type ListSearchRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSearchRulesRequest) Reset() {
+	*x = ListSearchRulesRequest{}
+	mi := &file_api_product_v1_product_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSearchRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSearchRulesRequest) ProtoMessage() {}
+
+func (x *ListSearchRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_v1_product_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSearchRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListSearchRulesRequest) Descriptor() ([]byte, []int) {
+	return file_api_product_v1_product_proto_rawDescGZIP(), []int{18}
+}
+
+type CreateSearchRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *SearchRule            `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSearchRuleRequest) Reset() {
+	*x = CreateSearchRuleRequest{}
+	mi := &file_api_product_v1_product_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSearchRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSearchRuleRequest) ProtoMessage() {}
+
+func (x *CreateSearchRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_v1_product_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSearchRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateSearchRuleRequest) Descriptor() ([]byte, []int) {
+	return file_api_product_v1_product_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CreateSearchRuleRequest) GetRule() *SearchRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type UpdateSearchRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *SearchRule            `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSearchRuleRequest) Reset() {
+	*x = UpdateSearchRuleRequest{}
+	mi := &file_api_product_v1_product_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSearchRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSearchRuleRequest) ProtoMessage() {}
+
+func (x *UpdateSearchRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_v1_product_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSearchRuleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSearchRuleRequest) Descriptor() ([]byte, []int) {
+	return file_api_product_v1_product_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UpdateSearchRuleRequest) GetRule() *SearchRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type DeleteSearchRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSearchRuleRequest) Reset() {
+	*x = DeleteSearchRuleRequest{}
+	mi := &file_api_product_v1_product_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSearchRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSearchRuleRequest) ProtoMessage() {}
+
+func (x *DeleteSearchRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_v1_product_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSearchRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSearchRuleRequest) Descriptor() ([]byte, []int) {
+	return file_api_product_v1_product_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeleteSearchRuleRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListProductsReply struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Products []*ProductInfo         `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	// -1 when not counted; see include_total
+	Total    int32 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page     int32 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize int32 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Set when include_facets was requested
+	Facets *Facets `protobuf:"bytes,5,opt,name=facets,proto3" json:"facets,omitempty"`
+	// Token for the next page; empty on the last page
+	NextPageToken string `protobuf:"bytes,6,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// SearchProducts only: set when no product matched the query by keyword
+	// and the results are for this spell-corrected query instead
+	CorrectedQuery string `protobuf:"bytes,7,opt,name=corrected_query,json=correctedQuery,proto3" json:"corrected_query,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListProductsReply) Reset() {
+	*x = ListProductsReply{}
+	mi := &file_api_product_v1_product_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsReply) ProtoMessage() {}
+
+func (x *ListProductsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_v1_product_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsReply.ProtoReflect.Descriptor instead.
+func (*ListProductsReply) Descriptor() ([]byte, []int) {
+	return file_api_product_v1_product_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListProductsReply) GetProducts() []*ProductInfo {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *ListProductsReply) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListProductsReply) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListProductsReply) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListProductsReply) GetFacets() *Facets {
+	if x != nil {
+		return x.Facets
+	}
+	return nil
+}
+
+func (x *ListProductsReply) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListProductsReply) GetCorrectedQuery() string {
+	if x != nil {
+		return x.CorrectedQuery
+	}
+	return ""
+}
+
+// Facets counts the products matching a request under each filter value.
+// Every facet ignores its own filter, so the counts of the alternatives to a
+// selected value stay visible.
+type Facets struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Brands        []*FacetValue          `protobuf:"bytes,1,rep,name=brands,proto3" json:"brands,omitempty"`
+	Categories    []*FacetValue          `protobuf:"bytes,2,rep,name=categories,proto3" json:"categories,omitempty"`
+	SubCategories []*FacetValue          `protobuf:"bytes,3,rep,name=sub_categories,json=subCategories,proto3" json:"sub_categories,omitempty"`
+	Sellers       []*FacetValue          `protobuf:"bytes,4,rep,name=sellers,proto3" json:"sellers,omitempty"`
+	// Histogram over the numeric selling price
+	PriceBuckets []*RangeFacet `protobuf:"bytes,5,rep,name=price_buckets,json=priceBuckets,proto3" json:"price_buckets,omitempty"`
+	// Products rated at least min, for each band
+	RatingBands []*RangeFacet `protobuf:"bytes,6,rep,name=rating_bands,json=ratingBands,proto3" json:"rating_bands,omitempty"`
+	InStock     int64         `protobuf:"varint,7,opt,name=in_stock,json=inStock,proto3" json:"in_stock,omitempty"`
+	OutOfStock  int64         `protobuf:"varint,8,opt,name=out_of_stock,json=outOfStock,proto3" json:"out_of_stock,omitempty"`
+	// Value counts per attribute key, most frequent first
+	Attributes    []*AttributeFacet `protobuf:"bytes,9,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Facets) Reset() {
+	*x = Facets{}
+	mi := &file_api_product_v1_product_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Facets) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Facets) ProtoMessage() {}
+
+func (x *Facets) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_v1_product_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Facets.ProtoReflect.Descriptor instead.
+func (*Facets) Descriptor() ([]byte, []int) {
+	return file_api_product_v1_product_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Facets) GetBrands() []*FacetValue {
+	if x != nil {
+		return x.Brands
+	}
+	return nil
+}
+
+func (x *Facets) GetCategories() []*FacetValue {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *Facets) GetSubCategories() []*FacetValue {
+	if x != nil {
+		return x.SubCategories
+	}
+	return nil
+}
+
+func (x *Facets) GetSellers() []*FacetValue {
+	if x != nil {
+		return x.Sellers
+	}
+	return nil
+}
+
+func (x *Facets) GetPriceBuckets() []*RangeFacet {
+	if x != nil {
+		return x.PriceBuckets
+	}
+	return nil
+}
+
+func (x *Facets) GetRatingBands() []*RangeFacet {
+	if x != nil {
+		return x.RatingBands
+	}
+	return nil
+}
+
+func (x *Facets) GetInStock() int64 {
+	if x != nil {
+		return x.InStock
+	}
+	return 0
+}
+
+func (x *Facets) GetOutOfStock() int64 {
+	if x != nil {
+		return x.OutOfStock
+	}
+	return 0
+}
+
+func (x *Facets) GetAttributes() []*AttributeFacet {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type AttributeFacet struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Values        []*FacetValue          `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttributeFacet) Reset() {
+	*x = AttributeFacet{}
+	mi := &file_api_product_v1_product_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttributeFacet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeFacet) ProtoMessage() {}
+
+func (x *AttributeFacet) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_v1_product_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeFacet.ProtoReflect.Descriptor instead.
+func (*AttributeFacet) Descriptor() ([]byte, []int) {
+	return file_api_product_v1_product_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *AttributeFacet) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *AttributeFacet) GetValues() []*FacetValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type FacetValue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FacetValue) Reset() {
+	*x = FacetValue{}
+	mi := &file_api_product_v1_product_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FacetValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FacetValue) ProtoMessage() {}
+
+func (x *FacetValue) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_v1_product_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FacetValue.ProtoReflect.Descriptor instead.
+func (*FacetValue) Descriptor() ([]byte, []int) {
+	return file_api_product_v1_product_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *FacetValue) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *FacetValue) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type RangeFacet struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Min   float64                `protobuf:"fixed64,1,opt,name=min,proto3" json:"min,omitempty"`
+	// Exclusive upper bound; 0 when the range is open-ended
+	Max           float64 `protobuf:"fixed64,2,opt,name=max,proto3" json:"max,omitempty"`
+	Count         int64   `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RangeFacet) Reset() {
+	*x = RangeFacet{}
+	mi := &file_api_product_v1_product_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RangeFacet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RangeFacet) ProtoMessage() {}
+
+func (x *RangeFacet) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_v1_product_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RangeFacet.ProtoReflect.Descriptor instead.
+func (*RangeFacet) Descriptor() ([]byte, []int) {
+	return file_api_product_v1_product_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RangeFacet) GetMin() float64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *RangeFacet) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *RangeFacet) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type SemanticSearchReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*ScoredProduct       `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SemanticSearchReply) Reset() {
+	*x = SemanticSearchReply{}
+	mi := &file_api_product_v1_product_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SemanticSearchReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SemanticSearchReply) ProtoMessage() {}
+
+func (x *SemanticSearchReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_v1_product_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SemanticSearchReply.ProtoReflect.Descriptor instead.
+func (*SemanticSearchReply) Descriptor() ([]byte, []int) {
+	return file_api_product_v1_product_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SemanticSearchReply) GetResults() []*ScoredProduct {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type HybridSearchReply struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Results []*HybridSearchResult  `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// "hybrid", or "lexical" when semantic search was unavailable
+	Mode string `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`
+	// Set when no product matched the query by keyword and the results are
+	// for this spell-corrected query instead
+	CorrectedQuery string `protobuf:"bytes,3,opt,name=corrected_query,json=correctedQuery,proto3" json:"corrected_query,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *HybridSearchReply) Reset() {
+	*x = HybridSearchReply{}
+	mi := &file_api_product_v1_product_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HybridSearchReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HybridSearchReply) ProtoMessage() {}
+
+func (x *HybridSearchReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_v1_product_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HybridSearchReply.ProtoReflect.Descriptor instead.
+func (*HybridSearchReply) Descriptor() ([]byte, []int) {
+	return file_api_product_v1_product_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *HybridSearchReply) GetResults() []*HybridSearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *HybridSearchReply) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *HybridSearchReply) GetCorrectedQuery() string {
+	if x != nil {
+		return x.CorrectedQuery
+	}
+	return ""
+}
+
+type HybridSearchResult struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Product *ProductInfo           `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	// Fused reciprocal rank score
+	Score float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	// 1-based rank in each retriever's results; 0 when it did not return the
+	// product
+	LexicalRank int32 `protobuf:"varint,3,opt,name=lexical_rank,json=lexicalRank,proto3" json:"lexical_rank,omitempty"`
+	VectorRank  int32 `protobuf:"varint,4,opt,name=vector_rank,json=vectorRank,proto3" json:"vector_rank,omitempty"`
+	// Cosine similarity to the query, when vector search returned the product
+	VectorScore   float32 `protobuf:"fixed32,5,opt,name=vector_score,json=vectorScore,proto3" json:"vector_score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HybridSearchResult) Reset() {
+	*x = HybridSearchResult{}
+	mi := &file_api_product_v1_product_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HybridSearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HybridSearchResult) ProtoMessage() {}
+
+func (x *HybridSearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_v1_product_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HybridSearchResult.ProtoReflect.Descriptor instead.
+func (*HybridSearchResult) Descriptor() ([]byte, []int) {
+	return file_api_product_v1_product_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *HybridSearchResult) GetProduct() *ProductInfo {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *HybridSearchResult) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *HybridSearchResult) GetLexicalRank() int32 {
+	if x != nil {
+		return x.LexicalRank
+	}
+	return 0
+}
+
+func (x *HybridSearchResult) GetVectorRank() int32 {
+	if x != nil {
+		return x.VectorRank
+	}
+	return 0
+}
+
+func (x *HybridSearchResult) GetVectorScore() float32 {
+	if x != nil {
+		return x.VectorScore
+	}
+	return 0
+}
+
+type SuggestQueriesReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Suggestions   []*QuerySuggestion     `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestQueriesReply) Reset() {
+	*x = SuggestQueriesReply{}
+	mi := &file_api_product_v1_product_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestQueriesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestQueriesReply) ProtoMessage() {}
+
+func (x *SuggestQueriesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_v1_product_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestQueriesReply.ProtoReflect.Descriptor instead.
+func (*SuggestQueriesReply) Descriptor() ([]byte, []int) {
+	return file_api_product_v1_product_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SuggestQueriesReply) GetSuggestions() []*QuerySuggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+type QuerySuggestion struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Text  string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	// "brand", "category", "title" or "keyword"
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	// Combined views and clicks of the matching products
+	Popularity    int64 `protobuf:"varint,3,opt,name=popularity,proto3" json:"popularity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuerySuggestion) Reset() {
+	*x = QuerySuggestion{}
+	mi := &file_api_product_v1_product_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuerySuggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuerySuggestion) ProtoMessage() {}
+
+func (x *QuerySuggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_v1_product_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuerySuggestion.ProtoReflect.Descriptor instead.
+func (*QuerySuggestion) Descriptor() ([]byte, []int) {
+	return file_api_product_v1_product_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *QuerySuggestion) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *QuerySuggestion) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *QuerySuggestion) GetPopularity() int64 {
+	if x != nil {
+		return x.Popularity
+	}
+	return 0
+}
+
+type AskCatalogReply struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Results []*ScoredProduct       `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// Generated answer; empty when no chat model is configured
+	Answer string `protobuf:"bytes,2,opt,name=answer,proto3" json:"answer,omitempty"`
+	// PIDs cited by the answer, all of which appear in results
+	CitedPids     []string `protobuf:"bytes,3,rep,name=cited_pids,json=citedPids,proto3" json:"cited_pids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AskCatalogReply) Reset() {
+	*x = AskCatalogReply{}
+	mi := &file_api_product_v1_product_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AskCatalogReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AskCatalogReply) ProtoMessage() {}
+
+func (x *AskCatalogReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_v1_product_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AskCatalogReply.ProtoReflect.Descriptor instead.
+func (*AskCatalogReply) Descriptor() ([]byte, []int) {
+	return file_api_product_v1_product_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *AskCatalogReply) GetResults() []*ScoredProduct {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *AskCatalogReply) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
+func (x *AskCatalogReply) GetCitedPids() []string {
+	if x != nil {
+		return x.CitedPids
+	}
+	return nil
+}
+
+// ExportProductsChunk is the next part of the export file.
+type ExportProductsChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportProductsChunk) Reset() {
+	*x = ExportProductsChunk{}
+	mi := &file_api_product_v1_product_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportProductsChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportProductsChunk) ProtoMessage() {}
+
+func (x *ExportProductsChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_v1_product_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportProductsChunk.ProtoReflect.Descriptor instead.
+func (*ExportProductsChunk) Descriptor() ([]byte, []int) {
+	return file_api_product_v1_product_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ExportProductsChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type AskCatalogEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Event:
+	//
+	//	*AskCatalogEvent_Products_
+	//	*AskCatalogEvent_Delta
+	//	*AskCatalogEvent_Citations_
+	Event         isAskCatalogEvent_Event `protobuf_oneof:"event"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AskCatalogEvent) Reset() {
+	*x = AskCatalogEvent{}
+	mi := &file_api_product_v1_product_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AskCatalogEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AskCatalogEvent) ProtoMessage() {}
+
+func (x *AskCatalogEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_v1_product_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AskCatalogEvent.ProtoReflect.Descriptor instead.
+func (*AskCatalogEvent) Descriptor() ([]byte, []int) {
+	return file_api_product_v1_product_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *AskCatalogEvent) GetEvent() isAskCatalogEvent_Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *AskCatalogEvent) GetProducts() *AskCatalogEvent_Products {
+	if x != nil {
+		if x, ok := x.Event.(*AskCatalogEvent_Products_); ok {
+			return x.Products
+		}
+	}
+	return nil
+}
+
+func (x *AskCatalogEvent) GetDelta() string {
+	if x != nil {
+		if x, ok := x.Event.(*AskCatalogEvent_Delta); ok {
+			return x.Delta
+		}
+	}
+	return ""
+}
+
+func (x *AskCatalogEvent) GetCitations() *AskCatalogEvent_Citations {
+	if x != nil {
+		if x, ok := x.Event.(*AskCatalogEvent_Citations_); ok {
+			return x.Citations
+		}
+	}
+	return nil
+}
+
+type isAskCatalogEvent_Event interface {
+	isAskCatalogEvent_Event()
+}
+
+type AskCatalogEvent_Products_ struct {
+	Products *AskCatalogEvent_Products `protobuf:"bytes,1,opt,name=products,proto3,oneof"`
+}
+
+type AskCatalogEvent_Delta struct {
+	Delta string `protobuf:"bytes,2,opt,name=delta,proto3,oneof"`
+}
+
+type AskCatalogEvent_Citations_ struct {
+	Citations *AskCatalogEvent_Citations `protobuf:"bytes,3,opt,name=citations,proto3,oneof"`
+}
+
+func (*AskCatalogEvent_Products_) isAskCatalogEvent_Event() {}
+
+func (*AskCatalogEvent_Delta) isAskCatalogEvent_Event() {}
+
+func (*AskCatalogEvent_Citations_) isAskCatalogEvent_Event() {}
+
+type EmbeddingBackfillStatus struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "idle", "running", "stopped", "completed" or "failed"
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// ID of the last product handled
+	Cursor    int64 `protobuf:"varint,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Processed int64 `protobuf:"varint,3,opt,name=processed,proto3" json:"processed,omitempty"`
+	Failed    int64 `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	// Products still without an embedding
+	Remaining     int64                  `protobuf:"varint,5,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	LastError     string                 `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	DeadLetters   []*EmbeddingFailure    `protobuf:"bytes,9,rep,name=dead_letters,json=deadLetters,proto3" json:"dead_letters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmbeddingBackfillStatus) Reset() {
+	*x = EmbeddingBackfillStatus{}
+	mi := &file_api_product_v1_product_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmbeddingBackfillStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmbeddingBackfillStatus) ProtoMessage() {}
+
+func (x *EmbeddingBackfillStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_v1_product_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmbeddingBackfillStatus.ProtoReflect.Descriptor instead.
+func (*EmbeddingBackfillStatus) Descriptor() ([]byte, []int) {
+	return file_api_product_v1_product_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *EmbeddingBackfillStatus) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *EmbeddingBackfillStatus) GetCursor() int64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *EmbeddingBackfillStatus) GetProcessed() int64 {
+	if x != nil {
+		return x.Processed
+	}
+	return 0
+}
+
+func (x *EmbeddingBackfillStatus) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *EmbeddingBackfillStatus) GetRemaining() int64 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *EmbeddingBackfillStatus) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *EmbeddingBackfillStatus) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *EmbeddingBackfillStatus) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+func (x *EmbeddingBackfillStatus) GetDeadLetters() []*EmbeddingFailure {
+	if x != nil {
+		return x.DeadLetters
+	}
+	return nil
+}
+
+type ListSearchRulesReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*SearchRule          `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSearchRulesReply) Reset() {
+	*x = ListSearchRulesReply{}
+	mi := &file_api_product_v1_product_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSearchRulesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSearchRulesReply) ProtoMessage() {}
+
+func (x *ListSearchRulesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_v1_product_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSearchRulesReply.ProtoReflect.Descriptor instead.
+func (*ListSearchRulesReply) Descriptor() ([]byte, []int) {
+	return file_api_product_v1_product_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListSearchRulesReply) GetRules() []*SearchRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+// SearchRule rewrites search queries containing one of its terms. Terms
+// match whole words, case-insensitively.
+type SearchRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// "two_way": every term also matches the other terms.
+	// "one_way": terms also match synonyms, but not the other way round.
+	// "category_boost": results in category score boost times higher.
+	Kind     string   `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Terms    []string `protobuf:"bytes,3,rep,name=terms,proto3" json:"terms,omitempty"`
+	Synonyms []string `protobuf:"bytes,4,rep,name=synonyms,proto3" json:"synonyms,omitempty"`
+	Category string   `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
+	Boost    float64  `protobuf:"fixed64,6,opt,name=boost,proto3" json:"boost,omitempty"`
+	// Disabled rules are kept but not applied
+	Disabled      bool                   `protobuf:"varint,7,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchRule) Reset() {
+	*x = SearchRule{}
+	mi := &file_api_product_v1_product_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSimilarProductsRequest) String() string {
+func (x *SearchRule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSimilarProductsRequest) ProtoMessage() {}
+func (*SearchRule) ProtoMessage() {}
 
-func (x *GetSimilarProductsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_product_v1_product_proto_msgTypes[5]
+func (x *SearchRule) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_v1_product_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -414,50 +2511,99 @@ func (x *GetSimilarProductsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSimilarProductsRequest.ProtoReflect.Descriptor instead.
-func (*GetSimilarProductsRequest) Descriptor() ([]byte, []int) {
-	return file_api_product_v1_product_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use SearchRule.ProtoReflect.Descriptor instead.
+func (*SearchRule) Descriptor() ([]byte, []int) {
+	return file_api_product_v1_product_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *GetSimilarProductsRequest) GetId() int64 {
+func (x *SearchRule) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *GetSimilarProductsRequest) GetLimit() int32 {
+func (x *SearchRule) GetKind() string {
 	if x != nil {
-		return x.Limit
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *SearchRule) GetTerms() []string {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+func (x *SearchRule) GetSynonyms() []string {
+	if x != nil {
+		return x.Synonyms
+	}
+	return nil
+}
+
+func (x *SearchRule) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SearchRule) GetBoost() float64 {
+	if x != nil {
+		return x.Boost
 	}
 	return 0
 }
 
-type ListProductsReply struct {
+func (x *SearchRule) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *SearchRule) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *SearchRule) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type EmbeddingFailure struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Products      []*ProductInfo         `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Attempts      int32                  `protobuf:"varint,2,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError     string                 `protobuf:"bytes,3,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProductsReply) Reset() {
-	*x = ListProductsReply{}
-	mi := &file_api_product_v1_product_proto_msgTypes[6]
+func (x *EmbeddingFailure) Reset() {
+	*x = EmbeddingFailure{}
+	mi := &file_api_product_v1_product_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProductsReply) String() string {
+func (x *EmbeddingFailure) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProductsReply) ProtoMessage() {}
+func (*EmbeddingFailure) ProtoMessage() {}
 
-func (x *ListProductsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_product_v1_product_proto_msgTypes[6]
+func (x *EmbeddingFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_v1_product_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -468,37 +2614,37 @@ func (x *ListProductsReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProductsReply.ProtoReflect.Descriptor instead.
-func (*ListProductsReply) Descriptor() ([]byte, []int) {
-	return file_api_product_v1_product_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use EmbeddingFailure.ProtoReflect.Descriptor instead.
+func (*EmbeddingFailure) Descriptor() ([]byte, []int) {
+	return file_api_product_v1_product_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *ListProductsReply) GetProducts() []*ProductInfo {
+func (x *EmbeddingFailure) GetProductId() int64 {
 	if x != nil {
-		return x.Products
+		return x.ProductId
 	}
-	return nil
+	return 0
 }
 
-func (x *ListProductsReply) GetTotal() int32 {
+func (x *EmbeddingFailure) GetAttempts() int32 {
 	if x != nil {
-		return x.Total
+		return x.Attempts
 	}
 	return 0
 }
 
-func (x *ListProductsReply) GetPage() int32 {
+func (x *EmbeddingFailure) GetLastError() string {
 	if x != nil {
-		return x.Page
+		return x.LastError
 	}
-	return 0
+	return ""
 }
 
-func (x *ListProductsReply) GetPageSize() int32 {
+func (x *EmbeddingFailure) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.PageSize
+		return x.UpdatedAt
 	}
-	return 0
+	return nil
 }
 
 type ProductInfo struct {
@@ -538,16 +2684,20 @@ type ProductInfo struct {
 	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,24,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,25,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	// Engagement metrics
-	ViewCount     int32 `protobuf:"varint,26,opt,name=view_count,json=viewCount,proto3" json:"view_count,omitempty"`
-	ClickCount    int32 `protobuf:"varint,27,opt,name=click_count,json=clickCount,proto3" json:"click_count,omitempty"`
-	Featured      bool  `protobuf:"varint,28,opt,name=featured,proto3" json:"featured,omitempty"`
+	ViewCount  int32 `protobuf:"varint,26,opt,name=view_count,json=viewCount,proto3" json:"view_count,omitempty"`
+	ClickCount int32 `protobuf:"varint,27,opt,name=click_count,json=clickCount,proto3" json:"click_count,omitempty"`
+	Featured   bool  `protobuf:"varint,28,opt,name=featured,proto3" json:"featured,omitempty"`
+	// Derived from the pricing and product_details; output only
+	ActualPriceNumeric int32 `protobuf:"varint,29,opt,name=actual_price_numeric,json=actualPriceNumeric,proto3" json:"actual_price_numeric,omitempty"`
+	// Set by GetProduct and GetProductByPID
+	Attributes    []*ProductAttribute `protobuf:"bytes,30,rep,name=attributes,proto3" json:"attributes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ProductInfo) Reset() {
 	*x = ProductInfo{}
-	mi := &file_api_product_v1_product_proto_msgTypes[7]
+	mi := &file_api_product_v1_product_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -559,7 +2709,7 @@ func (x *ProductInfo) String() string {
 func (*ProductInfo) ProtoMessage() {}
 
 func (x *ProductInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_api_product_v1_product_proto_msgTypes[7]
+	mi := &file_api_product_v1_product_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -572,7 +2722,7 @@ func (x *ProductInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProductInfo.ProtoReflect.Descriptor instead.
 func (*ProductInfo) Descriptor() ([]byte, []int) {
-	return file_api_product_v1_product_proto_rawDescGZIP(), []int{7}
+	return file_api_product_v1_product_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *ProductInfo) GetId() int64 {
@@ -771,6 +2921,128 @@ func (x *ProductInfo) GetFeatured() bool {
 	return false
 }
 
+func (x *ProductInfo) GetActualPriceNumeric() int32 {
+	if x != nil {
+		return x.ActualPriceNumeric
+	}
+	return 0
+}
+
+func (x *ProductInfo) GetAttributes() []*ProductAttribute {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+// ProductAttribute is a normalised product detail, e.g. color=blue.
+type ProductAttribute struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "color", "fabric", "pattern", "size" or "closure"
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Lower-cased
+	Value         string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductAttribute) Reset() {
+	*x = ProductAttribute{}
+	mi := &file_api_product_v1_product_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductAttribute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductAttribute) ProtoMessage() {}
+
+func (x *ProductAttribute) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_v1_product_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductAttribute.ProtoReflect.Descriptor instead.
+func (*ProductAttribute) Descriptor() ([]byte, []int) {
+	return file_api_product_v1_product_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ProductAttribute) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ProductAttribute) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type ScoredProduct struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Product *ProductInfo           `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	// Cosine similarity between the query and the product, in [-1, 1]
+	Score         float32 `protobuf:"fixed32,2,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScoredProduct) Reset() {
+	*x = ScoredProduct{}
+	mi := &file_api_product_v1_product_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoredProduct) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoredProduct) ProtoMessage() {}
+
+func (x *ScoredProduct) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_v1_product_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoredProduct.ProtoReflect.Descriptor instead.
+func (*ScoredProduct) Descriptor() ([]byte, []int) {
+	return file_api_product_v1_product_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ScoredProduct) GetProduct() *ProductInfo {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *ScoredProduct) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
 type PriceRange struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Min           int32                  `protobuf:"varint,1,opt,name=min,proto3" json:"min,omitempty"`
@@ -781,7 +3053,7 @@ type PriceRange struct {
 
 func (x *PriceRange) Reset() {
 	*x = PriceRange{}
-	mi := &file_api_product_v1_product_proto_msgTypes[8]
+	mi := &file_api_product_v1_product_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -793,7 +3065,7 @@ func (x *PriceRange) String() string {
 func (*PriceRange) ProtoMessage() {}
 
 func (x *PriceRange) ProtoReflect() protoreflect.Message {
-	mi := &file_api_product_v1_product_proto_msgTypes[8]
+	mi := &file_api_product_v1_product_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -806,7 +3078,7 @@ func (x *PriceRange) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PriceRange.ProtoReflect.Descriptor instead.
 func (*PriceRange) Descriptor() ([]byte, []int) {
-	return file_api_product_v1_product_proto_rawDescGZIP(), []int{8}
+	return file_api_product_v1_product_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *PriceRange) GetMin() int32 {
@@ -823,15 +3095,112 @@ func (x *PriceRange) GetMax() int32 {
 	return 0
 }
 
+type AskCatalogEvent_Products struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*ScoredProduct       `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AskCatalogEvent_Products) Reset() {
+	*x = AskCatalogEvent_Products{}
+	mi := &file_api_product_v1_product_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AskCatalogEvent_Products) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AskCatalogEvent_Products) ProtoMessage() {}
+
+func (x *AskCatalogEvent_Products) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_v1_product_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AskCatalogEvent_Products.ProtoReflect.Descriptor instead.
+func (*AskCatalogEvent_Products) Descriptor() ([]byte, []int) {
+	return file_api_product_v1_product_proto_rawDescGZIP(), []int{34, 0}
+}
+
+func (x *AskCatalogEvent_Products) GetResults() []*ScoredProduct {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type AskCatalogEvent_Citations struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// PIDs cited by the answer, all of which appear in the products event
+	CitedPids     []string `protobuf:"bytes,1,rep,name=cited_pids,json=citedPids,proto3" json:"cited_pids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AskCatalogEvent_Citations) Reset() {
+	*x = AskCatalogEvent_Citations{}
+	mi := &file_api_product_v1_product_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AskCatalogEvent_Citations) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AskCatalogEvent_Citations) ProtoMessage() {}
+
+func (x *AskCatalogEvent_Citations) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_v1_product_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AskCatalogEvent_Citations.ProtoReflect.Descriptor instead.
+func (*AskCatalogEvent_Citations) Descriptor() ([]byte, []int) {
+	return file_api_product_v1_product_proto_rawDescGZIP(), []int{34, 1}
+}
+
+func (x *AskCatalogEvent_Citations) GetCitedPids() []string {
+	if x != nil {
+		return x.CitedPids
+	}
+	return nil
+}
+
 var File_api_product_v1_product_proto protoreflect.FileDescriptor
 
 const file_api_product_v1_product_proto_rawDesc = "" +
 	"\n" +
-	"\x1capi/product/v1/product.proto\x12\x0eapi.product.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a)api/product/v1/product_error_reason.proto\"#\n" +
+	"\x1capi/product/v1/product.proto\x12\x0eapi.product.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a google/protobuf/field_mask.proto\x1a)api/product/v1/product_error_reason.proto\"#\n" +
 	"\x11GetProductRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"M\n" +
+	"\x14CreateProductRequest\x125\n" +
+	"\aproduct\x18\x01 \x01(\v2\x1b.api.product.v1.ProductInfoR\aproduct\"\x8a\x01\n" +
+	"\x14UpdateProductRequest\x125\n" +
+	"\aproduct\x18\x01 \x01(\v2\x1b.api.product.v1.ProductInfoR\aproduct\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"&\n" +
+	"\x14DeleteProductRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"*\n" +
 	"\x16GetProductByPIDRequest\x12\x10\n" +
-	"\x03pid\x18\x01 \x01(\tR\x03pid\"\xa7\x03\n" +
+	"\x03pid\x18\x01 \x01(\tR\x03pid\"\xf4\x05\n" +
 	"\x13ListProductsRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1a\n" +
@@ -849,24 +3218,191 @@ const file_api_product_v1_product_proto_rawDesc = "" +
 	"\asort_by\x18\f \x01(\tR\x06sortBy\x12\x1d\n" +
 	"\n" +
 	"sort_order\x18\r \x01(\tR\tsortOrder\x12!\n" +
-	"\fsearch_query\x18\x0e \x01(\tR\vsearchQuery\"\x9c\x01\n" +
+	"\fsearch_query\x18\x0e \x01(\tR\vsearchQuery\x12%\n" +
+	"\x0einclude_facets\x18\x0f \x01(\bR\rincludeFacets\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x10 \x01(\tR\tpageToken\x12#\n" +
+	"\rinclude_total\x18\x11 \x01(\bR\fincludeTotal\x12S\n" +
+	"\n" +
+	"attributes\x18\x12 \x03(\v23.api.product.v1.ListProductsRequest.AttributesEntryR\n" +
+	"attributes\x12L\n" +
+	"\x11attribute_filters\x18\x13 \x03(\v2\x1f.api.product.v1.AttributeFilterR\x10attributeFilters\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x9d\x01\n" +
+	"\x0fAttributeFilter\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x16\n" +
+	"\x06values\x18\x02 \x03(\tR\x06values\x12;\n" +
+	"\x05match\x18\x03 \x01(\x0e2%.api.product.v1.AttributeFilter.MatchR\x05match\"#\n" +
+	"\x05Match\x12\a\n" +
+	"\x03ANY\x10\x00\x12\a\n" +
+	"\x03ALL\x10\x01\x12\b\n" +
+	"\x04NONE\x10\x02\"\xc3\x01\n" +
 	"\x15SearchProductsRequest\x12\x14\n" +
 	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x1a\n" +
 	"\bcategory\x18\x03 \x01(\tR\bcategory\x12;\n" +
 	"\vprice_range\x18\x04 \x01(\v2\x1a.api.product.v1.PriceRangeR\n" +
-	"priceRange\"N\n" +
+	"priceRange\x12%\n" +
+	"\x0einclude_facets\x18\x05 \x01(\bR\rincludeFacets\"N\n" +
 	"\x1aGetFeaturedProductsRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x1a\n" +
 	"\bcategory\x18\x02 \x01(\tR\bcategory\"A\n" +
 	"\x19GetSimilarProductsRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
-	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\x93\x01\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\x9c\x01\n" +
+	"\x15SemanticSearchRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\x12;\n" +
+	"\vprice_range\x18\x04 \x01(\v2\x1a.api.product.v1.PriceRangeR\n" +
+	"priceRange\"\xe6\x01\n" +
+	"\x13HybridSearchRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\x12;\n" +
+	"\vprice_range\x18\x04 \x01(\v2\x1a.api.product.v1.PriceRangeR\n" +
+	"priceRange\x12%\n" +
+	"\x0elexical_weight\x18\x05 \x01(\x02R\rlexicalWeight\x12#\n" +
+	"\rvector_weight\x18\x06 \x01(\x02R\fvectorWeight\"E\n" +
+	"\x15SuggestQueriesRequest\x12\x16\n" +
+	"\x06prefix\x18\x01 \x01(\tR\x06prefix\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\x98\x01\n" +
+	"\x11AskCatalogRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\x12;\n" +
+	"\vprice_range\x18\x04 \x01(\v2\x1a.api.product.v1.PriceRangeR\n" +
+	"priceRange\"\x95\x02\n" +
+	"\x15ExportProductsRequest\x12;\n" +
+	"\x06filter\x18\x01 \x01(\v2#.api.product.v1.ListProductsRequestR\x06filter\x12D\n" +
+	"\x06format\x18\x02 \x01(\x0e2,.api.product.v1.ExportProductsRequest.FormatR\x06format\x12\x16\n" +
+	"\x06fields\x18\x03 \x03(\tR\x06fields\x12-\n" +
+	"\x12include_embeddings\x18\x04 \x01(\bR\x11includeEmbeddings\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\"\x1c\n" +
+	"\x06Format\x12\t\n" +
+	"\x05JSONL\x10\x00\x12\a\n" +
+	"\x03CSV\x10\x01\"9\n" +
+	"\x1dStartEmbeddingBackfillRequest\x12\x18\n" +
+	"\arestart\x18\x01 \x01(\bR\arestart\"\x1e\n" +
+	"\x1cStopEmbeddingBackfillRequest\"#\n" +
+	"!GetEmbeddingBackfillStatusRequest\"\x18\n" +
+	"\x16ListSearchRulesRequest\"I\n" +
+	"\x17CreateSearchRuleRequest\x12.\n" +
+	"\x04rule\x18\x01 \x01(\v2\x1a.api.product.v1.SearchRuleR\x04rule\"I\n" +
+	"\x17UpdateSearchRuleRequest\x12.\n" +
+	"\x04rule\x18\x01 \x01(\v2\x1a.api.product.v1.SearchRuleR\x04rule\")\n" +
+	"\x17DeleteSearchRuleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x94\x02\n" +
 	"\x11ListProductsReply\x127\n" +
 	"\bproducts\x18\x01 \x03(\v2\x1b.api.product.v1.ProductInfoR\bproducts\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xb7\b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12.\n" +
+	"\x06facets\x18\x05 \x01(\v2\x16.api.product.v1.FacetsR\x06facets\x12&\n" +
+	"\x0fnext_page_token\x18\x06 \x01(\tR\rnextPageToken\x12'\n" +
+	"\x0fcorrected_query\x18\a \x01(\tR\x0ecorrectedQuery\"\xee\x03\n" +
+	"\x06Facets\x122\n" +
+	"\x06brands\x18\x01 \x03(\v2\x1a.api.product.v1.FacetValueR\x06brands\x12:\n" +
+	"\n" +
+	"categories\x18\x02 \x03(\v2\x1a.api.product.v1.FacetValueR\n" +
+	"categories\x12A\n" +
+	"\x0esub_categories\x18\x03 \x03(\v2\x1a.api.product.v1.FacetValueR\rsubCategories\x124\n" +
+	"\asellers\x18\x04 \x03(\v2\x1a.api.product.v1.FacetValueR\asellers\x12?\n" +
+	"\rprice_buckets\x18\x05 \x03(\v2\x1a.api.product.v1.RangeFacetR\fpriceBuckets\x12=\n" +
+	"\frating_bands\x18\x06 \x03(\v2\x1a.api.product.v1.RangeFacetR\vratingBands\x12\x19\n" +
+	"\bin_stock\x18\a \x01(\x03R\ainStock\x12 \n" +
+	"\fout_of_stock\x18\b \x01(\x03R\n" +
+	"outOfStock\x12>\n" +
+	"\n" +
+	"attributes\x18\t \x03(\v2\x1e.api.product.v1.AttributeFacetR\n" +
+	"attributes\"V\n" +
+	"\x0eAttributeFacet\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x122\n" +
+	"\x06values\x18\x02 \x03(\v2\x1a.api.product.v1.FacetValueR\x06values\"8\n" +
+	"\n" +
+	"FacetValue\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"F\n" +
+	"\n" +
+	"RangeFacet\x12\x10\n" +
+	"\x03min\x18\x01 \x01(\x01R\x03min\x12\x10\n" +
+	"\x03max\x18\x02 \x01(\x01R\x03max\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x03R\x05count\"N\n" +
+	"\x13SemanticSearchReply\x127\n" +
+	"\aresults\x18\x01 \x03(\v2\x1d.api.product.v1.ScoredProductR\aresults\"\x8e\x01\n" +
+	"\x11HybridSearchReply\x12<\n" +
+	"\aresults\x18\x01 \x03(\v2\".api.product.v1.HybridSearchResultR\aresults\x12\x12\n" +
+	"\x04mode\x18\x02 \x01(\tR\x04mode\x12'\n" +
+	"\x0fcorrected_query\x18\x03 \x01(\tR\x0ecorrectedQuery\"\xc8\x01\n" +
+	"\x12HybridSearchResult\x125\n" +
+	"\aproduct\x18\x01 \x01(\v2\x1b.api.product.v1.ProductInfoR\aproduct\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x12!\n" +
+	"\flexical_rank\x18\x03 \x01(\x05R\vlexicalRank\x12\x1f\n" +
+	"\vvector_rank\x18\x04 \x01(\x05R\n" +
+	"vectorRank\x12!\n" +
+	"\fvector_score\x18\x05 \x01(\x02R\vvectorScore\"X\n" +
+	"\x13SuggestQueriesReply\x12A\n" +
+	"\vsuggestions\x18\x01 \x03(\v2\x1f.api.product.v1.QuerySuggestionR\vsuggestions\"Y\n" +
+	"\x0fQuerySuggestion\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x1e\n" +
+	"\n" +
+	"popularity\x18\x03 \x01(\x03R\n" +
+	"popularity\"\x81\x01\n" +
+	"\x0fAskCatalogReply\x127\n" +
+	"\aresults\x18\x01 \x03(\v2\x1d.api.product.v1.ScoredProductR\aresults\x12\x16\n" +
+	"\x06answer\x18\x02 \x01(\tR\x06answer\x12\x1d\n" +
+	"\n" +
+	"cited_pids\x18\x03 \x03(\tR\tcitedPids\")\n" +
+	"\x13ExportProductsChunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"\xb6\x02\n" +
+	"\x0fAskCatalogEvent\x12F\n" +
+	"\bproducts\x18\x01 \x01(\v2(.api.product.v1.AskCatalogEvent.ProductsH\x00R\bproducts\x12\x16\n" +
+	"\x05delta\x18\x02 \x01(\tH\x00R\x05delta\x12I\n" +
+	"\tcitations\x18\x03 \x01(\v2).api.product.v1.AskCatalogEvent.CitationsH\x00R\tcitations\x1aC\n" +
+	"\bProducts\x127\n" +
+	"\aresults\x18\x01 \x03(\v2\x1d.api.product.v1.ScoredProductR\aresults\x1a*\n" +
+	"\tCitations\x12\x1d\n" +
+	"\n" +
+	"cited_pids\x18\x01 \x03(\tR\tcitedPidsB\a\n" +
+	"\x05event\"\xf9\x02\n" +
+	"\x17EmbeddingBackfillStatus\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\x03R\x06cursor\x12\x1c\n" +
+	"\tprocessed\x18\x03 \x01(\x03R\tprocessed\x12\x16\n" +
+	"\x06failed\x18\x04 \x01(\x03R\x06failed\x12\x1c\n" +
+	"\tremaining\x18\x05 \x01(\x03R\tremaining\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x06 \x01(\tR\tlastError\x129\n" +
+	"\n" +
+	"started_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12;\n" +
+	"\vfinished_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\x12C\n" +
+	"\fdead_letters\x18\t \x03(\v2 .api.product.v1.EmbeddingFailureR\vdeadLetters\"H\n" +
+	"\x14ListSearchRulesReply\x120\n" +
+	"\x05rules\x18\x01 \x03(\v2\x1a.api.product.v1.SearchRuleR\x05rules\"\xa6\x02\n" +
+	"\n" +
+	"SearchRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05terms\x18\x03 \x03(\tR\x05terms\x12\x1a\n" +
+	"\bsynonyms\x18\x04 \x03(\tR\bsynonyms\x12\x1a\n" +
+	"\bcategory\x18\x05 \x01(\tR\bcategory\x12\x14\n" +
+	"\x05boost\x18\x06 \x01(\x01R\x05boost\x12\x1a\n" +
+	"\bdisabled\x18\a \x01(\bR\bdisabled\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xa7\x01\n" +
+	"\x10EmbeddingFailure\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x1a\n" +
+	"\battempts\x18\x02 \x01(\x05R\battempts\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x03 \x01(\tR\tlastError\x129\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xab\t\n" +
 	"\vProductInfo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
 	"\voriginal_id\x18\x02 \x01(\tR\n" +
@@ -904,22 +3440,49 @@ const file_api_product_v1_product_proto_rawDesc = "" +
 	"view_count\x18\x1a \x01(\x05R\tviewCount\x12\x1f\n" +
 	"\vclick_count\x18\x1b \x01(\x05R\n" +
 	"clickCount\x12\x1a\n" +
-	"\bfeatured\x18\x1c \x01(\bR\bfeatured\x1aA\n" +
+	"\bfeatured\x18\x1c \x01(\bR\bfeatured\x120\n" +
+	"\x14actual_price_numeric\x18\x1d \x01(\x05R\x12actualPriceNumeric\x12@\n" +
+	"\n" +
+	"attributes\x18\x1e \x03(\v2 .api.product.v1.ProductAttributeR\n" +
+	"attributes\x1aA\n" +
 	"\x13ProductDetailsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"0\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\":\n" +
+	"\x10ProductAttribute\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"\\\n" +
+	"\rScoredProduct\x125\n" +
+	"\aproduct\x18\x01 \x01(\v2\x1b.api.product.v1.ProductInfoR\aproduct\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x02R\x05score\"0\n" +
 	"\n" +
 	"PriceRange\x12\x10\n" +
 	"\x03min\x18\x01 \x01(\x05R\x03min\x12\x10\n" +
-	"\x03max\x18\x02 \x01(\x05R\x03max2\xdf\x05\n" +
+	"\x03max\x18\x02 \x01(\x05R\x03max2\xdc\x15\n" +
 	"\aProduct\x12g\n" +
 	"\n" +
-	"GetProduct\x12!.api.product.v1.GetProductRequest\x1a\x1b.api.product.v1.ProductInfo\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/products/{id}\x12v\n" +
+	"GetProduct\x12!.api.product.v1.GetProductRequest\x1a\x1b.api.product.v1.ProductInfo\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/products/{id}\x12q\n" +
+	"\rCreateProduct\x12$.api.product.v1.CreateProductRequest\x1a\x1b.api.product.v1.ProductInfo\"\x1d\x82\xd3\xe4\x93\x02\x17:\aproduct\"\f/v1/products\x12~\n" +
+	"\rUpdateProduct\x12$.api.product.v1.UpdateProductRequest\x1a\x1b.api.product.v1.ProductInfo\"*\x82\xd3\xe4\x93\x02$:\aproduct2\x19/v1/products/{product.id}\x12m\n" +
+	"\rDeleteProduct\x12$.api.product.v1.DeleteProductRequest\x1a\x1b.api.product.v1.ProductInfo\"\x19\x82\xd3\xe4\x93\x02\x13*\x11/v1/products/{id}\x12v\n" +
 	"\x0fGetProductByPID\x12&.api.product.v1.GetProductByPIDRequest\x1a\x1b.api.product.v1.ProductInfo\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/products/pid/{pid}\x12l\n" +
 	"\fListProducts\x12#.api.product.v1.ListProductsRequest\x1a!.api.product.v1.ListProductsReply\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/products\x12w\n" +
 	"\x0eSearchProducts\x12%.api.product.v1.SearchProductsRequest\x1a!.api.product.v1.ListProductsReply\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/products/search\x12\x83\x01\n" +
 	"\x13GetFeaturedProducts\x12*.api.product.v1.GetFeaturedProductsRequest\x1a!.api.product.v1.ListProductsReply\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/products/featured\x12\x85\x01\n" +
-	"\x12GetSimilarProducts\x12).api.product.v1.GetSimilarProductsRequest\x1a!.api.product.v1.ListProductsReply\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/products/{id}/similarB3\n" +
+	"\x12GetSimilarProducts\x12).api.product.v1.GetSimilarProductsRequest\x1a!.api.product.v1.ListProductsReply\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/products/{id}/similar\x12\x85\x01\n" +
+	"\x0eSemanticSearch\x12%.api.product.v1.SemanticSearchRequest\x1a#.api.product.v1.SemanticSearchReply\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/products/semantic-search\x12}\n" +
+	"\fHybridSearch\x12#.api.product.v1.HybridSearchRequest\x1a!.api.product.v1.HybridSearchReply\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/products/hybrid-search\x12|\n" +
+	"\x0eSuggestQueries\x12%.api.product.v1.SuggestQueriesRequest\x1a#.api.product.v1.SuggestQueriesReply\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/search/suggestions\x12m\n" +
+	"\n" +
+	"AskCatalog\x12!.api.product.v1.AskCatalogRequest\x1a\x1f.api.product.v1.AskCatalogReply\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v1/products/ask\x12X\n" +
+	"\x10StreamAskCatalog\x12!.api.product.v1.AskCatalogRequest\x1a\x1f.api.product.v1.AskCatalogEvent0\x01\x12^\n" +
+	"\x0eExportProducts\x12%.api.product.v1.ExportProductsRequest\x1a#.api.product.v1.ExportProductsChunk0\x01\x12\xa0\x01\n" +
+	"\x16StartEmbeddingBackfill\x12-.api.product.v1.StartEmbeddingBackfillRequest\x1a'.api.product.v1.EmbeddingBackfillStatus\".\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/admin/embeddings/backfill/start\x12\x9d\x01\n" +
+	"\x15StopEmbeddingBackfill\x12,.api.product.v1.StopEmbeddingBackfillRequest\x1a'.api.product.v1.EmbeddingBackfillStatus\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/admin/embeddings/backfill/stop\x12\x9f\x01\n" +
+	"\x1aGetEmbeddingBackfillStatus\x121.api.product.v1.GetEmbeddingBackfillStatusRequest\x1a'.api.product.v1.EmbeddingBackfillStatus\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/admin/embeddings/backfill\x12\x7f\n" +
+	"\x0fListSearchRules\x12&.api.product.v1.ListSearchRulesRequest\x1a$.api.product.v1.ListSearchRulesReply\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/admin/search/rules\x12}\n" +
+	"\x10CreateSearchRule\x12'.api.product.v1.CreateSearchRuleRequest\x1a\x1a.api.product.v1.SearchRule\"$\x82\xd3\xe4\x93\x02\x1e:\x04rule\"\x16/v1/admin/search/rules\x12\x87\x01\n" +
+	"\x10UpdateSearchRule\x12'.api.product.v1.UpdateSearchRuleRequest\x1a\x1a.api.product.v1.SearchRule\".\x82\xd3\xe4\x93\x02(:\x04rule\x1a /v1/admin/search/rules/{rule.id}\x12|\n" +
+	"\x10DeleteSearchRule\x12'.api.product.v1.DeleteSearchRuleRequest\x1a\x1a.api.product.v1.SearchRule\"#\x82\xd3\xe4\x93\x02\x1d*\x1b/v1/admin/search/rules/{id}B3\n" +
 	"\x0eapi.product.v1P\x01Z\x1fyinni_backend/api/product/v1;v1b\x06proto3"
 
 var (
@@ -934,44 +3497,156 @@ func file_api_product_v1_product_proto_rawDescGZIP() []byte {
 	return file_api_product_v1_product_proto_rawDescData
 }
 
-var file_api_product_v1_product_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_api_product_v1_product_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_api_product_v1_product_proto_msgTypes = make([]protoimpl.MessageInfo, 47)
 var file_api_product_v1_product_proto_goTypes = []any{
-	(*GetProductRequest)(nil),          // 0: api.product.v1.GetProductRequest
-	(*GetProductByPIDRequest)(nil),     // 1: api.product.v1.GetProductByPIDRequest
-	(*ListProductsRequest)(nil),        // 2: api.product.v1.ListProductsRequest
-	(*SearchProductsRequest)(nil),      // 3: api.product.v1.SearchProductsRequest
-	(*GetFeaturedProductsRequest)(nil), // 4: api.product.v1.GetFeaturedProductsRequest
-	(*GetSimilarProductsRequest)(nil),  // 5: api.product.v1.GetSimilarProductsRequest
-	(*ListProductsReply)(nil),          // 6: api.product.v1.ListProductsReply
-	(*ProductInfo)(nil),                // 7: api.product.v1.ProductInfo
-	(*PriceRange)(nil),                 // 8: api.product.v1.PriceRange
-	nil,                                // 9: api.product.v1.ProductInfo.ProductDetailsEntry
-	(*timestamppb.Timestamp)(nil),      // 10: google.protobuf.Timestamp
+	(AttributeFilter_Match)(0),                // 0: api.product.v1.AttributeFilter.Match
+	(ExportProductsRequest_Format)(0),         // 1: api.product.v1.ExportProductsRequest.Format
+	(*GetProductRequest)(nil),                 // 2: api.product.v1.GetProductRequest
+	(*CreateProductRequest)(nil),              // 3: api.product.v1.CreateProductRequest
+	(*UpdateProductRequest)(nil),              // 4: api.product.v1.UpdateProductRequest
+	(*DeleteProductRequest)(nil),              // 5: api.product.v1.DeleteProductRequest
+	(*GetProductByPIDRequest)(nil),            // 6: api.product.v1.GetProductByPIDRequest
+	(*ListProductsRequest)(nil),               // 7: api.product.v1.ListProductsRequest
+	(*AttributeFilter)(nil),                   // 8: api.product.v1.AttributeFilter
+	(*SearchProductsRequest)(nil),             // 9: api.product.v1.SearchProductsRequest
+	(*GetFeaturedProductsRequest)(nil),        // 10: api.product.v1.GetFeaturedProductsRequest
+	(*GetSimilarProductsRequest)(nil),         // 11: api.product.v1.GetSimilarProductsRequest
+	(*SemanticSearchRequest)(nil),             // 12: api.product.v1.SemanticSearchRequest
+	(*HybridSearchRequest)(nil),               // 13: api.product.v1.HybridSearchRequest
+	(*SuggestQueriesRequest)(nil),             // 14: api.product.v1.SuggestQueriesRequest
+	(*AskCatalogRequest)(nil),                 // 15: api.product.v1.AskCatalogRequest
+	(*ExportProductsRequest)(nil),             // 16: api.product.v1.ExportProductsRequest
+	(*StartEmbeddingBackfillRequest)(nil),     // 17: api.product.v1.StartEmbeddingBackfillRequest
+	(*StopEmbeddingBackfillRequest)(nil),      // 18: api.product.v1.StopEmbeddingBackfillRequest
+	(*GetEmbeddingBackfillStatusRequest)(nil), // 19: api.product.v1.GetEmbeddingBackfillStatusRequest
+	(*ListSearchRulesRequest)(nil),            // 20: api.product.v1.ListSearchRulesRequest
+	(*CreateSearchRuleRequest)(nil),           // 21: api.product.v1.CreateSearchRuleRequest
+	(*UpdateSearchRuleRequest)(nil),           // 22: api.product.v1.UpdateSearchRuleRequest
+	(*DeleteSearchRuleRequest)(nil),           // 23: api.product.v1.DeleteSearchRuleRequest
+	(*ListProductsReply)(nil),                 // 24: api.product.v1.ListProductsReply
+	(*Facets)(nil),                            // 25: api.product.v1.Facets
+	(*AttributeFacet)(nil),                    // 26: api.product.v1.AttributeFacet
+	(*FacetValue)(nil),                        // 27: api.product.v1.FacetValue
+	(*RangeFacet)(nil),                        // 28: api.product.v1.RangeFacet
+	(*SemanticSearchReply)(nil),               // 29: api.product.v1.SemanticSearchReply
+	(*HybridSearchReply)(nil),                 // 30: api.product.v1.HybridSearchReply
+	(*HybridSearchResult)(nil),                // 31: api.product.v1.HybridSearchResult
+	(*SuggestQueriesReply)(nil),               // 32: api.product.v1.SuggestQueriesReply
+	(*QuerySuggestion)(nil),                   // 33: api.product.v1.QuerySuggestion
+	(*AskCatalogReply)(nil),                   // 34: api.product.v1.AskCatalogReply
+	(*ExportProductsChunk)(nil),               // 35: api.product.v1.ExportProductsChunk
+	(*AskCatalogEvent)(nil),                   // 36: api.product.v1.AskCatalogEvent
+	(*EmbeddingBackfillStatus)(nil),           // 37: api.product.v1.EmbeddingBackfillStatus
+	(*ListSearchRulesReply)(nil),              // 38: api.product.v1.ListSearchRulesReply
+	(*SearchRule)(nil),                        // 39: api.product.v1.SearchRule
+	(*EmbeddingFailure)(nil),                  // 40: api.product.v1.EmbeddingFailure
+	(*ProductInfo)(nil),                       // 41: api.product.v1.ProductInfo
+	(*ProductAttribute)(nil),                  // 42: api.product.v1.ProductAttribute
+	(*ScoredProduct)(nil),                     // 43: api.product.v1.ScoredProduct
+	(*PriceRange)(nil),                        // 44: api.product.v1.PriceRange
+	nil,                                       // 45: api.product.v1.ListProductsRequest.AttributesEntry
+	(*AskCatalogEvent_Products)(nil),          // 46: api.product.v1.AskCatalogEvent.Products
+	(*AskCatalogEvent_Citations)(nil),         // 47: api.product.v1.AskCatalogEvent.Citations
+	nil,                                       // 48: api.product.v1.ProductInfo.ProductDetailsEntry
+	(*fieldmaskpb.FieldMask)(nil),             // 49: google.protobuf.FieldMask
+	(*timestamppb.Timestamp)(nil),             // 50: google.protobuf.Timestamp
 }
 var file_api_product_v1_product_proto_depIdxs = []int32{
-	8,  // 0: api.product.v1.SearchProductsRequest.price_range:type_name -> api.product.v1.PriceRange
-	7,  // 1: api.product.v1.ListProductsReply.products:type_name -> api.product.v1.ProductInfo
-	9,  // 2: api.product.v1.ProductInfo.product_details:type_name -> api.product.v1.ProductInfo.ProductDetailsEntry
-	10, // 3: api.product.v1.ProductInfo.crawled_at:type_name -> google.protobuf.Timestamp
-	10, // 4: api.product.v1.ProductInfo.created_at:type_name -> google.protobuf.Timestamp
-	10, // 5: api.product.v1.ProductInfo.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 6: api.product.v1.Product.GetProduct:input_type -> api.product.v1.GetProductRequest
-	1,  // 7: api.product.v1.Product.GetProductByPID:input_type -> api.product.v1.GetProductByPIDRequest
-	2,  // 8: api.product.v1.Product.ListProducts:input_type -> api.product.v1.ListProductsRequest
-	3,  // 9: api.product.v1.Product.SearchProducts:input_type -> api.product.v1.SearchProductsRequest
-	4,  // 10: api.product.v1.Product.GetFeaturedProducts:input_type -> api.product.v1.GetFeaturedProductsRequest
-	5,  // 11: api.product.v1.Product.GetSimilarProducts:input_type -> api.product.v1.GetSimilarProductsRequest
-	7,  // 12: api.product.v1.Product.GetProduct:output_type -> api.product.v1.ProductInfo
-	7,  // 13: api.product.v1.Product.GetProductByPID:output_type -> api.product.v1.ProductInfo
-	6,  // 14: api.product.v1.Product.ListProducts:output_type -> api.product.v1.ListProductsReply
-	6,  // 15: api.product.v1.Product.SearchProducts:output_type -> api.product.v1.ListProductsReply
-	6,  // 16: api.product.v1.Product.GetFeaturedProducts:output_type -> api.product.v1.ListProductsReply
-	6,  // 17: api.product.v1.Product.GetSimilarProducts:output_type -> api.product.v1.ListProductsReply
-	12, // [12:18] is the sub-list for method output_type
-	6,  // [6:12] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	41, // 0: api.product.v1.CreateProductRequest.product:type_name -> api.product.v1.ProductInfo
+	41, // 1: api.product.v1.UpdateProductRequest.product:type_name -> api.product.v1.ProductInfo
+	49, // 2: api.product.v1.UpdateProductRequest.update_mask:type_name -> google.protobuf.FieldMask
+	45, // 3: api.product.v1.ListProductsRequest.attributes:type_name -> api.product.v1.ListProductsRequest.AttributesEntry
+	8,  // 4: api.product.v1.ListProductsRequest.attribute_filters:type_name -> api.product.v1.AttributeFilter
+	0,  // 5: api.product.v1.AttributeFilter.match:type_name -> api.product.v1.AttributeFilter.Match
+	44, // 6: api.product.v1.SearchProductsRequest.price_range:type_name -> api.product.v1.PriceRange
+	44, // 7: api.product.v1.SemanticSearchRequest.price_range:type_name -> api.product.v1.PriceRange
+	44, // 8: api.product.v1.HybridSearchRequest.price_range:type_name -> api.product.v1.PriceRange
+	44, // 9: api.product.v1.AskCatalogRequest.price_range:type_name -> api.product.v1.PriceRange
+	7,  // 10: api.product.v1.ExportProductsRequest.filter:type_name -> api.product.v1.ListProductsRequest
+	1,  // 11: api.product.v1.ExportProductsRequest.format:type_name -> api.product.v1.ExportProductsRequest.Format
+	39, // 12: api.product.v1.CreateSearchRuleRequest.rule:type_name -> api.product.v1.SearchRule
+	39, // 13: api.product.v1.UpdateSearchRuleRequest.rule:type_name -> api.product.v1.SearchRule
+	41, // 14: api.product.v1.ListProductsReply.products:type_name -> api.product.v1.ProductInfo
+	25, // 15: api.product.v1.ListProductsReply.facets:type_name -> api.product.v1.Facets
+	27, // 16: api.product.v1.Facets.brands:type_name -> api.product.v1.FacetValue
+	27, // 17: api.product.v1.Facets.categories:type_name -> api.product.v1.FacetValue
+	27, // 18: api.product.v1.Facets.sub_categories:type_name -> api.product.v1.FacetValue
+	27, // 19: api.product.v1.Facets.sellers:type_name -> api.product.v1.FacetValue
+	28, // 20: api.product.v1.Facets.price_buckets:type_name -> api.product.v1.RangeFacet
+	28, // 21: api.product.v1.Facets.rating_bands:type_name -> api.product.v1.RangeFacet
+	26, // 22: api.product.v1.Facets.attributes:type_name -> api.product.v1.AttributeFacet
+	27, // 23: api.product.v1.AttributeFacet.values:type_name -> api.product.v1.FacetValue
+	43, // 24: api.product.v1.SemanticSearchReply.results:type_name -> api.product.v1.ScoredProduct
+	31, // 25: api.product.v1.HybridSearchReply.results:type_name -> api.product.v1.HybridSearchResult
+	41, // 26: api.product.v1.HybridSearchResult.product:type_name -> api.product.v1.ProductInfo
+	33, // 27: api.product.v1.SuggestQueriesReply.suggestions:type_name -> api.product.v1.QuerySuggestion
+	43, // 28: api.product.v1.AskCatalogReply.results:type_name -> api.product.v1.ScoredProduct
+	46, // 29: api.product.v1.AskCatalogEvent.products:type_name -> api.product.v1.AskCatalogEvent.Products
+	47, // 30: api.product.v1.AskCatalogEvent.citations:type_name -> api.product.v1.AskCatalogEvent.Citations
+	50, // 31: api.product.v1.EmbeddingBackfillStatus.started_at:type_name -> google.protobuf.Timestamp
+	50, // 32: api.product.v1.EmbeddingBackfillStatus.finished_at:type_name -> google.protobuf.Timestamp
+	40, // 33: api.product.v1.EmbeddingBackfillStatus.dead_letters:type_name -> api.product.v1.EmbeddingFailure
+	39, // 34: api.product.v1.ListSearchRulesReply.rules:type_name -> api.product.v1.SearchRule
+	50, // 35: api.product.v1.SearchRule.created_at:type_name -> google.protobuf.Timestamp
+	50, // 36: api.product.v1.SearchRule.updated_at:type_name -> google.protobuf.Timestamp
+	50, // 37: api.product.v1.EmbeddingFailure.updated_at:type_name -> google.protobuf.Timestamp
+	48, // 38: api.product.v1.ProductInfo.product_details:type_name -> api.product.v1.ProductInfo.ProductDetailsEntry
+	50, // 39: api.product.v1.ProductInfo.crawled_at:type_name -> google.protobuf.Timestamp
+	50, // 40: api.product.v1.ProductInfo.created_at:type_name -> google.protobuf.Timestamp
+	50, // 41: api.product.v1.ProductInfo.updated_at:type_name -> google.protobuf.Timestamp
+	42, // 42: api.product.v1.ProductInfo.attributes:type_name -> api.product.v1.ProductAttribute
+	41, // 43: api.product.v1.ScoredProduct.product:type_name -> api.product.v1.ProductInfo
+	43, // 44: api.product.v1.AskCatalogEvent.Products.results:type_name -> api.product.v1.ScoredProduct
+	2,  // 45: api.product.v1.Product.GetProduct:input_type -> api.product.v1.GetProductRequest
+	3,  // 46: api.product.v1.Product.CreateProduct:input_type -> api.product.v1.CreateProductRequest
+	4,  // 47: api.product.v1.Product.UpdateProduct:input_type -> api.product.v1.UpdateProductRequest
+	5,  // 48: api.product.v1.Product.DeleteProduct:input_type -> api.product.v1.DeleteProductRequest
+	6,  // 49: api.product.v1.Product.GetProductByPID:input_type -> api.product.v1.GetProductByPIDRequest
+	7,  // 50: api.product.v1.Product.ListProducts:input_type -> api.product.v1.ListProductsRequest
+	9,  // 51: api.product.v1.Product.SearchProducts:input_type -> api.product.v1.SearchProductsRequest
+	10, // 52: api.product.v1.Product.GetFeaturedProducts:input_type -> api.product.v1.GetFeaturedProductsRequest
+	11, // 53: api.product.v1.Product.GetSimilarProducts:input_type -> api.product.v1.GetSimilarProductsRequest
+	12, // 54: api.product.v1.Product.SemanticSearch:input_type -> api.product.v1.SemanticSearchRequest
+	13, // 55: api.product.v1.Product.HybridSearch:input_type -> api.product.v1.HybridSearchRequest
+	14, // 56: api.product.v1.Product.SuggestQueries:input_type -> api.product.v1.SuggestQueriesRequest
+	15, // 57: api.product.v1.Product.AskCatalog:input_type -> api.product.v1.AskCatalogRequest
+	15, // 58: api.product.v1.Product.StreamAskCatalog:input_type -> api.product.v1.AskCatalogRequest
+	16, // 59: api.product.v1.Product.ExportProducts:input_type -> api.product.v1.ExportProductsRequest
+	17, // 60: api.product.v1.Product.StartEmbeddingBackfill:input_type -> api.product.v1.StartEmbeddingBackfillRequest
+	18, // 61: api.product.v1.Product.StopEmbeddingBackfill:input_type -> api.product.v1.StopEmbeddingBackfillRequest
+	19, // 62: api.product.v1.Product.GetEmbeddingBackfillStatus:input_type -> api.product.v1.GetEmbeddingBackfillStatusRequest
+	20, // 63: api.product.v1.Product.ListSearchRules:input_type -> api.product.v1.ListSearchRulesRequest
+	21, // 64: api.product.v1.Product.CreateSearchRule:input_type -> api.product.v1.CreateSearchRuleRequest
+	22, // 65: api.product.v1.Product.UpdateSearchRule:input_type -> api.product.v1.UpdateSearchRuleRequest
+	23, // 66: api.product.v1.Product.DeleteSearchRule:input_type -> api.product.v1.DeleteSearchRuleRequest
+	41, // 67: api.product.v1.Product.GetProduct:output_type -> api.product.v1.ProductInfo
+	41, // 68: api.product.v1.Product.CreateProduct:output_type -> api.product.v1.ProductInfo
+	41, // 69: api.product.v1.Product.UpdateProduct:output_type -> api.product.v1.ProductInfo
+	41, // 70: api.product.v1.Product.DeleteProduct:output_type -> api.product.v1.ProductInfo
+	41, // 71: api.product.v1.Product.GetProductByPID:output_type -> api.product.v1.ProductInfo
+	24, // 72: api.product.v1.Product.ListProducts:output_type -> api.product.v1.ListProductsReply
+	24, // 73: api.product.v1.Product.SearchProducts:output_type -> api.product.v1.ListProductsReply
+	24, // 74: api.product.v1.Product.GetFeaturedProducts:output_type -> api.product.v1.ListProductsReply
+	24, // 75: api.product.v1.Product.GetSimilarProducts:output_type -> api.product.v1.ListProductsReply
+	29, // 76: api.product.v1.Product.SemanticSearch:output_type -> api.product.v1.SemanticSearchReply
+	30, // 77: api.product.v1.Product.HybridSearch:output_type -> api.product.v1.HybridSearchReply
+	32, // 78: api.product.v1.Product.SuggestQueries:output_type -> api.product.v1.SuggestQueriesReply
+	34, // 79: api.product.v1.Product.AskCatalog:output_type -> api.product.v1.AskCatalogReply
+	36, // 80: api.product.v1.Product.StreamAskCatalog:output_type -> api.product.v1.AskCatalogEvent
+	35, // 81: api.product.v1.Product.ExportProducts:output_type -> api.product.v1.ExportProductsChunk
+	37, // 82: api.product.v1.Product.StartEmbeddingBackfill:output_type -> api.product.v1.EmbeddingBackfillStatus
+	37, // 83: api.product.v1.Product.StopEmbeddingBackfill:output_type -> api.product.v1.EmbeddingBackfillStatus
+	37, // 84: api.product.v1.Product.GetEmbeddingBackfillStatus:output_type -> api.product.v1.EmbeddingBackfillStatus
+	38, // 85: api.product.v1.Product.ListSearchRules:output_type -> api.product.v1.ListSearchRulesReply
+	39, // 86: api.product.v1.Product.CreateSearchRule:output_type -> api.product.v1.SearchRule
+	39, // 87: api.product.v1.Product.UpdateSearchRule:output_type -> api.product.v1.SearchRule
+	39, // 88: api.product.v1.Product.DeleteSearchRule:output_type -> api.product.v1.SearchRule
+	67, // [67:89] is the sub-list for method output_type
+	45, // [45:67] is the sub-list for method input_type
+	45, // [45:45] is the sub-list for extension type_name
+	45, // [45:45] is the sub-list for extension extendee
+	0,  // [0:45] is the sub-list for field type_name
 }
 
 func init() { file_api_product_v1_product_proto_init() }
@@ -980,18 +3655,24 @@ func file_api_product_v1_product_proto_init() {
 		return
 	}
 	file_api_product_v1_product_error_reason_proto_init()
+	file_api_product_v1_product_proto_msgTypes[34].OneofWrappers = []any{
+		(*AskCatalogEvent_Products_)(nil),
+		(*AskCatalogEvent_Delta)(nil),
+		(*AskCatalogEvent_Citations_)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_product_v1_product_proto_rawDesc), len(file_api_product_v1_product_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   10,
+			NumEnums:      2,
+			NumMessages:   47,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_api_product_v1_product_proto_goTypes,
 		DependencyIndexes: file_api_product_v1_product_proto_depIdxs,
+		EnumInfos:         file_api_product_v1_product_proto_enumTypes,
 		MessageInfos:      file_api_product_v1_product_proto_msgTypes,
 	}.Build()
 	File_api_product_v1_product_proto = out.File
@@ -34,19 +34,29 @@ const (
 	ErrorReason_DATABASE_ERROR           ErrorReason = 5
 	ErrorReason_SEARCH_FAILED            ErrorReason = 6
 	ErrorReason_EMBEDDING_IS_NOT_ENABLED ErrorReason = 7
+	ErrorReason_PRODUCT_ALREADY_EXISTS   ErrorReason = 8
+	ErrorReason_INVALID_UPDATE_MASK      ErrorReason = 9
+	ErrorReason_BACKFILL_ALREADY_RUNNING ErrorReason = 10
+	ErrorReason_INVALID_PAGE_TOKEN       ErrorReason = 11
+	ErrorReason_SEARCH_RULE_NOT_FOUND    ErrorReason = 12
 )
 
 // Enum value maps for ErrorReason.
 var (
 	ErrorReason_name = map[int32]string{
-		0: "PRODUCT_UNSPECIFIED",
-		1: "PRODUCT_NOT_FOUND",
-		2: "INVALID_PRODUCT_ID",
-		3: "INVALID_PRICE_RANGE",
-		4: "INVALID_PARAMETERS",
-		5: "DATABASE_ERROR",
-		6: "SEARCH_FAILED",
-		7: "EMBEDDING_IS_NOT_ENABLED",
+		0:  "PRODUCT_UNSPECIFIED",
+		1:  "PRODUCT_NOT_FOUND",
+		2:  "INVALID_PRODUCT_ID",
+		3:  "INVALID_PRICE_RANGE",
+		4:  "INVALID_PARAMETERS",
+		5:  "DATABASE_ERROR",
+		6:  "SEARCH_FAILED",
+		7:  "EMBEDDING_IS_NOT_ENABLED",
+		8:  "PRODUCT_ALREADY_EXISTS",
+		9:  "INVALID_UPDATE_MASK",
+		10: "BACKFILL_ALREADY_RUNNING",
+		11: "INVALID_PAGE_TOKEN",
+		12: "SEARCH_RULE_NOT_FOUND",
 	}
 	ErrorReason_value = map[string]int32{
 		"PRODUCT_UNSPECIFIED":      0,
@@ -57,6 +67,11 @@ var (
 		"DATABASE_ERROR":           5,
 		"SEARCH_FAILED":            6,
 		"EMBEDDING_IS_NOT_ENABLED": 7,
+		"PRODUCT_ALREADY_EXISTS":   8,
+		"INVALID_UPDATE_MASK":      9,
+		"BACKFILL_ALREADY_RUNNING": 10,
+		"INVALID_PAGE_TOKEN":       11,
+		"SEARCH_RULE_NOT_FOUND":    12,
 	}
 )
 
@@ -91,7 +106,7 @@ var File_api_product_v1_product_error_reason_proto protoreflect.FileDescriptor
 
 const file_api_product_v1_product_error_reason_proto_rawDesc = "" +
 	"\n" +
-	")api/product/v1/product_error_reason.proto\x12\x0eapi.product.v1*\xcb\x01\n" +
+	")api/product/v1/product_error_reason.proto\x12\x0eapi.product.v1*\xd1\x02\n" +
 	"\vErrorReason\x12\x17\n" +
 	"\x13PRODUCT_UNSPECIFIED\x10\x00\x12\x15\n" +
 	"\x11PRODUCT_NOT_FOUND\x10\x01\x12\x16\n" +
@@ -100,7 +115,13 @@ const file_api_product_v1_product_error_reason_proto_rawDesc = "" +
 	"\x12INVALID_PARAMETERS\x10\x04\x12\x12\n" +
 	"\x0eDATABASE_ERROR\x10\x05\x12\x11\n" +
 	"\rSEARCH_FAILED\x10\x06\x12\x1c\n" +
-	"\x18EMBEDDING_IS_NOT_ENABLED\x10\aB3\n" +
+	"\x18EMBEDDING_IS_NOT_ENABLED\x10\a\x12\x1a\n" +
+	"\x16PRODUCT_ALREADY_EXISTS\x10\b\x12\x17\n" +
+	"\x13INVALID_UPDATE_MASK\x10\t\x12\x1c\n" +
+	"\x18BACKFILL_ALREADY_RUNNING\x10\n" +
+	"\x12\x16\n" +
+	"\x12INVALID_PAGE_TOKEN\x10\v\x12\x19\n" +
+	"\x15SEARCH_RULE_NOT_FOUND\x10\fB3\n" +
 	"\x0eapi.product.v1P\x01Z\x1fyinni_backend/api/product/v1;v1b\x06proto3"
 
 var (
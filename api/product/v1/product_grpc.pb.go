@@ -21,12 +21,28 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Product_GetProduct_FullMethodName          = "/api.product.v1.Product/GetProduct"
-	Product_GetProductByPID_FullMethodName     = "/api.product.v1.Product/GetProductByPID"
-	Product_ListProducts_FullMethodName        = "/api.product.v1.Product/ListProducts"
-	Product_SearchProducts_FullMethodName      = "/api.product.v1.Product/SearchProducts"
-	Product_GetFeaturedProducts_FullMethodName = "/api.product.v1.Product/GetFeaturedProducts"
-	Product_GetSimilarProducts_FullMethodName  = "/api.product.v1.Product/GetSimilarProducts"
+	Product_GetProduct_FullMethodName                 = "/api.product.v1.Product/GetProduct"
+	Product_CreateProduct_FullMethodName              = "/api.product.v1.Product/CreateProduct"
+	Product_UpdateProduct_FullMethodName              = "/api.product.v1.Product/UpdateProduct"
+	Product_DeleteProduct_FullMethodName              = "/api.product.v1.Product/DeleteProduct"
+	Product_GetProductByPID_FullMethodName            = "/api.product.v1.Product/GetProductByPID"
+	Product_ListProducts_FullMethodName               = "/api.product.v1.Product/ListProducts"
+	Product_SearchProducts_FullMethodName             = "/api.product.v1.Product/SearchProducts"
+	Product_GetFeaturedProducts_FullMethodName        = "/api.product.v1.Product/GetFeaturedProducts"
+	Product_GetSimilarProducts_FullMethodName         = "/api.product.v1.Product/GetSimilarProducts"
+	Product_SemanticSearch_FullMethodName             = "/api.product.v1.Product/SemanticSearch"
+	Product_HybridSearch_FullMethodName               = "/api.product.v1.Product/HybridSearch"
+	Product_SuggestQueries_FullMethodName             = "/api.product.v1.Product/SuggestQueries"
+	Product_AskCatalog_FullMethodName                 = "/api.product.v1.Product/AskCatalog"
+	Product_StreamAskCatalog_FullMethodName           = "/api.product.v1.Product/StreamAskCatalog"
+	Product_ExportProducts_FullMethodName             = "/api.product.v1.Product/ExportProducts"
+	Product_StartEmbeddingBackfill_FullMethodName     = "/api.product.v1.Product/StartEmbeddingBackfill"
+	Product_StopEmbeddingBackfill_FullMethodName      = "/api.product.v1.Product/StopEmbeddingBackfill"
+	Product_GetEmbeddingBackfillStatus_FullMethodName = "/api.product.v1.Product/GetEmbeddingBackfillStatus"
+	Product_ListSearchRules_FullMethodName            = "/api.product.v1.Product/ListSearchRules"
+	Product_CreateSearchRule_FullMethodName           = "/api.product.v1.Product/CreateSearchRule"
+	Product_UpdateSearchRule_FullMethodName           = "/api.product.v1.Product/UpdateSearchRule"
+	Product_DeleteSearchRule_FullMethodName           = "/api.product.v1.Product/DeleteSearchRule"
 )
 
 // ProductClient is the client API for Product service.
@@ -35,6 +51,12 @@ const (
 type ProductClient interface {
 	// Get product by ID
 	GetProduct(ctx context.Context, in *GetProductRequest, opts ...grpc.CallOption) (*ProductInfo, error)
+	// Create product
+	CreateProduct(ctx context.Context, in *CreateProductRequest, opts ...grpc.CallOption) (*ProductInfo, error)
+	// Update product, optionally restricted to the fields in update_mask
+	UpdateProduct(ctx context.Context, in *UpdateProductRequest, opts ...grpc.CallOption) (*ProductInfo, error)
+	// Delete product
+	DeleteProduct(ctx context.Context, in *DeleteProductRequest, opts ...grpc.CallOption) (*ProductInfo, error)
 	// Get product by Flipkart PID
 	GetProductByPID(ctx context.Context, in *GetProductByPIDRequest, opts ...grpc.CallOption) (*ProductInfo, error)
 	// List products
@@ -45,6 +67,35 @@ type ProductClient interface {
 	GetFeaturedProducts(ctx context.Context, in *GetFeaturedProductsRequest, opts ...grpc.CallOption) (*ListProductsReply, error)
 	// Get similar products
 	GetSimilarProducts(ctx context.Context, in *GetSimilarProductsRequest, opts ...grpc.CallOption) (*ListProductsReply, error)
+	// Semantic search over product embeddings
+	SemanticSearch(ctx context.Context, in *SemanticSearchRequest, opts ...grpc.CallOption) (*SemanticSearchReply, error)
+	// Keyword and semantic search fused by reciprocal rank, with the score
+	// breakdown of every result
+	HybridSearch(ctx context.Context, in *HybridSearchRequest, opts ...grpc.CallOption) (*HybridSearchReply, error)
+	// Complete a partially typed search query
+	SuggestQueries(ctx context.Context, in *SuggestQueriesRequest, opts ...grpc.CallOption) (*SuggestQueriesReply, error)
+	// Answer a natural-language question from the catalog (RAG)
+	AskCatalog(ctx context.Context, in *AskCatalogRequest, opts ...grpc.CallOption) (*AskCatalogReply, error)
+	// Stream the answer to a catalog question: the matching products first,
+	// then answer deltas, then the validated citations. Served over HTTP as
+	// server-sent events at GET /v1/products/ask/stream.
+	StreamAskCatalog(ctx context.Context, in *AskCatalogRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AskCatalogEvent], error)
+	// Export the products matching a listing's filters as JSONL or CSV. The
+	// file is streamed in chunks to concatenate; served over gRPC only.
+	ExportProducts(ctx context.Context, in *ExportProductsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportProductsChunk], error)
+	// Start (or resume) embedding products that have none
+	StartEmbeddingBackfill(ctx context.Context, in *StartEmbeddingBackfillRequest, opts ...grpc.CallOption) (*EmbeddingBackfillStatus, error)
+	// Stop the embedding backfill; a later start resumes where it stopped
+	StopEmbeddingBackfill(ctx context.Context, in *StopEmbeddingBackfillRequest, opts ...grpc.CallOption) (*EmbeddingBackfillStatus, error)
+	// Get embedding backfill progress and dead-lettered products
+	GetEmbeddingBackfillStatus(ctx context.Context, in *GetEmbeddingBackfillStatusRequest, opts ...grpc.CallOption) (*EmbeddingBackfillStatus, error)
+	// Search rules: synonyms and category boosts applied to search queries.
+	// Changes take effect without a restart.
+	ListSearchRules(ctx context.Context, in *ListSearchRulesRequest, opts ...grpc.CallOption) (*ListSearchRulesReply, error)
+	CreateSearchRule(ctx context.Context, in *CreateSearchRuleRequest, opts ...grpc.CallOption) (*SearchRule, error)
+	// Replace a rule
+	UpdateSearchRule(ctx context.Context, in *UpdateSearchRuleRequest, opts ...grpc.CallOption) (*SearchRule, error)
+	DeleteSearchRule(ctx context.Context, in *DeleteSearchRuleRequest, opts ...grpc.CallOption) (*SearchRule, error)
 }
 
 type productClient struct {
@@ -65,6 +116,36 @@ func (c *productClient) GetProduct(ctx context.Context, in *GetProductRequest, o
 	return out, nil
 }
 
+func (c *productClient) CreateProduct(ctx context.Context, in *CreateProductRequest, opts ...grpc.CallOption) (*ProductInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductInfo)
+	err := c.cc.Invoke(ctx, Product_CreateProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) UpdateProduct(ctx context.Context, in *UpdateProductRequest, opts ...grpc.CallOption) (*ProductInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductInfo)
+	err := c.cc.Invoke(ctx, Product_UpdateProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) DeleteProduct(ctx context.Context, in *DeleteProductRequest, opts ...grpc.CallOption) (*ProductInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductInfo)
+	err := c.cc.Invoke(ctx, Product_DeleteProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *productClient) GetProductByPID(ctx context.Context, in *GetProductByPIDRequest, opts ...grpc.CallOption) (*ProductInfo, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ProductInfo)
@@ -115,12 +196,166 @@ func (c *productClient) GetSimilarProducts(ctx context.Context, in *GetSimilarPr
 	return out, nil
 }
 
+func (c *productClient) SemanticSearch(ctx context.Context, in *SemanticSearchRequest, opts ...grpc.CallOption) (*SemanticSearchReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SemanticSearchReply)
+	err := c.cc.Invoke(ctx, Product_SemanticSearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) HybridSearch(ctx context.Context, in *HybridSearchRequest, opts ...grpc.CallOption) (*HybridSearchReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HybridSearchReply)
+	err := c.cc.Invoke(ctx, Product_HybridSearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) SuggestQueries(ctx context.Context, in *SuggestQueriesRequest, opts ...grpc.CallOption) (*SuggestQueriesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestQueriesReply)
+	err := c.cc.Invoke(ctx, Product_SuggestQueries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) AskCatalog(ctx context.Context, in *AskCatalogRequest, opts ...grpc.CallOption) (*AskCatalogReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AskCatalogReply)
+	err := c.cc.Invoke(ctx, Product_AskCatalog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) StreamAskCatalog(ctx context.Context, in *AskCatalogRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AskCatalogEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Product_ServiceDesc.Streams[0], Product_StreamAskCatalog_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[AskCatalogRequest, AskCatalogEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Product_StreamAskCatalogClient = grpc.ServerStreamingClient[AskCatalogEvent]
+
+func (c *productClient) ExportProducts(ctx context.Context, in *ExportProductsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportProductsChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Product_ServiceDesc.Streams[1], Product_ExportProducts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportProductsRequest, ExportProductsChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Product_ExportProductsClient = grpc.ServerStreamingClient[ExportProductsChunk]
+
+func (c *productClient) StartEmbeddingBackfill(ctx context.Context, in *StartEmbeddingBackfillRequest, opts ...grpc.CallOption) (*EmbeddingBackfillStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmbeddingBackfillStatus)
+	err := c.cc.Invoke(ctx, Product_StartEmbeddingBackfill_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) StopEmbeddingBackfill(ctx context.Context, in *StopEmbeddingBackfillRequest, opts ...grpc.CallOption) (*EmbeddingBackfillStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmbeddingBackfillStatus)
+	err := c.cc.Invoke(ctx, Product_StopEmbeddingBackfill_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) GetEmbeddingBackfillStatus(ctx context.Context, in *GetEmbeddingBackfillStatusRequest, opts ...grpc.CallOption) (*EmbeddingBackfillStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmbeddingBackfillStatus)
+	err := c.cc.Invoke(ctx, Product_GetEmbeddingBackfillStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) ListSearchRules(ctx context.Context, in *ListSearchRulesRequest, opts ...grpc.CallOption) (*ListSearchRulesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSearchRulesReply)
+	err := c.cc.Invoke(ctx, Product_ListSearchRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) CreateSearchRule(ctx context.Context, in *CreateSearchRuleRequest, opts ...grpc.CallOption) (*SearchRule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchRule)
+	err := c.cc.Invoke(ctx, Product_CreateSearchRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) UpdateSearchRule(ctx context.Context, in *UpdateSearchRuleRequest, opts ...grpc.CallOption) (*SearchRule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchRule)
+	err := c.cc.Invoke(ctx, Product_UpdateSearchRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) DeleteSearchRule(ctx context.Context, in *DeleteSearchRuleRequest, opts ...grpc.CallOption) (*SearchRule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchRule)
+	err := c.cc.Invoke(ctx, Product_DeleteSearchRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProductServer is the server API for Product service.
 // All implementations must embed UnimplementedProductServer
 // for forward compatibility.
 type ProductServer interface {
 	// Get product by ID
 	GetProduct(context.Context, *GetProductRequest) (*ProductInfo, error)
+	// Create product
+	CreateProduct(context.Context, *CreateProductRequest) (*ProductInfo, error)
+	// Update product, optionally restricted to the fields in update_mask
+	UpdateProduct(context.Context, *UpdateProductRequest) (*ProductInfo, error)
+	// Delete product
+	DeleteProduct(context.Context, *DeleteProductRequest) (*ProductInfo, error)
 	// Get product by Flipkart PID
 	GetProductByPID(context.Context, *GetProductByPIDRequest) (*ProductInfo, error)
 	// List products
@@ -131,6 +366,35 @@ type ProductServer interface {
 	GetFeaturedProducts(context.Context, *GetFeaturedProductsRequest) (*ListProductsReply, error)
 	// Get similar products
 	GetSimilarProducts(context.Context, *GetSimilarProductsRequest) (*ListProductsReply, error)
+	// Semantic search over product embeddings
+	SemanticSearch(context.Context, *SemanticSearchRequest) (*SemanticSearchReply, error)
+	// Keyword and semantic search fused by reciprocal rank, with the score
+	// breakdown of every result
+	HybridSearch(context.Context, *HybridSearchRequest) (*HybridSearchReply, error)
+	// Complete a partially typed search query
+	SuggestQueries(context.Context, *SuggestQueriesRequest) (*SuggestQueriesReply, error)
+	// Answer a natural-language question from the catalog (RAG)
+	AskCatalog(context.Context, *AskCatalogRequest) (*AskCatalogReply, error)
+	// Stream the answer to a catalog question: the matching products first,
+	// then answer deltas, then the validated citations. Served over HTTP as
+	// server-sent events at GET /v1/products/ask/stream.
+	StreamAskCatalog(*AskCatalogRequest, grpc.ServerStreamingServer[AskCatalogEvent]) error
+	// Export the products matching a listing's filters as JSONL or CSV. The
+	// file is streamed in chunks to concatenate; served over gRPC only.
+	ExportProducts(*ExportProductsRequest, grpc.ServerStreamingServer[ExportProductsChunk]) error
+	// Start (or resume) embedding products that have none
+	StartEmbeddingBackfill(context.Context, *StartEmbeddingBackfillRequest) (*EmbeddingBackfillStatus, error)
+	// Stop the embedding backfill; a later start resumes where it stopped
+	StopEmbeddingBackfill(context.Context, *StopEmbeddingBackfillRequest) (*EmbeddingBackfillStatus, error)
+	// Get embedding backfill progress and dead-lettered products
+	GetEmbeddingBackfillStatus(context.Context, *GetEmbeddingBackfillStatusRequest) (*EmbeddingBackfillStatus, error)
+	// Search rules: synonyms and category boosts applied to search queries.
+	// Changes take effect without a restart.
+	ListSearchRules(context.Context, *ListSearchRulesRequest) (*ListSearchRulesReply, error)
+	CreateSearchRule(context.Context, *CreateSearchRuleRequest) (*SearchRule, error)
+	// Replace a rule
+	UpdateSearchRule(context.Context, *UpdateSearchRuleRequest) (*SearchRule, error)
+	DeleteSearchRule(context.Context, *DeleteSearchRuleRequest) (*SearchRule, error)
 	mustEmbedUnimplementedProductServer()
 }
 
@@ -144,6 +408,15 @@ type UnimplementedProductServer struct{}
 func (UnimplementedProductServer) GetProduct(context.Context, *GetProductRequest) (*ProductInfo, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetProduct not implemented")
 }
+func (UnimplementedProductServer) CreateProduct(context.Context, *CreateProductRequest) (*ProductInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateProduct not implemented")
+}
+func (UnimplementedProductServer) UpdateProduct(context.Context, *UpdateProductRequest) (*ProductInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateProduct not implemented")
+}
+func (UnimplementedProductServer) DeleteProduct(context.Context, *DeleteProductRequest) (*ProductInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteProduct not implemented")
+}
 func (UnimplementedProductServer) GetProductByPID(context.Context, *GetProductByPIDRequest) (*ProductInfo, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetProductByPID not implemented")
 }
@@ -159,6 +432,45 @@ func (UnimplementedProductServer) GetFeaturedProducts(context.Context, *GetFeatu
 func (UnimplementedProductServer) GetSimilarProducts(context.Context, *GetSimilarProductsRequest) (*ListProductsReply, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetSimilarProducts not implemented")
 }
+func (UnimplementedProductServer) SemanticSearch(context.Context, *SemanticSearchRequest) (*SemanticSearchReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method SemanticSearch not implemented")
+}
+func (UnimplementedProductServer) HybridSearch(context.Context, *HybridSearchRequest) (*HybridSearchReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method HybridSearch not implemented")
+}
+func (UnimplementedProductServer) SuggestQueries(context.Context, *SuggestQueriesRequest) (*SuggestQueriesReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method SuggestQueries not implemented")
+}
+func (UnimplementedProductServer) AskCatalog(context.Context, *AskCatalogRequest) (*AskCatalogReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method AskCatalog not implemented")
+}
+func (UnimplementedProductServer) StreamAskCatalog(*AskCatalogRequest, grpc.ServerStreamingServer[AskCatalogEvent]) error {
+	return status.Error(codes.Unimplemented, "method StreamAskCatalog not implemented")
+}
+func (UnimplementedProductServer) ExportProducts(*ExportProductsRequest, grpc.ServerStreamingServer[ExportProductsChunk]) error {
+	return status.Error(codes.Unimplemented, "method ExportProducts not implemented")
+}
+func (UnimplementedProductServer) StartEmbeddingBackfill(context.Context, *StartEmbeddingBackfillRequest) (*EmbeddingBackfillStatus, error) {
+	return nil, status.Error(codes.Unimplemented, "method StartEmbeddingBackfill not implemented")
+}
+func (UnimplementedProductServer) StopEmbeddingBackfill(context.Context, *StopEmbeddingBackfillRequest) (*EmbeddingBackfillStatus, error) {
+	return nil, status.Error(codes.Unimplemented, "method StopEmbeddingBackfill not implemented")
+}
+func (UnimplementedProductServer) GetEmbeddingBackfillStatus(context.Context, *GetEmbeddingBackfillStatusRequest) (*EmbeddingBackfillStatus, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetEmbeddingBackfillStatus not implemented")
+}
+func (UnimplementedProductServer) ListSearchRules(context.Context, *ListSearchRulesRequest) (*ListSearchRulesReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSearchRules not implemented")
+}
+func (UnimplementedProductServer) CreateSearchRule(context.Context, *CreateSearchRuleRequest) (*SearchRule, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateSearchRule not implemented")
+}
+func (UnimplementedProductServer) UpdateSearchRule(context.Context, *UpdateSearchRuleRequest) (*SearchRule, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateSearchRule not implemented")
+}
+func (UnimplementedProductServer) DeleteSearchRule(context.Context, *DeleteSearchRuleRequest) (*SearchRule, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteSearchRule not implemented")
+}
 func (UnimplementedProductServer) mustEmbedUnimplementedProductServer() {}
 func (UnimplementedProductServer) testEmbeddedByValue()                 {}
 
@@ -198,6 +510,60 @@ func _Product_GetProduct_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Product_CreateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).CreateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_CreateProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).CreateProduct(ctx, req.(*CreateProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_UpdateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).UpdateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_UpdateProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).UpdateProduct(ctx, req.(*UpdateProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_DeleteProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).DeleteProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_DeleteProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).DeleteProduct(ctx, req.(*DeleteProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Product_GetProductByPID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetProductByPIDRequest)
 	if err := dec(in); err != nil {
@@ -288,6 +654,226 @@ func _Product_GetSimilarProducts_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Product_SemanticSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SemanticSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).SemanticSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_SemanticSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).SemanticSearch(ctx, req.(*SemanticSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_HybridSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HybridSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).HybridSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_HybridSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).HybridSearch(ctx, req.(*HybridSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_SuggestQueries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestQueriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).SuggestQueries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_SuggestQueries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).SuggestQueries(ctx, req.(*SuggestQueriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_AskCatalog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AskCatalogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).AskCatalog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_AskCatalog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).AskCatalog(ctx, req.(*AskCatalogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_StreamAskCatalog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AskCatalogRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProductServer).StreamAskCatalog(m, &grpc.GenericServerStream[AskCatalogRequest, AskCatalogEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Product_StreamAskCatalogServer = grpc.ServerStreamingServer[AskCatalogEvent]
+
+func _Product_ExportProducts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportProductsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProductServer).ExportProducts(m, &grpc.GenericServerStream[ExportProductsRequest, ExportProductsChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Product_ExportProductsServer = grpc.ServerStreamingServer[ExportProductsChunk]
+
+func _Product_StartEmbeddingBackfill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartEmbeddingBackfillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).StartEmbeddingBackfill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_StartEmbeddingBackfill_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).StartEmbeddingBackfill(ctx, req.(*StartEmbeddingBackfillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_StopEmbeddingBackfill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopEmbeddingBackfillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).StopEmbeddingBackfill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_StopEmbeddingBackfill_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).StopEmbeddingBackfill(ctx, req.(*StopEmbeddingBackfillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_GetEmbeddingBackfillStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEmbeddingBackfillStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).GetEmbeddingBackfillStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_GetEmbeddingBackfillStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).GetEmbeddingBackfillStatus(ctx, req.(*GetEmbeddingBackfillStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_ListSearchRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSearchRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).ListSearchRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_ListSearchRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).ListSearchRules(ctx, req.(*ListSearchRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_CreateSearchRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSearchRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).CreateSearchRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_CreateSearchRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).CreateSearchRule(ctx, req.(*CreateSearchRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_UpdateSearchRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSearchRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).UpdateSearchRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_UpdateSearchRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).UpdateSearchRule(ctx, req.(*UpdateSearchRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_DeleteSearchRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSearchRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).DeleteSearchRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_DeleteSearchRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).DeleteSearchRule(ctx, req.(*DeleteSearchRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Product_ServiceDesc is the grpc.ServiceDesc for Product service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -299,6 +885,18 @@ var Product_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetProduct",
 			Handler:    _Product_GetProduct_Handler,
 		},
+		{
+			MethodName: "CreateProduct",
+			Handler:    _Product_CreateProduct_Handler,
+		},
+		{
+			MethodName: "UpdateProduct",
+			Handler:    _Product_UpdateProduct_Handler,
+		},
+		{
+			MethodName: "DeleteProduct",
+			Handler:    _Product_DeleteProduct_Handler,
+		},
 		{
 			MethodName: "GetProductByPID",
 			Handler:    _Product_GetProductByPID_Handler,
@@ -319,7 +917,62 @@ var Product_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSimilarProducts",
 			Handler:    _Product_GetSimilarProducts_Handler,
 		},
+		{
+			MethodName: "SemanticSearch",
+			Handler:    _Product_SemanticSearch_Handler,
+		},
+		{
+			MethodName: "HybridSearch",
+			Handler:    _Product_HybridSearch_Handler,
+		},
+		{
+			MethodName: "SuggestQueries",
+			Handler:    _Product_SuggestQueries_Handler,
+		},
+		{
+			MethodName: "AskCatalog",
+			Handler:    _Product_AskCatalog_Handler,
+		},
+		{
+			MethodName: "StartEmbeddingBackfill",
+			Handler:    _Product_StartEmbeddingBackfill_Handler,
+		},
+		{
+			MethodName: "StopEmbeddingBackfill",
+			Handler:    _Product_StopEmbeddingBackfill_Handler,
+		},
+		{
+			MethodName: "GetEmbeddingBackfillStatus",
+			Handler:    _Product_GetEmbeddingBackfillStatus_Handler,
+		},
+		{
+			MethodName: "ListSearchRules",
+			Handler:    _Product_ListSearchRules_Handler,
+		},
+		{
+			MethodName: "CreateSearchRule",
+			Handler:    _Product_CreateSearchRule_Handler,
+		},
+		{
+			MethodName: "UpdateSearchRule",
+			Handler:    _Product_UpdateSearchRule_Handler,
+		},
+		{
+			MethodName: "DeleteSearchRule",
+			Handler:    _Product_DeleteSearchRule_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamAskCatalog",
+			Handler:       _Product_StreamAskCatalog_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportProducts",
+			Handler:       _Product_ExportProducts_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/product/v1/product.proto",
 }
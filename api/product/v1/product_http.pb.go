@@ -19,14 +19,38 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationProductAskCatalog = "/api.product.v1.Product/AskCatalog"
+const OperationProductCreateProduct = "/api.product.v1.Product/CreateProduct"
+const OperationProductCreateSearchRule = "/api.product.v1.Product/CreateSearchRule"
+const OperationProductDeleteProduct = "/api.product.v1.Product/DeleteProduct"
+const OperationProductDeleteSearchRule = "/api.product.v1.Product/DeleteSearchRule"
+const OperationProductGetEmbeddingBackfillStatus = "/api.product.v1.Product/GetEmbeddingBackfillStatus"
 const OperationProductGetFeaturedProducts = "/api.product.v1.Product/GetFeaturedProducts"
 const OperationProductGetProduct = "/api.product.v1.Product/GetProduct"
 const OperationProductGetProductByPID = "/api.product.v1.Product/GetProductByPID"
 const OperationProductGetSimilarProducts = "/api.product.v1.Product/GetSimilarProducts"
+const OperationProductHybridSearch = "/api.product.v1.Product/HybridSearch"
 const OperationProductListProducts = "/api.product.v1.Product/ListProducts"
+const OperationProductListSearchRules = "/api.product.v1.Product/ListSearchRules"
 const OperationProductSearchProducts = "/api.product.v1.Product/SearchProducts"
+const OperationProductSemanticSearch = "/api.product.v1.Product/SemanticSearch"
+const OperationProductStartEmbeddingBackfill = "/api.product.v1.Product/StartEmbeddingBackfill"
+const OperationProductStopEmbeddingBackfill = "/api.product.v1.Product/StopEmbeddingBackfill"
+const OperationProductSuggestQueries = "/api.product.v1.Product/SuggestQueries"
+const OperationProductUpdateProduct = "/api.product.v1.Product/UpdateProduct"
+const OperationProductUpdateSearchRule = "/api.product.v1.Product/UpdateSearchRule"
 
 type ProductHTTPServer interface {
+	// AskCatalog Answer a natural-language question from the catalog (RAG)
+	AskCatalog(context.Context, *AskCatalogRequest) (*AskCatalogReply, error)
+	// CreateProduct Create product
+	CreateProduct(context.Context, *CreateProductRequest) (*ProductInfo, error)
+	CreateSearchRule(context.Context, *CreateSearchRuleRequest) (*SearchRule, error)
+	// DeleteProduct Delete product
+	DeleteProduct(context.Context, *DeleteProductRequest) (*ProductInfo, error)
+	DeleteSearchRule(context.Context, *DeleteSearchRuleRequest) (*SearchRule, error)
+	// GetEmbeddingBackfillStatus Get embedding backfill progress and dead-lettered products
+	GetEmbeddingBackfillStatus(context.Context, *GetEmbeddingBackfillStatusRequest) (*EmbeddingBackfillStatus, error)
 	// GetFeaturedProducts Get featured products
 	GetFeaturedProducts(context.Context, *GetFeaturedProductsRequest) (*ListProductsReply, error)
 	// GetProduct Get product by ID
@@ -35,20 +59,52 @@ type ProductHTTPServer interface {
 	GetProductByPID(context.Context, *GetProductByPIDRequest) (*ProductInfo, error)
 	// GetSimilarProducts Get similar products
 	GetSimilarProducts(context.Context, *GetSimilarProductsRequest) (*ListProductsReply, error)
+	// HybridSearch Keyword and semantic search fused by reciprocal rank, with the score
+	// breakdown of every result
+	HybridSearch(context.Context, *HybridSearchRequest) (*HybridSearchReply, error)
 	// ListProducts List products
 	ListProducts(context.Context, *ListProductsRequest) (*ListProductsReply, error)
+	// ListSearchRules Search rules: synonyms and category boosts applied to search queries.
+	// Changes take effect without a restart.
+	ListSearchRules(context.Context, *ListSearchRulesRequest) (*ListSearchRulesReply, error)
 	// SearchProducts Search products
 	SearchProducts(context.Context, *SearchProductsRequest) (*ListProductsReply, error)
+	// SemanticSearch Semantic search over product embeddings
+	SemanticSearch(context.Context, *SemanticSearchRequest) (*SemanticSearchReply, error)
+	// StartEmbeddingBackfill Start (or resume) embedding products that have none
+	StartEmbeddingBackfill(context.Context, *StartEmbeddingBackfillRequest) (*EmbeddingBackfillStatus, error)
+	// StopEmbeddingBackfill Stop the embedding backfill; a later start resumes where it stopped
+	StopEmbeddingBackfill(context.Context, *StopEmbeddingBackfillRequest) (*EmbeddingBackfillStatus, error)
+	// SuggestQueries Complete a partially typed search query
+	SuggestQueries(context.Context, *SuggestQueriesRequest) (*SuggestQueriesReply, error)
+	// UpdateProduct Update product, optionally restricted to the fields in update_mask
+	UpdateProduct(context.Context, *UpdateProductRequest) (*ProductInfo, error)
+	// UpdateSearchRule Replace a rule
+	UpdateSearchRule(context.Context, *UpdateSearchRuleRequest) (*SearchRule, error)
 }
 
 func RegisterProductHTTPServer(s *http.Server, srv ProductHTTPServer) {
 	r := s.Route("/")
 	r.GET("/v1/products/{id}", _Product_GetProduct0_HTTP_Handler(srv))
+	r.POST("/v1/products", _Product_CreateProduct0_HTTP_Handler(srv))
+	r.PATCH("/v1/products/{product.id}", _Product_UpdateProduct0_HTTP_Handler(srv))
+	r.DELETE("/v1/products/{id}", _Product_DeleteProduct0_HTTP_Handler(srv))
 	r.GET("/v1/products/pid/{pid}", _Product_GetProductByPID0_HTTP_Handler(srv))
 	r.GET("/v1/products", _Product_ListProducts0_HTTP_Handler(srv))
 	r.GET("/v1/products/search", _Product_SearchProducts0_HTTP_Handler(srv))
 	r.GET("/v1/products/featured", _Product_GetFeaturedProducts0_HTTP_Handler(srv))
 	r.GET("/v1/products/{id}/similar", _Product_GetSimilarProducts0_HTTP_Handler(srv))
+	r.POST("/v1/products/semantic-search", _Product_SemanticSearch0_HTTP_Handler(srv))
+	r.POST("/v1/products/hybrid-search", _Product_HybridSearch0_HTTP_Handler(srv))
+	r.GET("/v1/search/suggestions", _Product_SuggestQueries0_HTTP_Handler(srv))
+	r.POST("/v1/products/ask", _Product_AskCatalog0_HTTP_Handler(srv))
+	r.POST("/v1/admin/embeddings/backfill/start", _Product_StartEmbeddingBackfill0_HTTP_Handler(srv))
+	r.POST("/v1/admin/embeddings/backfill/stop", _Product_StopEmbeddingBackfill0_HTTP_Handler(srv))
+	r.GET("/v1/admin/embeddings/backfill", _Product_GetEmbeddingBackfillStatus0_HTTP_Handler(srv))
+	r.GET("/v1/admin/search/rules", _Product_ListSearchRules0_HTTP_Handler(srv))
+	r.POST("/v1/admin/search/rules", _Product_CreateSearchRule0_HTTP_Handler(srv))
+	r.PUT("/v1/admin/search/rules/{rule.id}", _Product_UpdateSearchRule0_HTTP_Handler(srv))
+	r.DELETE("/v1/admin/search/rules/{id}", _Product_DeleteSearchRule0_HTTP_Handler(srv))
 }
 
 func _Product_GetProduct0_HTTP_Handler(srv ProductHTTPServer) func(ctx http.Context) error {
@@ -73,6 +129,75 @@ func _Product_GetProduct0_HTTP_Handler(srv ProductHTTPServer) func(ctx http.Cont
 	}
 }
 
+func _Product_CreateProduct0_HTTP_Handler(srv ProductHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateProductRequest
+		if err := ctx.Bind(&in.Product); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProductCreateProduct)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateProduct(ctx, req.(*CreateProductRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ProductInfo)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Product_UpdateProduct0_HTTP_Handler(srv ProductHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateProductRequest
+		if err := ctx.Bind(&in.Product); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProductUpdateProduct)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateProduct(ctx, req.(*UpdateProductRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ProductInfo)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Product_DeleteProduct0_HTTP_Handler(srv ProductHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteProductRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProductDeleteProduct)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteProduct(ctx, req.(*DeleteProductRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ProductInfo)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _Product_GetProductByPID0_HTTP_Handler(srv ProductHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in GetProductByPIDRequest
@@ -174,7 +299,253 @@ func _Product_GetSimilarProducts0_HTTP_Handler(srv ProductHTTPServer) func(ctx h
 	}
 }
 
+func _Product_SemanticSearch0_HTTP_Handler(srv ProductHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SemanticSearchRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProductSemanticSearch)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SemanticSearch(ctx, req.(*SemanticSearchRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SemanticSearchReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Product_HybridSearch0_HTTP_Handler(srv ProductHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in HybridSearchRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProductHybridSearch)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.HybridSearch(ctx, req.(*HybridSearchRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*HybridSearchReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Product_SuggestQueries0_HTTP_Handler(srv ProductHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SuggestQueriesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProductSuggestQueries)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SuggestQueries(ctx, req.(*SuggestQueriesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SuggestQueriesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Product_AskCatalog0_HTTP_Handler(srv ProductHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AskCatalogRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProductAskCatalog)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AskCatalog(ctx, req.(*AskCatalogRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AskCatalogReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Product_StartEmbeddingBackfill0_HTTP_Handler(srv ProductHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in StartEmbeddingBackfillRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProductStartEmbeddingBackfill)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.StartEmbeddingBackfill(ctx, req.(*StartEmbeddingBackfillRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*EmbeddingBackfillStatus)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Product_StopEmbeddingBackfill0_HTTP_Handler(srv ProductHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in StopEmbeddingBackfillRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProductStopEmbeddingBackfill)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.StopEmbeddingBackfill(ctx, req.(*StopEmbeddingBackfillRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*EmbeddingBackfillStatus)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Product_GetEmbeddingBackfillStatus0_HTTP_Handler(srv ProductHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetEmbeddingBackfillStatusRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProductGetEmbeddingBackfillStatus)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetEmbeddingBackfillStatus(ctx, req.(*GetEmbeddingBackfillStatusRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*EmbeddingBackfillStatus)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Product_ListSearchRules0_HTTP_Handler(srv ProductHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListSearchRulesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProductListSearchRules)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListSearchRules(ctx, req.(*ListSearchRulesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListSearchRulesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Product_CreateSearchRule0_HTTP_Handler(srv ProductHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateSearchRuleRequest
+		if err := ctx.Bind(&in.Rule); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProductCreateSearchRule)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateSearchRule(ctx, req.(*CreateSearchRuleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SearchRule)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Product_UpdateSearchRule0_HTTP_Handler(srv ProductHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateSearchRuleRequest
+		if err := ctx.Bind(&in.Rule); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProductUpdateSearchRule)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateSearchRule(ctx, req.(*UpdateSearchRuleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SearchRule)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Product_DeleteSearchRule0_HTTP_Handler(srv ProductHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteSearchRuleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProductDeleteSearchRule)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteSearchRule(ctx, req.(*DeleteSearchRuleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SearchRule)
+		return ctx.Result(200, reply)
+	}
+}
+
 type ProductHTTPClient interface {
+	// AskCatalog Answer a natural-language question from the catalog (RAG)
+	AskCatalog(ctx context.Context, req *AskCatalogRequest, opts ...http.CallOption) (rsp *AskCatalogReply, err error)
+	// CreateProduct Create product
+	CreateProduct(ctx context.Context, req *CreateProductRequest, opts ...http.CallOption) (rsp *ProductInfo, err error)
+	CreateSearchRule(ctx context.Context, req *CreateSearchRuleRequest, opts ...http.CallOption) (rsp *SearchRule, err error)
+	// DeleteProduct Delete product
+	DeleteProduct(ctx context.Context, req *DeleteProductRequest, opts ...http.CallOption) (rsp *ProductInfo, err error)
+	DeleteSearchRule(ctx context.Context, req *DeleteSearchRuleRequest, opts ...http.CallOption) (rsp *SearchRule, err error)
+	// GetEmbeddingBackfillStatus Get embedding backfill progress and dead-lettered products
+	GetEmbeddingBackfillStatus(ctx context.Context, req *GetEmbeddingBackfillStatusRequest, opts ...http.CallOption) (rsp *EmbeddingBackfillStatus, err error)
 	// GetFeaturedProducts Get featured products
 	GetFeaturedProducts(ctx context.Context, req *GetFeaturedProductsRequest, opts ...http.CallOption) (rsp *ListProductsReply, err error)
 	// GetProduct Get product by ID
@@ -183,10 +554,28 @@ type ProductHTTPClient interface {
 	GetProductByPID(ctx context.Context, req *GetProductByPIDRequest, opts ...http.CallOption) (rsp *ProductInfo, err error)
 	// GetSimilarProducts Get similar products
 	GetSimilarProducts(ctx context.Context, req *GetSimilarProductsRequest, opts ...http.CallOption) (rsp *ListProductsReply, err error)
+	// HybridSearch Keyword and semantic search fused by reciprocal rank, with the score
+	// breakdown of every result
+	HybridSearch(ctx context.Context, req *HybridSearchRequest, opts ...http.CallOption) (rsp *HybridSearchReply, err error)
 	// ListProducts List products
 	ListProducts(ctx context.Context, req *ListProductsRequest, opts ...http.CallOption) (rsp *ListProductsReply, err error)
+	// ListSearchRules Search rules: synonyms and category boosts applied to search queries.
+	// Changes take effect without a restart.
+	ListSearchRules(ctx context.Context, req *ListSearchRulesRequest, opts ...http.CallOption) (rsp *ListSearchRulesReply, err error)
 	// SearchProducts Search products
 	SearchProducts(ctx context.Context, req *SearchProductsRequest, opts ...http.CallOption) (rsp *ListProductsReply, err error)
+	// SemanticSearch Semantic search over product embeddings
+	SemanticSearch(ctx context.Context, req *SemanticSearchRequest, opts ...http.CallOption) (rsp *SemanticSearchReply, err error)
+	// StartEmbeddingBackfill Start (or resume) embedding products that have none
+	StartEmbeddingBackfill(ctx context.Context, req *StartEmbeddingBackfillRequest, opts ...http.CallOption) (rsp *EmbeddingBackfillStatus, err error)
+	// StopEmbeddingBackfill Stop the embedding backfill; a later start resumes where it stopped
+	StopEmbeddingBackfill(ctx context.Context, req *StopEmbeddingBackfillRequest, opts ...http.CallOption) (rsp *EmbeddingBackfillStatus, err error)
+	// SuggestQueries Complete a partially typed search query
+	SuggestQueries(ctx context.Context, req *SuggestQueriesRequest, opts ...http.CallOption) (rsp *SuggestQueriesReply, err error)
+	// UpdateProduct Update product, optionally restricted to the fields in update_mask
+	UpdateProduct(ctx context.Context, req *UpdateProductRequest, opts ...http.CallOption) (rsp *ProductInfo, err error)
+	// UpdateSearchRule Replace a rule
+	UpdateSearchRule(ctx context.Context, req *UpdateSearchRuleRequest, opts ...http.CallOption) (rsp *SearchRule, err error)
 }
 
 type ProductHTTPClientImpl struct {
@@ -197,6 +586,88 @@ func NewProductHTTPClient(client *http.Client) ProductHTTPClient {
 	return &ProductHTTPClientImpl{client}
 }
 
+// AskCatalog Answer a natural-language question from the catalog (RAG)
+func (c *ProductHTTPClientImpl) AskCatalog(ctx context.Context, in *AskCatalogRequest, opts ...http.CallOption) (*AskCatalogReply, error) {
+	var out AskCatalogReply
+	pattern := "/v1/products/ask"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationProductAskCatalog))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateProduct Create product
+func (c *ProductHTTPClientImpl) CreateProduct(ctx context.Context, in *CreateProductRequest, opts ...http.CallOption) (*ProductInfo, error) {
+	var out ProductInfo
+	pattern := "/v1/products"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationProductCreateProduct))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.Product, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ProductHTTPClientImpl) CreateSearchRule(ctx context.Context, in *CreateSearchRuleRequest, opts ...http.CallOption) (*SearchRule, error) {
+	var out SearchRule
+	pattern := "/v1/admin/search/rules"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationProductCreateSearchRule))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.Rule, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteProduct Delete product
+func (c *ProductHTTPClientImpl) DeleteProduct(ctx context.Context, in *DeleteProductRequest, opts ...http.CallOption) (*ProductInfo, error) {
+	var out ProductInfo
+	pattern := "/v1/products/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationProductDeleteProduct))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ProductHTTPClientImpl) DeleteSearchRule(ctx context.Context, in *DeleteSearchRuleRequest, opts ...http.CallOption) (*SearchRule, error) {
+	var out SearchRule
+	pattern := "/v1/admin/search/rules/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationProductDeleteSearchRule))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetEmbeddingBackfillStatus Get embedding backfill progress and dead-lettered products
+func (c *ProductHTTPClientImpl) GetEmbeddingBackfillStatus(ctx context.Context, in *GetEmbeddingBackfillStatusRequest, opts ...http.CallOption) (*EmbeddingBackfillStatus, error) {
+	var out EmbeddingBackfillStatus
+	pattern := "/v1/admin/embeddings/backfill"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationProductGetEmbeddingBackfillStatus))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // GetFeaturedProducts Get featured products
 func (c *ProductHTTPClientImpl) GetFeaturedProducts(ctx context.Context, in *GetFeaturedProductsRequest, opts ...http.CallOption) (*ListProductsReply, error) {
 	var out ListProductsReply
@@ -253,6 +724,21 @@ func (c *ProductHTTPClientImpl) GetSimilarProducts(ctx context.Context, in *GetS
 	return &out, nil
 }
 
+// HybridSearch Keyword and semantic search fused by reciprocal rank, with the score
+// breakdown of every result
+func (c *ProductHTTPClientImpl) HybridSearch(ctx context.Context, in *HybridSearchRequest, opts ...http.CallOption) (*HybridSearchReply, error) {
+	var out HybridSearchReply
+	pattern := "/v1/products/hybrid-search"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationProductHybridSearch))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // ListProducts List products
 func (c *ProductHTTPClientImpl) ListProducts(ctx context.Context, in *ListProductsRequest, opts ...http.CallOption) (*ListProductsReply, error) {
 	var out ListProductsReply
@@ -267,6 +753,21 @@ func (c *ProductHTTPClientImpl) ListProducts(ctx context.Context, in *ListProduc
 	return &out, nil
 }
 
+// ListSearchRules Search rules: synonyms and category boosts applied to search queries.
+// Changes take effect without a restart.
+func (c *ProductHTTPClientImpl) ListSearchRules(ctx context.Context, in *ListSearchRulesRequest, opts ...http.CallOption) (*ListSearchRulesReply, error) {
+	var out ListSearchRulesReply
+	pattern := "/v1/admin/search/rules"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationProductListSearchRules))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // SearchProducts Search products
 func (c *ProductHTTPClientImpl) SearchProducts(ctx context.Context, in *SearchProductsRequest, opts ...http.CallOption) (*ListProductsReply, error) {
 	var out ListProductsReply
@@ -280,3 +781,87 @@ func (c *ProductHTTPClientImpl) SearchProducts(ctx context.Context, in *SearchPr
 	}
 	return &out, nil
 }
+
+// SemanticSearch Semantic search over product embeddings
+func (c *ProductHTTPClientImpl) SemanticSearch(ctx context.Context, in *SemanticSearchRequest, opts ...http.CallOption) (*SemanticSearchReply, error) {
+	var out SemanticSearchReply
+	pattern := "/v1/products/semantic-search"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationProductSemanticSearch))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// StartEmbeddingBackfill Start (or resume) embedding products that have none
+func (c *ProductHTTPClientImpl) StartEmbeddingBackfill(ctx context.Context, in *StartEmbeddingBackfillRequest, opts ...http.CallOption) (*EmbeddingBackfillStatus, error) {
+	var out EmbeddingBackfillStatus
+	pattern := "/v1/admin/embeddings/backfill/start"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationProductStartEmbeddingBackfill))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// StopEmbeddingBackfill Stop the embedding backfill; a later start resumes where it stopped
+func (c *ProductHTTPClientImpl) StopEmbeddingBackfill(ctx context.Context, in *StopEmbeddingBackfillRequest, opts ...http.CallOption) (*EmbeddingBackfillStatus, error) {
+	var out EmbeddingBackfillStatus
+	pattern := "/v1/admin/embeddings/backfill/stop"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationProductStopEmbeddingBackfill))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SuggestQueries Complete a partially typed search query
+func (c *ProductHTTPClientImpl) SuggestQueries(ctx context.Context, in *SuggestQueriesRequest, opts ...http.CallOption) (*SuggestQueriesReply, error) {
+	var out SuggestQueriesReply
+	pattern := "/v1/search/suggestions"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationProductSuggestQueries))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateProduct Update product, optionally restricted to the fields in update_mask
+func (c *ProductHTTPClientImpl) UpdateProduct(ctx context.Context, in *UpdateProductRequest, opts ...http.CallOption) (*ProductInfo, error) {
+	var out ProductInfo
+	pattern := "/v1/products/{product.id}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationProductUpdateProduct))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PATCH", path, in.Product, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateSearchRule Replace a rule
+func (c *ProductHTTPClientImpl) UpdateSearchRule(ctx context.Context, in *UpdateSearchRuleRequest, opts ...http.CallOption) (*SearchRule, error) {
+	var out SearchRule
+	pattern := "/v1/admin/search/rules/{rule.id}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationProductUpdateSearchRule))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in.Rule, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
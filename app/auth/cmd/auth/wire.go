@@ -11,6 +11,8 @@ import (
 	"yinni_backend/app/auth/internal/server"
 	"yinni_backend/app/auth/internal/service"
 	"yinni_backend/internal/conf"
+	"yinni_backend/pkg/jwks"
+	"yinni_backend/pkg/revocation"
 
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/log"
@@ -19,5 +21,5 @@ import (
 
 // wireApp init kratos application.
 func wireApp(confServer *conf.Server, confAuth *conf.Auth, confData *conf.Data, logger log.Logger) (*kratos.App, func(), error) {
-	panic(wire.Build(server.ProviderSet, data.ProviderSet, biz.ProviderSet, service.ProviderSet, newApp))
+	panic(wire.Build(server.ProviderSet, data.ProviderSet, biz.ProviderSet, service.ProviderSet, revocation.ProviderSet, jwks.ProviderSet, newApp))
 }
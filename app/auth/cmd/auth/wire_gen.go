@@ -14,6 +14,8 @@ import (
 	"yinni_backend/app/auth/internal/server"
 	"yinni_backend/app/auth/internal/service"
 	"yinni_backend/internal/conf"
+	"yinni_backend/pkg/jwks"
+	"yinni_backend/pkg/revocation"
 )
 
 import (
@@ -29,16 +31,29 @@ func wireApp(confServer *conf.Server, confAuth *conf.Auth, confData *conf.Data,
 		return nil, nil, err
 	}
 	authRepo := data.NewAuthRepo(dataData, logger)
-	authUsecase, err := biz.NewAuthUsecase(authRepo, confAuth)
+	store, cleanup2, err := revocation.NewStore(confData, logger)
 	if err != nil {
 		cleanup()
 		return nil, nil, err
 	}
+	keySet, err := jwks.NewKeySet(confAuth)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	authUsecase, err := biz.NewAuthUsecase(authRepo, store, keySet, confAuth, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
 	authService := service.NewAuthService(authUsecase)
-	grpcServer := server.NewGRPCServer(confServer, authService, logger)
-	httpServer := server.NewHTTPServer(confServer, authService, logger)
+	grpcServer := server.NewGRPCServer(confServer, confAuth, store, keySet, authService, logger)
+	httpServer := server.NewHTTPServer(confServer, confAuth, store, keySet, authService, logger)
 	app := newApp(logger, grpcServer, httpServer)
 	return app, func() {
+		cleanup2()
 		cleanup()
 	}, nil
 }
@@ -2,9 +2,17 @@ package biz
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"time"
 	"yinni_backend/internal/conf"
+	"yinni_backend/pkg/auth"
+	"yinni_backend/pkg/jwks"
+	"yinni_backend/pkg/revocation"
 
+	"github.com/go-kratos/kratos/v2/log"
 	"github.com/golang-jwt/jwt/v5"
 	"golang.org/x/crypto/bcrypt"
 )
@@ -15,6 +23,7 @@ type User struct {
 	Email     string
 	Password  string // Hashed password
 	Name      string
+	Role      string
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
@@ -26,35 +35,88 @@ func (uc *AuthUsecase) JWTExpire() time.Duration {
 
 // JWT Claims structure matching your middleware
 type JWTClaims struct {
-	UserID int64 `json:"user_id"`
+	UserID int64    `json:"user_id"`
+	Roles  []string `json:"roles,omitempty"`
+	Scopes []string `json:"scopes,omitempty"`
 	jwt.RegisteredClaims
 }
 
+// Tokens are an access token and the refresh token that renews it.
+type Tokens struct {
+	AccessToken      string
+	ExpiresIn        time.Duration
+	RefreshToken     string
+	RefreshExpiresIn time.Duration
+}
+
+// Reasons a refresh token was revoked.
+const (
+	RevokeReasonRotated = "rotated"
+	RevokeReasonSignOut = "sign_out"
+	RevokeReasonReuse   = "reuse"
+)
+
+// RefreshToken is a stored refresh token. The token itself is only handed
+// to the client; the store keeps its hash.
+type RefreshToken struct {
+	ID        int64
+	UserID    int64
+	FamilyID  string
+	ExpiresAt time.Time
+	// RevokedAt is nil while the token is usable.
+	RevokedAt    *time.Time
+	RevokeReason string
+}
+
 // AuthRepo is an Auth repository interface.
 type AuthRepo interface {
 	CreateUser(ctx context.Context, user *User) (*User, error)
 	FindByEmail(ctx context.Context, email string) (*User, error)
 	GetUserByID(ctx context.Context, id int64) (*User, error)
+
+	// Refresh tokens, looked up by the hash of the token
+	CreateRefreshToken(ctx context.Context, t *RefreshToken, hash string) error
+	// FindRefreshToken returns nil when no token has the hash.
+	FindRefreshToken(ctx context.Context, hash string) (*RefreshToken, error)
+	// RotateRefreshToken revokes the token as rotated and stores next in one
+	// transaction. It returns false, storing nothing, when the token was
+	// already revoked.
+	RotateRefreshToken(ctx context.Context, id int64, next *RefreshToken, nextHash string) (bool, error)
+	// RevokeRefreshTokenFamily revokes every unrevoked token of the family.
+	RevokeRefreshTokenFamily(ctx context.Context, familyID, reason string) error
 }
 
 // AuthUsecase is an Auth usecase.
 type AuthUsecase struct {
-	repo      AuthRepo
-	jwtSecret string
-	jwtExpire time.Duration
+	repo          AuthRepo
+	revocations   revocation.Store
+	keys          *jwks.KeySet
+	jwtSecret     string
+	jwtExpire     time.Duration
+	refreshExpire time.Duration
+	log           *log.Helper
 }
 
-func NewAuthUsecase(repo AuthRepo, c *conf.Auth) (*AuthUsecase, error) {
-	// Convert int64 nanoseconds to time.Duration
-	jwtExpire := time.Duration(c.JwtExpire)
+// NewAuthUsecase creates an Auth usecase. Tokens are signed with keys, or
+// with the shared secret when keys is nil.
+func NewAuthUsecase(repo AuthRepo, revocations revocation.Store, keys *jwks.KeySet, c *conf.Auth, logger log.Logger) (*AuthUsecase, error) {
+	jwtExpire := time.Duration(c.JwtExpire) * time.Second
 	if jwtExpire == 0 {
 		jwtExpire = 24 * time.Hour // default 24 hours
 	}
+	refreshExpire := c.RefreshExpire.AsDuration()
+	if refreshExpire <= 0 {
+		refreshExpire = 30 * 24 * time.Hour
+	}
 
 	return &AuthUsecase{
-		repo:      repo,
-		jwtSecret: c.JwtSecret,
-		jwtExpire: jwtExpire,
+		repo:          repo,
+		revocations:   revocations,
+		keys:          keys,
+		jwtSecret:     c.JwtSecret,
+		jwtExpire:     jwtExpire,
+		refreshExpire: refreshExpire,
+		log:           log.NewHelper(logger),
 	}, nil
 }
 
@@ -70,34 +132,111 @@ func checkPassword(password, hash string) bool {
 	return err == nil
 }
 
-// generateJWTToken creates a JWT token for the user
-func (uc *AuthUsecase) generateJWTToken(userID int64) (string, error) {
+// generateJWTToken creates a JWT token for the user, granting the scopes of
+// their role. Its jti lets it be revoked on its own.
+func (uc *AuthUsecase) generateJWTToken(user *User) (string, error) {
 	expirationTime := time.Now().Add(uc.jwtExpire)
 
+	jti, err := randomToken()
+	if err != nil {
+		return "", err
+	}
 	claims := &JWTClaims{
-		UserID: userID,
+		UserID: user.ID,
+		Roles:  []string{user.Role},
+		Scopes: auth.RoleScopes(user.Role),
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        jti,
 			ExpiresAt: jwt.NewNumericDate(expirationTime),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 		},
 	}
 
+	if uc.keys != nil {
+		return uc.keys.Sign(claims)
+	}
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 	return token.SignedString([]byte(uc.jwtSecret))
 }
 
-// SignUp creates a new user
-func (uc *AuthUsecase) SignUp(ctx context.Context, email, password, name string) (*User, string, error) {
+// parseJWTToken verifies a token issued by generateJWTToken.
+func (uc *AuthUsecase) parseJWTToken(tokenStr string) (*JWTClaims, error) {
+	keyfunc := func(token *jwt.Token) (interface{}, error) {
+		return []byte(uc.jwtSecret), nil
+	}
+	methods := []string{jwt.SigningMethodHS256.Alg()}
+	if uc.keys != nil {
+		keyfunc = uc.keys.Keyfunc
+		methods = jwks.Algorithms
+	}
+
+	claims := &JWTClaims{}
+	token, err := jwt.ParseWithClaims(tokenStr, claims, keyfunc, jwt.WithValidMethods(methods))
+	if err != nil {
+		return nil, err
+	}
+	if !token.Valid {
+		return nil, jwt.ErrTokenInvalidClaims
+	}
+	return claims, nil
+}
+
+// newRefreshToken creates a refresh token of the family for the user and
+// returns it with the hash to store.
+func (uc *AuthUsecase) newRefreshToken(userID int64, familyID string) (*RefreshToken, string, string, error) {
+	token, err := randomToken()
+	if err != nil {
+		return nil, "", "", err
+	}
+	t := &RefreshToken{
+		UserID:    userID,
+		FamilyID:  familyID,
+		ExpiresAt: time.Now().Add(uc.refreshExpire),
+	}
+	return t, token, hashRefreshToken(token), nil
+}
+
+// issueTokens signs an access token and pairs it with the refresh token.
+func (uc *AuthUsecase) issueTokens(user *User, refreshToken string) (*Tokens, error) {
+	accessToken, err := uc.generateJWTToken(user)
+	if err != nil {
+		return nil, err
+	}
+	return &Tokens{
+		AccessToken:      accessToken,
+		ExpiresIn:        uc.jwtExpire,
+		RefreshToken:     refreshToken,
+		RefreshExpiresIn: uc.refreshExpire,
+	}, nil
+}
+
+// randomToken returns 32 random bytes, base64url encoded.
+func randomToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func hashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// SignUp creates a new user and signs them in, starting a refresh token
+// family like SignIn.
+func (uc *AuthUsecase) SignUp(ctx context.Context, email, password, name string) (*User, *Tokens, error) {
 	// Check if user already exists
 	existingUser, err := uc.repo.FindByEmail(ctx, email)
 	if err == nil && existingUser != nil {
-		return nil, "", NewAuthError("user already exists", ErrUserAlreadyExists)
+		return nil, nil, NewAuthError("user already exists", ErrUserAlreadyExists)
 	}
 
 	// Hash password
 	hashedPassword, err := hashPassword(password)
 	if err != nil {
-		return nil, "", NewAuthError("failed to hash password", ErrInternal)
+		return nil, nil, NewAuthError("failed to hash password", ErrInternal)
 	}
 
 	// Create user
@@ -109,38 +248,176 @@ func (uc *AuthUsecase) SignUp(ctx context.Context, email, password, name string)
 
 	createdUser, err := uc.repo.CreateUser(ctx, user)
 	if err != nil {
-		return nil, "", NewAuthError("failed to create user", ErrInternal)
+		return nil, nil, NewAuthError("failed to create user", ErrInternal)
 	}
 
-	// Generate JWT token
-	token, err := uc.generateJWTToken(createdUser.ID)
+	tokens, err := uc.startSession(ctx, createdUser)
 	if err != nil {
-		return nil, "", NewAuthError("failed to generate token", ErrInternal)
+		return nil, nil, err
 	}
-
-	return createdUser, token, nil
+	return createdUser, tokens, nil
 }
 
-// SignIn authenticates a user
-func (uc *AuthUsecase) SignIn(ctx context.Context, email, password string) (*User, string, error) {
+// SignIn authenticates a user and starts a refresh token family
+func (uc *AuthUsecase) SignIn(ctx context.Context, email, password string) (*User, *Tokens, error) {
 	// Find user by email
 	user, err := uc.repo.FindByEmail(ctx, email)
 	if err != nil || user == nil {
-		return nil, "", NewAuthError("invalid email or password", ErrInvalidCredentials)
+		return nil, nil, NewAuthError("invalid email or password", ErrInvalidCredentials)
 	}
 
 	// Check password
 	if !checkPassword(password, user.Password) {
-		return nil, "", NewAuthError("invalid email or password", ErrInvalidCredentials)
+		return nil, nil, NewAuthError("invalid email or password", ErrInvalidCredentials)
 	}
 
-	// Generate JWT token
-	token, err := uc.generateJWTToken(user.ID)
+	tokens, err := uc.startSession(ctx, user)
 	if err != nil {
-		return nil, "", NewAuthError("failed to generate token", ErrInternal)
+		return nil, nil, err
 	}
+	return user, tokens, nil
+}
 
-	return user, token, nil
+// startSession issues the tokens of a new sign-in: an access token and the
+// first refresh token of a new family.
+func (uc *AuthUsecase) startSession(ctx context.Context, user *User) (*Tokens, error) {
+	familyID, err := randomToken()
+	if err != nil {
+		return nil, NewAuthError("failed to generate token", ErrInternal)
+	}
+	refresh, refreshToken, hash, err := uc.newRefreshToken(user.ID, familyID)
+	if err != nil {
+		return nil, NewAuthError("failed to generate token", ErrInternal)
+	}
+	if err := uc.repo.CreateRefreshToken(ctx, refresh, hash); err != nil {
+		return nil, NewAuthError("failed to store token", ErrInternal)
+	}
+
+	tokens, err := uc.issueTokens(user, refreshToken)
+	if err != nil {
+		return nil, NewAuthError("failed to generate token", ErrInternal)
+	}
+	return tokens, nil
+}
+
+// RefreshToken exchanges a refresh token for new tokens. The refresh token
+// is rotated: it is revoked and the new one joins its family. A rotated
+// token presented again was copied, so the whole family is revoked and its
+// holders have to sign in again.
+func (uc *AuthUsecase) RefreshToken(ctx context.Context, refreshToken string) (*Tokens, error) {
+	current, err := uc.repo.FindRefreshToken(ctx, hashRefreshToken(refreshToken))
+	if err != nil {
+		return nil, NewAuthError("failed to load token", ErrInternal)
+	}
+	if current == nil {
+		return nil, NewAuthError("invalid refresh token", ErrInvalidRefreshToken)
+	}
+	if current.RevokedAt != nil {
+		if current.RevokeReason == RevokeReasonRotated {
+			uc.revokeReusedFamily(ctx, current)
+		}
+		return nil, NewAuthError("invalid refresh token", ErrInvalidRefreshToken)
+	}
+	if time.Now().After(current.ExpiresAt) {
+		return nil, NewAuthError("refresh token expired", ErrInvalidRefreshToken)
+	}
+	// The user is reloaded so role changes apply from the next refresh
+	user, err := uc.repo.GetUserByID(ctx, current.UserID)
+	if err != nil {
+		return nil, NewAuthError("invalid refresh token", ErrInvalidRefreshToken)
+	}
+
+	next, nextToken, hash, err := uc.newRefreshToken(current.UserID, current.FamilyID)
+	if err != nil {
+		return nil, NewAuthError("failed to generate token", ErrInternal)
+	}
+	rotated, err := uc.repo.RotateRefreshToken(ctx, current.ID, next, hash)
+	if err != nil {
+		return nil, NewAuthError("failed to store token", ErrInternal)
+	}
+	if !rotated {
+		// Another request rotated it first: the token is being reused
+		uc.revokeReusedFamily(ctx, current)
+		return nil, NewAuthError("invalid refresh token", ErrInvalidRefreshToken)
+	}
+
+	tokens, err := uc.issueTokens(user, nextToken)
+	if err != nil {
+		return nil, NewAuthError("failed to generate token", ErrInternal)
+	}
+	return tokens, nil
+}
+
+// revokeReusedFamily revokes the family of a reused refresh token and,
+// since the access tokens it renewed may have leaked too, every access
+// token of its user.
+func (uc *AuthUsecase) revokeReusedFamily(ctx context.Context, t *RefreshToken) {
+	uc.log.WithContext(ctx).Warnf("Rotated refresh token %d of user %d reused, revoking its family", t.ID, t.UserID)
+	if err := uc.repo.RevokeRefreshTokenFamily(ctx, t.FamilyID, RevokeReasonReuse); err != nil {
+		uc.log.WithContext(ctx).Errorf("Revoking refresh token family of user %d failed: %v", t.UserID, err)
+	}
+	if err := uc.revokeUserTokens(ctx, t.UserID); err != nil {
+		uc.log.WithContext(ctx).Errorf("Revoking access tokens of user %d failed: %v", t.UserID, err)
+	}
+}
+
+// RevokeUserTokens revokes every access token issued to the user so far,
+// e.g. after a password or role change. Refresh tokens are not affected, so
+// the user's sessions go on with new access tokens. The principal of ctx
+// must be the user or an admin.
+func (uc *AuthUsecase) RevokeUserTokens(ctx context.Context, userID int64) error {
+	p, ok := auth.FromContext(ctx)
+	if !ok || (p.UserID != userID && !p.HasScope(auth.ScopeAdmin)) {
+		return NewAuthError("not allowed to revoke the user's tokens", ErrForbidden)
+	}
+	if err := uc.revokeUserTokens(ctx, userID); err != nil {
+		return NewAuthError("failed to revoke tokens", ErrInternal)
+	}
+	uc.log.WithContext(ctx).Infof("User %d revoked the access tokens of user %d", p.UserID, userID)
+	return nil
+}
+
+func (uc *AuthUsecase) revokeUserTokens(ctx context.Context, userID int64) error {
+	now := time.Now()
+	return uc.revocations.RevokeIssuedBefore(ctx, userID, now, now.Add(uc.jwtExpire))
+}
+
+// revokeAccessToken revokes a valid access token of the user by its jti.
+// Tokens that do not verify, or belong to someone else, are ignored.
+func (uc *AuthUsecase) revokeAccessToken(ctx context.Context, userID int64, accessToken string) error {
+	claims, err := uc.parseJWTToken(accessToken)
+	if err != nil || claims.ID == "" || claims.UserID != userID || claims.ExpiresAt == nil {
+		return nil
+	}
+	return uc.revocations.Revoke(ctx, claims.ID, claims.ExpiresAt.Time)
+}
+
+// SignOut revokes the refresh token and its family, and the access token
+// when one is given. Unknown and already revoked tokens are ignored, so
+// signing out twice succeeds, but a rotated token still counts as reused.
+func (uc *AuthUsecase) SignOut(ctx context.Context, refreshToken, accessToken string) error {
+	t, err := uc.repo.FindRefreshToken(ctx, hashRefreshToken(refreshToken))
+	if err != nil {
+		return NewAuthError("failed to load token", ErrInternal)
+	}
+	if t == nil {
+		return nil
+	}
+	if t.RevokedAt != nil {
+		if t.RevokeReason == RevokeReasonRotated {
+			uc.revokeReusedFamily(ctx, t)
+		}
+		return nil
+	}
+	if err := uc.repo.RevokeRefreshTokenFamily(ctx, t.FamilyID, RevokeReasonSignOut); err != nil {
+		return NewAuthError("failed to revoke token", ErrInternal)
+	}
+	if accessToken != "" {
+		if err := uc.revokeAccessToken(ctx, t.UserID, accessToken); err != nil {
+			return NewAuthError("failed to revoke token", ErrInternal)
+		}
+	}
+	return nil
 }
 
 // GetUserByID retrieves a user by ID
@@ -152,9 +429,11 @@ func (uc *AuthUsecase) GetUserByID(ctx context.Context, id int64) (*User, error)
 type AuthErrorType string
 
 const (
-	ErrInvalidCredentials AuthErrorType = "INVALID_CREDENTIALS"
-	ErrUserAlreadyExists  AuthErrorType = "USER_ALREADY_EXISTS"
-	ErrInternal           AuthErrorType = "INTERNAL_ERROR"
+	ErrInvalidCredentials  AuthErrorType = "INVALID_CREDENTIALS"
+	ErrUserAlreadyExists   AuthErrorType = "USER_ALREADY_EXISTS"
+	ErrInternal            AuthErrorType = "INTERNAL_ERROR"
+	ErrInvalidRefreshToken AuthErrorType = "INVALID_REFRESH_TOKEN"
+	ErrForbidden           AuthErrorType = "FORBIDDEN"
 )
 
 type AuthError struct {
@@ -0,0 +1,405 @@
+package biz
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"yinni_backend/internal/conf"
+	"yinni_backend/pkg/auth"
+	"yinni_backend/pkg/revocation"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// fakeAuthRepo keeps users and refresh tokens in memory.
+type fakeAuthRepo struct {
+	mu     sync.Mutex
+	users  []*User
+	tokens map[string]*RefreshToken
+}
+
+func newFakeAuthRepo() *fakeAuthRepo {
+	return &fakeAuthRepo{tokens: make(map[string]*RefreshToken)}
+}
+
+func (r *fakeAuthRepo) CreateUser(ctx context.Context, u *User) (*User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	created := *u
+	created.ID = int64(len(r.users) + 1)
+	created.Role = "customer"
+	r.users = append(r.users, &created)
+	return &created, nil
+}
+
+func (r *fakeAuthRepo) FindByEmail(ctx context.Context, email string) (*User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, u := range r.users {
+		if u.Email == email {
+			return u, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeAuthRepo) GetUserByID(ctx context.Context, id int64) (*User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.users[id-1], nil
+}
+
+func (r *fakeAuthRepo) CreateRefreshToken(ctx context.Context, t *RefreshToken, hash string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	t.ID = int64(len(r.tokens) + 1)
+	r.tokens[hash] = t
+	return nil
+}
+
+func (r *fakeAuthRepo) FindRefreshToken(ctx context.Context, hash string) (*RefreshToken, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if t, ok := r.tokens[hash]; ok {
+		copied := *t
+		return &copied, nil
+	}
+	return nil, nil
+}
+
+func (r *fakeAuthRepo) RotateRefreshToken(ctx context.Context, id int64, next *RefreshToken, nextHash string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, t := range r.tokens {
+		if t.ID != id {
+			continue
+		}
+		if t.RevokedAt != nil {
+			return false, nil
+		}
+		now := time.Now()
+		t.RevokedAt, t.RevokeReason = &now, RevokeReasonRotated
+		next.ID = int64(len(r.tokens) + 1)
+		r.tokens[nextHash] = next
+		return true, nil
+	}
+	return false, nil
+}
+
+func (r *fakeAuthRepo) RevokeRefreshTokenFamily(ctx context.Context, familyID, reason string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := time.Now()
+	for _, t := range r.tokens {
+		if t.FamilyID == familyID && t.RevokedAt == nil {
+			t.RevokedAt, t.RevokeReason = &now, reason
+		}
+	}
+	return nil
+}
+
+func newTestAuthUsecase(t *testing.T) (*AuthUsecase, *fakeAuthRepo, *revocation.MemoryStore) {
+	t.Helper()
+	repo := newFakeAuthRepo()
+	store := revocation.NewMemoryStore()
+	uc, err := NewAuthUsecase(repo, store, nil, &conf.Auth{
+		JwtSecret:     "test-secret",
+		JwtExpire:     3600,
+		RefreshExpire: durationpb.New(24 * time.Hour),
+	}, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return uc, repo, store
+}
+
+func isAuthError(err error, typ AuthErrorType) bool {
+	authErr, ok := err.(*AuthError)
+	return ok && authErr.Type == typ
+}
+
+func TestSignUpStartsRefreshFamily(t *testing.T) {
+	uc, _, _ := newTestAuthUsecase(t)
+	ctx := context.Background()
+
+	user, tokens, err := uc.SignUp(ctx, "ann@example.com", "hunter22", "Ann")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tokens.AccessToken == "" || tokens.RefreshToken == "" {
+		t.Fatalf("SignUp returned tokens %+v, want an access and a refresh token", tokens)
+	}
+	claims, err := uc.parseJWTToken(tokens.AccessToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if claims.UserID != user.ID || claims.ID == "" {
+		t.Errorf("access token of user %d with jti %q, want user %d with a jti", claims.UserID, claims.ID, user.ID)
+	}
+
+	// The refresh token of a sign-up renews like one of a sign-in
+	if _, err := uc.RefreshToken(ctx, tokens.RefreshToken); err != nil {
+		t.Errorf("refreshing the sign-up token: %v", err)
+	}
+
+	if _, _, err := uc.SignUp(ctx, "ann@example.com", "other", "Ann"); !isAuthError(err, ErrUserAlreadyExists) {
+		t.Errorf("second sign-up: err = %v, want %s", err, ErrUserAlreadyExists)
+	}
+}
+
+func TestSignIn(t *testing.T) {
+	uc, _, _ := newTestAuthUsecase(t)
+	ctx := context.Background()
+	if _, _, err := uc.SignUp(ctx, "ann@example.com", "hunter22", "Ann"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := uc.SignIn(ctx, "ann@example.com", "wrong"); !isAuthError(err, ErrInvalidCredentials) {
+		t.Errorf("wrong password: err = %v, want %s", err, ErrInvalidCredentials)
+	}
+	if _, _, err := uc.SignIn(ctx, "bob@example.com", "hunter22"); !isAuthError(err, ErrInvalidCredentials) {
+		t.Errorf("unknown email: err = %v, want %s", err, ErrInvalidCredentials)
+	}
+
+	_, tokens, err := uc.SignIn(ctx, "ann@example.com", "hunter22")
+	if err != nil {
+		t.Fatal(err)
+	}
+	claims, err := uc.parseJWTToken(tokens.AccessToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(claims.Roles) != 1 || claims.Roles[0] != "customer" {
+		t.Errorf("Roles = %v, want [customer]", claims.Roles)
+	}
+}
+
+func TestRefreshTokenRotation(t *testing.T) {
+	uc, _, _ := newTestAuthUsecase(t)
+	ctx := context.Background()
+	_, first, err := uc.SignUp(ctx, "ann@example.com", "hunter22", "Ann")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	second, err := uc.RefreshToken(ctx, first.RefreshToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if second.RefreshToken == first.RefreshToken || second.AccessToken == first.AccessToken {
+		t.Fatal("refreshing did not issue new tokens")
+	}
+	third, err := uc.RefreshToken(ctx, second.RefreshToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if third.RefreshToken == second.RefreshToken {
+		t.Fatal("refreshing did not rotate the refresh token")
+	}
+
+	if _, err := uc.RefreshToken(ctx, "not-a-token"); !isAuthError(err, ErrInvalidRefreshToken) {
+		t.Errorf("unknown token: err = %v, want %s", err, ErrInvalidRefreshToken)
+	}
+}
+
+func TestTokenLifetime(t *testing.T) {
+	uc, _, _ := newTestAuthUsecase(t)
+	ctx := context.Background()
+	_, signedUp, err := uc.SignUp(ctx, "ann@example.com", "hunter22", "Ann")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, signedIn, err := uc.SignIn(ctx, "ann@example.com", "hunter22")
+	if err != nil {
+		t.Fatal(err)
+	}
+	refreshed, err := uc.RefreshToken(ctx, signedIn.RefreshToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// jwt_expire is 3600 seconds, which the replies report as expires_in
+	for name, tokens := range map[string]*Tokens{"SignUp": signedUp, "SignIn": signedIn, "RefreshToken": refreshed} {
+		if got := int64(tokens.ExpiresIn.Seconds()); got != 3600 {
+			t.Errorf("%s: expires in %ds, want 3600s", name, got)
+		}
+		claims, err := uc.parseJWTToken(tokens.AccessToken)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if left := time.Until(claims.ExpiresAt.Time); left < 59*time.Minute || left > time.Hour {
+			t.Errorf("%s: access token expires in %v, want an hour", name, left)
+		}
+	}
+}
+
+func TestRefreshTokenReuseRevokesFamily(t *testing.T) {
+	uc, _, store := newTestAuthUsecase(t)
+	ctx := context.Background()
+	user, first, err := uc.SignUp(ctx, "ann@example.com", "hunter22", "Ann")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A second session of the same user is not affected
+	_, other, err := uc.SignIn(ctx, "ann@example.com", "hunter22")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	second, err := uc.RefreshToken(ctx, first.RefreshToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The rotated token is presented again, e.g. by whoever copied it
+	if _, err := uc.RefreshToken(ctx, first.RefreshToken); !isAuthError(err, ErrInvalidRefreshToken) {
+		t.Fatalf("reused token: err = %v, want %s", err, ErrInvalidRefreshToken)
+	}
+	if _, err := uc.RefreshToken(ctx, second.RefreshToken); !isAuthError(err, ErrInvalidRefreshToken) {
+		t.Errorf("latest token of a reused family: err = %v, want %s", err, ErrInvalidRefreshToken)
+	}
+	if _, err := uc.RefreshToken(ctx, other.RefreshToken); err != nil {
+		t.Errorf("token of another family: %v", err)
+	}
+
+	// Access tokens issued before the reuse are revoked; watermarks have
+	// second precision, so one issued a second earlier stands for them
+	revoked, err := store.Revoked(ctx, "any-jti", user.ID, time.Now().Add(-time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !revoked {
+		t.Error("access tokens issued before the reuse are not revoked")
+	}
+}
+
+func TestRefreshTokenExpired(t *testing.T) {
+	uc, repo, _ := newTestAuthUsecase(t)
+	ctx := context.Background()
+	_, tokens, err := uc.SignUp(ctx, "ann@example.com", "hunter22", "Ann")
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo.tokens[hashRefreshToken(tokens.RefreshToken)].ExpiresAt = time.Now().Add(-time.Minute)
+
+	if _, err := uc.RefreshToken(ctx, tokens.RefreshToken); !isAuthError(err, ErrInvalidRefreshToken) {
+		t.Errorf("expired token: err = %v, want %s", err, ErrInvalidRefreshToken)
+	}
+}
+
+func TestSignOut(t *testing.T) {
+	uc, _, store := newTestAuthUsecase(t)
+	ctx := context.Background()
+	user, first, err := uc.SignUp(ctx, "ann@example.com", "hunter22", "Ann")
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := uc.RefreshToken(ctx, first.RefreshToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := uc.SignOut(ctx, second.RefreshToken, second.AccessToken); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := uc.RefreshToken(ctx, second.RefreshToken); !isAuthError(err, ErrInvalidRefreshToken) {
+		t.Errorf("signed out token: err = %v, want %s", err, ErrInvalidRefreshToken)
+	}
+
+	claims, err := uc.parseJWTToken(second.AccessToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	revoked, err := store.Revoked(ctx, claims.ID, user.ID, claims.IssuedAt.Time)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !revoked {
+		t.Error("access token of the session is not revoked")
+	}
+
+	// Signing out twice, or with an unknown token, succeeds
+	if err := uc.SignOut(ctx, second.RefreshToken, ""); err != nil {
+		t.Errorf("second sign-out: %v", err)
+	}
+	if err := uc.SignOut(ctx, "not-a-token", ""); err != nil {
+		t.Errorf("unknown token: %v", err)
+	}
+}
+
+func TestSignOutIgnoresOthersAccessTokens(t *testing.T) {
+	uc, _, store := newTestAuthUsecase(t)
+	ctx := context.Background()
+	_, ann, err := uc.SignUp(ctx, "ann@example.com", "hunter22", "Ann")
+	if err != nil {
+		t.Fatal(err)
+	}
+	bob, bobTokens, err := uc.SignUp(ctx, "bob@example.com", "hunter22", "Bob")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Ann cannot revoke Bob's access token by sending it with her sign-out
+	if err := uc.SignOut(ctx, ann.RefreshToken, bobTokens.AccessToken); err != nil {
+		t.Fatal(err)
+	}
+	claims, err := uc.parseJWTToken(bobTokens.AccessToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if revoked, _ := store.Revoked(ctx, claims.ID, bob.ID, claims.IssuedAt.Time); revoked {
+		t.Error("another user's access token was revoked")
+	}
+}
+
+func TestRevokeUserTokens(t *testing.T) {
+	uc, _, store := newTestAuthUsecase(t)
+	ctx := context.Background()
+	ann, _, err := uc.SignUp(ctx, "ann@example.com", "hunter22", "Ann")
+	if err != nil {
+		t.Fatal(err)
+	}
+	bob, _, err := uc.SignUp(ctx, "bob@example.com", "hunter22", "Bob")
+	if err != nil {
+		t.Fatal(err)
+	}
+	revoked := func(userID int64) bool {
+		t.Helper()
+		r, err := store.Revoked(ctx, "any-jti", userID, time.Now().Add(-time.Second))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return r
+	}
+
+	// Anonymous callers and other customers are refused
+	if err := uc.RevokeUserTokens(ctx, ann.ID); !isAuthError(err, ErrForbidden) {
+		t.Errorf("anonymous: err = %v, want %s", err, ErrForbidden)
+	}
+	bobCtx := auth.NewContext(ctx, &auth.Principal{UserID: bob.ID, Roles: []string{auth.RoleCustomer}})
+	if err := uc.RevokeUserTokens(bobCtx, ann.ID); !isAuthError(err, ErrForbidden) {
+		t.Errorf("another customer: err = %v, want %s", err, ErrForbidden)
+	}
+	if revoked(ann.ID) {
+		t.Fatal("tokens revoked by a caller that was refused")
+	}
+
+	// Users revoke their own tokens
+	if err := uc.RevokeUserTokens(bobCtx, bob.ID); err != nil {
+		t.Fatal(err)
+	}
+	if !revoked(bob.ID) {
+		t.Error("own tokens not revoked")
+	}
+
+	// Admins revoke anyone's
+	adminCtx := auth.NewContext(ctx, &auth.Principal{UserID: 99, Roles: []string{auth.RoleAdmin}, Scopes: auth.RoleScopes(auth.RoleAdmin)})
+	if err := uc.RevokeUserTokens(adminCtx, ann.ID); err != nil {
+		t.Fatal(err)
+	}
+	if !revoked(ann.ID) {
+		t.Error("tokens revoked by an admin not revoked")
+	}
+}
@@ -2,9 +2,12 @@ package data
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"yinni_backend/app/auth/internal/biz"
 	"yinni_backend/ent"
+	"yinni_backend/ent/refreshtoken"
 	"yinni_backend/ent/user"
 
 	"github.com/go-kratos/kratos/v2/log"
@@ -42,6 +45,7 @@ func (r *authRepo) CreateUser(ctx context.Context, u *biz.User) (*biz.User, erro
 		Email:     entUser.Email,
 		Password:  entUser.Password,
 		Name:      entUser.Name,
+		Role:      entUser.Role.String(),
 		CreatedAt: entUser.CreateTime,
 		UpdatedAt: entUser.UpdateTime,
 	}, nil
@@ -66,6 +70,7 @@ func (r *authRepo) FindByEmail(ctx context.Context, email string) (*biz.User, er
 		Email:     entUser.Email,
 		Password:  entUser.Password,
 		Name:      entUser.Name,
+		Role:      entUser.Role.String(),
 		CreatedAt: entUser.CreateTime,
 		UpdatedAt: entUser.UpdateTime,
 	}, nil
@@ -85,7 +90,100 @@ func (r *authRepo) GetUserByID(ctx context.Context, id int64) (*biz.User, error)
 		Email:     entUser.Email,
 		Password:  entUser.Password,
 		Name:      entUser.Name,
+		Role:      entUser.Role.String(),
 		CreatedAt: entUser.CreateTime,
 		UpdatedAt: entUser.UpdateTime,
 	}, nil
 }
+
+// CreateRefreshToken stores a refresh token by its hash.
+func (r *authRepo) CreateRefreshToken(ctx context.Context, t *biz.RefreshToken, hash string) error {
+	return createRefreshToken(ctx, r.data.ent.RefreshToken, t, hash)
+}
+
+func createRefreshToken(ctx context.Context, client *ent.RefreshTokenClient, t *biz.RefreshToken, hash string) error {
+	saved, err := client.
+		Create().
+		SetUserID(int(t.UserID)).
+		SetTokenHash(hash).
+		SetFamilyID(t.FamilyID).
+		SetExpiresAt(t.ExpiresAt).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	t.ID = int64(saved.ID)
+	return nil
+}
+
+// FindRefreshToken finds a refresh token by its hash.
+func (r *authRepo) FindRefreshToken(ctx context.Context, hash string) (*biz.RefreshToken, error) {
+	t, err := r.data.ent.RefreshToken.
+		Query().
+		Where(refreshtoken.TokenHash(hash)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &biz.RefreshToken{
+		ID:           int64(t.ID),
+		UserID:       int64(t.UserID),
+		FamilyID:     t.FamilyID,
+		ExpiresAt:    t.ExpiresAt,
+		RevokedAt:    t.RevokedAt,
+		RevokeReason: string(t.RevokeReason),
+	}, nil
+}
+
+// RotateRefreshToken revokes a refresh token and stores its successor. The
+// revocation only matches an unrevoked token, so of two concurrent
+// rotations of one token only the first succeeds.
+func (r *authRepo) RotateRefreshToken(ctx context.Context, id int64, next *biz.RefreshToken, nextHash string) (bool, error) {
+	tx, err := r.data.ent.Tx(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	n, err := tx.RefreshToken.
+		Update().
+		Where(
+			refreshtoken.ID(int(id)),
+			refreshtoken.RevokedAtIsNil(),
+		).
+		SetRevokedAt(time.Now()).
+		SetRevokeReason(refreshtoken.RevokeReasonRotated).
+		Save(ctx)
+	if err != nil || n == 0 {
+		return false, rollback(tx, err)
+	}
+	if err := createRefreshToken(ctx, tx.RefreshToken, next, nextHash); err != nil {
+		return false, rollback(tx, err)
+	}
+	return true, tx.Commit()
+}
+
+// RevokeRefreshTokenFamily revokes the unrevoked tokens of a family.
+func (r *authRepo) RevokeRefreshTokenFamily(ctx context.Context, familyID, reason string) error {
+	_, err := r.data.ent.RefreshToken.
+		Update().
+		Where(
+			refreshtoken.FamilyID(familyID),
+			refreshtoken.RevokedAtIsNil(),
+		).
+		SetRevokedAt(time.Now()).
+		SetRevokeReason(refreshtoken.RevokeReason(reason)).
+		Save(ctx)
+	return err
+}
+
+// rollback rolls tx back and returns err, joined with the rollback error.
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		return errors.Join(err, rerr)
+	}
+	return err
+}
@@ -0,0 +1,37 @@
+package server
+
+import (
+	v1 "yinni_backend/api/auth/v1"
+	"yinni_backend/internal/conf"
+	"yinni_backend/pkg/auth"
+	"yinni_backend/pkg/jwks"
+	"yinni_backend/pkg/middleware"
+	"yinni_backend/pkg/revocation"
+
+	kmiddleware "github.com/go-kratos/kratos/v2/middleware"
+)
+
+// publicOperations are called without an access token, or to get one.
+var publicOperations = []string{
+	v1.OperationAuthSignUp,
+	v1.OperationAuthSignIn,
+	v1.OperationAuthRefreshToken,
+	v1.OperationAuthSignOut,
+}
+
+// authMiddleware authenticates the calls to every operation but the public
+// ones with the service's own keys.
+func authMiddleware(authConf *conf.Auth, revocations revocation.Store, keys *jwks.KeySet) kmiddleware.Middleware {
+	return middleware.JWT(authConf.JwtSecret,
+		middleware.WithRevocationStore(revocations),
+		middleware.WithKeySet(keys),
+		middleware.WithPublic(publicOperations...),
+	)
+}
+
+// peerMiddleware restores the principal other services send when calling on
+// behalf of a user, which then stands in for an access token. Only the gRPC
+// server, the one services call, uses it.
+func peerMiddleware(authConf *conf.Auth) kmiddleware.Middleware {
+	return auth.Server(authConf.PeerSecret)
+}
@@ -4,6 +4,8 @@ import (
 	v1 "yinni_backend/api/auth/v1"
 	"yinni_backend/app/auth/internal/service"
 	"yinni_backend/internal/conf"
+	"yinni_backend/pkg/jwks"
+	"yinni_backend/pkg/revocation"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
@@ -11,10 +13,12 @@ import (
 )
 
 // NewGRPCServer new a gRPC server.
-func NewGRPCServer(c *conf.Server, auth *service.AuthService, logger log.Logger) *grpc.Server {
+func NewGRPCServer(c *conf.Server, authConf *conf.Auth, revocations revocation.Store, keys *jwks.KeySet, auth *service.AuthService, logger log.Logger) *grpc.Server {
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(
 			recovery.Recovery(),
+			peerMiddleware(authConf),
+			authMiddleware(authConf, revocations, keys),
 		),
 	}
 	if c.Grpc.Network != "" {
@@ -1,9 +1,13 @@
 package server
 
 import (
+	stdhttp "net/http"
+
 	v1 "yinni_backend/api/auth/v1"
 	"yinni_backend/app/auth/internal/service"
 	"yinni_backend/internal/conf"
+	"yinni_backend/pkg/jwks"
+	"yinni_backend/pkg/revocation"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/logging"
@@ -13,7 +17,7 @@ import (
 )
 
 // NewHTTPServer new an HTTP server.
-func NewHTTPServer(c *conf.Server, auth *service.AuthService, logger log.Logger) *http.Server {
+func NewHTTPServer(c *conf.Server, authConf *conf.Auth, revocations revocation.Store, keys *jwks.KeySet, auth *service.AuthService, logger log.Logger) *http.Server {
 	corsHandler := cors.New(cors.Options{
 		AllowedOrigins:   []string{"*"},
 		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
@@ -25,6 +29,7 @@ func NewHTTPServer(c *conf.Server, auth *service.AuthService, logger log.Logger)
 		http.Middleware(
 			recovery.Recovery(),
 			logging.Server(logger),
+			authMiddleware(authConf, revocations, keys),
 		),
 		http.Filter(corsHandler.Handler),
 	}
@@ -39,5 +44,27 @@ func NewHTTPServer(c *conf.Server, auth *service.AuthService, logger log.Logger)
 	}
 	srv := http.NewServer(opts...)
 	v1.RegisterAuthHTTPServer(srv, auth)
+	srv.HandleFunc(jwks.Path, jwksHandler(keys, logger))
 	return srv
 }
+
+// jwksHandler publishes the public signing keys, an empty set when tokens
+// are signed with the shared secret.
+func jwksHandler(keys *jwks.KeySet, logger log.Logger) stdhttp.HandlerFunc {
+	helper := log.NewHelper(logger)
+	body := []byte(`{"keys":[]}`)
+	if keys != nil {
+		body = keys.JWKS()
+	}
+	return func(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+		if r.Method != stdhttp.MethodGet && r.Method != stdhttp.MethodHead {
+			w.WriteHeader(stdhttp.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "public, max-age=300")
+		if _, err := w.Write(body); err != nil {
+			helper.WithContext(r.Context()).Warnf("Writing the JWKS response failed: %v", err)
+		}
+	}
+}
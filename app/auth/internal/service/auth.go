@@ -3,9 +3,13 @@ package service
 import (
 	"context"
 	"errors"
+	"strings"
 
 	pb "yinni_backend/api/auth/v1"
 	"yinni_backend/app/auth/internal/biz"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport"
 )
 
 type AuthService struct {
@@ -24,7 +28,7 @@ func (s *AuthService) SignUp(ctx context.Context, req *pb.SignUpRequest) (*pb.Si
 	}
 
 	// Call usecase
-	user, _, err := s.uc.SignUp(ctx, req.Email, req.Password, req.Name)
+	user, tokens, err := s.uc.SignUp(ctx, req.Email, req.Password, req.Name)
 	if err != nil {
 		// Handle specific auth errors
 		if authErr, ok := err.(*biz.AuthError); ok {
@@ -41,7 +45,12 @@ func (s *AuthService) SignUp(ctx context.Context, req *pb.SignUpRequest) (*pb.Si
 	}
 
 	return &pb.SignUpReply{
-		UserId: user.ID,
+		UserId:           user.ID,
+		AccessToken:      tokens.AccessToken,
+		TokenType:        "Bearer",
+		ExpiresIn:        int64(tokens.ExpiresIn.Seconds()),
+		RefreshToken:     tokens.RefreshToken,
+		RefreshExpiresIn: int64(tokens.RefreshExpiresIn.Seconds()),
 	}, nil
 }
 
@@ -52,7 +61,7 @@ func (s *AuthService) SignIn(ctx context.Context, req *pb.SignInRequest) (*pb.Si
 	}
 
 	// Call usecase
-	_, token, err := s.uc.SignIn(ctx, req.Email, req.Password)
+	_, tokens, err := s.uc.SignIn(ctx, req.Email, req.Password)
 	if err != nil {
 		// Handle specific auth errors
 		if authErr, ok := err.(*biz.AuthError); ok {
@@ -67,8 +76,80 @@ func (s *AuthService) SignIn(ctx context.Context, req *pb.SignInRequest) (*pb.Si
 	}
 
 	return &pb.SignInReply{
-		AccessToken: token,
-		TokenType:   "Bearer",
-		ExpiresIn:   int64(s.uc.JWTExpire().Seconds()), // Assuming you add this method to AuthUsecase
+		AccessToken:      tokens.AccessToken,
+		TokenType:        "Bearer",
+		ExpiresIn:        int64(tokens.ExpiresIn.Seconds()),
+		RefreshToken:     tokens.RefreshToken,
+		RefreshExpiresIn: int64(tokens.RefreshExpiresIn.Seconds()),
+	}, nil
+}
+
+func (s *AuthService) RefreshToken(ctx context.Context, req *pb.RefreshTokenRequest) (*pb.RefreshTokenReply, error) {
+	// Validate request
+	if req.RefreshToken == "" {
+		return nil, errors.New("refresh_token is required")
+	}
+
+	// Call usecase
+	tokens, err := s.uc.RefreshToken(ctx, req.RefreshToken)
+	if err != nil {
+		// Handle specific auth errors
+		if authErr, ok := err.(*biz.AuthError); ok {
+			switch authErr.Type {
+			case biz.ErrInvalidRefreshToken:
+				return nil, errors.New("invalid refresh token")
+			default:
+				return nil, errors.New("internal server error")
+			}
+		}
+		return nil, err
+	}
+
+	return &pb.RefreshTokenReply{
+		AccessToken:      tokens.AccessToken,
+		TokenType:        "Bearer",
+		ExpiresIn:        int64(tokens.ExpiresIn.Seconds()),
+		RefreshToken:     tokens.RefreshToken,
+		RefreshExpiresIn: int64(tokens.RefreshExpiresIn.Seconds()),
 	}, nil
 }
+
+func (s *AuthService) SignOut(ctx context.Context, req *pb.SignOutRequest) (*pb.SignOutReply, error) {
+	// Validate request
+	if req.RefreshToken == "" {
+		return nil, errors.New("refresh_token is required")
+	}
+
+	// The access token of the session, when sent, is revoked too
+	var accessToken string
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		accessToken = strings.TrimPrefix(tr.RequestHeader().Get("Authorization"), "Bearer ")
+	}
+
+	// Call usecase
+	if err := s.uc.SignOut(ctx, req.RefreshToken, accessToken); err != nil {
+		if _, ok := err.(*biz.AuthError); ok {
+			return nil, errors.New("internal server error")
+		}
+		return nil, err
+	}
+
+	return &pb.SignOutReply{}, nil
+}
+
+func (s *AuthService) RevokeUserTokens(ctx context.Context, req *pb.RevokeUserTokensRequest) (*pb.RevokeUserTokensReply, error) {
+	// Call usecase
+	if err := s.uc.RevokeUserTokens(ctx, req.UserId); err != nil {
+		if authErr, ok := err.(*biz.AuthError); ok {
+			switch authErr.Type {
+			case biz.ErrForbidden:
+				return nil, kerrors.Forbidden(string(authErr.Type), authErr.Message)
+			default:
+				return nil, errors.New("internal server error")
+			}
+		}
+		return nil, err
+	}
+
+	return &pb.RevokeUserTokensReply{}, nil
+}
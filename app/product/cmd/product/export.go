@@ -0,0 +1,147 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"yinni_backend/app/product/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const exportUsage = `usage: product [-conf path] export [flags]
+
+Writes the products matching the filters as JSONL or CSV, in id order, to
+stdout or the -o file. Files in the import command's format can be read
+back by it. Attribute filters are key=values or key:match=values, with
+comma-separated values and match any, all or none, e.g. -attr color=blue,red.
+
+`
+
+// attrFlags collects the repeated -attr flag.
+type attrFlags []*biz.AttributeFilter
+
+func (a *attrFlags) String() string { return "" }
+
+func (a *attrFlags) Set(s string) error {
+	key, values, ok := strings.Cut(s, "=")
+	if !ok {
+		return fmt.Errorf("want key=values, got %q", s)
+	}
+	f := &biz.AttributeFilter{Values: strings.Split(values, ",")}
+	f.Key, f.Match, _ = strings.Cut(key, ":")
+	*a = append(*a, f)
+	return nil
+}
+
+// runExport runs the export command and returns the exit code.
+func runExport(args []string) int {
+	fs := flag.NewFlagSet("export", flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprint(fs.Output(), exportUsage)
+		fs.PrintDefaults()
+	}
+	fs.StringVar(&flagconf, "conf", flagconf, "config path, eg: -conf config.yaml")
+	out := fs.String("o", "-", "output file, - for stdout")
+	format := fs.String("format", "", "jsonl or csv; guessed from the -o extension, jsonl by default")
+	fields := fs.String("fields", "", "comma-separated columns to write, in order; all but the embeddings by default")
+	embeddings := fs.Bool("embeddings", false, "add the embedding and embedding_model columns")
+	limit := fs.Int("limit", 0, "export at most this many products, 0 for all")
+	batchSize := fs.Int("batch-size", 0, "products read per query")
+
+	params := &biz.ListProductsParams{}
+	var attrs attrFlags
+	fs.StringVar(&params.Category, "category", "", "filter by category")
+	fs.StringVar(&params.SubCategory, "sub-category", "", "filter by sub-category")
+	fs.StringVar(&params.Brand, "brand", "", "filter by brand")
+	fs.StringVar(&params.Seller, "seller", "", "filter by seller")
+	minPrice := fs.Int("min-price", 0, "filter by minimum selling price")
+	maxPrice := fs.Int("max-price", 0, "filter by maximum selling price")
+	minRating := fs.Float64("min-rating", 0, "filter by minimum rating")
+	fs.BoolVar(&params.InStock, "in-stock", false, "only products in stock")
+	fs.BoolVar(&params.Featured, "featured", false, "only featured products")
+	fs.StringVar(&params.SearchQuery, "q", "", "filter by text in title, description or brand")
+	fs.Var(&attrs, "attr", "filter by attribute, repeatable")
+	if err := fs.Parse(args); err != nil {
+		return 1
+	}
+	if fs.NArg() != 0 {
+		fs.Usage()
+		return 1
+	}
+	params.MinPrice = int32(*minPrice)
+	params.MaxPrice = int32(*maxPrice)
+	params.MinRating = float32(*minRating)
+	params.AttributeFilters = attrs
+
+	if *format == "" {
+		*format = biz.ExportFormatJSONL
+		if strings.EqualFold(filepath.Ext(*out), ".csv") {
+			*format = biz.ExportFormatCSV
+		}
+	}
+	opts := biz.ExportOptions{
+		Format:            *format,
+		IncludeEmbeddings: *embeddings,
+		Limit:             *limit,
+		BatchSize:         *batchSize,
+	}
+	if *fields != "" {
+		opts.Fields = strings.Split(*fields, ",")
+	}
+
+	logger := log.With(log.NewStdLogger(os.Stderr),
+		"ts", log.DefaultTimestamp,
+		"caller", log.DefaultCaller,
+	)
+
+	bc, err := loadBootstrap()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "export: %v\n", err)
+		return 1
+	}
+	exporter, cleanup, err := wireExporter(bc.Data, logger)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "export: %v\n", err)
+		return 1
+	}
+	defer cleanup()
+
+	f := os.Stdout
+	if *out != "-" {
+		if f, err = os.Create(*out); err != nil {
+			fmt.Fprintf(os.Stderr, "export: %v\n", err)
+			return 1
+		}
+		defer f.Close()
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	start := time.Now()
+	w := bufio.NewWriter(f)
+	n, err := exporter.Export(ctx, params, opts, w)
+	if err == nil {
+		err = w.Flush()
+	}
+	if err == nil && f != os.Stdout {
+		err = f.Sync()
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "export failed after %d products: %v\n", n, err)
+		if f != os.Stdout {
+			os.Remove(*out)
+		}
+		return 1
+	}
+	fmt.Fprintf(os.Stderr, "exported %d products in %v\n", n, time.Since(start).Round(time.Millisecond))
+	return 0
+}
@@ -0,0 +1,549 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"yinni_backend/app/product/internal/biz"
+	"yinni_backend/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/file"
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Import file formats.
+const (
+	formatJSON  = "json"
+	formatJSONL = "jsonl"
+	formatCSV   = "csv"
+)
+
+// maxImportLineBytes caps a JSONL line; product_details can be long.
+const maxImportLineBytes = 16 << 20
+
+const importUsage = `usage: product [-conf path] import [flags] <file>
+
+Upserts products from a JSON array, JSONL or CSV file, matching stored
+products by pid, then original_id. Pass - to read stdin. CSV files need a
+header row; images are a JSON array or |-separated URLs and product_details
+is a JSON array of objects.
+
+Exits with 1 when the import was aborted and 2 when some rows failed.
+
+`
+
+// runImport runs the import command and returns the exit code.
+func runImport(args []string) int {
+	fs := flag.NewFlagSet("import", flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprint(fs.Output(), importUsage)
+		fs.PrintDefaults()
+	}
+	fs.StringVar(&flagconf, "conf", flagconf, "config path, eg: -conf config.yaml")
+	dryRun := fs.Bool("dry-run", false, "validate and match rows without writing")
+	limit := fs.Int("limit", 0, "import at most this many rows, 0 for all")
+	format := fs.String("format", "", "json, jsonl or csv; guessed from the file extension by default")
+	batchSize := fs.Int("batch-size", 0, "rows matched against the catalog per query")
+	if err := fs.Parse(args); err != nil {
+		return 1
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return 1
+	}
+	path := fs.Arg(0)
+
+	if *format == "" {
+		*format = importFormat(path)
+	}
+	if *format != formatJSON && *format != formatJSONL && *format != formatCSV {
+		fmt.Fprintf(os.Stderr, "import: unknown format %q, pass -format\n", *format)
+		return 1
+	}
+
+	logger := log.With(log.NewStdLogger(os.Stderr),
+		"ts", log.DefaultTimestamp,
+		"caller", log.DefaultCaller,
+	)
+
+	bc, err := loadBootstrap()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "import: %v\n", err)
+		return 1
+	}
+
+	in := os.Stdin
+	if path != "-" {
+		if in, err = os.Open(path); err != nil {
+			fmt.Fprintf(os.Stderr, "import: %v\n", err)
+			return 1
+		}
+		defer in.Close()
+	}
+	reader, err := newProductReader(*format, in)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "import: %v\n", err)
+		return 1
+	}
+
+	importer, cleanup, err := wireImporter(bc.Data, logger)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "import: %v\n", err)
+		return 1
+	}
+	defer cleanup()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	start := time.Now()
+	report, err := importer.Import(ctx, reader, biz.ImportOptions{
+		DryRun:    *dryRun,
+		Limit:     *limit,
+		BatchSize: *batchSize,
+	})
+	printImportReport(os.Stdout, report, *dryRun, time.Since(start))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "import aborted: %v\n", err)
+		return 1
+	}
+	if report.Failed > 0 {
+		return 2
+	}
+	return 0
+}
+
+// loadBootstrap reads the config at flagconf.
+func loadBootstrap() (*conf.Bootstrap, error) {
+	c := config.New(
+		config.WithSource(
+			file.NewSource(flagconf),
+		),
+	)
+	defer c.Close()
+
+	if err := c.Load(); err != nil {
+		return nil, err
+	}
+
+	var bc conf.Bootstrap
+	if err := c.Scan(&bc); err != nil {
+		return nil, err
+	}
+	return &bc, nil
+}
+
+func importFormat(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".jsonl", ".ndjson":
+		return formatJSONL
+	case ".csv":
+		return formatCSV
+	case ".json":
+		return formatJSON
+	}
+	return ""
+}
+
+func printImportReport(w io.Writer, report *biz.ImportReport, dryRun bool, took time.Duration) {
+	for _, e := range report.Errors {
+		msg := e.Err.Error()
+		if se := kerrors.FromError(e.Err); se.Reason != "" {
+			msg = se.Message
+		}
+		if e.Key != "" {
+			fmt.Fprintf(w, "row %d (%s): %s\n", e.Line, e.Key, msg)
+		} else {
+			fmt.Fprintf(w, "row %d: %s\n", e.Line, msg)
+		}
+	}
+	if hidden := report.Failed - len(report.Errors); hidden > 0 {
+		fmt.Fprintf(w, "... and %d more failed rows\n", hidden)
+	}
+
+	mode := ""
+	if dryRun {
+		mode = " (dry run, nothing written)"
+	}
+	fmt.Fprintf(w, "read %d rows: %d created, %d updated, %d failed in %s%s\n",
+		report.Read, report.Created, report.Updated, report.Failed, took.Round(time.Millisecond), mode)
+}
+
+func newProductReader(format string, r io.Reader) (biz.ProductReader, error) {
+	switch format {
+	case formatJSON:
+		return newJSONArrayReader(r)
+	case formatJSONL:
+		sc := bufio.NewScanner(r)
+		sc.Buffer(make([]byte, 0, 64<<10), maxImportLineBytes)
+		return &jsonlReader{sc: sc}, nil
+	default:
+		return newCSVReader(r)
+	}
+}
+
+// jsonArrayReader decodes the elements of a JSON array one at a time, so
+// files larger than memory can be imported. A syntax error ends the import
+// since the decoder cannot resynchronise.
+type jsonArrayReader struct {
+	dec   *json.Decoder
+	index int
+}
+
+func newJSONArrayReader(r io.Reader) (*jsonArrayReader, error) {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, fmt.Errorf("reading JSON array: %w", err)
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+		return nil, errors.New("reading JSON array: file does not start with [")
+	}
+	return &jsonArrayReader{dec: dec}, nil
+}
+
+func (r *jsonArrayReader) Next() (*biz.ImportRecord, error) {
+	if !r.dec.More() {
+		return nil, io.EOF
+	}
+	r.index++
+
+	var raw json.RawMessage
+	if err := r.dec.Decode(&raw); err != nil {
+		return nil, fmt.Errorf("element %d: %w", r.index, err)
+	}
+	return decodeJSONRecord(r.index, raw), nil
+}
+
+// jsonlReader decodes one JSON object per line; blank lines are skipped.
+type jsonlReader struct {
+	sc   *bufio.Scanner
+	line int
+}
+
+func (r *jsonlReader) Next() (*biz.ImportRecord, error) {
+	for r.sc.Scan() {
+		r.line++
+		line := strings.TrimSpace(r.sc.Text())
+		if line == "" {
+			continue
+		}
+		return decodeJSONRecord(r.line, []byte(line)), nil
+	}
+	if err := r.sc.Err(); err != nil {
+		return nil, fmt.Errorf("line %d: %w", r.line+1, err)
+	}
+	return nil, io.EOF
+}
+
+func decodeJSONRecord(line int, raw []byte) *biz.ImportRecord {
+	var values map[string]any
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	if err := dec.Decode(&values); err != nil {
+		return &biz.ImportRecord{Line: line, Err: fmt.Errorf("invalid JSON object: %w", err)}
+	}
+	return importRecord(line, values)
+}
+
+// csvReader maps the columns of each row to the names in the header row.
+type csvReader struct {
+	r      *csv.Reader
+	header []string
+}
+
+func newCSVReader(r io.Reader) (*csvReader, error) {
+	cr := csv.NewReader(r)
+	cr.ReuseRecord = true
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("reading CSV header: %w", err)
+	}
+	header = append([]string(nil), header...)
+	for i, name := range header {
+		header[i] = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+	}
+	return &csvReader{r: cr, header: header}, nil
+}
+
+func (r *csvReader) Next() (*biz.ImportRecord, error) {
+	row, err := r.r.Read()
+	var perr *csv.ParseError
+	if errors.As(err, &perr) && errors.Is(err, csv.ErrFieldCount) {
+		return &biz.ImportRecord{Line: perr.StartLine, Err: fmt.Errorf("has %d columns, the header has %d", len(row), len(r.header))}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	line, _ := r.r.FieldPos(0)
+
+	values := make(map[string]any, len(row))
+	for i, cell := range row {
+		if cell != "" {
+			values[r.header[i]] = cell
+		}
+	}
+	return importRecord(line, values), nil
+}
+
+// importFields maps import keys to product fields. The dataset names the
+// original ID "_id".
+var importFields = map[string]string{
+	"_id":                          biz.ProductFieldOriginalID,
+	biz.ProductFieldOriginalID:     biz.ProductFieldOriginalID,
+	biz.ProductFieldPID:            biz.ProductFieldPID,
+	biz.ProductFieldTitle:          biz.ProductFieldTitle,
+	biz.ProductFieldBrand:          biz.ProductFieldBrand,
+	biz.ProductFieldDescription:    biz.ProductFieldDescription,
+	biz.ProductFieldActualPrice:    biz.ProductFieldActualPrice,
+	biz.ProductFieldSellingPrice:   biz.ProductFieldSellingPrice,
+	biz.ProductFieldDiscount:       biz.ProductFieldDiscount,
+	biz.ProductFieldCategory:       biz.ProductFieldCategory,
+	biz.ProductFieldSubCategory:    biz.ProductFieldSubCategory,
+	biz.ProductFieldOutOfStock:     biz.ProductFieldOutOfStock,
+	biz.ProductFieldSeller:         biz.ProductFieldSeller,
+	biz.ProductFieldAverageRating:  biz.ProductFieldAverageRating,
+	biz.ProductFieldImages:         biz.ProductFieldImages,
+	biz.ProductFieldProductDetails: biz.ProductFieldProductDetails,
+	biz.ProductFieldURL:            biz.ProductFieldURL,
+	biz.ProductFieldStyleCode:      biz.ProductFieldStyleCode,
+	biz.ProductFieldFeatured:       biz.ProductFieldFeatured,
+}
+
+// importRecord builds a product from the values of one row. Unknown keys
+// and empty values are ignored; crawled_at is only written on create. The
+// first bad value, in key order, fails the row.
+func importRecord(line int, values map[string]any) *biz.ImportRecord {
+	rec := &biz.ImportRecord{Line: line, Product: &biz.Product{}}
+	p := rec.Product
+	fail := func(field string, err error) {
+		if rec.Err == nil {
+			rec.Err = fmt.Errorf("%s %w", field, err)
+		}
+	}
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		v := values[key]
+		if key == "crawled_at" {
+			s, err := importString(v)
+			if err == nil && s != "" {
+				p.CrawledAt, err = parseImportTime(s)
+			}
+			if err != nil {
+				fail(key, err)
+			}
+			continue
+		}
+
+		field, ok := importFields[key]
+		if !ok || v == nil {
+			continue
+		}
+		set, err := setImportField(p, field, v)
+		if err != nil {
+			fail(field, err)
+			continue
+		}
+		if set {
+			rec.Fields = append(rec.Fields, field)
+		}
+	}
+	return rec
+}
+
+// setImportField sets one field of p and reports whether v was non-empty.
+func setImportField(p *biz.Product, field string, v any) (bool, error) {
+	switch field {
+	case biz.ProductFieldOutOfStock, biz.ProductFieldFeatured:
+		b, err := importBool(v)
+		if err != nil {
+			return false, err
+		}
+		if field == biz.ProductFieldOutOfStock {
+			p.OutOfStock = b
+		} else {
+			p.Featured = b
+		}
+		return true, nil
+	case biz.ProductFieldImages:
+		images, err := importImages(v)
+		p.Images = images
+		return len(images) > 0, err
+	case biz.ProductFieldProductDetails:
+		details, err := importDetails(v)
+		p.ProductDetails = details
+		return len(details) > 0, err
+	}
+
+	s, err := importString(v)
+	if err != nil || s == "" {
+		return false, err
+	}
+	switch field {
+	case biz.ProductFieldOriginalID:
+		p.OriginalID = s
+	case biz.ProductFieldPID:
+		p.PID = s
+	case biz.ProductFieldTitle:
+		p.Title = s
+	case biz.ProductFieldBrand:
+		p.Brand = s
+	case biz.ProductFieldDescription:
+		p.Description = s
+	case biz.ProductFieldActualPrice:
+		p.ActualPrice = s
+	case biz.ProductFieldSellingPrice:
+		p.SellingPrice = s
+	case biz.ProductFieldDiscount:
+		p.Discount = s
+	case biz.ProductFieldCategory:
+		p.Category = s
+	case biz.ProductFieldSubCategory:
+		p.SubCategory = s
+	case biz.ProductFieldSeller:
+		p.Seller = s
+	case biz.ProductFieldAverageRating:
+		p.AverageRating = s
+	case biz.ProductFieldURL:
+		p.URL = s
+	case biz.ProductFieldStyleCode:
+		p.StyleCode = s
+	}
+	return true, nil
+}
+
+// importString accepts strings and, for prices and ratings, numbers.
+func importString(v any) (string, error) {
+	switch v := v.(type) {
+	case string:
+		return strings.TrimSpace(v), nil
+	case json.Number:
+		return v.String(), nil
+	}
+	return "", fmt.Errorf("must be a string, got %T", v)
+}
+
+func importBool(v any) (bool, error) {
+	switch v := v.(type) {
+	case bool:
+		return v, nil
+	case string:
+		b, err := strconv.ParseBool(strings.TrimSpace(v))
+		if err != nil {
+			return false, fmt.Errorf("must be true or false, got %q", v)
+		}
+		return b, nil
+	}
+	return false, fmt.Errorf("must be a boolean, got %T", v)
+}
+
+// importImages accepts a JSON array, or in CSV a JSON array string or
+// |-separated URLs.
+func importImages(v any) ([]string, error) {
+	if s, ok := v.(string); ok {
+		s = strings.TrimSpace(s)
+		if !strings.HasPrefix(s, "[") {
+			var images []string
+			for _, url := range strings.Split(s, "|") {
+				if url = strings.TrimSpace(url); url != "" {
+					images = append(images, url)
+				}
+			}
+			return images, nil
+		}
+		var parsed any
+		if err := json.Unmarshal([]byte(s), &parsed); err != nil {
+			return nil, fmt.Errorf("is not a JSON array: %w", err)
+		}
+		v = parsed
+	}
+
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("must be an array, got %T", v)
+	}
+	images := make([]string, 0, len(list))
+	for _, item := range list {
+		url, ok := item.(string)
+		if !ok {
+			return nil, fmt.Errorf("must hold strings, got %T", item)
+		}
+		if url = strings.TrimSpace(url); url != "" {
+			images = append(images, url)
+		}
+	}
+	return images, nil
+}
+
+// importDetails accepts an array of objects, or in CSV the same as a JSON
+// string. Non-string detail values are formatted as JSON.
+func importDetails(v any) ([]map[string]string, error) {
+	if s, ok := v.(string); ok {
+		var parsed any
+		dec := json.NewDecoder(strings.NewReader(s))
+		dec.UseNumber()
+		if err := dec.Decode(&parsed); err != nil {
+			return nil, fmt.Errorf("is not a JSON array: %w", err)
+		}
+		v = parsed
+	}
+
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("must be an array of objects, got %T", v)
+	}
+	details := make([]map[string]string, 0, len(list))
+	for _, item := range list {
+		obj, ok := item.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("must hold objects, got %T", item)
+		}
+		detail := make(map[string]string, len(obj))
+		for k, val := range obj {
+			if s, ok := val.(string); ok {
+				detail[k] = s
+				continue
+			}
+			b, err := json.Marshal(val)
+			if err != nil {
+				return nil, err
+			}
+			detail[k] = string(b)
+		}
+		details = append(details, detail)
+	}
+	return details, nil
+}
+
+// parseImportTime accepts the dataset's crawled_at layout and RFC 3339.
+func parseImportTime(s string) (time.Time, error) {
+	for _, layout := range []string{"02/01/2006, 15:04:05", "2006-01-02 15:04:05", time.RFC3339} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("has an unknown time format: %q", s)
+}
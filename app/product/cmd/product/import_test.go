@@ -0,0 +1,112 @@
+package main
+
+import (
+	"errors"
+	"io"
+	"slices"
+	"strings"
+	"testing"
+
+	"yinni_backend/app/product/internal/biz"
+)
+
+// readAll reads every record of a file in format.
+func readAll(t *testing.T, format, file string) []*biz.ImportRecord {
+	t.Helper()
+	r, err := newProductReader(format, strings.NewReader(file))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var records []*biz.ImportRecord
+	for {
+		rec, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			return records
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		records = append(records, rec)
+	}
+}
+
+func TestImportReaders(t *testing.T) {
+	files := map[string]string{
+		formatJSON: `[
+			{"_id": "o1", "pid": "P1", "title": "Shirt", "selling_price": 499, "images": ["a.jpg", "b.jpg"], "out_of_stock": false},
+			{"pid": "P2", "product_details": [{"Color": "Black"}]}
+		]`,
+		formatJSONL: `{"_id": "o1", "pid": "P1", "title": "Shirt", "selling_price": 499, "images": ["a.jpg", "b.jpg"], "out_of_stock": false}
+
+{"pid": "P2", "product_details": [{"Color": "Black"}]}
+`,
+		formatCSV: `_id,pid,title,selling_price,images,out_of_stock,product_details
+o1,P1,Shirt,499,a.jpg|b.jpg,false,
+,P2,,,,,"[{""Color"": ""Black""}]"
+`,
+	}
+	lines := map[string][]int{formatJSON: {1, 2}, formatJSONL: {1, 3}, formatCSV: {2, 3}}
+
+	for format, file := range files {
+		records := readAll(t, format, file)
+		if len(records) != 2 {
+			t.Fatalf("%s: %d records, want 2", format, len(records))
+		}
+		for i, rec := range records {
+			if rec.Err != nil {
+				t.Errorf("%s record %d: %v", format, i, rec.Err)
+			}
+			if rec.Line != lines[format][i] {
+				t.Errorf("%s record %d: line %d, want %d", format, i, rec.Line, lines[format][i])
+			}
+		}
+
+		// Fields lists the keys with values, in key order
+		p := records[0].Product
+		if p.OriginalID != "o1" || p.PID != "P1" || p.Title != "Shirt" || p.SellingPrice != "499" || !slices.Equal(p.Images, []string{"a.jpg", "b.jpg"}) {
+			t.Errorf("%s: first product %+v", format, p)
+		}
+		wantFields := []string{biz.ProductFieldOriginalID, biz.ProductFieldImages, biz.ProductFieldOutOfStock, biz.ProductFieldPID, biz.ProductFieldSellingPrice, biz.ProductFieldTitle}
+		if !slices.Equal(records[0].Fields, wantFields) {
+			t.Errorf("%s: fields %v, want %v", format, records[0].Fields, wantFields)
+		}
+		if p := records[1].Product; len(p.ProductDetails) != 1 || p.ProductDetails[0]["Color"] != "Black" {
+			t.Errorf("%s: product details %v", format, p.ProductDetails)
+		}
+		if fields := records[1].Fields; !slices.Equal(fields, []string{biz.ProductFieldPID, biz.ProductFieldProductDetails}) {
+			t.Errorf("%s: second record fields %v", format, fields)
+		}
+	}
+}
+
+func TestImportReaderRowErrors(t *testing.T) {
+	tests := []struct {
+		format, file string
+		line         int
+	}{
+		{formatJSONL, "{\"pid\": \"P1\"}\n{not json}\n", 2},
+		{formatJSONL, `{"pid": "P1", "featured": "maybe"}`, 1},
+		{formatJSON, `[{"pid": "P1", "title": true}]`, 1},
+		{formatCSV, "pid,title\nP1,Shirt,extra\n", 2},
+		{formatCSV, "pid,images\nP1,[broken\n", 2},
+	}
+	for _, tt := range tests {
+		records := readAll(t, tt.format, tt.file)
+		rec := records[len(records)-1]
+		if rec.Err == nil || rec.Line != tt.line {
+			t.Errorf("%s %q: line %d, err %v; want an error on line %d", tt.format, tt.file, rec.Line, rec.Err, tt.line)
+		}
+	}
+
+	// A JSON syntax error aborts the import
+	r, err := newProductReader(formatJSON, strings.NewReader(`[{"pid": "P1"}, {"pid": `))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Next(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Next(); err == nil || errors.Is(err, io.EOF) {
+		t.Errorf("truncated array: err = %v, want a read error", err)
+	}
+}
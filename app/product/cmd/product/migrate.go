@@ -0,0 +1,128 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"text/tabwriter"
+	"time"
+
+	"yinni_backend/app/product/internal/data"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const migrateUsage = `usage: product [-conf path] migrate <command> [flags]
+
+Commands:
+  status   list the pending schema changes and the versioned migrations
+  up       sync the schema and apply the pending migrations
+  down     revert the last applied migrations
+
+The schema sync only adds tables, columns and indexes. Drops and column
+type changes are skipped and listed by status; make them in a versioned
+migration.
+
+`
+
+// runMigrate runs the migrate command and returns the exit code.
+func runMigrate(args []string) int {
+	fs := flag.NewFlagSet("migrate", flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprint(fs.Output(), migrateUsage)
+		fs.PrintDefaults()
+	}
+	fs.StringVar(&flagconf, "conf", flagconf, "config path, eg: -conf config.yaml")
+	to := fs.Int64("to", 0, "up: apply migrations up to this version, 0 for all")
+	steps := fs.Int("steps", 1, "down: number of migrations to revert")
+	if len(args) == 0 {
+		fs.Usage()
+		return 1
+	}
+	cmd := args[0]
+	if err := fs.Parse(args[1:]); err != nil {
+		return 1
+	}
+	if cmd != "status" && cmd != "up" && cmd != "down" {
+		fs.Usage()
+		return 1
+	}
+
+	logger := log.With(log.NewStdLogger(os.Stderr),
+		"ts", log.DefaultTimestamp,
+		"caller", log.DefaultCaller,
+	)
+
+	bc, err := loadBootstrap()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "migrate: %v\n", err)
+		return 1
+	}
+	m, cleanup, err := wireMigrator(bc.Data, logger)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "migrate: %v\n", err)
+		return 1
+	}
+	defer cleanup()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	if err := m.WaitReady(ctx, dbReadyTimeout); err != nil {
+		fmt.Fprintf(os.Stderr, "migrate: %v\n", err)
+		return 1
+	}
+
+	switch cmd {
+	case "status":
+		status, err := m.Status(ctx)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "migrate: %v\n", err)
+			return 1
+		}
+		printMigrationStatus(os.Stdout, status)
+	case "up":
+		n, err := m.Up(ctx, *to)
+		fmt.Printf("applied %d migrations\n", n)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "migrate: %v\n", err)
+			return 1
+		}
+	case "down":
+		n, err := m.Down(ctx, *steps)
+		fmt.Printf("reverted %d migrations\n", n)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "migrate: %v\n", err)
+			return 1
+		}
+	}
+	return 0
+}
+
+func printMigrationStatus(w io.Writer, status *data.SchemaStatus) {
+	if len(status.PendingSchema) == 0 {
+		fmt.Fprintln(w, "schema: in sync")
+	} else {
+		fmt.Fprintf(w, "schema: %d pending statements\n", len(status.PendingSchema))
+		for _, stmt := range status.PendingSchema {
+			fmt.Fprintf(w, "  %s\n", stmt)
+		}
+	}
+	for _, s := range status.SkippedSchema {
+		fmt.Fprintf(w, "  skipped %s\n", s)
+	}
+	fmt.Fprintln(w)
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "VERSION\tNAME\tAPPLIED")
+	for _, ms := range status.Migrations {
+		applied := "pending"
+		if !ms.AppliedAt.IsZero() {
+			applied = ms.AppliedAt.Local().Format(time.DateTime)
+		}
+		fmt.Fprintf(tw, "%d\t%s\t%s\n", ms.Version, ms.Name, applied)
+	}
+	tw.Flush()
+}
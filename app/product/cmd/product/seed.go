@@ -0,0 +1,206 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"yinni_backend/app/product/internal/biz"
+	"yinni_backend/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	// datasetPath is the catalog seeded into an empty database.
+	datasetPath = "./product_dataset.json"
+	// seedFeaturedEvery marks every nth seeded product as featured.
+	seedFeaturedEvery = 20
+	// maxSeedErrorsLogged caps the failed rows logged by a seed.
+	maxSeedErrorsLogged = 20
+
+	dbReadyTimeout = 60 * time.Second
+)
+
+// prepareDatabase brings the database up to date before the service
+// starts: it applies pending migrations unless migrate is false, then seeds
+// the catalog when it is empty or force is set. Seeding upserts, so
+// existing products, their counters and embeddings are kept. A failed seed
+// is logged and does not stop the service.
+func prepareDatabase(ctx context.Context, c *conf.Data, migrate, force bool, logger log.Logger) error {
+	logHelper := log.NewHelper(logger)
+
+	m, cleanup, err := wireMigrator(c, logger)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	if err := m.WaitReady(ctx, dbReadyTimeout); err != nil {
+		return err
+	}
+	if migrate {
+		n, err := m.Up(ctx, 0)
+		if err != nil {
+			return fmt.Errorf("migration failed: %w", err)
+		}
+		logHelper.Infof("Database migrated, %d migrations applied", n)
+	}
+
+	if !force {
+		empty, err := m.CatalogEmpty(ctx)
+		if err != nil {
+			return err
+		}
+		if !empty {
+			logHelper.Info("Catalog has products, skipping seeding")
+			return nil
+		}
+	}
+
+	if err := seedCatalog(ctx, c, logger); err != nil {
+		logHelper.Errorf("Seeding failed: %v", err)
+	}
+	return nil
+}
+
+// seedCatalog imports the bundled dataset, or two sample products when the
+// dataset is missing.
+func seedCatalog(ctx context.Context, c *conf.Data, logger log.Logger) error {
+	logHelper := log.NewHelper(logger)
+
+	importer, cleanup, err := wireImporter(c, logger)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	var reader biz.ProductReader
+	f, err := os.Open(datasetPath)
+	switch {
+	case err == nil:
+		defer f.Close()
+		if reader, err = newJSONArrayReader(f); err != nil {
+			return err
+		}
+		logHelper.Infof("Seeding the catalog from %s", datasetPath)
+	case errors.Is(err, os.ErrNotExist):
+		reader = &sliceReader{values: sampleProducts}
+		logHelper.Warnf("%s not found, seeding sample products", datasetPath)
+	default:
+		return err
+	}
+
+	report, err := importer.Import(ctx, &featuredReader{r: reader}, biz.ImportOptions{})
+	if err != nil {
+		return err
+	}
+	for i, e := range report.Errors {
+		if i == maxSeedErrorsLogged {
+			break
+		}
+		logHelper.Warnf("Seed row %d (%s) failed: %v", e.Line, e.Key, e.Err)
+	}
+	logHelper.Infof("Seeded the catalog: %d created, %d updated, %d failed",
+		report.Created, report.Updated, report.Failed)
+	return nil
+}
+
+// featuredReader marks every seedFeaturedEvery-th record as featured.
+type featuredReader struct {
+	r biz.ProductReader
+	n int
+}
+
+func (r *featuredReader) Next() (*biz.ImportRecord, error) {
+	rec, err := r.r.Next()
+	if err != nil {
+		return nil, err
+	}
+	r.n++
+	if r.n%seedFeaturedEvery == 0 && rec.Err == nil {
+		rec.Product.Featured = true
+		rec.Fields = append(rec.Fields, biz.ProductFieldFeatured)
+	}
+	return rec, nil
+}
+
+// sliceReader reads records from decoded dataset rows.
+type sliceReader struct {
+	values []map[string]any
+	i      int
+}
+
+func (r *sliceReader) Next() (*biz.ImportRecord, error) {
+	if r.i == len(r.values) {
+		return nil, io.EOF
+	}
+	r.i++
+	return importRecord(r.i, r.values[r.i-1]), nil
+}
+
+// sampleProducts are seeded when the dataset is missing.
+var sampleProducts = []map[string]any{
+	{
+		"title":          "Solid Men Multicolor Track Pants",
+		"brand":          "York",
+		"category":       "Clothing and Accessories",
+		"sub_category":   "Bottomwear",
+		"description":    "Yorker trackpants made from 100% rich combed cotton giving it a rich look. Designed for Comfort, Skin friendly fabric, itch-free waistband & great for all year round use Proudly made in India",
+		"actual_price":   "2,999",
+		"selling_price":  "921",
+		"discount":       "69% off",
+		"pid":            "TKPFCZ9EA7H5FYZH",
+		"_id":            "fa8e22d6-c0b6-5229-bb9e-ad52eda39a0a",
+		"seller":         "Shyam Enterprises",
+		"average_rating": "3.9",
+		"images": []any{
+			"https://rukminim1.flixcart.com/image/128/128/jr3t5e80/track-pant/z/y/n/m-1005combo2-yorker-original-imafczg3xfh5qqd4.jpeg?q=70",
+			"https://rukminim1.flixcart.com/image/128/128/jr58l8w0/track-pant/w/d/a/l-1005combo8-yorker-original-imafczg3pgtxgraq.jpeg?q=70",
+		},
+		"product_details": []any{
+			map[string]any{"Style Code": "1005COMBO2"},
+			map[string]any{"Closure": "Elastic"},
+			map[string]any{"Pockets": "Side Pockets"},
+			map[string]any{"Fabric": "Cotton Blend"},
+			map[string]any{"Pattern": "Solid"},
+			map[string]any{"Color": "Multicolor"},
+		},
+		"url":        "https://www.flipkart.com/yorker-solid-men-multicolor-track-pants/p/itmd2c76aadce459?pid=TKPFCZ9EA7H5FYZH&lid=LSTTKPFCZ9EA7H5FYZHVYXWP0&marketplace=FLIPKART&srno=b_1_1&otracker=browse&fm=organic&iid=177a46eb-d053-4732-b3de-fcad6ff59cbd.TKPFCZ9EA7H5FYZH.SEARCH&ssid=utkd4t3gb40000001612415717799",
+		"style_code": "1005COMBO2",
+		"crawled_at": "02/10/2021, 20:11:51",
+		"featured":   true,
+	},
+	{
+		"title":          "Solid Men Blue Track Pants",
+		"brand":          "York",
+		"category":       "Clothing and Accessories",
+		"sub_category":   "Bottomwear",
+		"description":    "Yorker trackpants made from 100% rich combed cotton giving it a rich look. Designed for Comfort, Skin friendly fabric, itch-free waistband & great for all year round use Proudly made in India",
+		"actual_price":   "1,499",
+		"selling_price":  "499",
+		"discount":       "66% off",
+		"pid":            "TKPFCZ9EJZV2UVRZ",
+		"_id":            "893e6980-f2a0-531f-b056-34dd63fe912c",
+		"seller":         "Shyam Enterprises",
+		"average_rating": "3.9",
+		"images": []any{
+			"https://rukminim1.flixcart.com/image/128/128/kfyasnk0/track-pant/g/5/y/s-19876-yorker-original-imafwamyzrwjynkf.jpeg?q=70",
+			"https://rukminim1.flixcart.com/image/128/128/kfyasnk0/track-pant/g/5/y/s-19876-yorker-original-imafwamynyeuu5zq.jpeg?q=70",
+		},
+		"product_details": []any{
+			map[string]any{"Style Code": "1005BLUE"},
+			map[string]any{"Closure": "Drawstring, Elastic"},
+			map[string]any{"Pockets": "Side Pockets"},
+			map[string]any{"Fabric": "Cotton Blend"},
+			map[string]any{"Pattern": "Solid"},
+			map[string]any{"Color": "Blue"},
+		},
+		"url":        "https://www.flipkart.com/yorker-solid-men-blue-track-pants/p/itmfczez7v6rzwer?pid=TKPFCZ9EJZV2UVRZ&lid=LSTTKPFCZ9EJZV2UVRZ9HEITU&marketplace=FLIPKART&srno=b_1_2&otracker=browse&fm=organic&iid=177a46eb-d053-4732-b3de-fcad6ff59cbd.TKPFCZ9EJZV2UVRZ.SEARCH&ssid=utkd4t3gb40000001612415717799",
+		"style_code": "1005BLUE",
+		"crawled_at": "02/10/2021, 20:11:52",
+	},
+}
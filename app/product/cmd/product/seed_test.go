@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"yinni_backend/ent"
+	"yinni_backend/ent/product"
+	"yinni_backend/internal/conf"
+
+	"entgo.io/ent/dialect"
+	"github.com/go-kratos/kratos/v2/log"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestPrepareDatabaseSeedsEmptyCatalog(t *testing.T) {
+	c := &conf.Data{Database: &conf.Data_Database{
+		Driver: dialect.SQLite,
+		Source: fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()),
+	}}
+	// Keeps the in-memory database open between the calls
+	client, err := ent.Open(c.Database.Driver, c.Database.Source)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	ctx := context.Background()
+
+	if err := prepareDatabase(ctx, c, true, false, log.DefaultLogger); err != nil {
+		t.Fatal(err)
+	}
+	if n := client.Product.Query().CountX(ctx); n != len(sampleProducts) {
+		t.Fatalf("%d products seeded, want %d", n, len(sampleProducts))
+	}
+
+	// A catalog with products is left alone
+	seeded := client.Product.Query().FirstX(ctx)
+	if err := seeded.Update().SetTitle("Renamed").SetViewCount(42).Exec(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if err := prepareDatabase(ctx, c, true, false, log.DefaultLogger); err != nil {
+		t.Fatal(err)
+	}
+	if p := client.Product.GetX(ctx, seeded.ID); p.Title != "Renamed" {
+		t.Errorf("restart reseeded: title %q", p.Title)
+	}
+
+	// Forcing the seed upserts, keeping the counters
+	if err := prepareDatabase(ctx, c, false, true, log.DefaultLogger); err != nil {
+		t.Fatal(err)
+	}
+	if n := client.Product.Query().CountX(ctx); n != len(sampleProducts) {
+		t.Errorf("%d products after a forced seed, want %d", n, len(sampleProducts))
+	}
+	p := client.Product.Query().Where(product.ID(seeded.ID)).OnlyX(ctx)
+	if p.Title == "Renamed" || p.ViewCount != 42 {
+		t.Errorf("forced seed: title %q, view count %d; want the dataset title and 42", p.Title, p.ViewCount)
+	}
+}
@@ -11,6 +11,8 @@ import (
 	"yinni_backend/app/product/internal/server"
 	"yinni_backend/app/product/internal/service"
 	"yinni_backend/internal/conf"
+	"yinni_backend/pkg/jwks"
+	"yinni_backend/pkg/revocation"
 
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/log"
@@ -18,6 +20,21 @@ import (
 )
 
 // wireApp init kratos application.
-func wireApp(*conf.Server, *conf.Auth, *conf.Data, *conf.Embeddings, log.Logger) (*kratos.App, func(), error) {
-	panic(wire.Build(server.ProviderSet, data.ProviderSet, biz.ProviderSet, service.ProviderSet, newApp))
+func wireApp(*conf.Server, *conf.Auth, *conf.Data, *conf.Embeddings, *conf.Chat, *conf.Search, log.Logger) (*kratos.App, func(), error) {
+	panic(wire.Build(server.ProviderSet, data.ProviderSet, biz.ProviderSet, service.ProviderSet, revocation.ProviderSet, jwks.ProviderSet, newApp))
+}
+
+// wireImporter init the catalog importer of the import command.
+func wireImporter(*conf.Data, log.Logger) (*biz.CatalogImporter, func(), error) {
+	panic(wire.Build(data.ProviderSet, biz.ProviderSet))
+}
+
+// wireExporter init the catalog exporter of the export command.
+func wireExporter(*conf.Data, log.Logger) (*biz.CatalogExporter, func(), error) {
+	panic(wire.Build(data.ProviderSet, biz.ProviderSet))
+}
+
+// wireMigrator init the database migrator.
+func wireMigrator(*conf.Data, log.Logger) (*data.Migrator, func(), error) {
+	panic(wire.Build(data.ProviderSet))
 }
@@ -14,6 +14,8 @@ import (
 	"yinni_backend/app/product/internal/server"
 	"yinni_backend/app/product/internal/service"
 	"yinni_backend/internal/conf"
+	"yinni_backend/pkg/jwks"
+	"yinni_backend/pkg/revocation"
 )
 
 import (
@@ -25,18 +27,93 @@ import (
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(confServer *conf.Server, auth *conf.Auth, confData *conf.Data, embeddings *conf.Embeddings, logger log.Logger) (*kratos.App, func(), error) {
+func wireApp(confServer *conf.Server, auth *conf.Auth, confData *conf.Data, embeddings *conf.Embeddings, chat *conf.Chat, search *conf.Search, logger log.Logger) (*kratos.App, func(), error) {
 	dataData, cleanup, err := data.NewData(confData, logger)
 	if err != nil {
 		return nil, nil, err
 	}
-	productRepo := data.NewProductRepo(dataData, embeddings, logger)
-	productUsecase := biz.NewProductUsecase(productRepo, embeddings, logger)
-	productService := service.NewProductService(productUsecase, logger)
-	grpcServer := server.NewGRPCServer(confServer, auth, productService, logger)
-	httpServer := server.NewHTTPServer(confServer, auth, productService, logger)
+	vectorIndex := data.NewVectorIndex()
+	embedder := data.NewEmbedder(embeddings, logger)
+	productRepo := data.NewProductRepo(dataData, vectorIndex, embedder, logger)
+	chatClient := data.NewChatClient(chat, logger)
+	spellChecker := biz.NewSpellChecker()
+	searchRuleRepo := data.NewSearchRuleRepo(dataData, logger)
+	searchRules, cleanup2 := biz.NewSearchRules(searchRuleRepo, search, logger)
+	productUsecase, cleanup3 := biz.NewProductUsecase(productRepo, chatClient, embedder, spellChecker, searchRules, embeddings, search, logger)
+	backfillRepo := data.NewBackfillRepo(dataData, logger)
+	embeddingBackfill, cleanup4 := biz.NewEmbeddingBackfill(productUsecase, backfillRepo, embeddings, logger)
+	suggestIndex := data.NewSuggestIndex()
+	querySuggester, cleanup5 := biz.NewQuerySuggester(productRepo, suggestIndex, spellChecker, search, logger)
+	exportRepo := data.NewExportRepo(dataData, logger)
+	catalogExporter := biz.NewCatalogExporter(exportRepo, logger)
+	productService := service.NewProductService(productUsecase, embeddingBackfill, querySuggester, searchRules, catalogExporter, logger)
+	store, cleanup6, err := revocation.NewStore(confData, logger)
+	if err != nil {
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	cache, cleanup7, err := jwks.NewCache(auth, logger)
+	if err != nil {
+		cleanup6()
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	grpcServer := server.NewGRPCServer(confServer, auth, store, cache, productService, logger)
+	httpServer := server.NewHTTPServer(confServer, auth, store, cache, productService, logger)
 	app := newApp(logger, grpcServer, httpServer)
 	return app, func() {
+		cleanup7()
+		cleanup6()
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+	}, nil
+}
+
+// wireImporter init the catalog importer of the import command.
+func wireImporter(confData *conf.Data, logger log.Logger) (*biz.CatalogImporter, func(), error) {
+	dataData, cleanup, err := data.NewData(confData, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	importRepo := data.NewImportRepo(dataData, logger)
+	catalogImporter := biz.NewCatalogImporter(importRepo, logger)
+	return catalogImporter, func() {
+		cleanup()
+	}, nil
+}
+
+// wireExporter init the catalog exporter of the export command.
+func wireExporter(confData *conf.Data, logger log.Logger) (*biz.CatalogExporter, func(), error) {
+	dataData, cleanup, err := data.NewData(confData, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	exportRepo := data.NewExportRepo(dataData, logger)
+	catalogExporter := biz.NewCatalogExporter(exportRepo, logger)
+	return catalogExporter, func() {
+		cleanup()
+	}, nil
+}
+
+// wireMigrator init the database migrator.
+func wireMigrator(confData *conf.Data, logger log.Logger) (*data.Migrator, func(), error) {
+	dataData, cleanup, err := data.NewData(confData, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	migrator := data.NewMigrator(dataData, logger)
+	return migrator, func() {
 		cleanup()
 	}, nil
 }
@@ -0,0 +1,445 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	v1 "yinni_backend/api/product/v1"
+	"yinni_backend/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var ErrBackfillAlreadyRunning = errors.Conflict(v1.ErrorReason_BACKFILL_ALREADY_RUNNING.String(), "embedding backfill is already running")
+
+// Backfill job statuses.
+const (
+	BackfillIdle      = "idle"
+	BackfillRunning   = "running"
+	BackfillStopped   = "stopped"
+	BackfillCompleted = "completed"
+	BackfillFailed    = "failed"
+)
+
+const (
+	defaultEmbeddingBatchSize  = 16
+	defaultBackfillMaxAttempts = 3
+	maxDeadLettersReported     = 100
+)
+
+// BackfillState is the persisted progress of the embedding backfill.
+type BackfillState struct {
+	Status     string
+	Cursor     int64
+	Processed  int64
+	Failed     int64
+	LastError  string
+	StartedAt  time.Time
+	FinishedAt time.Time
+}
+
+// BackfillStatus is a BackfillState plus figures computed on request.
+type BackfillStatus struct {
+	*BackfillState
+	Remaining   int64
+	DeadLetters []*EmbeddingFailure
+}
+
+// EmbeddingFailure counts the failed embedding attempts of a product.
+type EmbeddingFailure struct {
+	ProductID int64
+	Attempts  int
+	LastError string
+	UpdatedAt time.Time
+}
+
+// BackfillLease is the exclusive right to run the backfill, shared by all
+// instances of the service.
+type BackfillLease interface {
+	// Check returns an error once the lease has been lost.
+	Check(ctx context.Context) error
+	Release()
+}
+
+// BackfillRepo persists backfill progress and embedding failures.
+type BackfillRepo interface {
+	// AcquireBackfillLease takes the backfill lease without waiting. It
+	// returns nil when another instance holds it.
+	AcquireBackfillLease(ctx context.Context) (BackfillLease, error)
+
+	// LoadBackfillState returns the saved state, or an idle state if none.
+	LoadBackfillState(ctx context.Context) (*BackfillState, error)
+	SaveBackfillState(ctx context.Context, state *BackfillState) error
+
+	// RecordEmbeddingFailure increments the attempts of a product.
+	RecordEmbeddingFailure(ctx context.Context, productID int64, reason string) error
+	// ClearEmbeddingFailures forgets the failures of products that have
+	// since been embedded.
+	ClearEmbeddingFailures(ctx context.Context, productIDs []int64) error
+	// ListEmbeddingFailures returns failures with at least minAttempts.
+	ListEmbeddingFailures(ctx context.Context, minAttempts, limit int) ([]*EmbeddingFailure, error)
+	// DeadLetteredIDs returns which of ids have at least minAttempts.
+	DeadLetteredIDs(ctx context.Context, ids []int64, minAttempts int) (map[int64]bool, error)
+}
+
+// EmbeddingBackfill embeds every product that needs an embedding in the
+// background. Progress is saved after each batch so a restart, or a stop
+// followed by a start, resumes where it left off. Products that fail
+// maxAttempts times are dead-lettered and skipped. A run holds the backfill
+// lease, so only one instance runs it at a time; Stop only reaches a run of
+// its own instance.
+type EmbeddingBackfill struct {
+	uc          *ProductUsecase
+	repo        BackfillRepo
+	limiter     *tokenBucket
+	batchSize   int
+	maxAttempts int
+	log         *log.Helper
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+	done   chan struct{}
+	// closing is set on shutdown so the run stays marked as running and is
+	// resumed by the next process.
+	closing bool
+}
+
+// NewEmbeddingBackfill creates the backfill worker. It resumes a run that was
+// interrupted by a restart, and starts a full run when the embedding model
+// has changed. The returned cleanup stops the worker.
+func NewEmbeddingBackfill(uc *ProductUsecase, repo BackfillRepo, conf *conf.Embeddings, logger log.Logger) (*EmbeddingBackfill, func()) {
+	b := &EmbeddingBackfill{
+		uc:          uc,
+		repo:        repo,
+		limiter:     newTokenBucket(float64(conf.GetRequestsPerSecond()), int(conf.GetBurst())),
+		batchSize:   int(conf.GetBatchSize()),
+		maxAttempts: int(conf.GetMaxAttempts()),
+		log:         log.NewHelper(logger),
+	}
+	if b.batchSize <= 0 {
+		b.batchSize = defaultEmbeddingBatchSize
+	}
+	if b.maxAttempts <= 0 {
+		b.maxAttempts = defaultBackfillMaxAttempts
+	}
+
+	if uc.embeddingsEnabled() {
+		go b.resume(context.Background())
+	}
+
+	return b, b.shutdown
+}
+
+// shutdown stops a running backfill without marking it stopped.
+func (b *EmbeddingBackfill) shutdown() {
+	b.mu.Lock()
+	b.closing = true
+	cancel, done := b.cancel, b.done
+	b.mu.Unlock()
+
+	if cancel != nil {
+		cancel()
+		<-done
+	}
+}
+
+// resume restarts a run whose process exited while it was running, or
+// re-embeds everything if embeddings from another model are stored.
+func (b *EmbeddingBackfill) resume(ctx context.Context) {
+	state, err := b.repo.LoadBackfillState(ctx)
+	if err != nil {
+		b.log.Errorf("Failed to load embedding backfill state: %v", err)
+		return
+	}
+
+	restart := false
+	if state.Status != BackfillRunning {
+		stale, err := b.uc.repo.CountStaleModelEmbeddings(ctx)
+		if err != nil {
+			b.log.Errorf("Failed to count embeddings from other models: %v", err)
+			return
+		}
+		if stale == 0 {
+			return
+		}
+		b.log.Infof("Embedding model changed to %s, re-embedding %d products", b.uc.embedder.Model(), stale)
+		restart = true
+	} else {
+		b.log.Infof("Resuming interrupted embedding backfill after product %d", state.Cursor)
+	}
+
+	if _, err := b.Start(ctx, restart); err != nil && !errors.Is(err, ErrBackfillAlreadyRunning) {
+		b.log.Errorf("Failed to resume embedding backfill: %v", err)
+	}
+}
+
+// Start launches the backfill. Unless restart is set, a previously stopped
+// or interrupted run continues after its saved cursor.
+func (b *EmbeddingBackfill) Start(ctx context.Context, restart bool) (*BackfillStatus, error) {
+	if !b.uc.embeddingsEnabled() {
+		return nil, ErrEmbeddingsNotEnabled
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.done != nil {
+		return nil, ErrBackfillAlreadyRunning
+	}
+	if b.closing {
+		return nil, context.Canceled
+	}
+
+	lease, err := b.repo.AcquireBackfillLease(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if lease == nil {
+		return nil, ErrBackfillAlreadyRunning
+	}
+
+	state, err := b.repo.LoadBackfillState(ctx)
+	if err != nil {
+		lease.Release()
+		return nil, err
+	}
+
+	resume := !restart && (state.Status == BackfillRunning || state.Status == BackfillStopped || state.Status == BackfillFailed)
+	if !resume {
+		state = &BackfillState{}
+	}
+	state.Status = BackfillRunning
+	state.LastError = ""
+	state.FinishedAt = time.Time{}
+	if state.StartedAt.IsZero() {
+		state.StartedAt = time.Now()
+	}
+	if err := b.repo.SaveBackfillState(ctx, state); err != nil {
+		lease.Release()
+		return nil, err
+	}
+
+	runCtx, cancel := context.WithCancel(context.Background())
+	b.cancel = cancel
+	b.done = make(chan struct{})
+	snapshot := *state
+	go b.run(runCtx, state, lease, b.done)
+
+	b.log.Infof("Embedding backfill started after product %d", snapshot.Cursor)
+	return b.status(ctx, &snapshot)
+}
+
+// Stop halts a running backfill and waits for it to save its progress.
+func (b *EmbeddingBackfill) Stop(ctx context.Context) (*BackfillStatus, error) {
+	b.mu.Lock()
+	cancel, done := b.cancel, b.done
+	b.mu.Unlock()
+
+	if cancel != nil {
+		cancel()
+		select {
+		case <-done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	return b.Status(ctx)
+}
+
+// Status reports the saved progress, the products left and the dead letters.
+func (b *EmbeddingBackfill) Status(ctx context.Context) (*BackfillStatus, error) {
+	state, err := b.repo.LoadBackfillState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return b.status(ctx, state)
+}
+
+func (b *EmbeddingBackfill) status(ctx context.Context, state *BackfillState) (*BackfillStatus, error) {
+	remaining, err := b.uc.repo.CountProductsNeedingEmbeddings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	deadLetters, err := b.repo.ListEmbeddingFailures(ctx, b.maxAttempts, maxDeadLettersReported)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BackfillStatus{
+		BackfillState: state,
+		Remaining:     int64(remaining),
+		DeadLetters:   deadLetters,
+	}, nil
+}
+
+func (b *EmbeddingBackfill) run(ctx context.Context, state *BackfillState, lease BackfillLease, done chan struct{}) {
+	defer func() {
+		lease.Release()
+		b.mu.Lock()
+		b.cancel()
+		b.cancel, b.done = nil, nil
+		b.mu.Unlock()
+		close(done)
+	}()
+
+	err := b.process(ctx, state, lease)
+	switch {
+	case err == nil:
+		state.Status = BackfillCompleted
+		state.Cursor = 0
+		b.log.Infof("Embedding backfill completed: %d embedded, %d failed", state.Processed, state.Failed)
+	case ctx.Err() != nil:
+		b.mu.Lock()
+		closing := b.closing
+		b.mu.Unlock()
+		if !closing {
+			state.Status = BackfillStopped
+		}
+		b.log.Infof("Embedding backfill stopped after product %d", state.Cursor)
+	default:
+		state.Status = BackfillFailed
+		state.LastError = err.Error()
+		b.log.Errorf("Embedding backfill failed after product %d: %v", state.Cursor, err)
+	}
+	state.FinishedAt = time.Now()
+
+	// Save even when stopped, with a context that outlives the run
+	saveCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 10*time.Second)
+	defer cancel()
+	if err := b.repo.SaveBackfillState(saveCtx, state); err != nil {
+		b.log.Errorf("Failed to save embedding backfill state: %v", err)
+	}
+}
+
+// process embeds batches after the cursor until none are left. It gives up
+// as soon as the lease is lost, before another instance can take over.
+func (b *EmbeddingBackfill) process(ctx context.Context, state *BackfillState, lease BackfillLease) error {
+	for {
+		if err := lease.Check(ctx); err != nil {
+			return err
+		}
+		products, err := b.uc.repo.GetProductsNeedingEmbeddings(ctx, state.Cursor, b.batchSize)
+		if err != nil {
+			return fmt.Errorf("failed to get products needing embeddings: %w", err)
+		}
+		if len(products) == 0 {
+			return nil
+		}
+
+		if err := b.processBatch(ctx, state, products); err != nil {
+			return err
+		}
+
+		state.Cursor = products[len(products)-1].ID
+		if err := b.repo.SaveBackfillState(ctx, state); err != nil {
+			return fmt.Errorf("failed to save backfill cursor: %w", err)
+		}
+	}
+}
+
+func (b *EmbeddingBackfill) processBatch(ctx context.Context, state *BackfillState, products []*Product) error {
+	ids := make([]int64, len(products))
+	for i, p := range products {
+		ids[i] = p.ID
+	}
+	dead, err := b.repo.DeadLetteredIDs(ctx, ids, b.maxAttempts)
+	if err != nil {
+		return fmt.Errorf("failed to check dead letters: %w", err)
+	}
+
+	pending := make([]*Product, 0, len(products))
+	for _, p := range products {
+		if !dead[p.ID] {
+			pending = append(pending, p)
+		}
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+
+	embeddings, failures := b.uc.embedProducts(ctx, pending, b.limiter)
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	for id, embedErr := range failures {
+		state.Failed++
+		if err := b.repo.RecordEmbeddingFailure(ctx, id, embedErr.Error()); err != nil {
+			return fmt.Errorf("failed to record embedding failure: %w", err)
+		}
+	}
+
+	if len(embeddings) == 0 {
+		return nil
+	}
+	if err := b.uc.repo.BatchUpdateEmbeddings(ctx, embeddings); err != nil {
+		return fmt.Errorf("failed to save embeddings: %w", err)
+	}
+
+	embedded := make([]int64, 0, len(embeddings))
+	for id := range embeddings {
+		embedded = append(embedded, id)
+	}
+	state.Processed += int64(len(embedded))
+	return b.repo.ClearEmbeddingFailures(ctx, embedded)
+}
+
+// tokenBucket is a token-bucket rate limiter. A nil bucket never waits.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64 // tokens per second
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// newTokenBucket returns a bucket refilling at rate tokens per second up to
+// burst tokens, or nil when rate is not positive.
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	if rate <= 0 {
+		return nil
+	}
+	if burst <= 0 {
+		burst = max(1, int(math.Ceil(rate)))
+	}
+	return &tokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Wait blocks until a token is available or ctx is done.
+func (tb *tokenBucket) Wait(ctx context.Context) error {
+	if tb == nil {
+		return ctx.Err()
+	}
+
+	for {
+		tb.mu.Lock()
+		now := time.Now()
+		tb.tokens = math.Min(tb.burst, tb.tokens+now.Sub(tb.last).Seconds()*tb.rate)
+		tb.last = now
+		if tb.tokens >= 1 {
+			tb.tokens--
+			tb.mu.Unlock()
+			return nil
+		}
+		wait := time.Duration((1 - tb.tokens) / tb.rate * float64(time.Second))
+		tb.mu.Unlock()
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+	}
+}
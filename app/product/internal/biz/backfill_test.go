@@ -0,0 +1,196 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	"yinni_backend/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// embedRepo serves the products needing embeddings and records the saved
+// ones.
+type embedRepo struct {
+	ProductRepo
+	mu       sync.Mutex
+	products []*Product
+	embedded map[int64]*ProductEmbedding
+}
+
+func (r *embedRepo) GetProductsNeedingEmbeddings(ctx context.Context, afterID int64, limit int) ([]*Product, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []*Product
+	for _, p := range r.products {
+		if _, ok := r.embedded[p.ID]; !ok && p.ID > afterID && len(out) < limit {
+			out = append(out, p)
+		}
+	}
+	return out, nil
+}
+
+func (r *embedRepo) BatchUpdateEmbeddings(ctx context.Context, embeddings map[int64]*ProductEmbedding) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for id, e := range embeddings {
+		r.embedded[id] = e
+	}
+	return nil
+}
+
+func (r *embedRepo) CountProductsNeedingEmbeddings(ctx context.Context) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.products) - len(r.embedded), nil
+}
+
+// fakeLease is lost once checks reaches loseAfter, when set.
+type fakeLease struct {
+	loseAfter int
+	checks    int
+	released  bool
+}
+
+func (l *fakeLease) Check(ctx context.Context) error {
+	l.checks++
+	if l.loseAfter > 0 && l.checks >= l.loseAfter {
+		return errors.New("embedding backfill lock lost")
+	}
+	return nil
+}
+
+func (l *fakeLease) Release() { l.released = true }
+
+// leaseRepo keeps the backfill state in memory. The lease is held by another
+// instance when lease is nil.
+type leaseRepo struct {
+	BackfillRepo
+	mu    sync.Mutex
+	lease *fakeLease
+	state *BackfillState
+}
+
+func (r *leaseRepo) AcquireBackfillLease(ctx context.Context) (BackfillLease, error) {
+	if r.lease == nil {
+		return nil, nil
+	}
+	return r.lease, nil
+}
+
+func (r *leaseRepo) LoadBackfillState(ctx context.Context) (*BackfillState, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.state == nil {
+		return &BackfillState{Status: BackfillIdle}, nil
+	}
+	state := *r.state
+	return &state, nil
+}
+
+func (r *leaseRepo) SaveBackfillState(ctx context.Context, state *BackfillState) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	saved := *state
+	r.state = &saved
+	return nil
+}
+
+func (r *leaseRepo) ClearEmbeddingFailures(ctx context.Context, productIDs []int64) error {
+	return nil
+}
+
+func (r *leaseRepo) ListEmbeddingFailures(ctx context.Context, minAttempts, limit int) ([]*EmbeddingFailure, error) {
+	return nil, nil
+}
+
+func (r *leaseRepo) DeadLetteredIDs(ctx context.Context, ids []int64, minAttempts int) (map[int64]bool, error) {
+	return nil, nil
+}
+
+func newTestBackfill(t *testing.T, repo *leaseRepo, n int) (*EmbeddingBackfill, *embedRepo) {
+	t.Helper()
+	products := &embedRepo{embedded: make(map[int64]*ProductEmbedding)}
+	for i := 1; i <= n; i++ {
+		products.products = append(products.products, &Product{ID: int64(i)})
+	}
+	uc, cleanup := NewProductUsecase(products, nil, constantEmbedder{}, nil, nil, &conf.Embeddings{}, &conf.Search{}, log.DefaultLogger)
+	t.Cleanup(cleanup)
+	// Built by hand so no resume races the test's own Start
+	b := &EmbeddingBackfill{
+		uc:          uc,
+		repo:        repo,
+		batchSize:   2,
+		maxAttempts: defaultBackfillMaxAttempts,
+		log:         log.NewHelper(log.DefaultLogger),
+	}
+	t.Cleanup(b.shutdown)
+	return b, products
+}
+
+// waitBackfill waits for the running backfill to finish.
+func waitBackfill(b *EmbeddingBackfill) {
+	b.mu.Lock()
+	done := b.done
+	b.mu.Unlock()
+	if done != nil {
+		<-done
+	}
+}
+
+func TestBackfillRunsUnderLease(t *testing.T) {
+	repo := &leaseRepo{lease: &fakeLease{}}
+	b, products := newTestBackfill(t, repo, 5)
+
+	if _, err := b.Start(context.Background(), false); err != nil {
+		t.Fatal(err)
+	}
+	waitBackfill(b)
+
+	if len(products.embedded) != 5 {
+		t.Errorf("%d products embedded, want 5", len(products.embedded))
+	}
+	if repo.state.Status != BackfillCompleted || repo.state.Processed != 5 {
+		t.Errorf("state %+v, want completed with 5 processed", repo.state)
+	}
+	// The lease is checked before every batch, and released at the end
+	if repo.lease.checks != 4 || !repo.lease.released {
+		t.Errorf("lease checked %d times, released %v; want 4 checks and released", repo.lease.checks, repo.lease.released)
+	}
+}
+
+func TestBackfillLeaseHeldElsewhere(t *testing.T) {
+	repo := &leaseRepo{}
+	b, products := newTestBackfill(t, repo, 5)
+
+	if _, err := b.Start(context.Background(), false); !errors.Is(err, ErrBackfillAlreadyRunning) {
+		t.Errorf("err = %v, want ErrBackfillAlreadyRunning", err)
+	}
+	if repo.state != nil || len(products.embedded) != 0 {
+		t.Errorf("backfill ran without the lease: state %+v, %d embedded", repo.state, len(products.embedded))
+	}
+}
+
+func TestBackfillStopsWhenLeaseLost(t *testing.T) {
+	repo := &leaseRepo{lease: &fakeLease{loseAfter: 2}}
+	b, products := newTestBackfill(t, repo, 5)
+
+	if _, err := b.Start(context.Background(), false); err != nil {
+		t.Fatal(err)
+	}
+	waitBackfill(b)
+
+	// Only the batch embedded before the lease was lost is saved
+	if len(products.embedded) != 2 {
+		t.Errorf("%d products embedded, want 2", len(products.embedded))
+	}
+	if repo.state.Status != BackfillFailed || !strings.Contains(repo.state.LastError, "lost") || repo.state.Cursor != 2 {
+		t.Errorf("state %+v, want failed after product 2 with the lost lease", repo.state)
+	}
+	if !repo.lease.released {
+		t.Error("lost lease not released")
+	}
+}
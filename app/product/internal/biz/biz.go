@@ -3,4 +3,4 @@ package biz
 import "github.com/google/wire"
 
 // ProviderSet is biz providers.
-var ProviderSet = wire.NewSet(NewProductUsecase)
+var ProviderSet = wire.NewSet(NewProductUsecase, NewEmbeddingBackfill, NewQuerySuggester, NewSpellChecker, NewSearchRules, NewCatalogImporter, NewCatalogExporter)
@@ -0,0 +1,230 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Chat message roles, as used by OpenAI-compatible APIs.
+const (
+	ChatRoleSystem    = "system"
+	ChatRoleUser      = "user"
+	ChatRoleAssistant = "assistant"
+)
+
+// ChatMessage is a single message of a chat completion conversation.
+type ChatMessage struct {
+	Role    string
+	Content string
+}
+
+// ChatClient generates chat completions for RAG answers.
+type ChatClient interface {
+	// Complete returns the assistant reply to the conversation.
+	Complete(ctx context.Context, messages []ChatMessage) (string, error)
+	// Stream generates the reply incrementally, calling onDelta with each
+	// chunk of text. An error from onDelta aborts the stream and is returned.
+	Stream(ctx context.Context, messages []ChatMessage, onDelta func(delta string) error) error
+}
+
+// RAGResult is the answer to a catalog question together with the products
+// it was grounded on.
+type RAGResult struct {
+	Answer string
+	// CitedPIDs are the PIDs referenced by the answer, in order of first
+	// appearance. Only PIDs of retrieved products are kept.
+	CitedPIDs []string
+	Products  []*ScoredProduct
+}
+
+const ragSystemPrompt = `You are a shopping assistant for an online clothing catalog.
+Answer the customer's question using only the products listed in the context.
+Cite every product you mention by its PID in square brackets, for example [TKPFCZ9EA7H5FYZH].
+If none of the products fit the question, say so briefly instead of guessing.`
+
+// RAGEventKind identifies the payload of a RAGEvent.
+type RAGEventKind int
+
+const (
+	// RAGEventProducts carries the retrieved products. It is sent first.
+	RAGEventProducts RAGEventKind = iota
+	// RAGEventDelta carries the next chunk of the answer.
+	RAGEventDelta
+	// RAGEventCitations carries the validated citations. It is sent last.
+	RAGEventCitations
+)
+
+// RAGEvent is one step of a streamed RAG answer.
+type RAGEvent struct {
+	Kind      RAGEventKind
+	Products  []*ScoredProduct
+	Delta     string
+	CitedPIDs []string
+}
+
+// citationPattern matches "[PID]" and the "[PID: PID]" variant some models produce.
+var citationPattern = regexp.MustCompile(`\[(?:PID:\s*)?([A-Za-z0-9_-]+)\]`)
+
+// extractCitations returns the answer with citations of unknown PIDs removed
+// and the PIDs it cites.
+func extractCitations(answer string, allowed map[string]bool) (string, []string) {
+	var cited []string
+	seen := make(map[string]bool)
+
+	cleaned := citationPattern.ReplaceAllStringFunc(answer, func(m string) string {
+		pid := citationPattern.FindStringSubmatch(m)[1]
+		if !allowed[pid] {
+			return ""
+		}
+		if !seen[pid] {
+			seen[pid] = true
+			cited = append(cited, pid)
+		}
+		return "[" + pid + "]"
+	})
+
+	return strings.TrimSpace(cleaned), cited
+}
+
+// citationPrefix matches text that may still grow into a citation.
+var citationPrefix = regexp.MustCompile(`^\[(?:PID:\s*)?[A-Za-z0-9_-]*$`)
+
+// maxCitationLen bounds the text held back by citationFilter.
+const maxCitationLen = 64
+
+// citationFilter removes citations of unknown PIDs from a streamed answer,
+// like extractCitations does for a complete one. Text that may be the start
+// of a citation is held back until the citation is complete or can no longer
+// match.
+type citationFilter struct {
+	allowed map[string]bool
+	pending strings.Builder
+}
+
+func newCitationFilter(allowed map[string]bool) *citationFilter {
+	return &citationFilter{allowed: allowed}
+}
+
+// Write filters the next chunk of the answer and returns the text that can
+// be sent.
+func (f *citationFilter) Write(delta string) string {
+	var out strings.Builder
+	for _, r := range delta {
+		if f.pending.Len() == 0 {
+			if r == '[' {
+				f.pending.WriteRune(r)
+			} else {
+				out.WriteRune(r)
+			}
+			continue
+		}
+
+		if r == ']' {
+			f.pending.WriteRune(r)
+			out.WriteString(f.citation(f.pending.String()))
+			f.pending.Reset()
+			continue
+		}
+		next := f.pending.String() + string(r)
+		if citationPrefix.MatchString(next) && len(next) <= maxCitationLen {
+			f.pending.WriteRune(r)
+			continue
+		}
+		// Not a citation after all; r may start the next one
+		out.WriteString(f.pending.String())
+		f.pending.Reset()
+		if r == '[' {
+			f.pending.WriteRune(r)
+		} else {
+			out.WriteRune(r)
+		}
+	}
+	return out.String()
+}
+
+// Flush returns the text held back at the end of the answer.
+func (f *citationFilter) Flush() string {
+	rest := f.pending.String()
+	f.pending.Reset()
+	return rest
+}
+
+// citation returns the complete bracketed text m as it is sent: normalized
+// when it cites a known PID, removed when it cites an unknown one.
+func (f *citationFilter) citation(m string) string {
+	match := citationPattern.FindStringSubmatch(m)
+	if match == nil || match[0] != m {
+		return m
+	}
+	if !f.allowed[match[1]] {
+		return ""
+	}
+	return "[" + match[1] + "]"
+}
+
+// FakeChatClient is a deterministic ChatClient for tests and offline
+// development. It answers by listing the products found in the context,
+// citing each by PID.
+type FakeChatClient struct{}
+
+// NewFakeChatClient creates a FakeChatClient.
+func NewFakeChatClient() *FakeChatClient {
+	return &FakeChatClient{}
+}
+
+var (
+	fakePIDLine   = regexp.MustCompile(`(?m)^PID: (.+)$`)
+	fakeTitleLine = regexp.MustCompile(`(?m)^Title: (.+)$`)
+)
+
+// Stream emits the Complete answer word by word.
+func (c *FakeChatClient) Stream(ctx context.Context, messages []ChatMessage, onDelta func(delta string) error) error {
+	answer, err := c.Complete(ctx, messages)
+	if err != nil {
+		return err
+	}
+
+	for _, word := range strings.SplitAfter(answer, " ") {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := onDelta(word); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Complete lists the titles and PIDs of the products in the last user message.
+func (c *FakeChatClient) Complete(ctx context.Context, messages []ChatMessage) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+
+	var prompt string
+	for i := len(messages) - 1; i >= 0; i-- {
+		if messages[i].Role == ChatRoleUser {
+			prompt = messages[i].Content
+			break
+		}
+	}
+
+	pids := fakePIDLine.FindAllStringSubmatch(prompt, -1)
+	titles := fakeTitleLine.FindAllStringSubmatch(prompt, -1)
+	if len(pids) == 0 {
+		return "I could not find any matching products in the catalog.", nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString("These products match your request:")
+	for i, pid := range pids {
+		title := ""
+		if i < len(titles) {
+			title = titles[i][1]
+		}
+		sb.WriteString(fmt.Sprintf("\n- %s [%s]", title, pid[1]))
+	}
+	return sb.String(), nil
+}
@@ -0,0 +1,199 @@
+package biz
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"yinni_backend/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// ragRepo serves fixed products to vector searches.
+type ragRepo struct {
+	ProductRepo
+	products []*ScoredProduct
+}
+
+func (r *ragRepo) SearchSimilarProducts(ctx context.Context, queryEmbedding []float32, limit int, category string, priceRange *PriceRange) ([]*ScoredProduct, error) {
+	return r.products, nil
+}
+
+type constantEmbedder struct{}
+
+func (constantEmbedder) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	vectors := make([][]float32, len(texts))
+	for i := range texts {
+		vectors[i] = []float32{1, 0}
+	}
+	return vectors, nil
+}
+
+func (constantEmbedder) Model() string { return "constant" }
+
+// hallucinatingChat answers like FakeChatClient and then cites a product
+// that was not in the context, streaming in chunks of chunkSize bytes.
+type hallucinatingChat struct {
+	FakeChatClient
+	chunkSize int
+}
+
+func (c *hallucinatingChat) Complete(ctx context.Context, messages []ChatMessage) (string, error) {
+	answer, err := c.FakeChatClient.Complete(ctx, messages)
+	if err != nil {
+		return "", err
+	}
+	return answer + "\nAlso try [PID: GHOST0000] or [NOTAPID!].", nil
+}
+
+func (c *hallucinatingChat) Stream(ctx context.Context, messages []ChatMessage, onDelta func(delta string) error) error {
+	answer, err := c.Complete(ctx, messages)
+	if err != nil {
+		return err
+	}
+	for len(answer) > 0 {
+		n := min(c.chunkSize, len(answer))
+		if err := onDelta(answer[:n]); err != nil {
+			return err
+		}
+		answer = answer[n:]
+	}
+	return nil
+}
+
+func newRAGUsecase(t *testing.T, chat ChatClient, products ...*Product) *ProductUsecase {
+	t.Helper()
+	repo := &ragRepo{}
+	for _, p := range products {
+		repo.products = append(repo.products, &ScoredProduct{Product: p, Score: 1})
+	}
+	uc, cleanup := NewProductUsecase(repo, chat, constantEmbedder{}, nil, nil, &conf.Embeddings{}, &conf.Search{}, log.DefaultLogger)
+	t.Cleanup(cleanup)
+	return uc
+}
+
+var ragProducts = []*Product{
+	{ID: 1, PID: "SHIRT0001", Title: "Linen shirt"},
+	{ID: 2, PID: "JEANS0002", Title: "Slim jeans"},
+}
+
+func TestRAGSearchCitesRetrievedProducts(t *testing.T) {
+	uc := newRAGUsecase(t, NewFakeChatClient(), ragProducts...)
+
+	result, err := uc.RAGSearch(context.Background(), "summer outfit", 10, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"SHIRT0001", "JEANS0002"}; !reflect.DeepEqual(result.CitedPIDs, want) {
+		t.Errorf("CitedPIDs = %v, want %v", result.CitedPIDs, want)
+	}
+	if !strings.Contains(result.Answer, "Linen shirt [SHIRT0001]") {
+		t.Errorf("Answer = %q, want the shirt cited", result.Answer)
+	}
+	if len(result.Products) != 2 {
+		t.Errorf("got %d products, want 2", len(result.Products))
+	}
+}
+
+func TestRAGSearchDropsUnknownCitations(t *testing.T) {
+	uc := newRAGUsecase(t, &hallucinatingChat{chunkSize: 1}, ragProducts...)
+
+	result, err := uc.RAGSearch(context.Background(), "summer outfit", 10, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(result.Answer, "GHOST0000") {
+		t.Errorf("Answer = %q, cites a product that was not retrieved", result.Answer)
+	}
+	if !strings.Contains(result.Answer, "[NOTAPID!]") {
+		t.Errorf("Answer = %q, want brackets that are not citations kept", result.Answer)
+	}
+	if want := []string{"SHIRT0001", "JEANS0002"}; !reflect.DeepEqual(result.CitedPIDs, want) {
+		t.Errorf("CitedPIDs = %v, want %v", result.CitedPIDs, want)
+	}
+}
+
+func TestRAGSearchWithoutProducts(t *testing.T) {
+	uc := newRAGUsecase(t, NewFakeChatClient())
+
+	result, err := uc.RAGSearch(context.Background(), "summer outfit", 10, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Answer != "" || len(result.CitedPIDs) != 0 {
+		t.Errorf("got answer %q citing %v, want none without products", result.Answer, result.CitedPIDs)
+	}
+}
+
+func TestStreamRAGSearchFiltersDeltas(t *testing.T) {
+	// Every chunk size splits some citation across deltas
+	for _, chunkSize := range []int{1, 3, 7, 1000} {
+		uc := newRAGUsecase(t, &hallucinatingChat{chunkSize: chunkSize}, ragProducts...)
+
+		var kinds []RAGEventKind
+		var answer strings.Builder
+		var cited []string
+		err := uc.StreamRAGSearch(context.Background(), "summer outfit", 10, "", nil, func(ev *RAGEvent) error {
+			kinds = append(kinds, ev.Kind)
+			switch ev.Kind {
+			case RAGEventDelta:
+				if strings.Contains(ev.Delta, "GHOST") {
+					t.Errorf("chunk size %d: delta %q leaks an unknown citation", chunkSize, ev.Delta)
+				}
+				answer.WriteString(ev.Delta)
+			case RAGEventCitations:
+				cited = ev.CitedPIDs
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if kinds[0] != RAGEventProducts || kinds[len(kinds)-1] != RAGEventCitations {
+			t.Errorf("chunk size %d: events %v, want products first and citations last", chunkSize, kinds)
+		}
+		// The stream carries the answer RAGSearch returns
+		want, err := uc.RAGSearch(context.Background(), "summer outfit", 10, "", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := strings.TrimSpace(answer.String()); got != want.Answer {
+			t.Errorf("chunk size %d: streamed answer %q, want %q", chunkSize, got, want.Answer)
+		}
+		if !reflect.DeepEqual(cited, []string{"SHIRT0001", "JEANS0002"}) {
+			t.Errorf("chunk size %d: cited %v", chunkSize, cited)
+		}
+	}
+}
+
+func TestCitationFilter(t *testing.T) {
+	allowed := map[string]bool{"ABC123": true}
+	tests := []struct {
+		name   string
+		deltas []string
+		want   string
+	}{
+		{"known", []string{"see [ABC123]"}, "see [ABC123]"},
+		{"normalized", []string{"see [PID: ABC", "123]"}, "see [ABC123]"},
+		{"unknown", []string{"see [XY", "Z999] now"}, "see  now"},
+		{"not a citation", []string{"a [b c] d"}, "a [b c] d"},
+		{"unclosed", []string{"price [under"}, "price [under"},
+		{"nested", []string{"[[ABC123]"}, "[[ABC123]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newCitationFilter(allowed)
+			var got strings.Builder
+			for _, d := range tt.deltas {
+				got.WriteString(f.Write(d))
+			}
+			got.WriteString(f.Flush())
+			if got.String() != tt.want {
+				t.Errorf("got %q, want %q", got.String(), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,12 @@
+package biz
+
+import "context"
+
+// Embedder turns text into embedding vectors.
+type Embedder interface {
+	// Embed returns one vector per input text, in the same order.
+	Embed(ctx context.Context, texts []string) ([][]float32, error)
+	// Model names the embedding space. Vectors from different models must
+	// not be compared.
+	Model() string
+}
@@ -0,0 +1,377 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Export file formats.
+const (
+	ExportFormatJSONL = "jsonl"
+	ExportFormatCSV   = "csv"
+)
+
+const defaultExportBatchSize = 500
+
+// Export columns besides the product fields.
+const (
+	ExportFieldID                 = "id"
+	ExportFieldCrawledAt          = "crawled_at"
+	ExportFieldCreatedAt          = "created_at"
+	ExportFieldUpdatedAt          = "updated_at"
+	ExportFieldPriceNumeric       = "price_numeric"
+	ExportFieldActualPriceNumeric = "actual_price_numeric"
+	ExportFieldDiscountPercent    = "discount_percent"
+	ExportFieldRatingNumeric      = "rating_numeric"
+	ExportFieldViewCount          = "view_count"
+	ExportFieldClickCount         = "click_count"
+	ExportFieldEmbedding          = "embedding"
+	ExportFieldEmbeddingModel     = "embedding_model"
+)
+
+// ExportFields are the columns written by default, in order. The columns
+// named like import keys hold values the import command reads back.
+var ExportFields = []string{
+	ExportFieldID,
+	ProductFieldPID,
+	ProductFieldOriginalID,
+	ProductFieldTitle,
+	ProductFieldBrand,
+	ProductFieldDescription,
+	ProductFieldActualPrice,
+	ProductFieldSellingPrice,
+	ProductFieldDiscount,
+	ExportFieldPriceNumeric,
+	ExportFieldActualPriceNumeric,
+	ExportFieldDiscountPercent,
+	ProductFieldCategory,
+	ProductFieldSubCategory,
+	ProductFieldOutOfStock,
+	ProductFieldSeller,
+	ProductFieldAverageRating,
+	ExportFieldRatingNumeric,
+	ProductFieldImages,
+	ProductFieldProductDetails,
+	ProductFieldURL,
+	ProductFieldStyleCode,
+	ProductFieldFeatured,
+	ExportFieldViewCount,
+	ExportFieldClickCount,
+	ExportFieldCrawledAt,
+	ExportFieldCreatedAt,
+	ExportFieldUpdatedAt,
+}
+
+// embeddingExportFields are only written when embeddings are included.
+var embeddingExportFields = []string{ExportFieldEmbedding, ExportFieldEmbeddingModel}
+
+// ExportOptions control a catalog export. Fields picks and orders the
+// columns, ExportFields by default; naming an embedding column includes
+// embeddings. Limit caps the products written, 0 writes all of them.
+type ExportOptions struct {
+	Format            string
+	Fields            []string
+	IncludeEmbeddings bool
+	Limit             int
+	BatchSize         int
+}
+
+func (o *ExportOptions) validate() error {
+	if o.Format == "" {
+		o.Format = ExportFormatJSONL
+	}
+	if o.Format != ExportFormatJSONL && o.Format != ExportFormatCSV {
+		return invalidParameter("format", "must be jsonl or csv")
+	}
+	if o.BatchSize <= 0 {
+		o.BatchSize = defaultExportBatchSize
+	}
+
+	if len(o.Fields) == 0 {
+		o.Fields = slices.Clone(ExportFields)
+		if o.IncludeEmbeddings {
+			o.Fields = append(o.Fields, embeddingExportFields...)
+		}
+		return nil
+	}
+	fields := make([]string, 0, len(o.Fields))
+	for _, f := range o.Fields {
+		f = strings.ToLower(strings.TrimSpace(f))
+		if slices.Contains(embeddingExportFields, f) {
+			o.IncludeEmbeddings = true
+		} else if !slices.Contains(ExportFields, f) {
+			return invalidParameter("fields", "has unknown field "+f)
+		}
+		if !slices.Contains(fields, f) {
+			fields = append(fields, f)
+		}
+	}
+	o.Fields = fields
+	return nil
+}
+
+// ExportRepo is the part of the product repository an export reads through.
+type ExportRepo interface {
+	// ListProductsAfter returns up to limit products matching the filters
+	// of params with an ID above afterID, in ID order. Embeddings are only
+	// loaded when withEmbeddings is set.
+	ListProductsAfter(ctx context.Context, params *ListProductsParams, afterID int64, limit int, withEmbeddings bool) ([]*Product, error)
+}
+
+// CatalogExporter writes catalog snapshots. It walks the matching products
+// in ID order a batch at a time, so memory stays flat however large the
+// catalog, and products written meanwhile are neither skipped nor repeated.
+type CatalogExporter struct {
+	repo ExportRepo
+	log  *log.Helper
+}
+
+// NewCatalogExporter creates a new catalog exporter.
+func NewCatalogExporter(repo ExportRepo, logger log.Logger) *CatalogExporter {
+	return &CatalogExporter{
+		repo: repo,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// Export writes the products matching the filters of params to w and
+// returns how many it wrote. Paging and sorting of params are ignored.
+func (ex *CatalogExporter) Export(ctx context.Context, params *ListProductsParams, opts ExportOptions, w io.Writer) (int, error) {
+	if params == nil {
+		params = &ListProductsParams{}
+	}
+	if err := params.Validate(); err != nil {
+		return 0, err
+	}
+	if err := opts.validate(); err != nil {
+		return 0, err
+	}
+
+	var enc exportEncoder
+	switch opts.Format {
+	case ExportFormatCSV:
+		enc = &csvExportEncoder{w: csv.NewWriter(w), fields: opts.Fields}
+	default:
+		enc = &jsonlExportEncoder{w: w, fields: opts.Fields}
+	}
+	if err := enc.begin(); err != nil {
+		return 0, err
+	}
+
+	n := 0
+	var afterID int64
+	for opts.Limit <= 0 || n < opts.Limit {
+		limit := opts.BatchSize
+		if opts.Limit > 0 {
+			limit = min(limit, opts.Limit-n)
+		}
+		products, err := ex.repo.ListProductsAfter(ctx, params, afterID, limit, opts.IncludeEmbeddings)
+		if err != nil {
+			return n, err
+		}
+		for _, p := range products {
+			if err := enc.write(p); err != nil {
+				return n, err
+			}
+			n++
+		}
+		if err := enc.flush(); err != nil {
+			return n, err
+		}
+		if len(products) < limit {
+			break
+		}
+		afterID = products[len(products)-1].ID
+	}
+
+	ex.log.Infof("Export finished: format=%s, products=%d, embeddings=%t", opts.Format, n, opts.IncludeEmbeddings)
+	return n, nil
+}
+
+// exportEncoder writes products in an export format.
+type exportEncoder interface {
+	begin() error
+	write(*Product) error
+	flush() error
+}
+
+// jsonlExportEncoder writes a JSON object per line, its keys in field
+// order, keeping the values typed.
+type jsonlExportEncoder struct {
+	w      io.Writer
+	fields []string
+	buf    bytes.Buffer
+}
+
+func (e *jsonlExportEncoder) begin() error { return nil }
+
+func (e *jsonlExportEncoder) write(p *Product) error {
+	e.buf.Reset()
+	e.buf.WriteByte('{')
+	for i, f := range e.fields {
+		if i > 0 {
+			e.buf.WriteByte(',')
+		}
+		key, _ := json.Marshal(f)
+		value, err := json.Marshal(exportValue(p, f))
+		if err != nil {
+			return fmt.Errorf("product %d %s: %w", p.ID, f, err)
+		}
+		e.buf.Write(key)
+		e.buf.WriteByte(':')
+		e.buf.Write(value)
+	}
+	e.buf.WriteString("}\n")
+	_, err := e.w.Write(e.buf.Bytes())
+	return err
+}
+
+func (e *jsonlExportEncoder) flush() error { return nil }
+
+// csvExportEncoder writes a header row and a row per product. Images are
+// separated by |, product details and embeddings are JSON, as the import
+// command reads them.
+type csvExportEncoder struct {
+	w      *csv.Writer
+	fields []string
+	row    []string
+}
+
+func (e *csvExportEncoder) begin() error {
+	e.row = make([]string, len(e.fields))
+	return e.w.Write(e.fields)
+}
+
+func (e *csvExportEncoder) write(p *Product) error {
+	for i, f := range e.fields {
+		cell, err := csvExportCell(exportValue(p, f))
+		if err != nil {
+			return fmt.Errorf("product %d %s: %w", p.ID, f, err)
+		}
+		e.row[i] = cell
+	}
+	return e.w.Write(e.row)
+}
+
+func (e *csvExportEncoder) flush() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+func csvExportCell(v any) (string, error) {
+	switch v := v.(type) {
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case int:
+		return strconv.Itoa(v), nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case []string:
+		return strings.Join(v, "|"), nil
+	case nil:
+		return "", nil
+	default:
+		b, err := json.Marshal(v)
+		return string(b), err
+	}
+}
+
+// exportValue returns the value of an export column. Unset times are
+// empty, not the zero time.
+func exportValue(p *Product, field string) any {
+	switch field {
+	case ExportFieldID:
+		return p.ID
+	case ProductFieldPID:
+		return p.PID
+	case ProductFieldOriginalID:
+		return p.OriginalID
+	case ProductFieldTitle:
+		return p.Title
+	case ProductFieldBrand:
+		return p.Brand
+	case ProductFieldDescription:
+		return p.Description
+	case ProductFieldActualPrice:
+		return p.ActualPrice
+	case ProductFieldSellingPrice:
+		return p.SellingPrice
+	case ProductFieldDiscount:
+		return p.Discount
+	case ExportFieldPriceNumeric:
+		return p.PriceNumeric
+	case ExportFieldActualPriceNumeric:
+		return p.ActualPriceNumeric
+	case ExportFieldDiscountPercent:
+		return p.DiscountPercent
+	case ProductFieldCategory:
+		return p.Category
+	case ProductFieldSubCategory:
+		return p.SubCategory
+	case ProductFieldOutOfStock:
+		return p.OutOfStock
+	case ProductFieldSeller:
+		return p.Seller
+	case ProductFieldAverageRating:
+		return p.AverageRating
+	case ExportFieldRatingNumeric:
+		return p.RatingNumeric
+	case ProductFieldImages:
+		if p.Images == nil {
+			return []string{}
+		}
+		return p.Images
+	case ProductFieldProductDetails:
+		if p.ProductDetails == nil {
+			return []map[string]string{}
+		}
+		return p.ProductDetails
+	case ProductFieldURL:
+		return p.URL
+	case ProductFieldStyleCode:
+		return p.StyleCode
+	case ProductFieldFeatured:
+		return p.Featured
+	case ExportFieldViewCount:
+		return p.ViewCount
+	case ExportFieldClickCount:
+		return p.ClickCount
+	case ExportFieldCrawledAt:
+		return exportTime(p.CrawledAt)
+	case ExportFieldCreatedAt:
+		return exportTime(p.CreatedAt)
+	case ExportFieldUpdatedAt:
+		return exportTime(p.UpdatedAt)
+	case ExportFieldEmbedding:
+		if p.Embedding == nil {
+			return nil
+		}
+		return p.Embedding
+	case ExportFieldEmbeddingModel:
+		return p.EmbeddingModel
+	}
+	return nil
+}
+
+func exportTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
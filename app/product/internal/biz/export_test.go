@@ -0,0 +1,160 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// exportRepo serves products in ID order and records the pages asked for.
+type exportRepo struct {
+	products       []*Product
+	afterIDs       []int64
+	withEmbeddings bool
+}
+
+func (r *exportRepo) ListProductsAfter(ctx context.Context, params *ListProductsParams, afterID int64, limit int, withEmbeddings bool) ([]*Product, error) {
+	r.afterIDs = append(r.afterIDs, afterID)
+	r.withEmbeddings = withEmbeddings
+	var out []*Product
+	for _, p := range r.products {
+		if p.ID > afterID && len(out) < limit {
+			out = append(out, p)
+		}
+	}
+	return out, nil
+}
+
+func newExportRepo(n int) *exportRepo {
+	r := &exportRepo{}
+	for i := 1; i <= n; i++ {
+		r.products = append(r.products, &Product{ID: int64(i)})
+	}
+	return r
+}
+
+var exportProduct = &Product{
+	ID:             7,
+	PID:            "P7",
+	Title:          `Shirt, "slim"`,
+	PriceNumeric:   499,
+	Images:         []string{"a.jpg", "b.jpg"},
+	ProductDetails: []map[string]string{{"Color": "Blue"}},
+	Featured:       true,
+	CrawledAt:      time.Date(2021, 2, 10, 20, 11, 51, 0, time.UTC),
+	Embedding:      []float32{0.5, -1},
+	EmbeddingModel: "m1",
+}
+
+func TestExportJSONL(t *testing.T) {
+	repo := &exportRepo{products: []*Product{exportProduct, {ID: 8, PID: "P8"}}}
+	ex := NewCatalogExporter(repo, log.DefaultLogger)
+	var buf bytes.Buffer
+
+	opts := ExportOptions{Fields: []string{"pid", "Title", "images", "product_details", "price_numeric", "featured", "crawled_at", "pid"}}
+	n, err := ex.Export(context.Background(), nil, opts, &buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 2 {
+		t.Errorf("exported %d products, want 2", n)
+	}
+	// Keys follow the field order, once each; unset lists and times are
+	// empty, not null
+	want := `{"pid":"P7","title":"Shirt, \"slim\"","images":["a.jpg","b.jpg"],"product_details":[{"Color":"Blue"}],"price_numeric":499,"featured":true,"crawled_at":"2021-02-10T20:11:51Z"}
+{"pid":"P8","title":"","images":[],"product_details":[],"price_numeric":0,"featured":false,"crawled_at":""}
+`
+	if buf.String() != want {
+		t.Errorf("exported\n%s\nwant\n%s", buf.String(), want)
+	}
+	if repo.withEmbeddings {
+		t.Error("embeddings loaded without an embedding field")
+	}
+}
+
+func TestExportCSV(t *testing.T) {
+	repo := &exportRepo{products: []*Product{exportProduct}}
+	ex := NewCatalogExporter(repo, log.DefaultLogger)
+	var buf bytes.Buffer
+
+	opts := ExportOptions{Format: ExportFormatCSV, Fields: []string{"id", "title", "images", "product_details", "featured", "embedding", "embedding_model"}}
+	if _, err := ex.Export(context.Background(), nil, opts, &buf); err != nil {
+		t.Fatal(err)
+	}
+	if !repo.withEmbeddings {
+		t.Error("embeddings not loaded for the embedding field")
+	}
+
+	rows, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := [][]string{
+		{"id", "title", "images", "product_details", "featured", "embedding", "embedding_model"},
+		{"7", `Shirt, "slim"`, "a.jpg|b.jpg", `[{"Color":"Blue"}]`, "true", "[0.5,-1]", "m1"},
+	}
+	if len(rows) != len(want) {
+		t.Fatalf("%d rows, want %d", len(rows), len(want))
+	}
+	for i := range want {
+		if !slices.Equal(rows[i], want[i]) {
+			t.Errorf("row %d = %q, want %q", i, rows[i], want[i])
+		}
+	}
+}
+
+func TestExportBatches(t *testing.T) {
+	repo := newExportRepo(5)
+	ex := NewCatalogExporter(repo, log.DefaultLogger)
+	var buf bytes.Buffer
+
+	n, err := ex.Export(context.Background(), nil, ExportOptions{Fields: []string{"id"}, BatchSize: 2}, &buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 5 || strings.Count(buf.String(), "\n") != 5 {
+		t.Errorf("exported %d products in %d lines, want 5", n, strings.Count(buf.String(), "\n"))
+	}
+	// Each batch continues after the last ID of the one before
+	if !slices.Equal(repo.afterIDs, []int64{0, 2, 4}) {
+		t.Errorf("pages after %v, want 0, 2 and 4", repo.afterIDs)
+	}
+
+	repo = newExportRepo(5)
+	ex = NewCatalogExporter(repo, log.DefaultLogger)
+	buf.Reset()
+	n, err = ex.Export(context.Background(), nil, ExportOptions{Fields: []string{"id"}, BatchSize: 2, Limit: 3}, &buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 3 || buf.String() != "{\"id\":1}\n{\"id\":2}\n{\"id\":3}\n" {
+		t.Errorf("limited export wrote %d products:\n%s", n, buf.String())
+	}
+}
+
+func TestExportOptionsValidate(t *testing.T) {
+	opts := ExportOptions{IncludeEmbeddings: true}
+	if err := opts.validate(); err != nil {
+		t.Fatal(err)
+	}
+	if opts.Format != ExportFormatJSONL || !slices.Equal(opts.Fields, append(slices.Clone(ExportFields), embeddingExportFields...)) {
+		t.Errorf("default options: format %q, fields %v", opts.Format, opts.Fields)
+	}
+
+	invalid := map[string]ExportOptions{
+		"unknown format": {Format: "parquet"},
+		"unknown field":  {Fields: []string{"id", "secret"}},
+	}
+	for name, opts := range invalid {
+		if err := opts.validate(); !kerrors.IsBadRequest(err) {
+			t.Errorf("%s: err = %v, want bad request", name, err)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package biz
+
+import "context"
+
+// PriceBucketBounds are the upper bounds of the price histogram buckets. The
+// last bucket is open-ended.
+var PriceBucketBounds = []int{500, 1000, 2000, 5000}
+
+// RatingBandFloors are the minimum ratings of the rating bands.
+var RatingBandFloors = []float64{4, 3, 2, 1}
+
+// FacetCount is the number of products with a facet value.
+type FacetCount struct {
+	Value string
+	Count int64
+}
+
+// RangeCount is the number of products in [Min, Max). A zero Max is
+// unbounded.
+type RangeCount struct {
+	Min   float64
+	Max   float64
+	Count int64
+}
+
+// AttributeFacet counts the products under each value of an attribute.
+type AttributeFacet struct {
+	Key    string
+	Values []*FacetCount
+}
+
+// Facets counts the products matching a listing under each filter value.
+// Each facet is computed without its own filter so multi-select works.
+type Facets struct {
+	Brands        []*FacetCount
+	Categories    []*FacetCount
+	SubCategories []*FacetCount
+	Sellers       []*FacetCount
+	PriceBuckets  []*RangeCount
+	RatingBands   []*RangeCount
+	InStock       int64
+	OutOfStock    int64
+	// Attributes follow ProductAttributeKeys; keys without values are left
+	// out.
+	Attributes []*AttributeFacet
+}
+
+// GetFacets returns the facet counts for a listing. A non-empty query counts
+// the products the keyword retriever of HybridSearch matches, after the
+// search rules rewrote it, instead of params.SearchQuery.
+func (uc *ProductUsecase) GetFacets(ctx context.Context, query string, params *ListProductsParams) (*Facets, error) {
+	if params == nil {
+		params = &ListProductsParams{}
+	}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if query != "" {
+		query = uc.rules.Rewrite(query).Query
+	}
+	return uc.repo.GetFacets(ctx, query, params)
+}
@@ -0,0 +1,226 @@
+package biz
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+
+	"yinni_backend/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// Hybrid search modes.
+const (
+	SearchModeHybrid  = "hybrid"
+	SearchModeLexical = "lexical"
+)
+
+const (
+	defaultRRFK             = 60
+	defaultHybridCandidates = 50
+)
+
+// HybridConfig tunes reciprocal rank fusion. Each retriever adds
+// weight / (RRFK + rank) to the score of every product it returns.
+type HybridConfig struct {
+	LexicalWeight float64
+	VectorWeight  float64
+	RRFK          int
+	Candidates    int
+}
+
+func newHybridConfig(c *conf.Search) *HybridConfig {
+	cfg := &HybridConfig{
+		LexicalWeight: float64(c.GetLexicalWeight()),
+		VectorWeight:  float64(c.GetVectorWeight()),
+		RRFK:          int(c.GetRrfK()),
+		Candidates:    int(c.GetCandidates()),
+	}
+	if cfg.LexicalWeight <= 0 {
+		cfg.LexicalWeight = 1
+	}
+	if cfg.VectorWeight <= 0 {
+		cfg.VectorWeight = 1
+	}
+	if cfg.RRFK <= 0 {
+		cfg.RRFK = defaultRRFK
+	}
+	if cfg.Candidates <= 0 {
+		cfg.Candidates = defaultHybridCandidates
+	}
+	return cfg
+}
+
+// HybridSearchParams narrows a hybrid search. Zero weights use the
+// configured ones.
+type HybridSearchParams struct {
+	Category      string
+	PriceRange    *PriceRange
+	LexicalWeight float64
+	VectorWeight  float64
+}
+
+// HybridHit is a fused search result. Ranks are 1-based positions in each
+// retriever's results and 0 when the retriever did not return the product.
+type HybridHit struct {
+	Product     *Product
+	Score       float64
+	LexicalRank int
+	VectorRank  int
+	VectorScore float32
+}
+
+// HybridSearchResult holds the fused hits and the mode that produced them.
+// CorrectedQuery is set when the query was spell-corrected and the hits are
+// for the corrected query.
+type HybridSearchResult struct {
+	Hits           []*HybridHit
+	Mode           string
+	CorrectedQuery string
+
+	// lexicalHits counts the products keyword retrieval returned.
+	lexicalHits int
+}
+
+// HybridSearch runs keyword and vector retrieval in parallel and fuses them
+// with weighted reciprocal rank fusion. It falls back to keyword results
+// alone when embeddings are disabled or vector search fails. A query no
+// product matches by keyword is spell-corrected and searched again.
+func (uc *ProductUsecase) HybridSearch(ctx context.Context, query string, limit int, params *HybridSearchParams) (*HybridSearchResult, error) {
+	uc.log.Infof("HybridSearch: %v", query)
+
+	result, err := uc.hybridSearch(ctx, query, limit, params)
+	if err != nil || result.lexicalHits > 0 || uc.spell == nil {
+		return result, err
+	}
+
+	corrected, ok := uc.spell.Correct(query)
+	if !ok {
+		return result, nil
+	}
+	rewritten, err := uc.hybridSearch(ctx, corrected, limit, params)
+	if err != nil {
+		return nil, err
+	}
+	if rewritten.lexicalHits == 0 {
+		return result, nil
+	}
+	uc.log.Infof("HybridSearch: rewrote %q to %q", query, corrected)
+	rewritten.CorrectedQuery = corrected
+	return rewritten, nil
+}
+
+func (uc *ProductUsecase) hybridSearch(ctx context.Context, query string, limit int, params *HybridSearchParams) (*HybridSearchResult, error) {
+	if strings.TrimSpace(query) == "" {
+		return nil, invalidParameter("query", "is required")
+	}
+	if params == nil {
+		params = &HybridSearchParams{}
+	}
+	if params.PriceRange != nil && params.PriceRange.Max > 0 && params.PriceRange.Min > params.PriceRange.Max {
+		return nil, ErrInvalidPriceRange
+	}
+
+	rewrite := uc.rules.Rewrite(query)
+	candidates := max(uc.hybrid.Candidates, limit)
+
+	var (
+		wg         sync.WaitGroup
+		vectorHits []*ScoredProduct
+		vectorErr  error = ErrEmbeddingsNotEnabled
+	)
+	if uc.embeddingsEnabled() {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			vectorHits, vectorErr = uc.SearchWithEmbeddings(ctx, rewrite.Query, candidates, params.Category, params.PriceRange)
+		}()
+	}
+
+	lexicalParams := &ListProductsParams{
+		Page:     1,
+		PageSize: int32(candidates),
+		Category: params.Category,
+	}
+	if params.PriceRange != nil {
+		lexicalParams.MinPrice = params.PriceRange.Min
+		lexicalParams.MaxPrice = params.PriceRange.Max
+	}
+	lexicalHits, _, lexicalErr := uc.repo.SearchProducts(ctx, rewrite.Query, lexicalParams)
+	wg.Wait()
+
+	if lexicalErr != nil {
+		return nil, lexicalErr
+	}
+
+	mode := SearchModeHybrid
+	if vectorErr != nil {
+		if !errors.Is(vectorErr, ErrEmbeddingsNotEnabled) {
+			uc.log.Warnf("Vector retrieval failed, using keyword results only: %v", vectorErr)
+		}
+		vectorHits = nil
+		mode = SearchModeLexical
+	}
+
+	lexicalWeight, vectorWeight := uc.hybrid.LexicalWeight, uc.hybrid.VectorWeight
+	if params.LexicalWeight > 0 {
+		lexicalWeight = params.LexicalWeight
+	}
+	if params.VectorWeight > 0 {
+		vectorWeight = params.VectorWeight
+	}
+
+	hits := fuseRanks(lexicalHits, vectorHits, lexicalWeight, vectorWeight, uc.hybrid.RRFK, rewrite)
+	if len(hits) > limit {
+		hits = hits[:limit]
+	}
+	return &HybridSearchResult{Hits: hits, Mode: mode, lexicalHits: len(lexicalHits)}, nil
+}
+
+// fuseRanks merges the two ranked lists by weighted reciprocal rank, scaled
+// by the rewrite's category boosts. Ties keep products found by both
+// retrievers first, then lower product IDs.
+func fuseRanks(lexical []*Product, vector []*ScoredProduct, lexicalWeight, vectorWeight float64, k int, rewrite *QueryRewrite) []*HybridHit {
+	byID := make(map[int64]*HybridHit, len(lexical)+len(vector))
+	hit := func(p *Product) *HybridHit {
+		h, ok := byID[p.ID]
+		if !ok {
+			h = &HybridHit{Product: p}
+			byID[p.ID] = h
+		}
+		return h
+	}
+
+	for i, p := range lexical {
+		h := hit(p)
+		h.LexicalRank = i + 1
+		h.Score += lexicalWeight / float64(k+i+1)
+	}
+	for i, sp := range vector {
+		h := hit(sp.Product)
+		h.VectorRank = i + 1
+		h.VectorScore = sp.Score
+		h.Score += vectorWeight / float64(k+i+1)
+	}
+
+	hits := make([]*HybridHit, 0, len(byID))
+	for _, h := range byID {
+		h.Score *= rewrite.Boost(h.Product)
+		hits = append(hits, h)
+	}
+	sort.Slice(hits, func(i, j int) bool {
+		a, b := hits[i], hits[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		aBoth := a.LexicalRank > 0 && a.VectorRank > 0
+		bBoth := b.LexicalRank > 0 && b.VectorRank > 0
+		if aBoth != bBoth {
+			return aBoth
+		}
+		return a.Product.ID < b.Product.ID
+	})
+	return hits
+}
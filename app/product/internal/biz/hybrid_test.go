@@ -0,0 +1,177 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"math"
+	"slices"
+	"testing"
+
+	"yinni_backend/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// hybridRepo serves fixed keyword and vector results.
+type hybridRepo struct {
+	ProductRepo
+	lexical   []*Product
+	vector    []*ScoredProduct
+	vectorErr error
+}
+
+func (r *hybridRepo) SearchProducts(ctx context.Context, query string, params *ListProductsParams) ([]*Product, int64, error) {
+	return r.lexical, int64(len(r.lexical)), nil
+}
+
+func (r *hybridRepo) SearchSimilarProducts(ctx context.Context, queryEmbedding []float32, limit int, category string, priceRange *PriceRange) ([]*ScoredProduct, error) {
+	return r.vector, r.vectorErr
+}
+
+func products(ids ...int64) []*Product {
+	out := make([]*Product, len(ids))
+	for i, id := range ids {
+		out[i] = &Product{ID: id, Category: "shirts"}
+	}
+	return out
+}
+
+func scored(ps []*Product) []*ScoredProduct {
+	out := make([]*ScoredProduct, len(ps))
+	for i, p := range ps {
+		out[i] = &ScoredProduct{Product: p, Score: 1 - float32(i)/10}
+	}
+	return out
+}
+
+func hitIDs(hits []*HybridHit) []int64 {
+	ids := make([]int64, len(hits))
+	for i, h := range hits {
+		ids[i] = h.Product.ID
+	}
+	return ids
+}
+
+func TestFuseRanksScores(t *testing.T) {
+	hits := fuseRanks(products(1, 2), scored(products(2, 3)), 1, 2, 60, &QueryRewrite{})
+
+	want := map[int64]struct {
+		score         float64
+		lexical, vect int
+	}{
+		1: {1.0 / 61, 1, 0},
+		2: {1.0/62 + 2.0/61, 2, 1},
+		3: {2.0 / 62, 0, 2},
+	}
+	if got := hitIDs(hits); !slices.Equal(got, []int64{2, 3, 1}) {
+		t.Fatalf("order = %v, want [2 3 1]", got)
+	}
+	for _, h := range hits {
+		w := want[h.Product.ID]
+		if math.Abs(h.Score-w.score) > 1e-12 {
+			t.Errorf("product %d scores %v, want %v", h.Product.ID, h.Score, w.score)
+		}
+		if h.LexicalRank != w.lexical || h.VectorRank != w.vect {
+			t.Errorf("product %d ranks (%d, %d), want (%d, %d)", h.Product.ID, h.LexicalRank, h.VectorRank, w.lexical, w.vect)
+		}
+	}
+}
+
+func TestFuseRanksTies(t *testing.T) {
+	// With k = 0 second place in both lists scores as much as first place
+	// in one: all three products tie on 1.
+	a, b, x := &Product{ID: 7}, &Product{ID: 5}, &Product{ID: 9}
+	hits := fuseRanks([]*Product{x, a}, []*ScoredProduct{{Product: b}, {Product: a}}, 1, 1, 0, &QueryRewrite{})
+	for _, h := range hits {
+		if h.Score != 1 {
+			t.Fatalf("product %d scores %v, want 1", h.Product.ID, h.Score)
+		}
+	}
+	// Products both retrievers found come first, then lower IDs
+	if got := hitIDs(hits); !slices.Equal(got, []int64{7, 5, 9}) {
+		t.Errorf("order = %v, want [7 5 9]", got)
+	}
+}
+
+func TestFuseRanksBoost(t *testing.T) {
+	lexical := []*Product{{ID: 1, Category: "shirts"}, {ID: 2, Category: "jeans"}}
+	rewrite := &QueryRewrite{Boosts: map[string]float64{"jeans": 2}}
+
+	hits := fuseRanks(lexical, nil, 1, 1, 60, rewrite)
+	if got := hitIDs(hits); !slices.Equal(got, []int64{2, 1}) {
+		t.Errorf("order = %v, want the boosted category first", got)
+	}
+	if want := 2.0 / 62; math.Abs(hits[0].Score-want) > 1e-12 {
+		t.Errorf("boosted score %v, want %v", hits[0].Score, want)
+	}
+}
+
+func newHybridUsecase(t *testing.T, repo ProductRepo, embedder Embedder, search *conf.Search) *ProductUsecase {
+	t.Helper()
+	uc, cleanup := NewProductUsecase(repo, nil, embedder, nil, nil, &conf.Embeddings{}, search, log.DefaultLogger)
+	t.Cleanup(cleanup)
+	return uc
+}
+
+func TestHybridSearch(t *testing.T) {
+	repo := &hybridRepo{lexical: products(1, 2, 3), vector: scored(products(3, 4))}
+	uc := newHybridUsecase(t, repo, constantEmbedder{}, &conf.Search{})
+
+	result, err := uc.HybridSearch(context.Background(), "shirt", 3, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Mode != SearchModeHybrid {
+		t.Errorf("Mode = %q, want %q", result.Mode, SearchModeHybrid)
+	}
+	// Product 3 ranks third and first; 2 and 4 tie on a second place
+	if got := hitIDs(result.Hits); !slices.Equal(got, []int64{3, 1, 2}) {
+		t.Errorf("hits = %v, want [3 1 2]", got)
+	}
+
+	// Heavier vector weight lifts products only vector search found
+	result, err = uc.HybridSearch(context.Background(), "shirt", 2, &HybridSearchParams{VectorWeight: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := hitIDs(result.Hits); !slices.Equal(got, []int64{3, 4}) {
+		t.Errorf("vector-weighted hits = %v, want [3 4]", got)
+	}
+}
+
+func TestHybridSearchFallsBackToLexical(t *testing.T) {
+	tests := []struct {
+		name     string
+		embedder Embedder
+		repo     *hybridRepo
+	}{
+		{"embeddings disabled", nil, &hybridRepo{lexical: products(1, 2)}},
+		{"vector search fails", constantEmbedder{}, &hybridRepo{lexical: products(1, 2), vectorErr: errors.New("index down")}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := newHybridUsecase(t, tt.repo, tt.embedder, &conf.Search{})
+			result, err := uc.HybridSearch(context.Background(), "shirt", 10, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result.Mode != SearchModeLexical {
+				t.Errorf("Mode = %q, want %q", result.Mode, SearchModeLexical)
+			}
+			if got := hitIDs(result.Hits); !slices.Equal(got, []int64{1, 2}) {
+				t.Errorf("hits = %v, want the keyword order", got)
+			}
+		})
+	}
+}
+
+func TestHybridSearchValidates(t *testing.T) {
+	uc := newHybridUsecase(t, &hybridRepo{}, nil, &conf.Search{})
+	if _, err := uc.HybridSearch(context.Background(), "  ", 10, nil); err == nil {
+		t.Error("empty query accepted")
+	}
+	params := &HybridSearchParams{PriceRange: &PriceRange{Min: 50, Max: 10}}
+	if _, err := uc.HybridSearch(context.Background(), "shirt", 10, params); !errors.Is(err, ErrInvalidPriceRange) {
+		t.Errorf("inverted price range: err = %v, want ErrInvalidPriceRange", err)
+	}
+}
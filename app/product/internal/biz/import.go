@@ -0,0 +1,229 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	defaultImportBatchSize = 200
+	maxImportErrorsKept    = 1000
+)
+
+// ImportRecord is one product read from an import file. Fields lists the
+// product fields the record carries a value for; updates write only those,
+// so an import never clears a field.
+type ImportRecord struct {
+	// Line is the line of a JSONL or CSV file, or the index of a JSON array
+	// element, counting from 1.
+	Line    int
+	Product *Product
+	Fields  []string
+	// Err is set when the record could not be decoded.
+	Err error
+}
+
+// ProductReader reads import records one at a time. Next returns io.EOF
+// after the last record; any other error aborts the import.
+type ProductReader interface {
+	Next() (*ImportRecord, error)
+}
+
+// ImportOptions control a catalog import. Limit caps the records read, 0
+// reads all of them. A dry run validates and matches records without
+// writing.
+type ImportOptions struct {
+	DryRun    bool
+	Limit     int
+	BatchSize int
+}
+
+// ImportRowError is a record that was not imported. Key is its pid, or its
+// original_id when it has no pid.
+type ImportRowError struct {
+	Line int
+	Key  string
+	Err  error
+}
+
+// ImportReport sums up a catalog import. Errors keeps the first
+// maxImportErrorsKept failures; Failed counts all of them.
+type ImportReport struct {
+	Read    int
+	Created int
+	Updated int
+	Failed  int
+	Errors  []*ImportRowError
+}
+
+func (r *ImportReport) fail(rec *ImportRecord, err error) {
+	r.Failed++
+	if len(r.Errors) >= maxImportErrorsKept {
+		return
+	}
+	e := &ImportRowError{Line: rec.Line, Err: err}
+	if p := rec.Product; p != nil {
+		e.Key = p.PID
+		if e.Key == "" {
+			e.Key = p.OriginalID
+		}
+	}
+	r.Errors = append(r.Errors, e)
+}
+
+// ImportRepo is the part of the product repository an import writes through.
+type ImportRepo interface {
+	// FindProductIDs returns, for each product, the ID of the stored product
+	// with its pid, or failing that its original_id; 0 when there is none.
+	FindProductIDs(ctx context.Context, products []*Product) ([]int64, error)
+	Create(context.Context, *Product) (*Product, error)
+	Update(context.Context, *Product, []string) (*Product, error)
+}
+
+// CatalogImporter upserts products from import files, matching them to
+// stored products by pid, then by original_id. It writes through the
+// repository without re-embedding; the embedding backfill picks up products
+// whose content changed.
+type CatalogImporter struct {
+	repo ImportRepo
+	log  *log.Helper
+}
+
+// NewCatalogImporter creates a new catalog importer.
+func NewCatalogImporter(repo ImportRepo, logger log.Logger) *CatalogImporter {
+	return &CatalogImporter{
+		repo: repo,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// Import reads every record of r and creates or updates its product. Bad
+// records are reported and skipped; only read and database lookup errors
+// abort the import, returning the report so far.
+func (im *CatalogImporter) Import(ctx context.Context, r ProductReader, opts ImportOptions) (*ImportReport, error) {
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = defaultImportBatchSize
+	}
+
+	report := &ImportReport{}
+	batch := make([]*ImportRecord, 0, opts.BatchSize)
+	for opts.Limit <= 0 || report.Read < opts.Limit {
+		rec, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return report, err
+		}
+		report.Read++
+
+		if rec.Err != nil {
+			report.fail(rec, rec.Err)
+			continue
+		}
+		batch = append(batch, rec)
+		if len(batch) == opts.BatchSize {
+			if err := im.importBatch(ctx, batch, opts.DryRun, report); err != nil {
+				return report, err
+			}
+			batch = batch[:0]
+		}
+	}
+	if len(batch) > 0 {
+		if err := im.importBatch(ctx, batch, opts.DryRun, report); err != nil {
+			return report, err
+		}
+	}
+
+	im.log.Infof("Import finished: read=%d, created=%d, updated=%d, failed=%d, dry_run=%t",
+		report.Read, report.Created, report.Updated, report.Failed, opts.DryRun)
+	return report, nil
+}
+
+// importBatch upserts a batch of decoded records in file order, so a later
+// record for the same product updates what an earlier one created.
+func (im *CatalogImporter) importBatch(ctx context.Context, batch []*ImportRecord, dryRun bool, report *ImportReport) error {
+	products := make([]*Product, len(batch))
+	for i, rec := range batch {
+		products[i] = rec.Product
+	}
+	ids, err := im.repo.FindProductIDs(ctx, products)
+	if err != nil {
+		return err
+	}
+
+	// Products created earlier in the batch, by pid and by original_id.
+	// A dry run creates nothing, so they get placeholder IDs.
+	created := make(map[string]int64)
+	lookup := func(p *Product) int64 {
+		if id, ok := created["pid:"+p.PID]; ok && p.PID != "" {
+			return id
+		}
+		if id, ok := created["oid:"+p.OriginalID]; ok && p.OriginalID != "" {
+			return id
+		}
+		return 0
+	}
+
+	for i, rec := range batch {
+		p := rec.Product
+		if p.PID == "" && p.OriginalID == "" {
+			report.fail(rec, invalidParameter(ProductFieldPID, "or original_id is required"))
+			continue
+		}
+
+		id := ids[i]
+		if id == 0 {
+			id = lookup(p)
+		}
+		if id == 0 {
+			if err := p.Validate(); err != nil {
+				report.fail(rec, err)
+				continue
+			}
+			id = -int64(i + 1)
+			if !dryRun {
+				saved, err := im.repo.Create(ctx, p)
+				if err != nil {
+					report.fail(rec, err)
+					continue
+				}
+				id = saved.ID
+			}
+			created["pid:"+p.PID] = id
+			created["oid:"+p.OriginalID] = id
+			report.Created++
+			continue
+		}
+
+		if err := validateImportFields(p, rec.Fields); err != nil {
+			report.fail(rec, err)
+			continue
+		}
+		if !dryRun {
+			p.ID = id
+			if _, err := im.repo.Update(ctx, p, rec.Fields); err != nil {
+				report.fail(rec, err)
+				continue
+			}
+		}
+		report.Updated++
+	}
+	return nil
+}
+
+// validateImportFields checks the fields an update would write.
+func validateImportFields(p *Product, fields []string) error {
+	for _, field := range fields {
+		if !updatableProductFields[field] {
+			return ErrInvalidUpdateMask.WithMetadata(map[string]string{"field": field})
+		}
+		if err := p.validateField(field); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// importRepo keeps products in memory, their IDs following their order.
+type importRepo struct {
+	products []*Product
+	updates  int
+}
+
+func (r *importRepo) FindProductIDs(ctx context.Context, products []*Product) ([]int64, error) {
+	ids := make([]int64, len(products))
+	for i, p := range products {
+		for _, stored := range r.products {
+			if p.PID != "" && stored.PID == p.PID {
+				ids[i] = stored.ID
+				break
+			}
+			if p.OriginalID != "" && stored.OriginalID == p.OriginalID && ids[i] == 0 {
+				ids[i] = stored.ID
+			}
+		}
+	}
+	return ids, nil
+}
+
+func (r *importRepo) Create(ctx context.Context, p *Product) (*Product, error) {
+	created := *p
+	created.ID = int64(len(r.products) + 1)
+	r.products = append(r.products, &created)
+	return &created, nil
+}
+
+func (r *importRepo) Update(ctx context.Context, p *Product, fields []string) (*Product, error) {
+	stored := r.products[p.ID-1]
+	for _, field := range fields {
+		switch field {
+		case ProductFieldTitle:
+			stored.Title = p.Title
+		case ProductFieldSellingPrice:
+			stored.SellingPrice = p.SellingPrice
+		}
+	}
+	r.updates++
+	return stored, nil
+}
+
+// recordReader serves records from a slice.
+type recordReader struct {
+	records []*ImportRecord
+	read    int
+}
+
+func (r *recordReader) Next() (*ImportRecord, error) {
+	if r.read == len(r.records) {
+		return nil, io.EOF
+	}
+	r.read++
+	return r.records[r.read-1], nil
+}
+
+func newImportRepo() *importRepo {
+	return &importRepo{products: []*Product{
+		{ID: 1, PID: "P1", OriginalID: "o1", Title: "Old shirt"},
+		{ID: 2, PID: "P2", OriginalID: "o2", Title: "Jeans", SellingPrice: "₹999"},
+	}}
+}
+
+// importRecords returns a file's worth of records: three updates, one create
+// and four bad rows.
+func importRecords() *recordReader {
+	return &recordReader{records: []*ImportRecord{
+		{Line: 1, Product: &Product{PID: "P1", Title: "New shirt"}, Fields: []string{ProductFieldPID, ProductFieldTitle}},
+		// Matched by original_id
+		{Line: 2, Product: &Product{OriginalID: "o2", SellingPrice: "₹499"}, Fields: []string{ProductFieldOriginalID, ProductFieldSellingPrice}},
+		{Line: 3, Product: &Product{PID: "P3", OriginalID: "o3", Title: "Belt", Brand: "Acme", Category: "Accessories", SubCategory: "Belts"}},
+		// Updates the product created by the line before, in the same batch
+		{Line: 4, Product: &Product{PID: "P3", Title: "Leather belt"}, Fields: []string{ProductFieldPID, ProductFieldTitle}},
+		{Line: 5, Err: errors.New("invalid JSON object")},
+		{Line: 6, Product: &Product{Title: "No keys"}, Fields: []string{ProductFieldTitle}},
+		{Line: 7, Product: &Product{PID: "P4", OriginalID: "o4", Title: "No brand"}},
+		{Line: 8, Product: &Product{PID: "P1", AverageRating: "9"}, Fields: []string{ProductFieldPID, ProductFieldAverageRating}},
+	}}
+}
+
+func checkImportReport(t *testing.T, report *ImportReport) {
+	t.Helper()
+	if report.Read != 8 || report.Created != 1 || report.Updated != 3 || report.Failed != 4 {
+		t.Errorf("read %d, created %d, updated %d, failed %d; want 8, 1, 3, 4",
+			report.Read, report.Created, report.Updated, report.Failed)
+	}
+	want := []struct {
+		line int
+		key  string
+	}{{5, ""}, {6, ""}, {7, "P4"}, {8, "P1"}}
+	if len(report.Errors) != len(want) {
+		t.Fatalf("%d row errors, want %d", len(report.Errors), len(want))
+	}
+	for i, w := range want {
+		if e := report.Errors[i]; e.Line != w.line || e.Key != w.key || e.Err == nil {
+			t.Errorf("row error %d: line %d, key %q, err %v; want line %d, key %q", i, e.Line, e.Key, e.Err, w.line, w.key)
+		}
+	}
+}
+
+func TestImportUpserts(t *testing.T) {
+	repo := newImportRepo()
+	im := NewCatalogImporter(repo, log.DefaultLogger)
+
+	report, err := im.Import(context.Background(), importRecords(), ImportOptions{BatchSize: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkImportReport(t, report)
+
+	if len(repo.products) != 3 {
+		t.Fatalf("%d products stored, want 3", len(repo.products))
+	}
+	titles := []string{"New shirt", "Jeans", "Leather belt"}
+	for i, p := range repo.products {
+		if p.Title != titles[i] {
+			t.Errorf("product %d: title %q, want %q", p.ID, p.Title, titles[i])
+		}
+	}
+	if p := repo.products[1]; p.SellingPrice != "₹499" {
+		t.Errorf("product matched by original_id: selling price %q, want ₹499", p.SellingPrice)
+	}
+}
+
+func TestImportDryRun(t *testing.T) {
+	repo := newImportRepo()
+	im := NewCatalogImporter(repo, log.DefaultLogger)
+
+	report, err := im.Import(context.Background(), importRecords(), ImportOptions{DryRun: true, BatchSize: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A dry run reports what a real import would do, without writing
+	checkImportReport(t, report)
+	if len(repo.products) != 2 || repo.updates != 0 || repo.products[0].Title != "Old shirt" {
+		t.Errorf("dry run wrote: %d products, %d updates", len(repo.products), repo.updates)
+	}
+}
+
+func TestImportLimit(t *testing.T) {
+	repo := newImportRepo()
+	im := NewCatalogImporter(repo, log.DefaultLogger)
+	r := importRecords()
+
+	report, err := im.Import(context.Background(), r, ImportOptions{Limit: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Read != 3 || r.read != 3 {
+		t.Errorf("reported %d and read %d records, want 3", report.Read, r.read)
+	}
+	if report.Created != 1 || report.Updated != 2 || report.Failed != 0 {
+		t.Errorf("created %d, updated %d, failed %d; want 1, 2, 0", report.Created, report.Updated, report.Failed)
+	}
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"slices"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -21,9 +24,77 @@ var (
 	ErrInvalidParameters    = errors.BadRequest(v1.ErrorReason_INVALID_PARAMETERS.String(), "invalid parameters")
 	ErrDatabaseError        = errors.InternalServer(v1.ErrorReason_DATABASE_ERROR.String(), "database error")
 	ErrSearchFailed         = errors.InternalServer(v1.ErrorReason_SEARCH_FAILED.String(), "search failed")
-	ErrEmbeddingsNotEnabled = errors.InternalServer(v1.ErrorReason_EMBEDDING_IS_NOT_ENABLED.String(), "embeddings not enabled")
+	ErrEmbeddingsNotEnabled = errors.ServiceUnavailable(v1.ErrorReason_EMBEDDING_IS_NOT_ENABLED.String(), "embeddings not enabled")
+	ErrProductAlreadyExists = errors.Conflict(v1.ErrorReason_PRODUCT_ALREADY_EXISTS.String(), "product with the same pid or original_id already exists")
+	ErrInvalidUpdateMask    = errors.BadRequest(v1.ErrorReason_INVALID_UPDATE_MASK.String(), "invalid update mask")
+	ErrInvalidPageToken     = errors.BadRequest(v1.ErrorReason_INVALID_PAGE_TOKEN.String(), "invalid page token")
 )
 
+// Product fields that can be written through UpdateProduct. The names match
+// the snake_case field names of ProductInfo so they can be used directly as
+// update mask paths.
+const (
+	ProductFieldOriginalID     = "original_id"
+	ProductFieldTitle          = "title"
+	ProductFieldBrand          = "brand"
+	ProductFieldDescription    = "description"
+	ProductFieldActualPrice    = "actual_price"
+	ProductFieldSellingPrice   = "selling_price"
+	ProductFieldDiscount       = "discount"
+	ProductFieldCategory       = "category"
+	ProductFieldSubCategory    = "sub_category"
+	ProductFieldOutOfStock     = "out_of_stock"
+	ProductFieldSeller         = "seller"
+	ProductFieldAverageRating  = "average_rating"
+	ProductFieldImages         = "images"
+	ProductFieldProductDetails = "product_details"
+	ProductFieldURL            = "url"
+	ProductFieldPID            = "pid"
+	ProductFieldStyleCode      = "style_code"
+	ProductFieldFeatured       = "featured"
+)
+
+// updatableProductFields is the set of accepted update mask paths.
+var updatableProductFields = map[string]bool{
+	ProductFieldOriginalID:     true,
+	ProductFieldTitle:          true,
+	ProductFieldBrand:          true,
+	ProductFieldDescription:    true,
+	ProductFieldActualPrice:    true,
+	ProductFieldSellingPrice:   true,
+	ProductFieldDiscount:       true,
+	ProductFieldCategory:       true,
+	ProductFieldSubCategory:    true,
+	ProductFieldOutOfStock:     true,
+	ProductFieldSeller:         true,
+	ProductFieldAverageRating:  true,
+	ProductFieldImages:         true,
+	ProductFieldProductDetails: true,
+	ProductFieldURL:            true,
+	ProductFieldPID:            true,
+	ProductFieldStyleCode:      true,
+	ProductFieldFeatured:       true,
+}
+
+// requiredProductFields cannot be empty on create nor cleared on update.
+var requiredProductFields = []string{
+	ProductFieldOriginalID,
+	ProductFieldPID,
+	ProductFieldTitle,
+	ProductFieldBrand,
+	ProductFieldCategory,
+	ProductFieldSubCategory,
+}
+
+// maxProductURLLength mirrors the MaxLen of the url column.
+const maxProductURLLength = 2048
+
+// invalidParameter returns an INVALID_PARAMETERS error naming the offending field.
+func invalidParameter(field, message string) error {
+	return errors.BadRequest(v1.ErrorReason_INVALID_PARAMETERS.String(), field+" "+message).
+		WithMetadata(map[string]string{"field": field})
+}
+
 // Product is a Product model.
 type Product struct {
 	ID             int64
@@ -54,6 +125,68 @@ type Product struct {
 	Featured       bool
 	Embedding      []float32 // Add this field
 	SearchKeywords []string  // Add this field
+
+	// EmbeddingModel and EmbeddingHash record the model and ContentHash the
+	// embedding was generated with. ContentHash covers the embedding inputs.
+	EmbeddingModel string
+	EmbeddingHash  string
+	ContentHash    string
+
+	// Derived by the product hooks. Attributes are only loaded for single
+	// product lookups.
+	ActualPriceNumeric int
+	DiscountPercent    float64
+	Attributes         []*ProductAttribute
+}
+
+// ProductAttribute is a normalised product detail, e.g. color=blue.
+type ProductAttribute struct {
+	Key   string
+	Value string
+}
+
+// ProductAttributeKeys are the attributes extracted from product details.
+var ProductAttributeKeys = []string{"color", "fabric", "pattern", "size", "closure"}
+
+// Attribute filter match modes.
+const (
+	AttributeMatchAny  = "any"
+	AttributeMatchAll  = "all"
+	AttributeMatchNone = "none"
+)
+
+// maxAttributeFilterValues caps the values of one attribute filter.
+const maxAttributeFilterValues = 50
+
+// AttributeFilter keeps products by one attribute: with any of Values, with
+// all of them, or with none of them.
+type AttributeFilter struct {
+	Key    string
+	Values []string
+	Match  string
+}
+
+// NeedsEmbedding reports whether the product lacks an embedding from model
+// for its current content.
+func (p *Product) NeedsEmbedding(model string) bool {
+	return len(p.Embedding) == 0 ||
+		p.EmbeddingModel != model ||
+		p.EmbeddingHash == "" ||
+		p.EmbeddingHash != p.ContentHash
+}
+
+// ProductEmbedding is a generated embedding and the ContentHash of the
+// product text it was generated from.
+type ProductEmbedding struct {
+	Vector      []float32
+	ContentHash string
+}
+
+// ScoredProduct is a search hit with its relevance score. For vector search
+// the score is the cosine similarity to the query.
+type ScoredProduct struct {
+	Product *Product
+	Score   float32
 }
 
 // ProductListItem is a lightweight version for lists
@@ -77,17 +210,23 @@ type ProductListItem struct {
 type ProductRepo interface {
 	// Basic CRUD
 	Create(context.Context, *Product) (*Product, error)
-	Update(context.Context, *Product) (*Product, error)
+	// Update writes the given fields of the product. With no fields, only
+	// non-empty values are written.
+	Update(context.Context, *Product, []string) (*Product, error)
 	Delete(context.Context, int64) (*Product, error)
 	GetProduct(context.Context, int64) (*Product, error)
 	GetProductByPID(context.Context, string) (*Product, error)
 
 	// List operations
 	ListAllProducts(context.Context) ([]*Product, error)
-	ListProducts(context.Context, *ListProductsParams) ([]*Product, int64, error)
+	ListProducts(context.Context, *ListProductsParams) (*ProductPage, error)
 
 	// Search
 	SearchProducts(context.Context, string, *ListProductsParams) ([]*Product, int64, error)
+	// GetFacets counts products per filter value; see Facets.
+	GetFacets(context.Context, string, *ListProductsParams) (*Facets, error)
+	// ListSuggestionSources reads the fields query suggestions are built from.
+	ListSuggestionSources(context.Context) ([]*SuggestionSource, error)
 
 	// Special queries
 	GetFeaturedProducts(context.Context, int, string) ([]*Product, error)
@@ -98,14 +237,85 @@ type ProductRepo interface {
 	IncrementClickCount(context.Context, int64) error
 
 	// Embedding operations
-	GenerateEmbedding(ctx context.Context, product *Product) ([]float32, error)
-	SearchSimilarProducts(ctx context.Context, queryEmbedding []float32, limit int, category string, priceRange *PriceRange) ([]*Product, error)
-	UpdateProductEmbedding(ctx context.Context, id int64, embedding []float32) error
-	BatchUpdateEmbeddings(ctx context.Context, productEmbeddings map[int64][]float32) error
-	GetProductsWithoutEmbeddings(ctx context.Context, limit int) ([]*Product, error)
+	SearchSimilarProducts(ctx context.Context, queryEmbedding []float32, limit int, category string, priceRange *PriceRange) ([]*ScoredProduct, error)
+	// Embeddings are stored with the model of the repo's embedder. Products
+	// need embedding when theirs is missing, from another model or generated
+	// from content that has since changed.
+	UpdateProductEmbedding(ctx context.Context, id int64, embedding *ProductEmbedding) error
+	BatchUpdateEmbeddings(ctx context.Context, productEmbeddings map[int64]*ProductEmbedding) error
+	GetProductsNeedingEmbeddings(ctx context.Context, afterID int64, limit int) ([]*Product, error)
+	CountProductsNeedingEmbeddings(ctx context.Context) (int, error)
+	// CountStaleModelEmbeddings counts embeddings made by another model.
+	CountStaleModelEmbeddings(ctx context.Context) (int, error)
 	GetProductsWithEmbeddings(ctx context.Context, limit int) ([]*Product, error)
 }
 
+// VectorIndex is a nearest-neighbour index over product embeddings used by
+// ProductRepo.SearchSimilarProducts. Implementations must be safe for
+// concurrent use.
+type VectorIndex interface {
+	// Upsert adds or replaces the entry for a product.
+	Upsert(entry *VectorEntry) error
+	// Delete removes a product from the index.
+	Delete(id int64)
+	// Search returns up to k entries passing filter, most similar first.
+	Search(query []float32, k int, filter *VectorFilter) []*VectorMatch
+	// Len returns the number of indexed products.
+	Len() int
+}
+
+// VectorEntry is a product embedding plus the attributes used for pre-filtering.
+type VectorEntry struct {
+	ID           int64
+	Vector       []float32
+	Category     string
+	PriceNumeric int
+}
+
+// VectorFilter restricts a vector search to matching products.
+type VectorFilter struct {
+	Category string
+	MinPrice int32
+	MaxPrice int32
+}
+
+// NewVectorFilter builds a filter from search arguments, or nil when none apply.
+func NewVectorFilter(category string, priceRange *PriceRange) *VectorFilter {
+	f := &VectorFilter{Category: category}
+	if priceRange != nil {
+		f.MinPrice = priceRange.Min
+		f.MaxPrice = priceRange.Max
+	}
+	if f.Category == "" && f.MinPrice <= 0 && f.MaxPrice <= 0 {
+		return nil
+	}
+	return f
+}
+
+// Match reports whether a product with the given attributes passes the filter.
+// A nil filter matches everything.
+func (f *VectorFilter) Match(category string, priceNumeric int) bool {
+	if f == nil {
+		return true
+	}
+	if f.Category != "" && f.Category != category {
+		return false
+	}
+	if f.MinPrice > 0 && priceNumeric < int(f.MinPrice) {
+		return false
+	}
+	if f.MaxPrice > 0 && priceNumeric > int(f.MaxPrice) {
+		return false
+	}
+	return true
+}
+
+// VectorMatch is a single vector search hit.
+type VectorMatch struct {
+	ID    int64
+	Score float32
+}
+
 // ListProductsParams defines parameters for listing products
 type ListProductsParams struct {
 	Page        int32
@@ -122,6 +332,25 @@ type ListProductsParams struct {
 	SortBy      string
 	SortOrder   string
 	SearchQuery string
+	// AttributeFilters combine by AND. Validate lower-cases their keys and
+	// values and drops duplicate values.
+	AttributeFilters []*AttributeFilter
+	// ProductIDs restricts the products to the given ones when not nil, e.g.
+	// to count the facets of search results. It is not a request filter.
+	ProductIDs []int64
+
+	// PageToken continues a listing after the page that returned it; Page
+	// is ignored. Such pages are counted only with IncludeTotal.
+	PageToken    string
+	IncludeTotal bool
+}
+
+// ProductPage is one page of a product listing.
+type ProductPage struct {
+	Products []*Product
+	// Total is -1 when the listing was not counted.
+	Total         int64
+	NextPageToken string
 }
 
 // Validate validates the ListProductsParams
@@ -144,6 +373,102 @@ func (p *ListProductsParams) Validate() error {
 	if p.MinPrice > p.MaxPrice && p.MaxPrice > 0 {
 		return ErrInvalidPriceRange
 	}
+	for _, f := range p.AttributeFilters {
+		if err := f.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *AttributeFilter) validate() error {
+	f.Key = strings.ToLower(strings.TrimSpace(f.Key))
+	if !slices.Contains(ProductAttributeKeys, f.Key) {
+		return invalidParameter("attribute_filters", "has unknown attribute "+f.Key)
+	}
+	if f.Match == "" {
+		f.Match = AttributeMatchAny
+	}
+	if f.Match != AttributeMatchAny && f.Match != AttributeMatchAll && f.Match != AttributeMatchNone {
+		return invalidParameter("attribute_filters", "match must be any, all or none")
+	}
+
+	values := make([]string, 0, len(f.Values))
+	for _, v := range f.Values {
+		v = NormalizeSuggestion(v)
+		if v != "" && !slices.Contains(values, v) {
+			values = append(values, v)
+		}
+	}
+	if len(values) == 0 {
+		return invalidParameter("attribute_filters", "needs a value for "+f.Key)
+	}
+	if len(values) > maxAttributeFilterValues {
+		return invalidParameter("attribute_filters", fmt.Sprintf("allows at most %d values per attribute", maxAttributeFilterValues))
+	}
+	f.Values = values
+	return nil
+}
+
+// Validate checks that a product carries everything required to be created.
+func (p *Product) Validate() error {
+	for _, field := range requiredProductFields {
+		if err := p.validateField(field); err != nil {
+			return err
+		}
+	}
+	return p.validateFormats()
+}
+
+// validateFormats checks the fields whose non-empty values must be well formed.
+func (p *Product) validateFormats() error {
+	if err := p.validateField(ProductFieldAverageRating); err != nil {
+		return err
+	}
+	return p.validateField(ProductFieldURL)
+}
+
+// validateField checks a single writable field of the product. Fields
+// without constraints always pass.
+func (p *Product) validateField(field string) error {
+	switch field {
+	case ProductFieldOriginalID:
+		if strings.TrimSpace(p.OriginalID) == "" {
+			return invalidParameter(field, "is required")
+		}
+	case ProductFieldTitle:
+		if strings.TrimSpace(p.Title) == "" {
+			return invalidParameter(field, "is required")
+		}
+	case ProductFieldBrand:
+		if strings.TrimSpace(p.Brand) == "" {
+			return invalidParameter(field, "is required")
+		}
+	case ProductFieldCategory:
+		if strings.TrimSpace(p.Category) == "" {
+			return invalidParameter(field, "is required")
+		}
+	case ProductFieldSubCategory:
+		if strings.TrimSpace(p.SubCategory) == "" {
+			return invalidParameter(field, "is required")
+		}
+	case ProductFieldPID:
+		if strings.TrimSpace(p.PID) == "" {
+			return invalidParameter(field, "is required")
+		}
+	case ProductFieldAverageRating:
+		if p.AverageRating == "" {
+			return nil
+		}
+		rating, err := strconv.ParseFloat(p.AverageRating, 64)
+		if err != nil || rating < 0 || rating > 5 {
+			return invalidParameter(field, "must be a number between 0 and 5")
+		}
+	case ProductFieldURL:
+		if len(p.URL) > maxProductURLLength {
+			return invalidParameter(field, fmt.Sprintf("must be at most %d characters", maxProductURLLength))
+		}
+	}
 	return nil
 }
 
@@ -164,27 +489,63 @@ type EmbeddingConfig struct {
 	Enabled    bool
 }
 
+// ragContextSize is the number of top matches given to the chat model.
+const ragContextSize = 5
+
 // ProductUsecase is a Product usecase.
 type ProductUsecase struct {
 	repo     ProductRepo
+	chat     ChatClient
+	embedder Embedder
 	log      *log.Helper
 	embedCfg *EmbeddingConfig
+	hybrid   *HybridConfig
+	spell    *SpellChecker
+	rules    *SearchRules
+
+	// reembed queues products whose text changed for re-embedding.
+	reembed chan int64
 }
 
-// NewProductUsecase creates a new ProductUsecase.
-func NewProductUsecase(repo ProductRepo, conf *conf.Embeddings, logger log.Logger) *ProductUsecase {
-	return &ProductUsecase{
-		repo: repo,
-		embedCfg: &EmbeddingConfig{
-			ApiKey:     conf.ApiKey,
-			Model:      conf.Model,
-			BatchSize:  conf.BatchSize,
-			BaseUrl:    conf.BaseUrl,
-			Timeout:    1000,
-			MaxRetries: conf.MaxRetries,
-			Enabled:    true,
-		},
-		log: log.NewHelper(logger),
+// NewProductUsecase creates a new ProductUsecase. chat may be nil, in which
+// case RAGSearch returns matches without an answer; embedder may be nil, in
+// which case embedding features report ErrEmbeddingsNotEnabled. The returned
+// cleanup stops the re-embedding worker.
+func NewProductUsecase(repo ProductRepo, chat ChatClient, embedder Embedder, spell *SpellChecker, rules *SearchRules, conf *conf.Embeddings, search *conf.Search, logger log.Logger) (*ProductUsecase, func()) {
+	embedCfg := &EmbeddingConfig{
+		ApiKey:     conf.ApiKey,
+		BatchSize:  conf.BatchSize,
+		BaseUrl:    conf.BaseUrl,
+		Timeout:    conf.TimeoutSeconds,
+		MaxRetries: conf.MaxRetries,
+		Enabled:    embedder != nil,
+	}
+	if embedder != nil {
+		embedCfg.Model = embedder.Model()
+	}
+
+	uc := &ProductUsecase{
+		repo:     repo,
+		chat:     chat,
+		embedder: embedder,
+		embedCfg: embedCfg,
+		hybrid:   newHybridConfig(search),
+		spell:    spell,
+		rules:    rules,
+		log:      log.NewHelper(logger),
+	}
+	if embedder == nil {
+		return uc, func() {}
+	}
+
+	uc.reembed = make(chan int64, reembedQueueSize)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go uc.runReembed(ctx, done)
+
+	return uc, func() {
+		cancel()
+		<-done
 	}
 }
 
@@ -193,18 +554,61 @@ func NewProductUsecase(repo ProductRepo, conf *conf.Embeddings, logger log.Logge
 // CreateProduct creates a new Product.
 func (uc *ProductUsecase) CreateProduct(ctx context.Context, p *Product) (*Product, error) {
 	uc.log.Infof("CreateProduct: %v", p.Title)
-	return uc.repo.Create(ctx, p)
+
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	created, err := uc.repo.Create(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.enqueueReembed(created)
+	return created, nil
 }
 
-// UpdateProduct updates an existing Product.
-func (uc *ProductUsecase) UpdateProduct(ctx context.Context, p *Product) (*Product, error) {
-	uc.log.Infof("UpdateProduct: %v", p.ID)
-	return uc.repo.Update(ctx, p)
+// UpdateProduct updates an existing Product. When fields is empty only the
+// non-empty values of p are applied; otherwise exactly the listed fields are
+// written, so an empty value clears the field.
+func (uc *ProductUsecase) UpdateProduct(ctx context.Context, p *Product, fields []string) (*Product, error) {
+	uc.log.Infof("UpdateProduct: %v, fields=%v", p.ID, fields)
+
+	if p.ID <= 0 {
+		return nil, ErrInvalidProductID
+	}
+
+	if len(fields) == 0 {
+		if err := p.validateFormats(); err != nil {
+			return nil, err
+		}
+	}
+	for _, field := range fields {
+		if !updatableProductFields[field] {
+			return nil, ErrInvalidUpdateMask.WithMetadata(map[string]string{"field": field})
+		}
+		if err := p.validateField(field); err != nil {
+			return nil, err
+		}
+	}
+
+	updated, err := uc.repo.Update(ctx, p, fields)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.enqueueReembed(updated)
+	return updated, nil
 }
 
 // DeleteProduct deletes a Product.
 func (uc *ProductUsecase) DeleteProduct(ctx context.Context, id int64) (*Product, error) {
 	uc.log.Infof("DeleteProduct: %v", id)
+
+	if id <= 0 {
+		return nil, ErrInvalidProductID
+	}
+
 	return uc.repo.Delete(ctx, id)
 }
 
@@ -247,11 +651,11 @@ func (uc *ProductUsecase) ListAllProducts(ctx context.Context) ([]*Product, erro
 }
 
 // ListProducts retrieves Products with filtering and pagination.
-func (uc *ProductUsecase) ListProducts(ctx context.Context, params *ListProductsParams) ([]*Product, int64, error) {
+func (uc *ProductUsecase) ListProducts(ctx context.Context, params *ListProductsParams) (*ProductPage, error) {
 	uc.log.Infof("ListProducts: page=%d, pageSize=%d", params.Page, params.PageSize)
 
 	if err := params.Validate(); err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 
 	return uc.repo.ListProducts(ctx, params)
@@ -262,7 +666,11 @@ func (uc *ProductUsecase) SearchProducts(ctx context.Context, query string, para
 	uc.log.Infof("SearchProducts: %v", query)
 
 	if query == "" {
-		return uc.ListProducts(ctx, params)
+		page, err := uc.ListProducts(ctx, params)
+		if err != nil {
+			return nil, 0, err
+		}
+		return page.Products, page.Total, nil
 	}
 
 	if params == nil {
@@ -272,7 +680,17 @@ func (uc *ProductUsecase) SearchProducts(ctx context.Context, query string, para
 		}
 	}
 
-	return uc.repo.SearchProducts(ctx, query, params)
+	rewrite := uc.rules.Rewrite(query)
+	products, total, err := uc.repo.SearchProducts(ctx, rewrite.Query, params)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(rewrite.Boosts) > 0 {
+		sort.SliceStable(products, func(i, j int) bool {
+			return rewrite.Boost(products[i]) > rewrite.Boost(products[j])
+		})
+	}
+	return products, total, nil
 }
 
 // GetFeaturedProducts retrieves featured Products.
@@ -339,8 +757,13 @@ func (uc *ProductUsecase) GenerateProductText(product *Product) string {
 	if len(product.ProductDetails) > 0 {
 		sb.WriteString("Details: ")
 		for _, detail := range product.ProductDetails {
-			for k, v := range detail {
-				sb.WriteString(fmt.Sprintf("%s: %s, ", k, v))
+			keys := make([]string, 0, len(detail))
+			for k := range detail {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			for _, k := range keys {
+				sb.WriteString(fmt.Sprintf("%s: %s, ", k, detail[k]))
 			}
 		}
 		sb.WriteString("\n")
@@ -359,20 +782,36 @@ func (uc *ProductUsecase) GenerateEmbedding(ctx context.Context, product *Produc
 		return nil, ErrEmbeddingsNotEnabled
 	}
 
-	return uc.repo.GenerateEmbedding(ctx, product)
+	return uc.embedText(ctx, uc.GenerateProductText(product))
+}
+
+// embedText embeds a single text.
+func (uc *ProductUsecase) embedText(ctx context.Context, text string) ([]float32, error) {
+	vectors, err := uc.embedder.Embed(ctx, []string{text})
+	if err != nil {
+		return nil, err
+	}
+	if len(vectors) == 0 {
+		return nil, fmt.Errorf("no embedding returned")
+	}
+	return vectors[0], nil
 }
 
 // SearchWithEmbeddings searches products using vector similarity
-func (uc *ProductUsecase) SearchWithEmbeddings(ctx context.Context, query string, limit int, category string, priceRange *PriceRange) ([]*Product, error) {
+func (uc *ProductUsecase) SearchWithEmbeddings(ctx context.Context, query string, limit int, category string, priceRange *PriceRange) ([]*ScoredProduct, error) {
 	if !uc.embeddingsEnabled() {
 		return nil, ErrEmbeddingsNotEnabled
 	}
 
+	if strings.TrimSpace(query) == "" {
+		return nil, invalidParameter("query", "is required")
+	}
+	if priceRange != nil && priceRange.Max > 0 && priceRange.Min > priceRange.Max {
+		return nil, ErrInvalidPriceRange
+	}
+
 	// Generate embedding for the query
-	queryEmbedding, err := uc.repo.GenerateEmbedding(ctx, &Product{
-		Title:       query,
-		Description: query,
-	})
+	queryEmbedding, err := uc.embedText(ctx, query)
 	if err != nil {
 		return nil, err
 	}
@@ -381,132 +820,156 @@ func (uc *ProductUsecase) SearchWithEmbeddings(ctx context.Context, query string
 	return uc.repo.SearchSimilarProducts(ctx, queryEmbedding, limit, category, priceRange)
 }
 
-// RAGSearch performs RAG-based semantic search
-func (uc *ProductUsecase) RAGSearch(ctx context.Context, prompt string, limit int, category string, priceRange *PriceRange) ([]*Product, error) {
-	if !uc.embeddingsEnabled() {
-		return nil, ErrEmbeddingsNotEnabled
-	}
-
-	// First, find similar products based on the prompt
-	products, err := uc.SearchWithEmbeddings(ctx, prompt, limit*2, category, priceRange)
+// RAGSearch performs RAG-based semantic search. The top matches are sent to
+// the chat model as context and its answer is returned along with the PIDs
+// it cites. Without a chat client only the matches are returned.
+func (uc *ProductUsecase) RAGSearch(ctx context.Context, prompt string, limit int, category string, priceRange *PriceRange) (*RAGResult, error) {
+	products, err := uc.retrieveForRAG(ctx, prompt, limit, category, priceRange)
 	if err != nil {
-		return nil, fmt.Errorf("vector search failed: %w", err)
+		return nil, err
 	}
 
-	if len(products) == 0 {
-		return products, nil
+	result := &RAGResult{Products: products}
+	if len(products) == 0 || uc.chat == nil {
+		return result, nil
 	}
 
-	// Prepare context for LLM
-	contextText := uc.buildContextFromProducts(products[:min(5, len(products))])
-
-	// Note: This would require an LLM client. For now, we'll return vector results.
-	// In a real implementation, you would call an LLM API here.
-	uc.log.Infof("LLM context would be sent for query: %s", prompt)
-	uc.log.Debugf("Context text: %s", contextText)
+	contextProducts := products[:min(ragContextSize, len(products))]
+	answer, err := uc.chat.Complete(ctx, uc.buildRAGMessages(prompt, contextProducts))
+	if err != nil {
+		uc.log.Errorf("Chat completion failed for query %q: %v", prompt, err)
+		return nil, ErrSearchFailed.WithCause(err)
+	}
 
-	// For now, return the top vector search results
-	return products[:min(limit, len(products))], nil
+	result.Answer, result.CitedPIDs = extractCitations(answer, productPIDs(contextProducts))
+	return result, nil
 }
 
-// GenerateAllEmbeddings generates embeddings for all products
-func (uc *ProductUsecase) GenerateAllEmbeddings(ctx context.Context, batchSize int) error {
-	if !uc.embeddingsEnabled() {
-		return ErrEmbeddingsNotEnabled
+// StreamRAGSearch is the streaming form of RAGSearch. It sends the matching
+// products, then the answer as it is generated, then the citations found in
+// the complete answer. Citations of unknown PIDs are dropped from the
+// deltas as in RAGSearch. It stops as soon as ctx is cancelled or send fails.
+func (uc *ProductUsecase) StreamRAGSearch(ctx context.Context, prompt string, limit int, category string, priceRange *PriceRange, send func(*RAGEvent) error) error {
+	products, err := uc.retrieveForRAG(ctx, prompt, limit, category, priceRange)
+	if err != nil {
+		return err
 	}
 
-	uc.log.Info("Starting embedding generation for all products")
-
-	page := 1
-	for {
-		// Get products without embeddings
-		params := &ListProductsParams{
-			Page:     int32(page),
-			PageSize: int32(batchSize),
-		}
+	if err := send(&RAGEvent{Kind: RAGEventProducts, Products: products}); err != nil {
+		return err
+	}
 
-		products, _, err := uc.ListProducts(ctx, params)
+	var cited []string
+	if len(products) > 0 && uc.chat != nil {
+		contextProducts := products[:min(ragContextSize, len(products))]
+		allowed := productPIDs(contextProducts)
+		filter := newCitationFilter(allowed)
+
+		var answer strings.Builder
+		err := uc.chat.Stream(ctx, uc.buildRAGMessages(prompt, contextProducts), func(delta string) error {
+			answer.WriteString(delta)
+			if text := filter.Write(delta); text != "" {
+				return send(&RAGEvent{Kind: RAGEventDelta, Delta: text})
+			}
+			return nil
+		})
 		if err != nil {
-			return fmt.Errorf("failed to list products: %w", err)
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			uc.log.Errorf("Chat stream failed for query %q: %v", prompt, err)
+			return ErrSearchFailed.WithCause(err)
 		}
-
-		if len(products) == 0 {
-			break
+		if text := filter.Flush(); text != "" {
+			if err := send(&RAGEvent{Kind: RAGEventDelta, Delta: text}); err != nil {
+				return err
+			}
 		}
 
-		// Process batch
-		productEmbeddings := make(map[int64][]float32)
-		for _, product := range products {
-			// Skip if already has embedding
-			if len(product.Embedding) > 0 {
-				continue
-			}
+		_, cited = extractCitations(answer.String(), allowed)
+	}
 
-			embedding, err := uc.GenerateEmbedding(ctx, product)
-			if err != nil {
-				uc.log.Errorf("Failed to generate embedding for product %d: %v", product.ID, err)
-				continue
-			}
+	return send(&RAGEvent{Kind: RAGEventCitations, CitedPIDs: cited})
+}
 
-			productEmbeddings[product.ID] = embedding
-			uc.log.Infof("Generated embedding for product %d: %s", product.ID, product.Title)
+// retrieveForRAG returns the products a RAG answer is grounded on. The chat
+// context is taken from the head of this list, so every citation refers to a
+// returned product.
+func (uc *ProductUsecase) retrieveForRAG(ctx context.Context, prompt string, limit int, category string, priceRange *PriceRange) ([]*ScoredProduct, error) {
+	if !uc.embeddingsEnabled() {
+		return nil, ErrEmbeddingsNotEnabled
+	}
 
-			// Rate limiting
-			time.Sleep(100 * time.Millisecond)
-		}
+	// First, find similar products based on the prompt
+	products, err := uc.SearchWithEmbeddings(ctx, prompt, limit, category, priceRange)
+	if err != nil {
+		return nil, fmt.Errorf("vector search failed: %w", err)
+	}
+	return products, nil
+}
 
-		// Batch update embeddings
-		if len(productEmbeddings) > 0 {
-			if err := uc.repo.BatchUpdateEmbeddings(ctx, productEmbeddings); err != nil {
-				uc.log.Errorf("Failed to batch update embeddings: %v", err)
-			}
-		}
+// buildRAGMessages builds the chat conversation answering prompt from the
+// given products.
+func (uc *ProductUsecase) buildRAGMessages(prompt string, products []*ScoredProduct) []ChatMessage {
+	contextText := uc.buildContextFromProducts(products)
+	return []ChatMessage{
+		{Role: ChatRoleSystem, Content: ragSystemPrompt},
+		{Role: ChatRoleUser, Content: fmt.Sprintf("Context:\n%s\nQuestion: %s", contextText, prompt)},
+	}
+}
 
-		page++
+// productPIDs returns the set of PIDs of the given products.
+func productPIDs(products []*ScoredProduct) map[string]bool {
+	pids := make(map[string]bool, len(products))
+	for _, hit := range products {
+		pids[hit.Product.PID] = true
 	}
+	return pids
+}
 
-	uc.log.Info("Embedding generation completed")
-	return nil
+// GenerateAllEmbeddings generates embeddings for all products that need one
+func (uc *ProductUsecase) GenerateAllEmbeddings(ctx context.Context, batchSize int) error {
+	return uc.GenerateEmbeddingsForMissing(ctx, batchSize)
 }
 
-// GenerateEmbeddingsForMissing generates embeddings only for products without them
+// GenerateEmbeddingsForMissing generates embeddings only for products whose
+// embedding is missing or stale. Each product is attempted once per call, so products that keep
+// failing cannot stall it.
 func (uc *ProductUsecase) GenerateEmbeddingsForMissing(ctx context.Context, batchSize int) error {
 	if !uc.embeddingsEnabled() {
 		return ErrEmbeddingsNotEnabled
 	}
+	if batchSize <= 0 {
+		batchSize = int(uc.embedCfg.BatchSize)
+	}
+	if batchSize <= 0 {
+		batchSize = defaultEmbeddingBatchSize
+	}
 
 	uc.log.Info("Generating embeddings for products without them")
 
+	var cursor int64
 	for {
-		// Get products without embeddings
-		products, err := uc.repo.GetProductsWithoutEmbeddings(ctx, batchSize)
+		products, err := uc.repo.GetProductsNeedingEmbeddings(ctx, cursor, batchSize)
 		if err != nil {
-			return fmt.Errorf("failed to get products without embeddings: %w", err)
+			return fmt.Errorf("failed to get products needing embeddings: %w", err)
 		}
 
 		if len(products) == 0 {
 			break
 		}
+		cursor = products[len(products)-1].ID
 
-		// Process batch
-		productEmbeddings := make(map[int64][]float32)
-		for _, product := range products {
-			embedding, err := uc.GenerateEmbedding(ctx, product)
-			if err != nil {
-				uc.log.Errorf("Failed to generate embedding for product %d: %v", product.ID, err)
-				continue
-			}
-
-			productEmbeddings[product.ID] = embedding
-			uc.log.Infof("Generated embedding for product %d: %s", product.ID, product.Title)
-
-			// Rate limiting
-			time.Sleep(100 * time.Millisecond)
+		embeddings, failures := uc.embedProducts(ctx, products, nil)
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		for id, err := range failures {
+			uc.log.Errorf("Failed to generate embedding for product %d: %v", id, err)
 		}
 
-		// Batch update embeddings
-		if len(productEmbeddings) > 0 {
-			if err := uc.repo.BatchUpdateEmbeddings(ctx, productEmbeddings); err != nil {
+		if len(embeddings) > 0 {
+			if err := uc.repo.BatchUpdateEmbeddings(ctx, embeddings); err != nil {
 				uc.log.Errorf("Failed to batch update embeddings: %v", err)
 			}
 		}
@@ -516,11 +979,60 @@ func (uc *ProductUsecase) GenerateEmbeddingsForMissing(ctx context.Context, batc
 	return nil
 }
 
+// embedProducts embeds products with one provider call. When that call
+// fails, each product is retried on its own so a single bad input does not
+// fail the whole batch. limiter, if set, is waited on before every call.
+func (uc *ProductUsecase) embedProducts(ctx context.Context, products []*Product, limiter *tokenBucket) (map[int64]*ProductEmbedding, map[int64]error) {
+	embeddings := make(map[int64]*ProductEmbedding, len(products))
+	failures := make(map[int64]error)
+
+	embed := func(batch []*Product) error {
+		if err := limiter.Wait(ctx); err != nil {
+			return err
+		}
+		texts := make([]string, len(batch))
+		for i, p := range batch {
+			texts[i] = uc.GenerateProductText(p)
+		}
+		vectors, err := uc.embedder.Embed(ctx, texts)
+		if err != nil {
+			return err
+		}
+		if len(vectors) != len(batch) {
+			return fmt.Errorf("embedder returned %d vectors for %d products", len(vectors), len(batch))
+		}
+		for i, p := range batch {
+			embeddings[p.ID] = &ProductEmbedding{Vector: vectors[i], ContentHash: p.ContentHash}
+		}
+		return nil
+	}
+
+	err := embed(products)
+	if err == nil {
+		return embeddings, failures
+	}
+	if len(products) == 1 || ctx.Err() != nil {
+		for _, p := range products {
+			failures[p.ID] = err
+		}
+		return embeddings, failures
+	}
+
+	uc.log.Warnf("Batch embedding of %d products failed, retrying individually: %v", len(products), err)
+	for _, p := range products {
+		if err := embed([]*Product{p}); err != nil {
+			failures[p.ID] = err
+		}
+	}
+	return embeddings, failures
+}
+
 // Helper function to build context from products
-func (uc *ProductUsecase) buildContextFromProducts(products []*Product) string {
+func (uc *ProductUsecase) buildContextFromProducts(products []*ScoredProduct) string {
 	var sb strings.Builder
 
-	for i, product := range products {
+	for i, hit := range products {
+		product := hit.Product
 		sb.WriteString(fmt.Sprintf("Product %d:\n", i+1))
 		sb.WriteString(fmt.Sprintf("PID: %s\n", product.PID))
 		sb.WriteString(fmt.Sprintf("Title: %s\n", product.Title))
@@ -543,7 +1055,7 @@ func (uc *ProductUsecase) buildContextFromProducts(products []*Product) string {
 
 // Check if embeddings are enabled
 func (uc *ProductUsecase) embeddingsEnabled() bool {
-	return uc.embedCfg != nil && uc.embedCfg.Enabled && uc.embedCfg.ApiKey != ""
+	return uc.embedder != nil
 }
 
 // Cosine similarity calculation
@@ -0,0 +1,36 @@
+package biz
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+)
+
+func TestAttributeFilterValidate(t *testing.T) {
+	f := &AttributeFilter{Key: " Color ", Values: []string{"Navy  Blue", "navy blue", " ", "RED"}}
+	if err := f.validate(); err != nil {
+		t.Fatal(err)
+	}
+	if f.Key != "color" || f.Match != AttributeMatchAny || !slices.Equal(f.Values, []string{"navy blue", "red"}) {
+		t.Errorf("validated filter %+v", f)
+	}
+
+	tooMany := make([]string, maxAttributeFilterValues+1)
+	for i := range tooMany {
+		tooMany[i] = strings.Repeat("x", i+1)
+	}
+	invalid := map[string]*AttributeFilter{
+		"unknown key":     {Key: "sleeve", Values: []string{"full"}},
+		"unknown match":   {Key: "color", Values: []string{"red"}, Match: "most"},
+		"no values":       {Key: "color", Values: []string{" "}},
+		"too many values": {Key: "color", Values: tooMany},
+	}
+	for name, f := range invalid {
+		params := &ListProductsParams{AttributeFilters: []*AttributeFilter{f}}
+		if err := params.Validate(); !kerrors.IsBadRequest(err) {
+			t.Errorf("%s: err = %v, want bad request", name, err)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package biz
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// reembedQueueSize bounds the products waiting for re-embedding. Products
+// that do not fit stay dirty until the next backfill.
+const reembedQueueSize = 1024
+
+// enqueueReembed schedules p for re-embedding if its embedding is stale.
+func (uc *ProductUsecase) enqueueReembed(p *Product) {
+	if uc.reembed == nil || !p.NeedsEmbedding(uc.embedder.Model()) {
+		return
+	}
+
+	select {
+	case uc.reembed <- p.ID:
+	default:
+		uc.log.Warnf("Re-embedding queue is full, product %d is left for the backfill", p.ID)
+	}
+}
+
+// runReembed embeds queued products, batching whatever is waiting.
+func (uc *ProductUsecase) runReembed(ctx context.Context, done chan struct{}) {
+	defer close(done)
+
+	batchSize := int(uc.embedCfg.BatchSize)
+	if batchSize <= 0 {
+		batchSize = defaultEmbeddingBatchSize
+	}
+
+	for {
+		var id int64
+		select {
+		case <-ctx.Done():
+			return
+		case id = <-uc.reembed:
+		}
+
+		ids := map[int64]bool{id: true}
+	drain:
+		for len(ids) < batchSize {
+			select {
+			case id := <-uc.reembed:
+				ids[id] = true
+			default:
+				break drain
+			}
+		}
+
+		uc.reembedProducts(ctx, ids)
+	}
+}
+
+// reembedProducts re-reads the products so the latest content is embedded,
+// skipping any that were deleted or embedded in the meantime.
+func (uc *ProductUsecase) reembedProducts(ctx context.Context, ids map[int64]bool) {
+	model := uc.embedder.Model()
+
+	products := make([]*Product, 0, len(ids))
+	for id := range ids {
+		p, err := uc.repo.GetProduct(ctx, id)
+		if err != nil {
+			if !errors.Is(err, ErrProductNotFound) {
+				uc.log.Errorf("Failed to load product %d for re-embedding: %v", id, err)
+			}
+			continue
+		}
+		if p.NeedsEmbedding(model) {
+			products = append(products, p)
+		}
+	}
+	if len(products) == 0 {
+		return
+	}
+
+	embeddings, failures := uc.embedProducts(ctx, products, nil)
+	for id, err := range failures {
+		uc.log.Errorf("Failed to re-embed product %d: %v", id, err)
+	}
+	if len(embeddings) == 0 {
+		return
+	}
+
+	if err := uc.repo.BatchUpdateEmbeddings(ctx, embeddings); err != nil {
+		uc.log.Errorf("Failed to save re-embedded products: %v", err)
+		return
+	}
+	uc.log.Infof("Re-embedded %d changed products", len(embeddings))
+}
@@ -0,0 +1,101 @@
+package biz
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// reembedRepo serves stored products and records the saved embeddings.
+type reembedRepo struct {
+	ProductRepo
+	products map[int64]*Product
+	saved    map[int64]*ProductEmbedding
+}
+
+func (r *reembedRepo) GetProduct(ctx context.Context, id int64) (*Product, error) {
+	p, ok := r.products[id]
+	if !ok {
+		return nil, ErrProductNotFound
+	}
+	return p, nil
+}
+
+func (r *reembedRepo) BatchUpdateEmbeddings(ctx context.Context, embeddings map[int64]*ProductEmbedding) error {
+	for id, e := range embeddings {
+		r.saved[id] = e
+	}
+	return nil
+}
+
+// embedded returns a product embedded by constantEmbedder from content
+// hashed as hash.
+func embedded(id int64, hash string) *Product {
+	return &Product{ID: id, Embedding: []float32{1, 0}, EmbeddingModel: "constant", EmbeddingHash: hash, ContentHash: hash}
+}
+
+func TestNeedsEmbedding(t *testing.T) {
+	changed := embedded(1, "old")
+	changed.ContentHash = "new"
+	otherModel := embedded(2, "h")
+	otherModel.EmbeddingModel = "previous"
+
+	tests := map[string]struct {
+		p    *Product
+		want bool
+	}{
+		"up to date":      {embedded(3, "h"), false},
+		"no embedding":    {&Product{ID: 4, ContentHash: "h"}, true},
+		"content changed": {changed, true},
+		"other model":     {otherModel, true},
+		"no hash":         {embedded(5, ""), true},
+	}
+	for name, tt := range tests {
+		if got := tt.p.NeedsEmbedding("constant"); got != tt.want {
+			t.Errorf("%s: NeedsEmbedding = %v, want %v", name, got, tt.want)
+		}
+	}
+}
+
+func TestEnqueueReembed(t *testing.T) {
+	uc := &ProductUsecase{embedder: constantEmbedder{}, reembed: make(chan int64, 1), log: log.NewHelper(log.DefaultLogger)}
+	changed := embedded(1, "old")
+	changed.ContentHash = "new"
+
+	uc.enqueueReembed(embedded(2, "h"))
+	if len(uc.reembed) != 0 {
+		t.Fatal("up-to-date product queued")
+	}
+	uc.enqueueReembed(changed)
+	if len(uc.reembed) != 1 || <-uc.reembed != 1 {
+		t.Fatal("changed product not queued")
+	}
+
+	// A full queue leaves products to the backfill instead of blocking
+	uc.enqueueReembed(changed)
+	uc.enqueueReembed(&Product{ID: 3})
+	if len(uc.reembed) != 1 {
+		t.Errorf("queue holds %d products, want 1", len(uc.reembed))
+	}
+}
+
+func TestReembedProducts(t *testing.T) {
+	changed := embedded(1, "old")
+	changed.ContentHash = "new"
+	repo := &reembedRepo{
+		products: map[int64]*Product{1: changed, 2: embedded(2, "h")},
+		saved:    make(map[int64]*ProductEmbedding),
+	}
+	uc := &ProductUsecase{repo: repo, embedder: constantEmbedder{}, log: log.NewHelper(log.DefaultLogger)}
+
+	// Product 2 was embedded and product 3 deleted since they were queued
+	uc.reembedProducts(context.Background(), map[int64]bool{1: true, 2: true, 3: true})
+
+	if len(repo.saved) != 1 {
+		t.Fatalf("saved embeddings of %d products, want 1", len(repo.saved))
+	}
+	if e := repo.saved[1]; e == nil || e.ContentHash != "new" || len(e.Vector) == 0 {
+		t.Errorf("product 1 saved %+v, want an embedding of content new", e)
+	}
+}
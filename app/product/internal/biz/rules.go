@@ -0,0 +1,375 @@
+package biz
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	v1 "yinni_backend/api/product/v1"
+	"yinni_backend/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var ErrSearchRuleNotFound = errors.NotFound(v1.ErrorReason_SEARCH_RULE_NOT_FOUND.String(), "search rule not found")
+
+// Search rule kinds.
+const (
+	SearchRuleTwoWay        = "two_way"
+	SearchRuleOneWay        = "one_way"
+	SearchRuleCategoryBoost = "category_boost"
+)
+
+const (
+	defaultRulesRefresh = time.Minute
+	maxCategoryBoost    = 10
+)
+
+// SearchRule rewrites queries containing one of its terms. Two-way rules
+// make every term match the others; one-way rules add Synonyms to queries
+// with a term; category boosts scale the score of results in Category.
+type SearchRule struct {
+	ID        int64
+	Kind      string
+	Terms     []string
+	Synonyms  []string
+	Category  string
+	Boost     float64
+	Enabled   bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Validate checks the rule has what its kind needs and normalises its terms.
+func (r *SearchRule) Validate() error {
+	r.Terms = normalizeTerms(r.Terms)
+	r.Synonyms = normalizeTerms(r.Synonyms)
+	r.Category = strings.TrimSpace(r.Category)
+
+	// Clear what the kind does not use
+	if r.Kind != SearchRuleOneWay {
+		r.Synonyms = nil
+	}
+	if r.Kind != SearchRuleCategoryBoost {
+		r.Category, r.Boost = "", 1
+	}
+
+	switch r.Kind {
+	case SearchRuleTwoWay:
+		if len(r.Terms) < 2 {
+			return invalidParameter("terms", "needs at least two terms for a two-way synonym")
+		}
+	case SearchRuleOneWay:
+		if len(r.Terms) == 0 {
+			return invalidParameter("terms", "is required")
+		}
+		if len(r.Synonyms) == 0 {
+			return invalidParameter("synonyms", "is required for a one-way synonym")
+		}
+	case SearchRuleCategoryBoost:
+		if len(r.Terms) == 0 {
+			return invalidParameter("terms", "is required")
+		}
+		if r.Category == "" {
+			return invalidParameter("category", "is required for a category boost")
+		}
+		if r.Boost <= 0 || r.Boost > maxCategoryBoost {
+			return invalidParameter("boost", "must be above 0 and at most 10")
+		}
+	default:
+		return invalidParameter("kind", "must be two_way, one_way or category_boost")
+	}
+	return nil
+}
+
+// normalizeTerms normalises terms like queries and drops empty and
+// duplicate ones.
+func normalizeTerms(terms []string) []string {
+	seen := make(map[string]bool, len(terms))
+	out := make([]string, 0, len(terms))
+	for _, t := range terms {
+		t = NormalizeSuggestion(t)
+		if t != "" && !seen[t] {
+			seen[t] = true
+			out = append(out, t)
+		}
+	}
+	return out
+}
+
+// SearchRuleRepo stores search rules.
+type SearchRuleRepo interface {
+	CreateSearchRule(ctx context.Context, rule *SearchRule) (*SearchRule, error)
+	// UpdateSearchRule replaces every field of the rule.
+	UpdateSearchRule(ctx context.Context, rule *SearchRule) (*SearchRule, error)
+	DeleteSearchRule(ctx context.Context, id int64) (*SearchRule, error)
+	// ListSearchRules returns every rule, newest first.
+	ListSearchRules(ctx context.Context) ([]*SearchRule, error)
+}
+
+// QueryRewrite is a query after the search rules were applied. Boosts maps
+// normalised category names to score multipliers.
+type QueryRewrite struct {
+	Query  string
+	Boosts map[string]float64
+}
+
+// Boost returns the multiplier for a product, 1 when no rule applies. Of
+// its category and sub-category, the larger boost counts.
+func (q *QueryRewrite) Boost(p *Product) float64 {
+	if len(q.Boosts) == 0 {
+		return 1
+	}
+	c, okC := q.Boosts[NormalizeSuggestion(p.Category)]
+	s, okS := q.Boosts[NormalizeSuggestion(p.SubCategory)]
+	switch {
+	case okC && okS:
+		return max(c, s)
+	case okC:
+		return c
+	case okS:
+		return s
+	}
+	return 1
+}
+
+// ruleSet is the enabled rules compiled for matching. Keys are normalised
+// terms; maxWords is the word count of the longest term.
+type ruleSet struct {
+	expansions map[string][]string
+	boosts     map[string]map[string]float64
+	maxWords   int
+}
+
+func compileRules(rules []*SearchRule) *ruleSet {
+	rs := &ruleSet{
+		expansions: make(map[string][]string),
+		boosts:     make(map[string]map[string]float64),
+	}
+	addTerm := func(term string) {
+		rs.maxWords = max(rs.maxWords, len(strings.Fields(term)))
+	}
+
+	for _, r := range rules {
+		if !r.Enabled {
+			continue
+		}
+		for _, term := range r.Terms {
+			addTerm(term)
+			switch r.Kind {
+			case SearchRuleTwoWay:
+				for _, other := range r.Terms {
+					if other != term {
+						rs.expansions[term] = append(rs.expansions[term], other)
+					}
+				}
+			case SearchRuleOneWay:
+				rs.expansions[term] = append(rs.expansions[term], r.Synonyms...)
+			case SearchRuleCategoryBoost:
+				if rs.boosts[term] == nil {
+					rs.boosts[term] = make(map[string]float64)
+				}
+				rs.boosts[term][NormalizeSuggestion(r.Category)] = r.Boost
+			}
+		}
+	}
+	return rs
+}
+
+// rewrite appends the expansions of every term found in query as optional
+// terms, quoting multi-word ones, and collects the category boosts. Words
+// carrying search operators (+required, -excluded, prefix*, "phrases") are
+// not matched.
+func (rs *ruleSet) rewrite(query string) *QueryRewrite {
+	out := &QueryRewrite{Query: query}
+	words := strings.Fields(strings.ToLower(query))
+	if len(words) == 0 || rs.maxWords == 0 {
+		return out
+	}
+
+	plain := make([]string, len(words))
+	for i, w := range words {
+		if !strings.ContainsAny(w, `+*"`) && !strings.HasPrefix(w, "-") {
+			plain[i] = w
+		}
+	}
+	present := make(map[string]bool)
+	for n := 1; n <= rs.maxWords; n++ {
+		for i := 0; i+n <= len(plain); i++ {
+			present[strings.Join(plain[i:i+n], " ")] = true
+		}
+	}
+
+	var (
+		added []string
+		seen  = make(map[string]bool)
+	)
+	for n := 1; n <= rs.maxWords; n++ {
+		for i := 0; i+n <= len(plain); i++ {
+			if slices.Contains(plain[i:i+n], "") {
+				continue
+			}
+			term := strings.Join(plain[i:i+n], " ")
+			for _, exp := range rs.expansions[term] {
+				if !present[exp] && !seen[exp] {
+					seen[exp] = true
+					added = append(added, exp)
+				}
+			}
+			for category, boost := range rs.boosts[term] {
+				if out.Boosts == nil {
+					out.Boosts = make(map[string]float64)
+				}
+				if cur, ok := out.Boosts[category]; !ok || boost > cur {
+					out.Boosts[category] = boost
+				}
+			}
+		}
+	}
+
+	if len(added) > 0 {
+		parts := []string{query}
+		for _, exp := range added {
+			if strings.Contains(exp, " ") {
+				exp = `"` + exp + `"`
+			}
+			parts = append(parts, exp)
+		}
+		out.Query = strings.Join(parts, " ")
+	}
+	return out
+}
+
+// SearchRules keeps the enabled search rules in memory. Changes made through
+// it apply at once; changes made through other instances are picked up on
+// the next periodic reload.
+type SearchRules struct {
+	repo    SearchRuleRepo
+	rules   atomic.Pointer[ruleSet]
+	refresh time.Duration
+	log     *log.Helper
+}
+
+// NewSearchRules loads the rules in the background and reloads them every
+// refresh interval until cleanup. Until the first load, queries pass
+// through unchanged.
+func NewSearchRules(repo SearchRuleRepo, c *conf.Search, logger log.Logger) (*SearchRules, func()) {
+	sr := &SearchRules{
+		repo:    repo,
+		refresh: c.GetRulesRefreshInterval().AsDuration(),
+		log:     log.NewHelper(logger),
+	}
+	if sr.refresh <= 0 {
+		sr.refresh = defaultRulesRefresh
+	}
+	sr.rules.Store(compileRules(nil))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		sr.run(ctx)
+	}()
+
+	return sr, func() {
+		cancel()
+		wg.Wait()
+	}
+}
+
+// Rewrite applies the rules to a search query. A nil SearchRules leaves
+// the query unchanged.
+func (sr *SearchRules) Rewrite(query string) *QueryRewrite {
+	if sr == nil {
+		return &QueryRewrite{Query: query}
+	}
+	return sr.rules.Load().rewrite(query)
+}
+
+func (sr *SearchRules) run(ctx context.Context) {
+	ticker := time.NewTicker(sr.refresh)
+	defer ticker.Stop()
+
+	for {
+		if err := sr.reload(ctx); err != nil && ctx.Err() == nil {
+			sr.log.Errorf("Failed to reload search rules: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (sr *SearchRules) reload(ctx context.Context) error {
+	rules, err := sr.repo.ListSearchRules(ctx)
+	if err != nil {
+		return err
+	}
+	sr.rules.Store(compileRules(rules))
+	return nil
+}
+
+// reloadAfterChange applies a change at once. The change is saved either
+// way, so a failed reload is only logged; the periodic reload retries.
+func (sr *SearchRules) reloadAfterChange(ctx context.Context) {
+	if err := sr.reload(ctx); err != nil {
+		sr.log.Errorf("Failed to reload search rules after a change: %v", err)
+	}
+}
+
+// ListRules returns every rule, enabled or not.
+func (sr *SearchRules) ListRules(ctx context.Context) ([]*SearchRule, error) {
+	return sr.repo.ListSearchRules(ctx)
+}
+
+// CreateRule validates and stores a new rule.
+func (sr *SearchRules) CreateRule(ctx context.Context, rule *SearchRule) (*SearchRule, error) {
+	sr.log.Infof("CreateSearchRule: kind=%s, terms=%v", rule.Kind, rule.Terms)
+
+	if err := rule.Validate(); err != nil {
+		return nil, err
+	}
+	created, err := sr.repo.CreateSearchRule(ctx, rule)
+	if err != nil {
+		return nil, err
+	}
+	sr.reloadAfterChange(ctx)
+	return created, nil
+}
+
+// UpdateRule validates and replaces a rule.
+func (sr *SearchRules) UpdateRule(ctx context.Context, rule *SearchRule) (*SearchRule, error) {
+	sr.log.Infof("UpdateSearchRule: %d", rule.ID)
+
+	if rule.ID <= 0 {
+		return nil, invalidParameter("id", "is required")
+	}
+	if err := rule.Validate(); err != nil {
+		return nil, err
+	}
+	updated, err := sr.repo.UpdateSearchRule(ctx, rule)
+	if err != nil {
+		return nil, err
+	}
+	sr.reloadAfterChange(ctx)
+	return updated, nil
+}
+
+// DeleteRule removes a rule.
+func (sr *SearchRules) DeleteRule(ctx context.Context, id int64) (*SearchRule, error) {
+	sr.log.Infof("DeleteSearchRule: %d", id)
+
+	deleted, err := sr.repo.DeleteSearchRule(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	sr.reloadAfterChange(ctx)
+	return deleted, nil
+}
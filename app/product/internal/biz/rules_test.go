@@ -0,0 +1,181 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+
+	"yinni_backend/internal/conf"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// ruleRepo keeps search rules in memory.
+type ruleRepo struct {
+	mu     sync.Mutex
+	rules  []*SearchRule
+	nextID int64
+}
+
+func (r *ruleRepo) CreateSearchRule(ctx context.Context, rule *SearchRule) (*SearchRule, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.nextID++
+	created := *rule
+	created.ID = r.nextID
+	r.rules = append(r.rules, &created)
+	return &created, nil
+}
+
+func (r *ruleRepo) UpdateSearchRule(ctx context.Context, rule *SearchRule) (*SearchRule, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, cur := range r.rules {
+		if cur.ID == rule.ID {
+			updated := *rule
+			r.rules[i] = &updated
+			return &updated, nil
+		}
+	}
+	return nil, ErrSearchRuleNotFound
+}
+
+func (r *ruleRepo) DeleteSearchRule(ctx context.Context, id int64) (*SearchRule, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, cur := range r.rules {
+		if cur.ID == id {
+			r.rules = slices.Delete(r.rules, i, i+1)
+			return cur, nil
+		}
+	}
+	return nil, ErrSearchRuleNotFound
+}
+
+func (r *ruleRepo) ListSearchRules(ctx context.Context) ([]*SearchRule, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.rules), nil
+}
+
+func TestSearchRuleValidate(t *testing.T) {
+	valid := []*SearchRule{
+		{Kind: SearchRuleTwoWay, Terms: []string{"sofa", "couch"}},
+		{Kind: SearchRuleOneWay, Terms: []string{"tee"}, Synonyms: []string{"t-shirt"}},
+		{Kind: SearchRuleCategoryBoost, Terms: []string{"jeans"}, Category: "Clothing", Boost: 2},
+	}
+	for _, r := range valid {
+		if err := r.Validate(); err != nil {
+			t.Errorf("%s rule: %v", r.Kind, err)
+		}
+	}
+
+	invalid := map[string]*SearchRule{
+		"two-way with one term":  {Kind: SearchRuleTwoWay, Terms: []string{"sofa", " SOFA "}},
+		"one-way synonyms":       {Kind: SearchRuleOneWay, Terms: []string{"tee"}},
+		"boost without category": {Kind: SearchRuleCategoryBoost, Terms: []string{"jeans"}, Boost: 2},
+		"boost too large":        {Kind: SearchRuleCategoryBoost, Terms: []string{"jeans"}, Category: "Clothing", Boost: 11},
+		"unknown kind":           {Kind: "regex", Terms: []string{"jeans"}},
+	}
+	for name, r := range invalid {
+		if err := r.Validate(); !kerrors.IsBadRequest(err) {
+			t.Errorf("%s: err = %v, want bad request", name, err)
+		}
+	}
+
+	// Fields the kind does not use are cleared
+	r := &SearchRule{Kind: SearchRuleTwoWay, Terms: []string{"Sofa", "couch", "sofa"}, Synonyms: []string{"x"}, Category: "c", Boost: 5}
+	if err := r.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(r.Terms, []string{"sofa", "couch"}) || r.Synonyms != nil || r.Category != "" || r.Boost != 1 {
+		t.Errorf("validated rule %+v", r)
+	}
+}
+
+func TestRuleSetRewrite(t *testing.T) {
+	rs := compileRules([]*SearchRule{
+		{Kind: SearchRuleTwoWay, Terms: []string{"sofa", "couch"}, Enabled: true},
+		{Kind: SearchRuleOneWay, Terms: []string{"tee"}, Synonyms: []string{"t shirt"}, Enabled: true},
+		{Kind: SearchRuleCategoryBoost, Terms: []string{"blue jeans"}, Category: "Clothing", Boost: 2, Enabled: true},
+		{Kind: SearchRuleCategoryBoost, Terms: []string{"jeans"}, Category: "clothing", Boost: 3, Enabled: true},
+		{Kind: SearchRuleOneWay, Terms: []string{"pants"}, Synonyms: []string{"trousers"}},
+	})
+
+	tests := []struct {
+		query, want string
+	}{
+		{"leather sofa", "leather sofa couch"},
+		// The other terms already present are not added again
+		{"sofa couch", "sofa couch"},
+		// One-way rules do not apply backwards; multi-word synonyms are quoted
+		{"tee", `tee "t shirt"`},
+		{"t shirt", "t shirt"},
+		// Terms carrying search operators are left alone
+		{"-sofa +tee*", "-sofa +tee*"},
+		// Disabled rules do not apply
+		{"pants", "pants"},
+	}
+	for _, tt := range tests {
+		if got := rs.rewrite(tt.query).Query; got != tt.want {
+			t.Errorf("rewrite(%q) = %q, want %q", tt.query, got, tt.want)
+		}
+	}
+
+	// The largest boost of the matching terms counts
+	rw := rs.rewrite("Blue Jeans")
+	if len(rw.Boosts) != 1 || rw.Boosts["clothing"] != 3 {
+		t.Errorf("boosts = %v, want clothing 3", rw.Boosts)
+	}
+	if b := rw.Boost(&Product{Category: "Clothing"}); b != 3 {
+		t.Errorf("boost of a clothing product = %v, want 3", b)
+	}
+	if b := rw.Boost(&Product{Category: "Footwear"}); b != 1 {
+		t.Errorf("boost of a footwear product = %v, want 1", b)
+	}
+}
+
+func TestSearchRulesApplyChanges(t *testing.T) {
+	repo := &ruleRepo{}
+	sr, cleanup := NewSearchRules(repo, &conf.Search{}, log.DefaultLogger)
+	defer cleanup()
+	ctx := context.Background()
+
+	created, err := sr.CreateRule(ctx, &SearchRule{Kind: SearchRuleTwoWay, Terms: []string{"sofa", "couch"}, Enabled: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := sr.Rewrite("sofa").Query; got != "sofa couch" {
+		t.Errorf("after create: rewrite = %q, want %q", got, "sofa couch")
+	}
+
+	created.Enabled = false
+	if _, err := sr.UpdateRule(ctx, created); err != nil {
+		t.Fatal(err)
+	}
+	if got := sr.Rewrite("sofa").Query; got != "sofa" {
+		t.Errorf("after disabling: rewrite = %q, want %q", got, "sofa")
+	}
+
+	if _, err := sr.DeleteRule(ctx, created.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sr.DeleteRule(ctx, created.ID); !errors.Is(err, ErrSearchRuleNotFound) {
+		t.Errorf("deleting twice: err = %v, want ErrSearchRuleNotFound", err)
+	}
+	if _, err := sr.CreateRule(ctx, &SearchRule{Kind: SearchRuleOneWay, Terms: []string{"tee"}}); !kerrors.IsBadRequest(err) {
+		t.Errorf("invalid rule: err = %v, want bad request", err)
+	}
+	if rules, _ := repo.ListSearchRules(ctx); len(rules) != 0 {
+		t.Errorf("%d rules stored, want 0", len(rules))
+	}
+
+	// Queries pass through a nil SearchRules
+	var none *SearchRules
+	if got := none.Rewrite("sofa").Query; got != "sofa" {
+		t.Errorf("nil rules: rewrite = %q", got)
+	}
+}
@@ -0,0 +1,212 @@
+package biz
+
+import (
+	"strings"
+	"sync/atomic"
+	"unicode"
+)
+
+// minCorrectableLen is the shortest word spelling correction touches;
+// shorter words have too many neighbours within one edit.
+const minCorrectableLen = 3
+
+// SpellChecker corrects misspelled query words against a vocabulary of the
+// brands, categories and search keywords in the catalog. It is rebuilt with
+// the query suggestions and safe for concurrent use.
+type SpellChecker struct {
+	vocab atomic.Pointer[vocabulary]
+}
+
+// vocabulary maps the compact form of each term (lower-case letters and
+// digits only) to the term, so "tshirt" finds "t-shirt". byLen groups the
+// compact forms by rune count for the edit distance scan.
+type vocabulary struct {
+	terms map[string]*vocabTerm
+	byLen map[int][]string
+}
+
+type vocabTerm struct {
+	text  string
+	count int64
+}
+
+// NewSpellChecker creates a spell checker with an empty vocabulary.
+func NewSpellChecker() *SpellChecker {
+	s := &SpellChecker{}
+	s.vocab.Store(&vocabulary{})
+	return s
+}
+
+// Rebuild replaces the vocabulary with the terms of sources. Multi-word
+// brands and categories contribute each word as well as the whole name.
+func (s *SpellChecker) Rebuild(sources []*SuggestionSource) {
+	variants := make(map[string]map[string]int64)
+	add := func(text string) {
+		text = NormalizeSuggestion(text)
+		key := compactTerm(text)
+		if len([]rune(key)) < minCorrectableLen {
+			return
+		}
+		if variants[key] == nil {
+			variants[key] = make(map[string]int64)
+		}
+		variants[key][text]++
+	}
+	addName := func(name string) {
+		add(name)
+		if words := strings.Fields(name); len(words) > 1 {
+			for _, w := range words {
+				add(w)
+			}
+		}
+	}
+
+	for _, src := range sources {
+		addName(src.Brand)
+		addName(src.Category)
+		addName(src.SubCategory)
+		for _, kw := range src.Keywords {
+			add(kw)
+		}
+	}
+
+	v := &vocabulary{
+		terms: make(map[string]*vocabTerm, len(variants)),
+		byLen: make(map[int][]string),
+	}
+	for key, texts := range variants {
+		// The commonest spelling stands for the term
+		term := &vocabTerm{}
+		var best int64
+		for text, n := range texts {
+			term.count += n
+			if n > best || (n == best && text < term.text) {
+				term.text, best = text, n
+			}
+		}
+		v.terms[key] = term
+		l := len([]rune(key))
+		v.byLen[l] = append(v.byLen[l], key)
+	}
+	s.vocab.Store(v)
+}
+
+// Correct rewrites the words of query that are not in the vocabulary to the
+// closest term within maxEdits, preferring fewer edits, then more common
+// terms. It reports whether anything changed.
+func (s *SpellChecker) Correct(query string) (string, bool) {
+	v := s.vocab.Load()
+	if len(v.terms) == 0 {
+		return query, false
+	}
+
+	words := strings.Fields(strings.ToLower(query))
+	changed := false
+	for i, w := range words {
+		if fixed, ok := v.correctWord(w); ok {
+			words[i] = fixed
+			changed = true
+		}
+	}
+	if !changed {
+		return query, false
+	}
+	return strings.Join(words, " "), true
+}
+
+func (v *vocabulary) correctWord(word string) (string, bool) {
+	// Leave search operators and numbers alone
+	if strings.ContainsAny(word, `+*"`) || strings.HasPrefix(word, "-") || !strings.ContainsFunc(word, unicode.IsLetter) {
+		return "", false
+	}
+	key := compactTerm(word)
+	n := len([]rune(key))
+	if n < minCorrectableLen {
+		return "", false
+	}
+	if t, ok := v.terms[key]; ok {
+		return t.text, t.text != word
+	}
+
+	limit := maxEdits(n)
+	var (
+		best     *vocabTerm
+		bestDist = limit + 1
+	)
+	for l := n - limit; l <= n+limit; l++ {
+		for _, cand := range v.byLen[l] {
+			d := editDistance(key, cand, bestDist)
+			if d > limit {
+				continue
+			}
+			t := v.terms[cand]
+			if best == nil || d < bestDist || d == bestDist && (t.count > best.count || t.count == best.count && t.text < best.text) {
+				best, bestDist = t, d
+			}
+		}
+	}
+	if best == nil {
+		return "", false
+	}
+	return best.text, true
+}
+
+// maxEdits is the edit budget for a word of n runes.
+func maxEdits(n int) int {
+	switch {
+	case n <= 4:
+		return 1
+	case n <= 8:
+		return 2
+	default:
+		return 3
+	}
+}
+
+// compactTerm keeps the lower-case letters and digits of s.
+func compactTerm(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsNumber(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, s)
+}
+
+// editDistance is the optimal string alignment distance between a and b:
+// insertions, deletions, substitutions and transpositions of adjacent runes
+// each cost one. It gives up once the distance must exceed bound, returning
+// bound+1.
+func editDistance(a, b string, bound int) int {
+	ra, rb := []rune(a), []rune(b)
+	if diff := len(ra) - len(rb); diff > bound || -diff > bound {
+		return bound + 1
+	}
+
+	prev2 := make([]int, len(rb)+1)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		rowMin := cur[0]
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(min(prev[j]+1, cur[j-1]+1), prev[j-1]+cost)
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				cur[j] = min(cur[j], prev2[j-2]+1)
+			}
+			rowMin = min(rowMin, cur[j])
+		}
+		if rowMin > bound {
+			return bound + 1
+		}
+		prev2, prev, cur = prev, cur, prev2
+	}
+	return prev[len(rb)]
+}
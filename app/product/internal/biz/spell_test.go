@@ -0,0 +1,143 @@
+package biz
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"yinni_backend/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b  string
+		bound int
+		want  int
+	}{
+		{"shirt", "shirt", 3, 0},
+		{"shirt", "shirts", 3, 1},
+		{"shirt", "shrit", 3, 1}, // transposition
+		{"shirt", "short", 3, 1},
+		{"jeans", "jenas", 3, 1},
+		{"kitten", "sitting", 3, 3},
+		{"", "abc", 3, 3},
+		{"café", "cafe", 3, 1}, // runes, not bytes
+		// Past the bound the result is bound+1
+		{"kitten", "sitting", 2, 3},
+		{"a", "abcdef", 2, 3},
+	}
+	for _, tt := range tests {
+		if got := editDistance(tt.a, tt.b, tt.bound); got != tt.want {
+			t.Errorf("editDistance(%q, %q, %d) = %d, want %d", tt.a, tt.b, tt.bound, got, tt.want)
+		}
+	}
+}
+
+func newSpellChecker() *SpellChecker {
+	s := NewSpellChecker()
+	s.Rebuild([]*SuggestionSource{
+		{Brand: "Nike", Category: "Clothing", SubCategory: "T-Shirts", Keywords: []string{"sneakers", "running"}},
+		{Brand: "Nike", Category: "Clothing", SubCategory: "Shirts", Keywords: []string{"shorts"}},
+		{Brand: "Louis Philippe", Category: "Clothing", SubCategory: "Shirts"},
+		{Brand: "Levis", Category: "Clothing", SubCategory: "Jeans", Keywords: []string{"denim"}},
+	})
+	return s
+}
+
+func TestSpellCorrect(t *testing.T) {
+	s := newSpellChecker()
+	tests := []struct {
+		query string
+		want  string
+		ok    bool
+	}{
+		{"nkie sneekers", "nike sneakers", true},
+		{"Runing", "running", true},
+		// The compact form finds the hyphenated spelling
+		{"tshirts", "t-shirts", true},
+		// Words of multi-word brands are terms of their own
+		{"philipe shirts", "philippe shirts", true},
+		// Fewer edits win: "shirts" is one away, "shorts" two
+		{"shirst", "shirts", true},
+		{"nike jeans", "nike jeans", false},
+		{"levi jeans", "levis jeans", true},
+		// Short words, numbers and search operators are left alone
+		{"nike 501 xl", "nike 501 xl", false},
+		{"+denm", "+denm", false},
+		{"-denm", "-denm", false},
+		{`"denm"`, `"denm"`, false},
+		// Too far from any term
+		{"xylophone", "xylophone", false},
+	}
+	for _, tt := range tests {
+		got, ok := s.Correct(tt.query)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("Correct(%q) = %q, %t, want %q, %t", tt.query, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestSpellCorrectPrefersCommonTerms(t *testing.T) {
+	s := NewSpellChecker()
+	s.Rebuild([]*SuggestionSource{
+		{Keywords: []string{"boot"}},
+		{Keywords: []string{"boat"}},
+		{Keywords: []string{"boat"}},
+	})
+	// "bot" is one edit from both
+	if got, _ := s.Correct("bot"); got != "boat" {
+		t.Errorf("Correct(%q) = %q, want the commoner %q", "bot", got, "boat")
+	}
+}
+
+func TestSpellCorrectEmptyVocabulary(t *testing.T) {
+	if got, ok := NewSpellChecker().Correct("nkie"); ok || got != "nkie" {
+		t.Errorf("Correct = %q, %t, want the query unchanged", got, ok)
+	}
+}
+
+// spellRepo matches products only for the corrected query.
+type spellRepo struct {
+	ProductRepo
+	queries []string
+}
+
+func (r *spellRepo) SearchProducts(ctx context.Context, query string, params *ListProductsParams) ([]*Product, int64, error) {
+	r.queries = append(r.queries, query)
+	if query == "nike sneakers" {
+		return products(1), 1, nil
+	}
+	return nil, 0, nil
+}
+
+func TestHybridSearchCorrectsZeroHitQueries(t *testing.T) {
+	repo := &spellRepo{}
+	uc, cleanup := NewProductUsecase(repo, nil, nil, newSpellChecker(), nil, &conf.Embeddings{}, &conf.Search{}, log.DefaultLogger)
+	t.Cleanup(cleanup)
+
+	result, err := uc.HybridSearch(context.Background(), "nkie sneekers", 10, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.CorrectedQuery != "nike sneakers" || len(result.Hits) != 1 {
+		t.Errorf("got %d hits for %q, want 1 for %q", len(result.Hits), result.CorrectedQuery, "nike sneakers")
+	}
+
+	// Queries that match are not corrected, nor are ones that match nothing
+	// after correction either
+	repo.queries = nil
+	for _, q := range []string{"nike sneakers", "xylophone"} {
+		result, err := uc.HybridSearch(context.Background(), q, 10, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.CorrectedQuery != "" {
+			t.Errorf("%q corrected to %q", q, result.CorrectedQuery)
+		}
+	}
+	if want := []string{"nike sneakers", "xylophone"}; !slices.Equal(repo.queries, want) {
+		t.Errorf("searched %q, want %q", repo.queries, want)
+	}
+}
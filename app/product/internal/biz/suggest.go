@@ -0,0 +1,208 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"yinni_backend/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Suggestion kinds. When several kinds produce the same text, the earlier
+// one here is reported.
+const (
+	SuggestionBrand    = "brand"
+	SuggestionCategory = "category"
+	SuggestionTitle    = "title"
+	SuggestionKeyword  = "keyword"
+)
+
+var suggestionKindRank = map[string]int{
+	SuggestionBrand:    0,
+	SuggestionCategory: 1,
+	SuggestionTitle:    2,
+	SuggestionKeyword:  3,
+}
+
+const (
+	defaultSuggestRefresh = 10 * time.Minute
+	defaultSuggestLimit   = 10
+	maxSuggestLimit       = 50
+
+	// A click says more about interest than a view.
+	suggestClickWeight = 5
+)
+
+// Suggestion is a query completion. Popularity sums the weighted views and
+// clicks of the products it came from.
+type Suggestion struct {
+	Text       string
+	Kind       string
+	Popularity int64
+}
+
+// SuggestionSource is the part of a product that suggestions are drawn from.
+type SuggestionSource struct {
+	Title       string
+	Brand       string
+	Category    string
+	SubCategory string
+	Keywords    []string
+	Views       int64
+	Clicks      int64
+}
+
+// SuggestIndex completes query prefixes. Implementations must be safe for
+// concurrent use.
+type SuggestIndex interface {
+	// Replace swaps the indexed suggestions for a new set. Texts must be
+	// normalised with NormalizeSuggestion.
+	Replace(suggestions []*Suggestion)
+	// Complete returns up to limit suggestions starting with the normalised
+	// prefix, most popular first.
+	Complete(prefix string, limit int) []*Suggestion
+	// Len returns the number of indexed suggestions.
+	Len() int
+}
+
+// NormalizeSuggestion lower-cases s and collapses its whitespace, so
+// prefixes match regardless of case and spacing.
+func NormalizeSuggestion(s string) string {
+	return strings.Join(strings.Fields(strings.ToLower(s)), " ")
+}
+
+// QuerySuggester serves SuggestQueries from an in-memory index that is
+// rebuilt from the catalog in the background.
+type QuerySuggester struct {
+	repo    ProductRepo
+	index   SuggestIndex
+	spell   *SpellChecker
+	refresh time.Duration
+	log     *log.Helper
+}
+
+// NewQuerySuggester builds the suggestion index, and the spell checker's
+// vocabulary from the same catalog scan, in the background and keeps
+// rebuilding them every refresh interval until cleanup.
+func NewQuerySuggester(repo ProductRepo, index SuggestIndex, spell *SpellChecker, c *conf.Search, logger log.Logger) (*QuerySuggester, func()) {
+	s := &QuerySuggester{
+		repo:    repo,
+		index:   index,
+		spell:   spell,
+		refresh: c.GetSuggestRefreshInterval().AsDuration(),
+		log:     log.NewHelper(logger),
+	}
+	if s.refresh <= 0 {
+		s.refresh = defaultSuggestRefresh
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		s.run(ctx)
+	}()
+
+	return s, func() {
+		cancel()
+		wg.Wait()
+	}
+}
+
+// Suggest returns completions of prefix. An empty prefix returns the most
+// popular suggestions. Until the first build finishes nothing is suggested.
+func (s *QuerySuggester) Suggest(ctx context.Context, prefix string, limit int) ([]*Suggestion, error) {
+	if limit <= 0 {
+		limit = defaultSuggestLimit
+	}
+	if limit > maxSuggestLimit {
+		limit = maxSuggestLimit
+	}
+	return s.index.Complete(NormalizeSuggestion(prefix), limit), nil
+}
+
+func (s *QuerySuggester) run(ctx context.Context) {
+	ticker := time.NewTicker(s.refresh)
+	defer ticker.Stop()
+
+	for {
+		if err := s.rebuild(ctx); err != nil && ctx.Err() == nil {
+			s.log.Errorf("Failed to rebuild query suggestions: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *QuerySuggester) rebuild(ctx context.Context) error {
+	start := time.Now()
+	sources, err := s.repo.ListSuggestionSources(ctx)
+	if err != nil {
+		return err
+	}
+
+	suggestions := collectSuggestions(sources)
+	s.index.Replace(suggestions)
+	s.spell.Rebuild(sources)
+	s.log.Infof("Rebuilt query suggestions: %d suggestions from %d products in %v",
+		len(suggestions), len(sources), time.Since(start))
+	return nil
+}
+
+// collectSuggestions turns products into suggestions, one per distinct
+// normalised text. The popularity of a text as a kind sums the popularity of
+// every product that produced it as that kind. A suggestion takes the
+// highest-ranked of its kinds, for display, and independently the highest
+// of its popularities, so a text is not ranked down for also being, say, a
+// rarely viewed brand.
+func collectSuggestions(sources []*SuggestionSource) []*Suggestion {
+	type key struct{ text, kind string }
+	popularity := make(map[key]int64)
+
+	for _, src := range sources {
+		pop := src.Views + suggestClickWeight*src.Clicks
+		seen := make(map[key]bool)
+		add := func(text, kind string) {
+			k := key{NormalizeSuggestion(text), kind}
+			if k.text == "" || seen[k] {
+				return
+			}
+			seen[k] = true
+			popularity[k] += pop
+		}
+
+		add(src.Brand, SuggestionBrand)
+		add(src.Category, SuggestionCategory)
+		add(src.SubCategory, SuggestionCategory)
+		add(src.Title, SuggestionTitle)
+		for _, kw := range src.Keywords {
+			add(kw, SuggestionKeyword)
+		}
+	}
+
+	byText := make(map[string]*Suggestion, len(popularity))
+	for k, pop := range popularity {
+		s, ok := byText[k.text]
+		if !ok {
+			byText[k.text] = &Suggestion{Text: k.text, Kind: k.kind, Popularity: pop}
+			continue
+		}
+		if suggestionKindRank[k.kind] < suggestionKindRank[s.Kind] {
+			s.Kind = k.kind
+		}
+		s.Popularity = max(s.Popularity, pop)
+	}
+
+	suggestions := make([]*Suggestion, 0, len(byText))
+	for _, s := range byText {
+		suggestions = append(suggestions, s)
+	}
+	return suggestions
+}
@@ -0,0 +1,62 @@
+package biz
+
+import "testing"
+
+func TestNormalizeSuggestion(t *testing.T) {
+	tests := map[string]string{
+		"Red  Shirt":  "red shirt",
+		"  JEANS\t ":  "jeans",
+		"":            "",
+		"\n":          "",
+		"Levi's 501 ": "levi's 501",
+	}
+	for in, want := range tests {
+		if got := NormalizeSuggestion(in); got != want {
+			t.Errorf("NormalizeSuggestion(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestCollectSuggestions(t *testing.T) {
+	sources := []*SuggestionSource{
+		{Title: "Nike Air", Brand: "Nike", Category: "Shoes", SubCategory: "Sneakers", Keywords: []string{"nike", "running"}, Views: 10, Clicks: 1},
+		{Title: "Nike Tee", Brand: "NIKE ", Category: "Clothing", SubCategory: "Shoes", Views: 3},
+		{Title: "Shoes", Brand: "Bata", Category: "Footwear", SubCategory: "Shoes", Views: 100},
+	}
+
+	byText := make(map[string]*Suggestion)
+	for _, s := range collectSuggestions(sources) {
+		if byText[s.Text] != nil {
+			t.Fatalf("%q suggested twice", s.Text)
+		}
+		byText[s.Text] = s
+	}
+
+	tests := []struct {
+		text       string
+		kind       string
+		popularity int64
+	}{
+		// Popularity sums across products per kind; the brand's 18 beats
+		// the keyword's 15 instead of adding to it
+		{"nike", SuggestionBrand, 15 + 3},
+		{"nike air", SuggestionTitle, 15},
+		{"running", SuggestionKeyword, 15},
+		// A category and sub-category of three products and a title
+		{"shoes", SuggestionCategory, 15 + 3 + 100},
+		{"sneakers", SuggestionCategory, 15},
+	}
+	for _, tt := range tests {
+		s := byText[tt.text]
+		if s == nil {
+			t.Errorf("%q not suggested", tt.text)
+			continue
+		}
+		if s.Kind != tt.kind || s.Popularity != tt.popularity {
+			t.Errorf("%q = %s with popularity %d, want %s with %d", tt.text, s.Kind, s.Popularity, tt.kind, tt.popularity)
+		}
+	}
+	if len(byText) != 9 {
+		t.Errorf("got %d suggestions, want 9", len(byText))
+	}
+}
@@ -0,0 +1,250 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"yinni_backend/app/product/internal/biz"
+	"yinni_backend/ent"
+	"yinni_backend/ent/embeddingfailure"
+	"yinni_backend/ent/embeddingjob"
+
+	"entgo.io/ent/dialect"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// backfillJobName names the embedding_jobs row of the backfill worker.
+const backfillJobName = "backfill"
+
+// backfillLockName is the advisory lock held by the instance running the
+// backfill.
+const backfillLockName = "yinni_backend.embedding_backfill"
+
+type backfillRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewBackfillRepo creates a new embedding backfill repository.
+func NewBackfillRepo(data *Data, logger log.Logger) biz.BackfillRepo {
+	return &backfillRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// AcquireBackfillLease takes a MySQL advisory lock on a connection of its
+// own. The lock is released with the connection, so a crashed instance does
+// not keep it. Other dialects run a single instance and are not locked.
+func (r *backfillRepo) AcquireBackfillLease(ctx context.Context) (biz.BackfillLease, error) {
+	if r.data.dialect != dialect.MySQL {
+		return noLease{}, nil
+	}
+
+	conn, err := r.data.db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var got sql.NullInt64
+	if err := conn.QueryRowContext(ctx, "SELECT GET_LOCK(?, 0)", backfillLockName).Scan(&got); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("lock embedding backfill: %w", err)
+	}
+	if got.Int64 != 1 {
+		conn.Close()
+		return nil, nil
+	}
+	return &backfillLease{conn: conn, log: r.log}, nil
+}
+
+// backfillLease holds the backfill lock on conn.
+type backfillLease struct {
+	conn *sql.Conn
+	log  *log.Helper
+}
+
+// Check verifies the lock is still held by the lease's connection, which
+// also keeps the connection from idling out.
+func (l *backfillLease) Check(ctx context.Context) error {
+	var held sql.NullBool
+	err := l.conn.QueryRowContext(ctx, "SELECT IS_USED_LOCK(?) = CONNECTION_ID()", backfillLockName).Scan(&held)
+	if err != nil {
+		return fmt.Errorf("check embedding backfill lock: %w", err)
+	}
+	if !held.Bool {
+		return errors.New("embedding backfill lock lost")
+	}
+	return nil
+}
+
+func (l *backfillLease) Release() {
+	if _, err := l.conn.ExecContext(context.Background(), "SELECT RELEASE_LOCK(?)", backfillLockName); err != nil {
+		l.log.Errorf("Failed to release the embedding backfill lock: %v", err)
+	}
+	l.conn.Close()
+}
+
+type noLease struct{}
+
+func (noLease) Check(context.Context) error { return nil }
+func (noLease) Release()                    {}
+
+func (r *backfillRepo) LoadBackfillState(ctx context.Context) (*biz.BackfillState, error) {
+	job, err := r.data.ent.EmbeddingJob.Query().
+		Where(embeddingjob.Name(backfillJobName)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return &biz.BackfillState{Status: biz.BackfillIdle}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	state := &biz.BackfillState{
+		Status:    string(job.Status),
+		Cursor:    int64(job.Cursor),
+		Processed: int64(job.Processed),
+		Failed:    int64(job.Failed),
+		LastError: job.LastError,
+	}
+	if job.StartedAt != nil {
+		state.StartedAt = *job.StartedAt
+	}
+	if job.FinishedAt != nil {
+		state.FinishedAt = *job.FinishedAt
+	}
+	return state, nil
+}
+
+// SaveBackfillState upserts the job row. Existence is checked first because
+// MySQL reports no affected rows for an update that changes nothing.
+func (r *backfillRepo) SaveBackfillState(ctx context.Context, state *biz.BackfillState) error {
+	exists, err := r.data.ent.EmbeddingJob.Query().
+		Where(embeddingjob.Name(backfillJobName)).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return r.data.ent.EmbeddingJob.Create().
+			SetName(backfillJobName).
+			SetStatus(embeddingjob.Status(state.Status)).
+			SetCursor(int(state.Cursor)).
+			SetProcessed(int(state.Processed)).
+			SetFailed(int(state.Failed)).
+			SetLastError(state.LastError).
+			SetNillableStartedAt(nonZeroTime(state.StartedAt)).
+			SetNillableFinishedAt(nonZeroTime(state.FinishedAt)).
+			Exec(ctx)
+	}
+
+	update := r.data.ent.EmbeddingJob.Update().
+		Where(embeddingjob.Name(backfillJobName)).
+		SetStatus(embeddingjob.Status(state.Status)).
+		SetCursor(int(state.Cursor)).
+		SetProcessed(int(state.Processed)).
+		SetFailed(int(state.Failed)).
+		SetLastError(state.LastError)
+	if state.StartedAt.IsZero() {
+		update.ClearStartedAt()
+	} else {
+		update.SetStartedAt(state.StartedAt)
+	}
+	if state.FinishedAt.IsZero() {
+		update.ClearFinishedAt()
+	} else {
+		update.SetFinishedAt(state.FinishedAt)
+	}
+	return update.Exec(ctx)
+}
+
+func (r *backfillRepo) RecordEmbeddingFailure(ctx context.Context, productID int64, reason string) error {
+	n, err := r.data.ent.EmbeddingFailure.Update().
+		Where(embeddingfailure.ProductID(int(productID))).
+		AddAttempts(1).
+		SetLastError(reason).
+		Save(ctx)
+	if err != nil || n > 0 {
+		return err
+	}
+
+	return r.data.ent.EmbeddingFailure.Create().
+		SetProductID(int(productID)).
+		SetAttempts(1).
+		SetLastError(reason).
+		Exec(ctx)
+}
+
+func (r *backfillRepo) ClearEmbeddingFailures(ctx context.Context, productIDs []int64) error {
+	if len(productIDs) == 0 {
+		return nil
+	}
+	_, err := r.data.ent.EmbeddingFailure.Delete().
+		Where(embeddingfailure.ProductIDIn(toInts(productIDs)...)).
+		Exec(ctx)
+	return err
+}
+
+func (r *backfillRepo) ListEmbeddingFailures(ctx context.Context, minAttempts, limit int) ([]*biz.EmbeddingFailure, error) {
+	failures, err := r.data.ent.EmbeddingFailure.Query().
+		Where(embeddingfailure.AttemptsGTE(minAttempts)).
+		Order(ent.Desc(embeddingfailure.FieldUpdateTime)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rv := make([]*biz.EmbeddingFailure, len(failures))
+	for i, f := range failures {
+		rv[i] = &biz.EmbeddingFailure{
+			ProductID: int64(f.ProductID),
+			Attempts:  f.Attempts,
+			LastError: f.LastError,
+			UpdatedAt: f.UpdateTime,
+		}
+	}
+	return rv, nil
+}
+
+func (r *backfillRepo) DeadLetteredIDs(ctx context.Context, ids []int64, minAttempts int) (map[int64]bool, error) {
+	dead := make(map[int64]bool)
+	if len(ids) == 0 {
+		return dead, nil
+	}
+
+	productIDs, err := r.data.ent.EmbeddingFailure.Query().
+		Where(
+			embeddingfailure.ProductIDIn(toInts(ids)...),
+			embeddingfailure.AttemptsGTE(minAttempts),
+		).
+		Select(embeddingfailure.FieldProductID).
+		Ints(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range productIDs {
+		dead[int64(id)] = true
+	}
+	return dead, nil
+}
+
+func nonZeroTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+func toInts(ids []int64) []int {
+	rv := make([]int, len(ids))
+	for i, id := range ids {
+		rv[i] = int(id)
+	}
+	return rv
+}
@@ -0,0 +1,60 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"yinni_backend/app/product/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestBackfillState(t *testing.T) {
+	r := NewBackfillRepo(newTestRepo(t).data, log.DefaultLogger)
+	ctx := context.Background()
+
+	// Only MySQL is shared by several instances; elsewhere the lease is free
+	lease, err := r.AcquireBackfillLease(ctx)
+	if err != nil || lease == nil {
+		t.Fatalf("lease %v, err %v", lease, err)
+	}
+	if err := lease.Check(ctx); err != nil {
+		t.Error(err)
+	}
+	lease.Release()
+
+	state, err := r.LoadBackfillState(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state.Status != biz.BackfillIdle {
+		t.Errorf("status %q before any run, want idle", state.Status)
+	}
+
+	started := time.Now().Truncate(time.Second)
+	running := &biz.BackfillState{Status: biz.BackfillRunning, Cursor: 40, Processed: 38, Failed: 2, StartedAt: started}
+	if err := r.SaveBackfillState(ctx, running); err != nil {
+		t.Fatal(err)
+	}
+	got, err := r.LoadBackfillState(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Status != running.Status || got.Cursor != 40 || got.Processed != 38 || got.Failed != 2 ||
+		!got.StartedAt.Equal(started) || !got.FinishedAt.IsZero() {
+		t.Errorf("loaded %+v, want %+v", got, running)
+	}
+
+	// Saving again updates the same job, clearing the times left zero
+	if err := r.SaveBackfillState(ctx, &biz.BackfillState{Status: biz.BackfillCompleted, Processed: 50}); err != nil {
+		t.Fatal(err)
+	}
+	got, err = r.LoadBackfillState(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Status != biz.BackfillCompleted || got.Processed != 50 || got.Cursor != 0 || !got.StartedAt.IsZero() {
+		t.Errorf("loaded %+v, want completed with 50 processed", got)
+	}
+}
@@ -0,0 +1,122 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"yinni_backend/app/product/internal/biz"
+	"yinni_backend/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	openai "github.com/sashabaranov/go-openai"
+)
+
+const defaultChatTimeout = 60 * time.Second
+
+// chatClient talks to any OpenAI-compatible chat completion endpoint.
+type chatClient struct {
+	client      *openai.Client
+	model       string
+	maxTokens   int
+	temperature float32
+}
+
+// NewChatClient creates the chat client described by cfg. It returns nil when
+// chat is not configured.
+func NewChatClient(cfg *conf.Chat, logger log.Logger) biz.ChatClient {
+	helper := log.NewHelper(logger)
+
+	if cfg.GetProvider() == "fake" {
+		helper.Warn("Using the fake chat client")
+		return biz.NewFakeChatClient()
+	}
+	if cfg.GetApiKey() == "" || cfg.GetModel() == "" {
+		helper.Info("Chat is not configured, RAG answers are disabled")
+		return nil
+	}
+
+	timeout := defaultChatTimeout
+	if cfg.TimeoutSeconds > 0 {
+		timeout = time.Duration(cfg.TimeoutSeconds) * time.Second
+	}
+
+	openaiConfig := openai.DefaultConfig(cfg.ApiKey)
+	if cfg.BaseUrl != "" {
+		openaiConfig.BaseURL = cfg.BaseUrl
+	}
+	// The timeout bounds the wait for the response headers only: a client
+	// timeout would also cut off long streamed answers, which are bounded by
+	// the request context instead.
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.ResponseHeaderTimeout = timeout
+	openaiConfig.HTTPClient = &http.Client{Transport: transport}
+
+	return &chatClient{
+		client:      openai.NewClientWithConfig(openaiConfig),
+		model:       cfg.Model,
+		maxTokens:   int(cfg.MaxTokens),
+		temperature: cfg.Temperature,
+	}
+}
+
+// Complete returns the first choice of a chat completion.
+func (c *chatClient) Complete(ctx context.Context, messages []biz.ChatMessage) (string, error) {
+	resp, err := c.client.CreateChatCompletion(ctx, openai.ChatCompletionRequest{
+		Model:       c.model,
+		Messages:    toOpenAIMessages(messages),
+		MaxTokens:   c.maxTokens,
+		Temperature: c.temperature,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to create chat completion: %w", err)
+	}
+
+	if len(resp.Choices) == 0 {
+		return "", fmt.Errorf("no chat completion choices returned")
+	}
+
+	return resp.Choices[0].Message.Content, nil
+}
+
+// Stream streams a chat completion, forwarding each content delta.
+func (c *chatClient) Stream(ctx context.Context, messages []biz.ChatMessage, onDelta func(delta string) error) error {
+	stream, err := c.client.CreateChatCompletionStream(ctx, openai.ChatCompletionRequest{
+		Model:       c.model,
+		Messages:    toOpenAIMessages(messages),
+		MaxTokens:   c.maxTokens,
+		Temperature: c.temperature,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create chat completion stream: %w", err)
+	}
+	defer stream.Close()
+
+	for {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read chat completion stream: %w", err)
+		}
+
+		if len(resp.Choices) == 0 || resp.Choices[0].Delta.Content == "" {
+			continue
+		}
+		if err := onDelta(resp.Choices[0].Delta.Content); err != nil {
+			return err
+		}
+	}
+}
+
+func toOpenAIMessages(messages []biz.ChatMessage) []openai.ChatCompletionMessage {
+	rv := make([]openai.ChatCompletionMessage, len(messages))
+	for i, m := range messages {
+		rv[i] = openai.ChatCompletionMessage{Role: m.Role, Content: m.Content}
+	}
+	return rv
+}
@@ -1,42 +1,51 @@
 package data
 
 import (
-	"context"
+	"database/sql"
 	"yinni_backend/ent"
 	_ "yinni_backend/ent/runtime"
 	"yinni_backend/internal/conf"
 
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/wire"
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewProductRepo)
+var ProviderSet = wire.NewSet(NewData, NewProductRepo, NewVectorIndex, NewChatClient, NewEmbedder, NewBackfillRepo, NewSuggestIndex, NewSearchRuleRepo, NewImportRepo, NewExportRepo, NewMigrator)
 
 // Data .
 type Data struct {
 	ent *ent.Client
+	db  *sql.DB
+	// dialect is the SQL dialect of the database, e.g. dialect.MySQL.
+	dialect string
 }
 
 // NewData .
 func NewData(c *conf.Data, logger log.Logger) (*Data, func(), error) {
 	log := log.NewHelper(logger)
 
-	client, err := ent.Open(
-		"mysql",
+	driver := c.Database.Driver
+	if driver == "" {
+		driver = dialect.MySQL
+	}
+
+	// The schema is created by the Migrator, not here, so a service started
+	// with -migrate=false never changes it.
+	drv, err := entsql.Open(
+		driver,
 		c.Database.Source,
 	)
 
 	if err != nil {
 		return nil, nil, err
 	}
-
-	if err := client.Schema.Create(context.Background()); err != nil {
-		return nil, nil, err
-	}
+	client := ent.NewClient(ent.Driver(drv))
 
 	cleanup := func() {
 		log.Info("closing the data resources")
 	}
-	return &Data{ent: client}, cleanup, nil
+	return &Data{ent: client, db: drv.DB(), dialect: driver}, cleanup, nil
 }
@@ -0,0 +1,263 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"yinni_backend/app/product/internal/biz"
+	"yinni_backend/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	openai "github.com/sashabaranov/go-openai"
+)
+
+const (
+	defaultEmbeddingTimeout   = 30 * time.Second
+	defaultEmbeddingBatchSize = 16
+	defaultLocalDimensions    = 384
+
+	// Retry backoff starts at embeddingRetryBase and doubles per attempt, up
+	// to embeddingRetryMax, with full jitter.
+	embeddingRetryBase = 500 * time.Millisecond
+	embeddingRetryMax  = 10 * time.Second
+
+	// maxEmbeddingInputChars keeps inputs well inside the model context.
+	maxEmbeddingInputChars = 8000
+)
+
+// NewEmbedder creates the embedder described by cfg. It returns nil when
+// embeddings are not configured.
+func NewEmbedder(cfg *conf.Embeddings, logger log.Logger) biz.Embedder {
+	helper := log.NewHelper(logger)
+
+	if cfg.GetProvider() == "local" {
+		dims := int(cfg.GetDimensions())
+		if dims <= 0 {
+			dims = defaultLocalDimensions
+		}
+		helper.Infof("Using the local hashing embedder with %d dimensions", dims)
+		return newLocalEmbedder(dims)
+	}
+	if cfg.GetApiKey() == "" {
+		helper.Info("Embeddings are not configured, semantic search is disabled")
+		return nil
+	}
+
+	timeout := defaultEmbeddingTimeout
+	if cfg.TimeoutSeconds > 0 {
+		timeout = time.Duration(cfg.TimeoutSeconds) * time.Second
+	}
+	batchSize := defaultEmbeddingBatchSize
+	if cfg.BatchSize > 0 {
+		batchSize = int(cfg.BatchSize)
+	}
+	model := cfg.Model
+	if model == "" {
+		model = string(openai.AdaEmbeddingV2)
+	}
+
+	openaiConfig := openai.DefaultConfig(cfg.ApiKey)
+	if cfg.BaseUrl != "" {
+		openaiConfig.BaseURL = cfg.BaseUrl
+	}
+	openaiConfig.HTTPClient = &http.Client{Timeout: timeout}
+
+	return &openAIEmbedder{
+		client:     openai.NewClientWithConfig(openaiConfig),
+		model:      model,
+		batchSize:  batchSize,
+		maxRetries: max(int(cfg.MaxRetries), 0),
+		log:        helper,
+	}
+}
+
+// openAIEmbedder calls any OpenAI-compatible embeddings endpoint.
+type openAIEmbedder struct {
+	client     *openai.Client
+	model      string
+	batchSize  int
+	maxRetries int
+	log        *log.Helper
+}
+
+func (e *openAIEmbedder) Model() string {
+	return e.model
+}
+
+// Embed sends texts in requests of at most batchSize inputs.
+func (e *openAIEmbedder) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	vectors := make([][]float32, 0, len(texts))
+	for start := 0; start < len(texts); start += e.batchSize {
+		batch := texts[start:min(start+e.batchSize, len(texts))]
+		embedded, err := e.embedBatch(ctx, batch)
+		if err != nil {
+			return nil, err
+		}
+		vectors = append(vectors, embedded...)
+	}
+	return vectors, nil
+}
+
+// embedBatch embeds one request worth of texts, retrying transient failures.
+func (e *openAIEmbedder) embedBatch(ctx context.Context, texts []string) ([][]float32, error) {
+	inputs := make([]string, len(texts))
+	for i, text := range texts {
+		if len(text) > maxEmbeddingInputChars {
+			text = text[:maxEmbeddingInputChars]
+		}
+		inputs[i] = text
+	}
+
+	var lastErr error
+	for attempt := 0; attempt <= e.maxRetries; attempt++ {
+		if attempt > 0 {
+			delay := retryDelay(attempt)
+			e.log.Warnf("Embedding request failed (attempt %d/%d), retrying in %s: %v", attempt, e.maxRetries+1, delay, lastErr)
+			select {
+			case <-time.After(delay):
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+
+		resp, err := e.client.CreateEmbeddings(ctx, openai.EmbeddingRequest{
+			Model: openai.EmbeddingModel(e.model),
+			Input: inputs,
+		})
+		if err != nil {
+			lastErr = err
+			if !isRetryableEmbeddingError(ctx, err) {
+				break
+			}
+			continue
+		}
+
+		if len(resp.Data) != len(inputs) {
+			return nil, fmt.Errorf("embedding response has %d vectors for %d inputs", len(resp.Data), len(inputs))
+		}
+		vectors := make([][]float32, len(inputs))
+		for _, d := range resp.Data {
+			if d.Index < 0 || d.Index >= len(vectors) {
+				return nil, fmt.Errorf("embedding response has out of range index %d", d.Index)
+			}
+			vectors[d.Index] = d.Embedding
+		}
+		return vectors, nil
+	}
+
+	return nil, fmt.Errorf("failed to create embeddings: %w", lastErr)
+}
+
+// retryDelay returns the full-jitter exponential backoff for a retry attempt.
+func retryDelay(attempt int) time.Duration {
+	backoff := min(embeddingRetryBase<<(attempt-1), embeddingRetryMax)
+	return time.Duration(rand.Int64N(int64(backoff)) + 1)
+}
+
+// isRetryableEmbeddingError reports whether a failed request may succeed when
+// repeated: rate limits, server errors and transport failures.
+func isRetryableEmbeddingError(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	var apiErr *openai.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.HTTPStatusCode == http.StatusTooManyRequests || apiErr.HTTPStatusCode >= 500
+	}
+	var reqErr *openai.RequestError
+	if errors.As(err, &reqErr) {
+		return reqErr.HTTPStatusCode == http.StatusTooManyRequests || reqErr.HTTPStatusCode >= 500
+	}
+	return true
+}
+
+// localEmbedder is a deterministic, dependency-free embedder for development
+// and CI. Word unigrams, word bigrams and character trigrams are hashed into
+// a fixed number of signed buckets with sublinear term-frequency weights,
+// then L2-normalised. Texts sharing vocabulary land close together, which is
+// enough to exercise semantic search without a model.
+type localEmbedder struct {
+	dims int
+}
+
+func newLocalEmbedder(dims int) *localEmbedder {
+	return &localEmbedder{dims: dims}
+}
+
+func (e *localEmbedder) Model() string {
+	return fmt.Sprintf("local-hash-v1-%d", e.dims)
+}
+
+func (e *localEmbedder) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	vectors := make([][]float32, len(texts))
+	for i, text := range texts {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		vectors[i] = e.embed(text)
+	}
+	return vectors, nil
+}
+
+// Relative weights of the feature families.
+const (
+	localUnigramWeight = 1.0
+	localBigramWeight  = 0.5
+	localTrigramWeight = 0.25
+)
+
+func (e *localEmbedder) embed(text string) []float32 {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+
+	counts := make(map[string]float64)
+	for i, w := range words {
+		counts["w:"+w] += localUnigramWeight
+		if i > 0 {
+			counts["b:"+words[i-1]+" "+w] += localBigramWeight
+		}
+		padded := []rune("#" + w + "#")
+		for j := 0; j+3 <= len(padded); j++ {
+			counts["c:"+string(padded[j:j+3])] += localTrigramWeight
+		}
+	}
+
+	// Accumulate in a fixed order so the float sums are reproducible
+	features := make([]string, 0, len(counts))
+	for feature := range counts {
+		features = append(features, feature)
+	}
+	sort.Strings(features)
+
+	vector := make([]float32, e.dims)
+	for _, feature := range features {
+		tf := counts[feature]
+		h := fnv.New64a()
+		h.Write([]byte(feature))
+		sum := h.Sum64()
+
+		// The low bits pick the bucket and the top bit the sign, so
+		// colliding features tend to cancel instead of accumulate.
+		weight := tf
+		if tf > 1 {
+			weight = 1 + math.Log(tf)
+		}
+		if sum>>63 == 1 {
+			weight = -weight
+		}
+		vector[sum%uint64(e.dims)] += float32(weight)
+	}
+
+	return normalize(vector)
+}
@@ -0,0 +1,272 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"yinni_backend/app/product/internal/biz"
+	"yinni_backend/ent"
+	"yinni_backend/ent/predicate"
+	"yinni_backend/ent/product"
+	"yinni_backend/ent/productattribute"
+
+	entsql "entgo.io/ent/dialect/sql"
+)
+
+// maxFacetValues caps the values returned per term facet, most frequent first.
+const maxFacetValues = 20
+
+// Facet names, used to leave a facet's own filter out of its counts.
+const (
+	facetBrand       = "brand"
+	facetCategory    = "category"
+	facetSubCategory = "sub_category"
+	facetSeller      = "seller"
+	facetPrice       = "price"
+	facetRating      = "rating"
+	facetStock       = "in_stock"
+)
+
+// attributeFacet names the facet of an attribute key.
+func attributeFacet(key string) string {
+	return "attribute:" + key
+}
+
+// facetRow scans one group of a facet GROUP BY query. Only the grouped
+// columns and count are selected; attribute facets group product_attributes
+// by key and value.
+type facetRow struct {
+	Brand         string  `json:"brand"`
+	Category      string  `json:"category"`
+	SubCategory   string  `json:"sub_category"`
+	Seller        string  `json:"seller"`
+	RatingNumeric float64 `json:"rating_numeric"`
+	OutOfStock    bool    `json:"out_of_stock"`
+	Key           string  `json:"key"`
+	Value         string  `json:"value"`
+	Count         int64   `json:"count"`
+}
+
+// GetFacets counts the matching products per facet value. A non-empty
+// query matches like SearchProducts; otherwise params.SearchQuery applies.
+func (r *productRepo) GetFacets(ctx context.Context, queryStr string, params *biz.ListProductsParams) (*biz.Facets, error) {
+	var match []predicate.Product
+	if queryStr != "" {
+		m, _, _, err := r.textMatch(ctx, queryStr, params)
+		if err != nil {
+			return nil, err
+		}
+		if m != nil {
+			match = append(match, m)
+		}
+		params = searchParams(params)
+	}
+
+	// groupBy counts the products under every filter except the facet's own
+	groupBy := func(facet, field string, extra ...predicate.Product) ([]facetRow, error) {
+		var rows []facetRow
+		err := r.data.ent.Product.Query().
+			Where(listFilters(params, facet)...).
+			Where(match...).
+			Where(extra...).
+			GroupBy(field).
+			Aggregate(ent.As(ent.Count(), "count")).
+			Scan(ctx, &rows)
+		return rows, err
+	}
+
+	facets := &biz.Facets{}
+	terms := []struct {
+		facet, field string
+		value        func(*facetRow) string
+		extra        []predicate.Product
+		dst          *[]*biz.FacetCount
+	}{
+		{facetBrand, product.FieldBrand, func(r *facetRow) string { return r.Brand }, nil, &facets.Brands},
+		{facetCategory, product.FieldCategory, func(r *facetRow) string { return r.Category }, nil, &facets.Categories},
+		{facetSubCategory, product.FieldSubCategory, func(r *facetRow) string { return r.SubCategory }, nil, &facets.SubCategories},
+		{facetSeller, product.FieldSeller, func(r *facetRow) string { return r.Seller },
+			[]predicate.Product{product.SellerNotNil(), product.SellerNEQ("")}, &facets.Sellers},
+	}
+	var err error
+	for _, t := range terms {
+		rows, err := groupBy(t.facet, t.field, t.extra...)
+		if err != nil {
+			return nil, err
+		}
+		*t.dst = topFacetCounts(rows, t.value)
+	}
+
+	facets.PriceBuckets, err = r.priceBuckets(ctx, append(listFilters(params, facetPrice), match...))
+	if err != nil {
+		return nil, err
+	}
+
+	ratings, err := groupBy(facetRating, product.FieldRatingNumeric, product.RatingNumericNotNil())
+	if err != nil {
+		return nil, err
+	}
+	facets.RatingBands = ratingBands(ratings)
+
+	stock, err := groupBy(facetStock, product.FieldOutOfStock)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range stock {
+		if row.OutOfStock {
+			facets.OutOfStock += row.Count
+		} else {
+			facets.InStock += row.Count
+		}
+	}
+
+	facets.Attributes, err = r.attributeFacets(ctx, params, match)
+	if err != nil {
+		return nil, err
+	}
+
+	return facets, nil
+}
+
+// attributeFacets counts the matching products per attribute value. Keys
+// without a filter of their own are counted together in one GROUP BY; each
+// filtered key is counted without its filters.
+func (r *productRepo) attributeFacets(ctx context.Context, params *biz.ListProductsParams, match []predicate.Product) ([]*biz.AttributeFacet, error) {
+	count := func(except string, keys ...string) ([]facetRow, error) {
+		preds := []predicate.ProductAttribute{productattribute.KeyIn(keys...)}
+		if products := append(listFilters(params, except), match...); len(products) > 0 {
+			preds = append(preds, productattribute.HasProductWith(products...))
+		}
+		var rows []facetRow
+		err := r.data.ent.ProductAttribute.Query().
+			Where(preds...).
+			GroupBy(productattribute.FieldKey, productattribute.FieldValue).
+			Aggregate(ent.As(ent.Count(), "count")).
+			Scan(ctx, &rows)
+		return rows, err
+	}
+
+	filtered := make(map[string]bool)
+	for _, f := range params.AttributeFilters {
+		filtered[f.Key] = true
+	}
+	var unfiltered []string
+	for _, key := range biz.ProductAttributeKeys {
+		if !filtered[key] {
+			unfiltered = append(unfiltered, key)
+		}
+	}
+
+	byKey := make(map[string][]facetRow)
+	if len(unfiltered) > 0 {
+		rows, err := count("", unfiltered...)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			byKey[row.Key] = append(byKey[row.Key], row)
+		}
+	}
+	for _, key := range biz.ProductAttributeKeys {
+		if !filtered[key] {
+			continue
+		}
+		rows, err := count(attributeFacet(key), key)
+		if err != nil {
+			return nil, err
+		}
+		byKey[key] = rows
+	}
+
+	var facets []*biz.AttributeFacet
+	for _, key := range biz.ProductAttributeKeys {
+		if rows := byKey[key]; len(rows) > 0 {
+			facets = append(facets, &biz.AttributeFacet{
+				Key:    key,
+				Values: topFacetCounts(rows, func(r *facetRow) string { return r.Value }),
+			})
+		}
+	}
+	return facets, nil
+}
+
+// topFacetCounts returns the most frequent values, ties broken by value.
+func topFacetCounts(rows []facetRow, value func(*facetRow) string) []*biz.FacetCount {
+	counts := make([]*biz.FacetCount, 0, len(rows))
+	for i := range rows {
+		counts = append(counts, &biz.FacetCount{Value: value(&rows[i]), Count: rows[i].Count})
+	}
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].Count != counts[j].Count {
+			return counts[i].Count > counts[j].Count
+		}
+		return counts[i].Value < counts[j].Value
+	})
+	if len(counts) > maxFacetValues {
+		counts = counts[:maxFacetValues]
+	}
+	return counts
+}
+
+// priceBuckets counts the priced products matching preds per histogram
+// bucket. The database groups them by bucket index, so only one row per
+// bucket is read whatever the number of distinct prices.
+func (r *productRepo) priceBuckets(ctx context.Context, preds []predicate.Product) ([]*biz.RangeCount, error) {
+	buckets := make([]*biz.RangeCount, len(biz.PriceBucketBounds)+1)
+	lower := 0
+	for i, upper := range biz.PriceBucketBounds {
+		buckets[i] = &biz.RangeCount{Min: float64(lower), Max: float64(upper)}
+		lower = upper
+	}
+	buckets[len(buckets)-1] = &biz.RangeCount{Min: float64(lower)}
+
+	selector := entsql.Dialect(r.data.dialect).Select().From(entsql.Table(product.Table))
+	price := selector.C(product.FieldPriceNumeric)
+	var bucket strings.Builder
+	bucket.WriteString("CASE")
+	for i, upper := range biz.PriceBucketBounds {
+		fmt.Fprintf(&bucket, " WHEN %s < %d THEN %d", price, upper, i)
+	}
+	fmt.Fprintf(&bucket, " ELSE %d END", len(biz.PriceBucketBounds))
+
+	selector.Select(entsql.As(bucket.String(), "bucket"), entsql.As(entsql.Count("*"), "count")).
+		Where(entsql.NotNull(price))
+	for _, p := range preds {
+		p(selector)
+	}
+	selector.GroupBy("bucket")
+
+	query, args := selector.Query()
+	rows, err := r.data.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var i int
+		var count int64
+		if err := rows.Scan(&i, &count); err != nil {
+			return nil, err
+		}
+		if i >= 0 && i < len(buckets) {
+			buckets[i].Count = count
+		}
+	}
+	return buckets, rows.Err()
+}
+
+// ratingBands counts the products rated at least each band's floor.
+func ratingBands(rows []facetRow) []*biz.RangeCount {
+	bands := make([]*biz.RangeCount, len(biz.RatingBandFloors))
+	for i, floor := range biz.RatingBandFloors {
+		bands[i] = &biz.RangeCount{Min: floor}
+		for _, row := range rows {
+			if row.RatingNumeric >= floor {
+				bands[i].Count += row.Count
+			}
+		}
+	}
+	return bands
+}
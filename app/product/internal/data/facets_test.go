@@ -0,0 +1,99 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"yinni_backend/app/product/internal/biz"
+)
+
+func facetCounts(counts []*biz.FacetCount) map[string]int64 {
+	m := make(map[string]int64, len(counts))
+	for _, c := range counts {
+		m[c.Value] = c.Count
+	}
+	return m
+}
+
+func TestGetFacetsSearch(t *testing.T) {
+	r := newTestRepo(t)
+	ctx := context.Background()
+	products := []struct{ title, brand, category string }{
+		{"Linen shirt", "Acme", "Clothing"},
+		{"Oxford shirt", "Acme", "Clothing"},
+		{"Denim shirt", "Bolt", "Clothing"},
+		{"Shirt dress", "Bolt", "Dresses"},
+		{"Shirt clip", "Acme", "Accessories"},
+		{"Leather belt", "Acme", "Accessories"},
+	}
+	for i, p := range products {
+		_, err := r.data.ent.Product.Create().
+			SetOriginalID(fmt.Sprintf("orig-%d", i)).
+			SetPid(fmt.Sprintf("PID%04d", i)).
+			SetTitle(p.title).
+			SetBrand(p.brand).
+			SetCategory(p.category).
+			SetSubCategory("Misc").
+			Save(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	facets, err := r.GetFacets(ctx, "shirt", &biz.ListProductsParams{Category: "Clothing"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The category facet leaves out its own filter, so the other categories
+	// of the matches are still counted
+	categories := facetCounts(facets.Categories)
+	want := map[string]int64{"Clothing": 3, "Dresses": 1, "Accessories": 1}
+	if len(categories) != len(want) {
+		t.Errorf("categories = %v, want %v", categories, want)
+	}
+	for value, count := range want {
+		if categories[value] != count {
+			t.Errorf("category %s: count %d, want %d", value, categories[value], count)
+		}
+	}
+
+	// Other facets count the matches within the category
+	brands := facetCounts(facets.Brands)
+	if len(brands) != 2 || brands["Acme"] != 2 || brands["Bolt"] != 1 {
+		t.Errorf("brands = %v, want Acme 2 and Bolt 1", brands)
+	}
+}
+
+func TestGetFacetsAttributes(t *testing.T) {
+	r := newTestRepo(t)
+	seedAttributeProducts(t, r,
+		map[string]string{"Color": "Black, White", "Fabric": "Cotton"},
+		map[string]string{"Color": "Black", "Fabric": "Linen"},
+		map[string]string{"Color": "White", "Fabric": "Cotton"},
+	)
+
+	facets, err := r.GetFacets(context.Background(), "", &biz.ListProductsParams{
+		AttributeFilters: []*biz.AttributeFilter{{Key: "color", Values: []string{"black"}, Match: biz.AttributeMatchAny}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	byKey := make(map[string]map[string]int64)
+	for _, f := range facets.Attributes {
+		byKey[f.Key] = facetCounts(f.Values)
+	}
+	if len(byKey) != 2 {
+		t.Errorf("attribute facets for %d keys, want color and fabric", len(byKey))
+	}
+
+	// The color facet leaves out the color filter
+	if colors := byKey["color"]; len(colors) != 2 || colors["black"] != 2 || colors["white"] != 2 {
+		t.Errorf("colors = %v, want black 2 and white 2", colors)
+	}
+	// Other attributes count the black products only
+	if fabrics := byKey["fabric"]; len(fabrics) != 2 || fabrics["cotton"] != 1 || fabrics["linen"] != 1 {
+		t.Errorf("fabrics = %v, want cotton 1 and linen 1", fabrics)
+	}
+}
@@ -0,0 +1,386 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"yinni_backend/ent"
+	"yinni_backend/ent/migrate"
+	"yinni_backend/ent/schemamigration"
+
+	atlas "ariga.io/atlas/sql/schema"
+	"entgo.io/ent/dialect"
+	sqlschema "entgo.io/ent/dialect/sql/schema"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	// migrateLockName serialises migrations across service instances.
+	migrateLockName    = "yinni_backend.schema_migrations"
+	migrateLockTimeout = 10 * time.Minute
+)
+
+// Migration is a versioned change the schema sync cannot make on its own:
+// backfills, renames, drops and type changes. Up must be idempotent, since a
+// migration that fails midway is rerun from the start. Down reverts it; a
+// nil Down has nothing to revert, so reverting only forgets the migration.
+type Migration struct {
+	Version int64
+	Name    string
+	Up      func(ctx context.Context, d *Data) error
+	Down    func(ctx context.Context, d *Data) error
+}
+
+// MigrationStatus is a migration and when it was applied; AppliedAt is zero
+// while it is pending.
+type MigrationStatus struct {
+	Version   int64
+	Name      string
+	AppliedAt time.Time
+}
+
+// SchemaStatus is what Up would do. PendingSchema holds the statements of
+// the schema sync; SkippedSchema the drops and type changes it leaves to
+// versioned migrations.
+type SchemaStatus struct {
+	PendingSchema []string
+	SkippedSchema []string
+	Migrations    []*MigrationStatus
+}
+
+// Migrator keeps the database schema current. Up first syncs the tables
+// with the ent schema, adding tables, columns and indexes but never
+// dropping them or changing column types, then applies the pending
+// versioned migrations, recording them in schema_migrations.
+type Migrator struct {
+	data       *Data
+	migrations []*Migration
+	log        *log.Helper
+
+	mu      sync.Mutex
+	skipped []string
+}
+
+// NewMigrator creates a migrator for the product service migrations.
+func NewMigrator(data *Data, logger log.Logger) *Migrator {
+	ms := append([]*Migration(nil), migrations...)
+	sort.Slice(ms, func(i, j int) bool { return ms[i].Version < ms[j].Version })
+	return &Migrator{
+		data:       data,
+		migrations: ms,
+		log:        log.NewHelper(logger),
+	}
+}
+
+// WaitReady pings the database until it answers or timeout passes.
+func (m *Migrator) WaitReady(ctx context.Context, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for {
+		err := m.data.db.PingContext(ctx)
+		if err == nil {
+			return nil
+		}
+		m.log.Infof("Database not ready, waiting: %v", err)
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("database not ready after %v: %w", timeout, err)
+		case <-time.After(3 * time.Second):
+		}
+	}
+}
+
+// Status reports the pending schema changes and the state of every
+// migration.
+func (m *Migrator) Status(ctx context.Context) (*SchemaStatus, error) {
+	var buf bytes.Buffer
+	m.resetSkipped()
+	if err := m.data.ent.Schema.WriteTo(ctx, &buf, m.schemaOptions()...); err != nil {
+		return nil, fmt.Errorf("diff schema: %w", err)
+	}
+
+	status := &SchemaStatus{SkippedSchema: m.takeSkipped()}
+	for _, line := range strings.Split(buf.String(), "\n") {
+		if line = strings.TrimSpace(line); line != "" && !strings.HasPrefix(line, "--") {
+			status.PendingSchema = append(status.PendingSchema, line)
+		}
+	}
+
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, mig := range m.migrations {
+		s := &MigrationStatus{Version: mig.Version, Name: mig.Name}
+		if row, ok := applied[mig.Version]; ok {
+			s.AppliedAt = row.AppliedAt
+		}
+		status.Migrations = append(status.Migrations, s)
+	}
+	return status, nil
+}
+
+// Up syncs the schema and applies the pending migrations up to and
+// including version to, or all of them when to is 0. It returns how many
+// migrations it applied.
+func (m *Migrator) Up(ctx context.Context, to int64) (int, error) {
+	unlock, err := m.lock(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer unlock()
+
+	m.resetSkipped()
+	if err := m.data.ent.Schema.Create(ctx, m.schemaOptions()...); err != nil {
+		return 0, fmt.Errorf("sync schema: %w", err)
+	}
+	for _, s := range m.takeSkipped() {
+		m.log.Warnf("Schema sync skipped %s; write a versioned migration for it", s)
+	}
+
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return 0, err
+	}
+	n := 0
+	for _, mig := range m.migrations {
+		if to > 0 && mig.Version > to {
+			break
+		}
+		if _, ok := applied[mig.Version]; ok {
+			continue
+		}
+
+		m.log.Infof("Applying migration %d %s", mig.Version, mig.Name)
+		start := time.Now()
+		if err := mig.Up(ctx, m.data); err != nil {
+			return n, fmt.Errorf("migration %d %s: %w", mig.Version, mig.Name, err)
+		}
+		err := m.data.ent.SchemaMigration.Create().
+			SetID(mig.Version).
+			SetName(mig.Name).
+			Exec(ctx)
+		if err != nil {
+			return n, fmt.Errorf("record migration %d: %w", mig.Version, err)
+		}
+		m.log.Infof("Applied migration %d %s in %v", mig.Version, mig.Name, time.Since(start))
+		n++
+	}
+	return n, nil
+}
+
+// Down reverts the last steps applied migrations, newest first, and returns
+// how many it reverted. The schema sync is never reverted.
+func (m *Migrator) Down(ctx context.Context, steps int) (int, error) {
+	unlock, err := m.lock(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer unlock()
+
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return 0, err
+	}
+	n := 0
+	for i := len(m.migrations) - 1; i >= 0 && n < steps; i-- {
+		mig := m.migrations[i]
+		if _, ok := applied[mig.Version]; !ok {
+			continue
+		}
+
+		m.log.Infof("Reverting migration %d %s", mig.Version, mig.Name)
+		if mig.Down != nil {
+			if err := mig.Down(ctx, m.data); err != nil {
+				return n, fmt.Errorf("revert migration %d %s: %w", mig.Version, mig.Name, err)
+			}
+		}
+		if err := m.data.ent.SchemaMigration.DeleteOneID(mig.Version).Exec(ctx); err != nil {
+			return n, fmt.Errorf("forget migration %d: %w", mig.Version, err)
+		}
+		n++
+	}
+	return n, nil
+}
+
+// CatalogEmpty reports whether there are no products.
+func (m *Migrator) CatalogEmpty(ctx context.Context) (bool, error) {
+	exists, err := m.data.ent.Product.Query().Exist(ctx)
+	return !exists, err
+}
+
+// applied returns the recorded migrations by version. Before the first sync
+// there is no schema_migrations table and nothing is applied.
+func (m *Migrator) applied(ctx context.Context) (map[int64]*ent.SchemaMigration, error) {
+	exists, err := m.tableExists(ctx, schemamigration.Table)
+	if err != nil || !exists {
+		return map[int64]*ent.SchemaMigration{}, err
+	}
+
+	rows, err := m.data.ent.SchemaMigration.Query().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	applied := make(map[int64]*ent.SchemaMigration, len(rows))
+	for _, row := range rows {
+		applied[row.ID] = row
+	}
+	return applied, nil
+}
+
+func (m *Migrator) tableExists(ctx context.Context, table string) (bool, error) {
+	var query string
+	switch m.data.dialect {
+	case dialect.MySQL:
+		query = "SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = DATABASE() AND table_name = ?"
+	case dialect.SQLite:
+		query = "SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = ?"
+	case dialect.Postgres:
+		query = "SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = CURRENT_SCHEMA() AND table_name = $1"
+	default:
+		return false, fmt.Errorf("unsupported dialect %q", m.data.dialect)
+	}
+
+	var n int
+	if err := m.data.db.QueryRowContext(ctx, query, table).Scan(&n); err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// lock takes a MySQL advisory lock so instances booting together migrate
+// one at a time. Other dialects are not locked.
+func (m *Migrator) lock(ctx context.Context) (func(), error) {
+	if m.data.dialect != dialect.MySQL {
+		return func() {}, nil
+	}
+
+	conn, err := m.data.db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var got sql.NullInt64
+	err = conn.QueryRowContext(ctx, "SELECT GET_LOCK(?, ?)", migrateLockName, int(migrateLockTimeout.Seconds())).Scan(&got)
+	if err == nil && got.Int64 != 1 {
+		err = fmt.Errorf("timed out after %v waiting for another instance to finish migrating", migrateLockTimeout)
+	}
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("lock migrations: %w", err)
+	}
+
+	return func() {
+		if _, err := conn.ExecContext(context.Background(), "SELECT RELEASE_LOCK(?)", migrateLockName); err != nil {
+			m.log.Errorf("Failed to release the migration lock: %v", err)
+		}
+		conn.Close()
+	}, nil
+}
+
+// schemaOptions make the schema sync additive. Drops are skipped, and so
+// are column type changes that can lose data; both are recorded for
+// takeSkipped. Ent's own drop filter runs beneath the diff hook and would
+// hide the drops from it, so it is turned off and the hook skips them.
+func (m *Migrator) schemaOptions() []sqlschema.MigrateOption {
+	return []sqlschema.MigrateOption{
+		migrate.WithForeignKeys(true),
+		migrate.WithDropColumn(true),
+		migrate.WithDropIndex(true),
+		sqlschema.WithDiffHook(m.skipUnsafeChanges),
+	}
+}
+
+func (m *Migrator) skipUnsafeChanges(next sqlschema.Differ) sqlschema.Differ {
+	return sqlschema.DiffFunc(func(current, desired *atlas.Schema) ([]atlas.Change, error) {
+		changes, err := next.Diff(current, desired)
+		if err != nil {
+			return nil, err
+		}
+
+		safe := changes[:0]
+		for _, c := range changes {
+			switch c := c.(type) {
+			case *atlas.DropTable:
+				m.skip("drop of table %s", c.T.Name)
+				continue
+			case *atlas.ModifyTable:
+				kept := c.Changes[:0]
+				for _, tc := range c.Changes {
+					switch tc := tc.(type) {
+					case *atlas.DropColumn:
+						m.skip("drop of column %s.%s", c.T.Name, tc.C.Name)
+						continue
+					case *atlas.DropIndex:
+						m.skip("drop of index %s.%s", c.T.Name, tc.I.Name)
+						continue
+					case *atlas.DropForeignKey:
+						m.skip("drop of foreign key %s.%s", c.T.Name, tc.F.Symbol)
+						continue
+					case *atlas.ModifyColumn:
+						if tc.Change.Is(atlas.ChangeType) && !widensType(tc) {
+							m.skip("type change of column %s.%s", c.T.Name, tc.From.Name)
+							continue
+						}
+					}
+					kept = append(kept, tc)
+				}
+				if len(kept) == 0 {
+					continue
+				}
+				c.Changes = kept
+			}
+			safe = append(safe, c)
+		}
+		return safe, nil
+	})
+}
+
+// widensType reports whether a column type change keeps every stored
+// value: an enum gaining values or a string getting longer.
+func widensType(c *atlas.ModifyColumn) bool {
+	switch from := c.From.Type.Type.(type) {
+	case *atlas.EnumType:
+		to, ok := c.To.Type.Type.(*atlas.EnumType)
+		if !ok {
+			return false
+		}
+		for _, v := range from.Values {
+			if !slices.Contains(to.Values, v) {
+				return false
+			}
+		}
+		return true
+	case *atlas.StringType:
+		to, ok := c.To.Type.Type.(*atlas.StringType)
+		return ok && to.T == from.T && to.Size >= from.Size
+	}
+	return false
+}
+
+func (m *Migrator) skip(format string, args ...any) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.skipped = append(m.skipped, fmt.Sprintf(format, args...))
+}
+
+func (m *Migrator) resetSkipped() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.skipped = nil
+}
+
+func (m *Migrator) takeSkipped() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	skipped := m.skipped
+	m.skipped = nil
+	return skipped
+}
@@ -0,0 +1,233 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"yinni_backend/ent"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// newTestData opens an empty in-memory database.
+func newTestData(t *testing.T) *Data {
+	t.Helper()
+	drv, err := entsql.Open(dialect.SQLite, fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := ent.NewClient(ent.Driver(drv))
+	t.Cleanup(func() { client.Close() })
+	return &Data{ent: client, db: drv.DB(), dialect: dialect.SQLite}
+}
+
+func appliedVersions(t *testing.T, m *Migrator) []int64 {
+	t.Helper()
+	status, err := m.Status(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var versions []int64
+	for _, s := range status.Migrations {
+		if !s.AppliedAt.IsZero() {
+			versions = append(versions, s.Version)
+		}
+	}
+	return versions
+}
+
+func TestMigratorUpDown(t *testing.T) {
+	m := NewMigrator(newTestData(t), log.DefaultLogger)
+	ctx := context.Background()
+	var calls []string
+	step := func(name string) func(context.Context, *Data) error {
+		return func(context.Context, *Data) error {
+			calls = append(calls, name)
+			return nil
+		}
+	}
+	m.migrations = []*Migration{
+		{Version: 1, Name: "one", Up: step("up 1"), Down: step("down 1")},
+		{Version: 2, Name: "two", Up: step("up 2")},
+		{Version: 3, Name: "three", Up: step("up 3"), Down: step("down 3")},
+	}
+
+	// Before the first sync every table is pending and nothing is applied
+	status, err := m.Status(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(status.PendingSchema) == 0 || len(status.Migrations) != 3 {
+		t.Errorf("status: %d schema statements, %d migrations", len(status.PendingSchema), len(status.Migrations))
+	}
+	if v := appliedVersions(t, m); len(v) != 0 {
+		t.Errorf("applied %v before migrating", v)
+	}
+
+	if n, err := m.Up(ctx, 2); err != nil || n != 2 {
+		t.Fatalf("Up(2) = %d, %v; want 2", n, err)
+	}
+	if n, err := m.Up(ctx, 0); err != nil || n != 1 {
+		t.Fatalf("Up(0) = %d, %v; want 1", n, err)
+	}
+	if n, err := m.Up(ctx, 0); err != nil || n != 0 {
+		t.Fatalf("Up(0) again = %d, %v; want 0", n, err)
+	}
+	if v := appliedVersions(t, m); !slices.Equal(v, []int64{1, 2, 3}) {
+		t.Errorf("applied %v, want 1, 2 and 3", v)
+	}
+	status, err = m.Status(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(status.PendingSchema) != 0 {
+		t.Errorf("schema still pending after Up: %v", status.PendingSchema)
+	}
+
+	// Down reverts newest first; a nil Down only forgets the migration
+	if n, err := m.Down(ctx, 2); err != nil || n != 2 {
+		t.Fatalf("Down(2) = %d, %v; want 2", n, err)
+	}
+	if v := appliedVersions(t, m); !slices.Equal(v, []int64{1}) {
+		t.Errorf("applied %v after Down(2), want 1", v)
+	}
+	want := []string{"up 1", "up 2", "up 3", "down 3"}
+	if !slices.Equal(calls, want) {
+		t.Errorf("calls %v, want %v", calls, want)
+	}
+}
+
+func TestMigratorFailedMigration(t *testing.T) {
+	m := NewMigrator(newTestData(t), log.DefaultLogger)
+	ctx := context.Background()
+	fail := errors.New("backfill failed")
+	m.migrations = []*Migration{
+		{Version: 1, Name: "one", Up: func(context.Context, *Data) error { return nil }},
+		{Version: 2, Name: "two", Up: func(context.Context, *Data) error { return fail }},
+		{Version: 3, Name: "three", Up: func(context.Context, *Data) error { return nil }},
+	}
+
+	// The migrations after a failed one are not applied, nor is it recorded
+	n, err := m.Up(ctx, 0)
+	if !errors.Is(err, fail) || n != 1 {
+		t.Fatalf("Up = %d, %v; want 1 and the migration error", n, err)
+	}
+	if v := appliedVersions(t, m); !slices.Equal(v, []int64{1}) {
+		t.Errorf("applied %v, want 1", v)
+	}
+
+	// It is rerun by the next Up
+	fail = nil
+	m.migrations[1].Up = func(context.Context, *Data) error { return nil }
+	if n, err := m.Up(ctx, 0); err != nil || n != 2 {
+		t.Fatalf("Up after the fix = %d, %v; want 2", n, err)
+	}
+}
+
+func TestMigratorSkipsUnsafeChanges(t *testing.T) {
+	d := newTestData(t)
+	m := NewMigrator(d, log.DefaultLogger)
+	m.migrations = nil
+	ctx := context.Background()
+	if _, err := m.Up(ctx, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	// A column and an index the ent schema no longer has
+	for _, stmt := range []string{
+		"ALTER TABLE products ADD COLUMN legacy_score integer",
+		"CREATE INDEX product_legacy_score ON products (legacy_score)",
+	} {
+		if _, err := d.db.ExecContext(ctx, stmt); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	status, err := m.Status(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, stmt := range status.PendingSchema {
+		if strings.Contains(strings.ToUpper(stmt), "DROP") {
+			t.Errorf("pending schema drops: %s", stmt)
+		}
+	}
+	for _, want := range []string{"drop of column products.legacy_score", "drop of index products.product_legacy_score"} {
+		if !slices.Contains(status.SkippedSchema, want) {
+			t.Errorf("skipped %v, want %q", status.SkippedSchema, want)
+		}
+	}
+
+	if _, err := m.Up(ctx, 0); err != nil {
+		t.Fatal(err)
+	}
+	var n int
+	if err := d.db.QueryRowContext(ctx, "SELECT COUNT(legacy_score) FROM products").Scan(&n); err != nil {
+		t.Errorf("column dropped by Up: %v", err)
+	}
+}
+
+func TestProductMigrations(t *testing.T) {
+	r := newTestRepo(t)
+	ctx := context.Background()
+	row, err := r.data.ent.Product.Create().
+		SetOriginalID("orig-1").
+		SetPid("PID0001").
+		SetTitle("Track pants").
+		SetBrand("York").
+		SetCategory("Clothing").
+		SetSubCategory("Bottomwear").
+		SetActualPrice("2,000").
+		SetSellingPrice("1,500").
+		SetProductDetails([]map[string]string{{"Color": "Blue"}, {"Fabric": "Cotton"}}).
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Roll the product back to before the hooks derived its columns
+	for _, stmt := range []string{
+		"UPDATE products SET actual_price_numeric = NULL, discount_percent = NULL",
+		"DELETE FROM product_attributes",
+	} {
+		if _, err := r.data.db.ExecContext(ctx, stmt); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	m := NewMigrator(r.data, log.DefaultLogger)
+	if n, err := m.Up(ctx, 0); err != nil || n != len(migrations) {
+		t.Fatalf("Up = %d, %v; want %d", n, err, len(migrations))
+	}
+
+	got := r.data.ent.Product.GetX(ctx, row.ID)
+	if got.ActualPriceNumeric != 2000 || got.DiscountPercent != 25 {
+		t.Errorf("actual price %d, discount %v; want 2000, 25", got.ActualPriceNumeric, got.DiscountPercent)
+	}
+	if !got.UpdateTime.Equal(row.UpdateTime) {
+		t.Errorf("backfill changed update_time from %v to %v", row.UpdateTime, got.UpdateTime)
+	}
+	if n := r.data.ent.ProductAttribute.Query().CountX(ctx); n != 2 {
+		t.Errorf("%d attributes backfilled, want 2", n)
+	}
+}
+
+func TestCatalogEmpty(t *testing.T) {
+	r := newTestRepo(t)
+	m := NewMigrator(r.data, log.DefaultLogger)
+	ctx := context.Background()
+
+	if empty, err := m.CatalogEmpty(ctx); err != nil || !empty {
+		t.Errorf("new catalog: empty %v, err %v", empty, err)
+	}
+	seedProducts(t, r, 1)
+	if empty, err := m.CatalogEmpty(ctx); err != nil || empty {
+		t.Errorf("seeded catalog: empty %v, err %v", empty, err)
+	}
+}
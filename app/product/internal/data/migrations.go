@@ -0,0 +1,114 @@
+package data
+
+import (
+	"context"
+
+	"yinni_backend/ent"
+	"yinni_backend/ent/product"
+	"yinni_backend/ent/schema"
+
+	entsql "entgo.io/ent/dialect/sql"
+)
+
+// migrationBatchSize is the number of products a backfill reads at a time.
+const migrationBatchSize = 500
+
+// migrations are the versioned migrations of the product service. Append
+// new ones with the next version; never renumber or edit applied ones.
+var migrations = []*Migration{
+	{
+		Version: 1,
+		Name:    "backfill_product_pricing",
+		Up:      backfillProductPricing,
+	},
+	{
+		Version: 2,
+		Name:    "backfill_product_attributes",
+		Up:      backfillProductAttributes,
+	},
+}
+
+// backfillProductPricing fills actual_price_numeric and discount_percent of
+// products written before the hooks derived them. It updates the columns
+// directly so the hooks do not touch crawled_at and update_time.
+func backfillProductPricing(ctx context.Context, d *Data) error {
+	afterID := 0
+	for {
+		rows, err := d.ent.Product.Query().
+			Where(
+				product.IDGT(afterID),
+				product.Or(
+					product.And(product.ActualPriceNotNil(), product.ActualPriceNumericIsNil()),
+					product.DiscountPercentIsNil(),
+				),
+			).
+			Order(ent.Asc(product.FieldID)).
+			Limit(migrationBatchSize).
+			Select(product.FieldID, product.FieldActualPrice, product.FieldSellingPrice, product.FieldDiscount).
+			All(ctx)
+		if err != nil || len(rows) == 0 {
+			return err
+		}
+
+		for _, row := range rows {
+			update := entsql.Dialect(d.dialect).
+				Update(product.Table).
+				Where(entsql.EQ(product.FieldID, row.ID))
+			changed := false
+			if row.ActualPrice != "" {
+				update.Set(product.FieldActualPriceNumeric, schema.PriceNumber(row.ActualPrice))
+				changed = true
+			}
+			if pct, ok := schema.DiscountPercent(row.Discount, row.ActualPrice, row.SellingPrice); ok {
+				update.Set(product.FieldDiscountPercent, pct)
+				changed = true
+			}
+			if !changed {
+				continue
+			}
+
+			query, args := update.Query()
+			if _, err := d.db.ExecContext(ctx, query, args...); err != nil {
+				return err
+			}
+		}
+		afterID = rows[len(rows)-1].ID
+	}
+}
+
+// backfillProductAttributes extracts the attributes of products written
+// before product_attributes existed.
+func backfillProductAttributes(ctx context.Context, d *Data) error {
+	afterID := 0
+	for {
+		rows, err := d.ent.Product.Query().
+			Where(
+				product.IDGT(afterID),
+				product.ProductDetailsNotNil(),
+				product.Not(product.HasAttributes()),
+			).
+			Order(ent.Asc(product.FieldID)).
+			Limit(migrationBatchSize).
+			Select(product.FieldID, product.FieldProductDetails).
+			All(ctx)
+		if err != nil || len(rows) == 0 {
+			return err
+		}
+
+		var builders []*ent.ProductAttributeCreate
+		for _, row := range rows {
+			for _, a := range schema.ExtractAttributes(row.ProductDetails) {
+				builders = append(builders, d.ent.ProductAttribute.Create().
+					SetProductID(row.ID).
+					SetKey(a.Key).
+					SetValue(a.Value))
+			}
+		}
+		if len(builders) > 0 {
+			if err := d.ent.ProductAttribute.CreateBulk(builders...).Exec(ctx); err != nil {
+				return err
+			}
+		}
+		afterID = rows[len(rows)-1].ID
+	}
+}
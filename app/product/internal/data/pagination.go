@@ -0,0 +1,200 @@
+package data
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"reflect"
+	"strings"
+	"time"
+
+	"yinni_backend/app/product/internal/biz"
+	"yinni_backend/ent"
+	"yinni_backend/ent/predicate"
+	"yinni_backend/ent/product"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// sortKey is a ListProducts ordering. Rows are ordered by field, then by id
+// in the same direction, so every position in the listing is unique.
+type sortKey struct {
+	field    string
+	desc     bool
+	nullable bool
+	// value returns the row's field value; isNull reports whether it was
+	// NULL, which the ent entity cannot tell apart from the zero value.
+	value  func(*ent.Product) any
+	isNull predicate.Product
+	// decode reads a value written by value back from a page token.
+	decode func(json.RawMessage) (any, error)
+}
+
+func decodeAs[T any](raw json.RawMessage) (any, error) {
+	var v T
+	err := json.Unmarshal(raw, &v)
+	return v, err
+}
+
+// listSortKey maps the sort_by and sort_order params to a sortKey.
+func listSortKey(params *biz.ListProductsParams) sortKey {
+	desc := strings.ToLower(params.SortOrder) != "asc"
+	switch strings.ToLower(params.SortBy) {
+	case "price":
+		return sortKey{
+			field:    product.FieldPriceNumeric,
+			desc:     desc,
+			nullable: true,
+			value:    func(p *ent.Product) any { return p.PriceNumeric },
+			isNull:   product.PriceNumericIsNil(),
+			decode:   decodeAs[int],
+		}
+	case "rating":
+		return sortKey{
+			field:    product.FieldRatingNumeric,
+			desc:     desc,
+			nullable: true,
+			value:    func(p *ent.Product) any { return p.RatingNumeric },
+			isNull:   product.RatingNumericIsNil(),
+			decode:   decodeAs[float64],
+		}
+	case "popular":
+		return sortKey{
+			field:  product.FieldViewCount,
+			desc:   desc,
+			value:  func(p *ent.Product) any { return p.ViewCount },
+			decode: decodeAs[int],
+		}
+	default: // newest
+		return sortKey{
+			field:  product.FieldCreateTime,
+			desc:   desc,
+			value:  func(p *ent.Product) any { return p.CreateTime },
+			decode: decodeAs[time.Time],
+		}
+	}
+}
+
+// order sorts by the key, then by id.
+func (k sortKey) order() product.OrderOption {
+	if k.desc {
+		return func(s *sql.Selector) {
+			s.OrderBy(sql.Desc(s.C(k.field)), sql.Desc(s.C(product.FieldID)))
+		}
+	}
+	return func(s *sql.Selector) {
+		s.OrderBy(sql.Asc(s.C(k.field)), sql.Asc(s.C(product.FieldID)))
+	}
+}
+
+// after selects the rows that follow the given position. NULLs sort before
+// every value on MySQL and SQLite, so they come last when descending and
+// first when ascending.
+func (k sortKey) after(value any, id int) predicate.Product {
+	return func(s *sql.Selector) {
+		c, idc := s.C(k.field), s.C(product.FieldID)
+		cmp := sql.GT
+		if k.desc {
+			cmp = sql.LT
+		}
+
+		switch {
+		case value == nil && k.desc:
+			s.Where(sql.And(sql.IsNull(c), cmp(idc, id)))
+		case value == nil:
+			s.Where(sql.Or(sql.And(sql.IsNull(c), cmp(idc, id)), sql.NotNull(c)))
+		case k.nullable && k.desc:
+			s.Where(sql.Or(cmp(c, value), sql.And(sql.EQ(c, value), cmp(idc, id)), sql.IsNull(c)))
+		default:
+			s.Where(sql.Or(cmp(c, value), sql.And(sql.EQ(c, value), cmp(idc, id))))
+		}
+	}
+}
+
+// pageToken is the position after the last product of a page. It is tied
+// to the sort and filters it was issued for.
+type pageToken struct {
+	Sort    string          `json:"s"`
+	Desc    bool            `json:"d,omitempty"`
+	Value   json.RawMessage `json:"v"`
+	ID      int             `json:"i"`
+	Filters uint64          `json:"f"`
+}
+
+func encodePageToken(t *pageToken) (string, error) {
+	b, err := json.Marshal(t)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// decodePageToken parses a token and checks it belongs to the listing.
+func decodePageToken(s string, key sortKey, filters uint64) (*pageToken, any, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, nil, biz.ErrInvalidPageToken
+	}
+	var t pageToken
+	if err := json.Unmarshal(b, &t); err != nil {
+		return nil, nil, biz.ErrInvalidPageToken
+	}
+	if t.Sort != key.field || t.Desc != key.desc || t.Filters != filters {
+		return nil, nil, biz.ErrInvalidPageToken.WithCause(fmt.Errorf("sorting or filters changed"))
+	}
+
+	if len(t.Value) == 0 || string(t.Value) == "null" {
+		return &t, nil, nil
+	}
+	value, err := key.decode(t.Value)
+	if err != nil {
+		return nil, nil, biz.ErrInvalidPageToken
+	}
+	return &t, value, nil
+}
+
+// listFiltersHash fingerprints the filters of a listing so a page token
+// cannot be replayed against a different one. Every field but the paging
+// ones is hashed; the sort is checked on its own.
+func listFiltersHash(params *biz.ListProductsParams) uint64 {
+	filters := *params
+	filters.Page, filters.PageSize, filters.PageToken, filters.IncludeTotal = 0, 0, "", false
+	filters.SortBy, filters.SortOrder = "", ""
+
+	// The params are plain values and slices, which always marshal
+	b, _ := json.Marshal(&filters)
+	h := fnv.New64a()
+	h.Write(b)
+	return h.Sum64()
+}
+
+// nextPageToken returns the token continuing after last. A zero value of a
+// nullable key is looked up to tell it apart from NULL.
+func (r *productRepo) nextPageToken(ctx context.Context, key sortKey, filters uint64, last *ent.Product) (string, error) {
+	value := key.value(last)
+	if key.nullable && reflect.ValueOf(value).IsZero() {
+		null, err := r.data.ent.Product.Query().
+			Where(product.ID(last.ID), key.isNull).
+			Exist(ctx)
+		if err != nil {
+			return "", err
+		}
+		if null {
+			value = nil
+		}
+	}
+
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return encodePageToken(&pageToken{
+		Sort:    key.field,
+		Desc:    key.desc,
+		Value:   raw,
+		ID:      last.ID,
+		Filters: filters,
+	})
+}
@@ -0,0 +1,241 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"yinni_backend/app/product/internal/biz"
+	"yinni_backend/ent"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// newTestRepo returns a repository over an in-memory SQLite catalog.
+func newTestRepo(t *testing.T) *productRepo {
+	t.Helper()
+	drv, err := entsql.Open(dialect.SQLite, fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := ent.NewClient(ent.Driver(drv))
+	t.Cleanup(func() { client.Close() })
+	if err := client.Schema.Create(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	return &productRepo{
+		data:  &Data{ent: client, db: drv.DB(), dialect: dialect.SQLite},
+		log:   log.NewHelper(log.DefaultLogger),
+		index: NewVectorIndex(),
+	}
+}
+
+// seedProducts adds n products whose prices, ratings and view counts
+// repeat, including NULL and zero prices and ratings.
+func seedProducts(t *testing.T, r *productRepo, n int) {
+	t.Helper()
+	ctx := context.Background()
+	from := r.data.ent.Product.Query().CountX(ctx)
+	for i := from; i < from+n; i++ {
+		create := r.data.ent.Product.Create().
+			SetOriginalID(fmt.Sprintf("orig-%d", i)).
+			SetTitle(fmt.Sprintf("Product %d", i)).
+			SetBrand("Acme").
+			SetCategory("Clothing").
+			SetSubCategory("Shirts").
+			SetPid(fmt.Sprintf("PID%04d", i)).
+			SetViewCount(i % 3)
+		switch i % 4 {
+		case 0: // NULL price and rating
+		case 1:
+			create.SetSellingPrice("0").SetAverageRating("0")
+		default:
+			create.SetSellingPrice(fmt.Sprintf("%d", 100*(i%5))).SetAverageRating(fmt.Sprintf("%d.5", i%4))
+		}
+		if _, err := create.Save(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func productIDs(products []*biz.Product) []int64 {
+	ids := make([]int64, len(products))
+	for i, p := range products {
+		ids[i] = p.ID
+	}
+	return ids
+}
+
+// walkPages lists every page of params by page token.
+func walkPages(t *testing.T, r *productRepo, params biz.ListProductsParams) []int64 {
+	t.Helper()
+	params.Page = 1
+	var ids []int64
+	for pages := 0; ; pages++ {
+		if pages > 100 {
+			t.Fatal("listing does not end")
+		}
+		page, err := r.ListProducts(context.Background(), &params)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, productIDs(page.Products)...)
+		if page.NextPageToken == "" {
+			return ids
+		}
+		params.PageToken = page.NextPageToken
+	}
+}
+
+func TestKeysetPagination(t *testing.T) {
+	r := newTestRepo(t)
+	seedProducts(t, r, 23)
+
+	for _, sortBy := range []string{"newest", "popular", "price", "rating"} {
+		for _, order := range []string{"asc", "desc"} {
+			t.Run(sortBy+" "+order, func(t *testing.T) {
+				params := biz.ListProductsParams{SortBy: sortBy, SortOrder: order}
+
+				all := params
+				all.Page, all.PageSize = 1, 100
+				page, err := r.ListProducts(context.Background(), &all)
+				if err != nil {
+					t.Fatal(err)
+				}
+				want := productIDs(page.Products)
+				if len(want) != 23 {
+					t.Fatalf("listed %d products, want 23", len(want))
+				}
+
+				// Every page size splits some run of equal values
+				for _, size := range []int32{1, 2, 5, 22, 23} {
+					params.PageSize = size
+					if got := walkPages(t, r, params); !slices.Equal(got, want) {
+						t.Errorf("page size %d: walked %v, want %v", size, got, want)
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestSortOrder(t *testing.T) {
+	r := newTestRepo(t)
+	seedProducts(t, r, 12)
+	ctx := context.Background()
+
+	// Every key honours sort_order; descending is the default
+	for _, sortBy := range []string{"newest", "popular", "price", "rating"} {
+		var lists [3][]int64
+		for i, order := range []string{"", "desc", "asc"} {
+			page, err := r.ListProducts(ctx, &biz.ListProductsParams{Page: 1, PageSize: 100, SortBy: sortBy, SortOrder: order})
+			if err != nil {
+				t.Fatal(err)
+			}
+			lists[i] = productIDs(page.Products)
+		}
+		if !slices.Equal(lists[0], lists[1]) {
+			t.Errorf("%s: default order %v, want descending %v", sortBy, lists[0], lists[1])
+		}
+		reversed := slices.Clone(lists[2])
+		slices.Reverse(reversed)
+		if !slices.Equal(reversed, lists[1]) {
+			t.Errorf("%s: ascending %v is not descending %v reversed", sortBy, lists[2], lists[1])
+		}
+	}
+}
+
+func TestKeysetPaginationWithInserts(t *testing.T) {
+	r := newTestRepo(t)
+	seedProducts(t, r, 10)
+	ctx := context.Background()
+
+	params := &biz.ListProductsParams{Page: 1, PageSize: 4, SortBy: "newest"}
+	first, err := r.ListProducts(ctx, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A product created between pages goes before the first page, so the
+	// next pages neither repeat nor skip products
+	seedProducts(t, r, 1)
+	seen := productIDs(first.Products)
+	params.PageToken = first.NextPageToken
+	for params.PageToken != "" {
+		page, err := r.ListProducts(ctx, params)
+		if err != nil {
+			t.Fatal(err)
+		}
+		seen = append(seen, productIDs(page.Products)...)
+		params.PageToken = page.NextPageToken
+	}
+	slices.Sort(seen)
+	if want := []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}; !slices.Equal(seen, want) {
+		t.Errorf("walked %v, want %v", seen, want)
+	}
+}
+
+func TestPageTokenRejected(t *testing.T) {
+	r := newTestRepo(t)
+	seedProducts(t, r, 5)
+	ctx := context.Background()
+
+	page, err := r.ListProducts(ctx, &biz.ListProductsParams{Page: 1, PageSize: 2, SortBy: "price", Brand: "Acme"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		params *biz.ListProductsParams
+	}{
+		{"garbage", &biz.ListProductsParams{PageSize: 2, SortBy: "price", Brand: "Acme", PageToken: "not a token!"}},
+		{"other sort", &biz.ListProductsParams{PageSize: 2, SortBy: "rating", Brand: "Acme", PageToken: page.NextPageToken}},
+		{"other order", &biz.ListProductsParams{PageSize: 2, SortBy: "price", SortOrder: "asc", Brand: "Acme", PageToken: page.NextPageToken}},
+		{"other filters", &biz.ListProductsParams{PageSize: 2, SortBy: "price", PageToken: page.NextPageToken}},
+		{"restricted to ids", &biz.ListProductsParams{PageSize: 2, SortBy: "price", Brand: "Acme", ProductIDs: []int64{1, 2, 3}, PageToken: page.NextPageToken}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := r.ListProducts(ctx, tt.params); !errors.Is(err, biz.ErrInvalidPageToken) {
+				t.Errorf("err = %v, want ErrInvalidPageToken", err)
+			}
+		})
+	}
+}
+
+func TestPageTokenCounting(t *testing.T) {
+	r := newTestRepo(t)
+	seedProducts(t, r, 5)
+	ctx := context.Background()
+
+	params := &biz.ListProductsParams{Page: 1, PageSize: 2}
+	page, err := r.ListProducts(ctx, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if page.Total != 5 {
+		t.Errorf("first page Total = %d, want 5", page.Total)
+	}
+
+	params.PageToken = page.NextPageToken
+	if page, err = r.ListProducts(ctx, params); err != nil {
+		t.Fatal(err)
+	}
+	if page.Total != -1 {
+		t.Errorf("token page Total = %d, want -1 without IncludeTotal", page.Total)
+	}
+
+	params.IncludeTotal = true
+	if page, err = r.ListProducts(ctx, params); err != nil {
+		t.Fatal(err)
+	}
+	if page.Total != 5 {
+		t.Errorf("token page Total = %d, want 5 with IncludeTotal", page.Total)
+	}
+}
@@ -2,41 +2,153 @@ package data
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"strings"
+	"sync"
+	"time"
 
 	"yinni_backend/app/product/internal/biz"
 	"yinni_backend/ent"
+	"yinni_backend/ent/predicate"
 	"yinni_backend/ent/product"
-	"yinni_backend/internal/conf"
+	"yinni_backend/ent/productattribute"
+	"yinni_backend/ent/schema"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"github.com/go-kratos/kratos/v2/log"
-	openai "github.com/sashabaranov/go-openai"
 )
 
+// minSimilarity is the cosine similarity below which vector matches are dropped.
+const minSimilarity = 0.3
+
 type productRepo struct {
-	data     *Data
-	log      *log.Helper
-	aiClient *openai.Client
+	data *Data
+	log  *log.Helper
+
+	// model is the embedding model of the running service. Only its
+	// embeddings are indexed; others are stale and get re-embedded.
+	model string
+
+	// index serves SearchSimilarProducts; indexReady is closed once it has
+	// been loaded from the database.
+	index      biz.VectorIndex
+	indexReady chan struct{}
+
+	// While the index loads, written records the products indexed by
+	// writes so the load does not overwrite them with what it read earlier.
+	indexMu sync.Mutex
+	written map[int64]struct{}
 }
 
-// NewProductRepo creates a new Product repository.
-func NewProductRepo(data *Data, cfg *conf.Embeddings, logger log.Logger) biz.ProductRepo {
-	var aiClient *openai.Client
+// NewProductRepo creates a new Product repository. embedder may be nil when
+// embeddings are not configured.
+func NewProductRepo(data *Data, index biz.VectorIndex, embedder biz.Embedder, logger log.Logger) biz.ProductRepo {
+	r := &productRepo{
+		data:       data,
+		log:        log.NewHelper(logger),
+		index:      index,
+		indexReady: make(chan struct{}),
+		written:    make(map[int64]struct{}),
+	}
+	if embedder != nil {
+		r.model = embedder.Model()
+	}
 
-	// Initialize AI client if embeddings are enabled
-	if cfg != nil && cfg.ApiKey != "" {
-		openaiConfig := openai.DefaultConfig(cfg.ApiKey)
-		if cfg.BaseUrl != "" {
-			openaiConfig.BaseURL = cfg.BaseUrl
+	go r.loadVectorIndex(context.Background())
+	go r.backfillSearchText(context.Background())
+
+	return r
+}
+
+// NewExportRepo creates the product repository of the catalog export. Like
+// the import repository it does not load the vector index.
+func NewExportRepo(data *Data, logger log.Logger) biz.ExportRepo {
+	r := &productRepo{
+		data:       data,
+		log:        log.NewHelper(logger),
+		index:      NewVectorIndex(),
+		indexReady: make(chan struct{}),
+	}
+	close(r.indexReady)
+	return r
+}
+
+// NewImportRepo creates the product repository of the import command. It
+// does not load the vector index, which only serves searches.
+func NewImportRepo(data *Data, logger log.Logger) biz.ImportRepo {
+	r := &productRepo{
+		data:       data,
+		log:        log.NewHelper(logger),
+		index:      NewVectorIndex(),
+		indexReady: make(chan struct{}),
+	}
+	close(r.indexReady)
+	return r
+}
+
+// loadVectorIndex fills the vector index with every stored embedding, except
+// those of the products written since the repository was created.
+func (r *productRepo) loadVectorIndex(ctx context.Context) {
+	defer close(r.indexReady)
+	defer func() {
+		r.indexMu.Lock()
+		r.written = nil
+		r.indexMu.Unlock()
+	}()
+
+	start := time.Now()
+	products, err := r.GetProductsWithEmbeddings(ctx, 0)
+	if err != nil {
+		r.log.Errorf("Failed to load embeddings into vector index: %v", err)
+		return
+	}
+
+	for _, p := range products {
+		r.indexMu.Lock()
+		if _, ok := r.written[p.ID]; !ok {
+			r.applyIndex(p)
 		}
-		aiClient = openai.NewClientWithConfig(openaiConfig)
+		r.indexMu.Unlock()
+	}
+	r.log.Infof("Vector index loaded with %d products in %s", r.index.Len(), time.Since(start))
+}
+
+// indexProduct mirrors a product's embedding into the vector index.
+func (r *productRepo) indexProduct(p *biz.Product) {
+	r.indexMu.Lock()
+	defer r.indexMu.Unlock()
+	if r.written != nil {
+		r.written[p.ID] = struct{}{}
 	}
+	r.applyIndex(p)
+}
 
-	return &productRepo{
-		data:     data,
-		aiClient: aiClient,
-		log:      log.NewHelper(logger),
+// unindexProduct removes a deleted product from the vector index.
+func (r *productRepo) unindexProduct(id int64) {
+	r.indexMu.Lock()
+	defer r.indexMu.Unlock()
+	if r.written != nil {
+		r.written[id] = struct{}{}
+	}
+	r.index.Delete(id)
+}
+
+// applyIndex updates the vector index entry of p. The caller holds indexMu.
+func (r *productRepo) applyIndex(p *biz.Product) {
+	if len(p.Embedding) == 0 || p.EmbeddingModel != r.model {
+		r.index.Delete(p.ID)
+		return
+	}
+
+	err := r.index.Upsert(&biz.VectorEntry{
+		ID:           p.ID,
+		Vector:       p.Embedding,
+		Category:     p.Category,
+		PriceNumeric: p.PriceNumeric,
+	})
+	if err != nil {
+		r.log.Errorf("Failed to index embedding for product %d: %v", p.ID, err)
 	}
 }
 
@@ -96,6 +208,9 @@ func (r *productRepo) Create(ctx context.Context, p *biz.Product) (*biz.Product,
 	if !p.CrawledAt.IsZero() {
 		builder.SetCrawledAt(p.CrawledAt)
 	}
+	if p.Featured {
+		builder.SetFeatured(true)
+	}
 	if len(p.Embedding) > 0 {
 		builder.SetEmbedding(p.Embedding)
 	}
@@ -105,15 +220,39 @@ func (r *productRepo) Create(ctx context.Context, p *biz.Product) (*biz.Product,
 
 	row, err := builder.Save(ctx)
 	if err != nil {
-		return nil, err
+		return nil, convertEntError(err)
 	}
 
-	return convertEntToBiz(row), nil
+	created := convertEntToBiz(row)
+	if len(created.Embedding) > 0 {
+		r.indexProduct(created)
+	}
+	return created, nil
 }
 
-func (r *productRepo) Update(ctx context.Context, p *biz.Product) (*biz.Product, error) {
+func (r *productRepo) Update(ctx context.Context, p *biz.Product, fields []string) (*biz.Product, error) {
 	builder := r.data.ent.Product.UpdateOneID(int(p.ID))
 
+	if len(fields) == 0 {
+		applyNonEmptyFields(builder, p)
+	} else {
+		applyMaskedFields(builder, p, fields)
+	}
+
+	row, err := builder.Save(ctx)
+	if err != nil {
+		return nil, convertEntError(err)
+	}
+
+	// Keep category and price filters in the vector index current.
+	updated := convertEntToBiz(row)
+	r.indexProduct(updated)
+	return updated, nil
+}
+
+// applyNonEmptyFields sets every non-empty field of p on the builder. False
+// is the empty bool, so flags can only be cleared through a field mask.
+func applyNonEmptyFields(builder *ent.ProductUpdateOne, p *biz.Product) {
 	// Update fields if they have values
 	if p.Title != "" {
 		builder.SetTitle(p.Title)
@@ -127,8 +266,9 @@ func (r *productRepo) Update(ctx context.Context, p *biz.Product) (*biz.Product,
 	if p.SubCategory != "" {
 		builder.SetSubCategory(p.SubCategory)
 	}
-	builder.SetOutOfStock(p.OutOfStock)
-
+	if p.OutOfStock {
+		builder.SetOutOfStock(p.OutOfStock)
+	}
 	if p.Description != "" {
 		builder.SetDescription(p.Description)
 	}
@@ -150,7 +290,7 @@ func (r *productRepo) Update(ctx context.Context, p *biz.Product) (*biz.Product,
 	if p.AverageRating != "" {
 		builder.SetAverageRating(p.AverageRating)
 	}
-	if p.RatingNumeric >= 0 {
+	if p.RatingNumeric > 0 {
 		builder.SetRatingNumeric(float64(p.RatingNumeric))
 	}
 	if p.Images != nil {
@@ -174,13 +314,92 @@ func (r *productRepo) Update(ctx context.Context, p *biz.Product) (*biz.Product,
 	if p.SearchKeywords != nil {
 		builder.SetSearchKeywords(p.SearchKeywords)
 	}
+}
 
-	row, err := builder.Save(ctx)
-	if err != nil {
-		return nil, err
+// applyMaskedFields writes exactly the listed fields of p, clearing optional
+// fields whose value is empty. Derived numeric columns are cleared along with
+// their source strings; when set, the schema hooks recompute them.
+func applyMaskedFields(builder *ent.ProductUpdateOne, p *biz.Product, fields []string) {
+	for _, field := range fields {
+		switch field {
+		case biz.ProductFieldOriginalID:
+			builder.SetOriginalID(p.OriginalID)
+		case biz.ProductFieldTitle:
+			builder.SetTitle(p.Title)
+		case biz.ProductFieldBrand:
+			builder.SetBrand(p.Brand)
+		case biz.ProductFieldCategory:
+			builder.SetCategory(p.Category)
+		case biz.ProductFieldSubCategory:
+			builder.SetSubCategory(p.SubCategory)
+		case biz.ProductFieldPID:
+			builder.SetPid(p.PID)
+		case biz.ProductFieldOutOfStock:
+			builder.SetOutOfStock(p.OutOfStock)
+		case biz.ProductFieldFeatured:
+			builder.SetFeatured(p.Featured)
+		case biz.ProductFieldDescription:
+			if p.Description == "" {
+				builder.ClearDescription()
+			} else {
+				builder.SetDescription(p.Description)
+			}
+		case biz.ProductFieldActualPrice:
+			if p.ActualPrice == "" {
+				builder.ClearActualPrice()
+			} else {
+				builder.SetActualPrice(p.ActualPrice)
+			}
+		case biz.ProductFieldSellingPrice:
+			if p.SellingPrice == "" {
+				builder.ClearSellingPrice().ClearPriceNumeric()
+			} else {
+				builder.SetSellingPrice(p.SellingPrice)
+			}
+		case biz.ProductFieldDiscount:
+			if p.Discount == "" {
+				builder.ClearDiscount()
+			} else {
+				builder.SetDiscount(p.Discount)
+			}
+		case biz.ProductFieldSeller:
+			if p.Seller == "" {
+				builder.ClearSeller()
+			} else {
+				builder.SetSeller(p.Seller)
+			}
+		case biz.ProductFieldAverageRating:
+			if p.AverageRating == "" {
+				builder.ClearAverageRating().ClearRatingNumeric()
+			} else {
+				builder.SetAverageRating(p.AverageRating)
+			}
+		case biz.ProductFieldImages:
+			if len(p.Images) == 0 {
+				builder.ClearImages()
+			} else {
+				builder.SetImages(p.Images)
+			}
+		case biz.ProductFieldProductDetails:
+			if len(p.ProductDetails) == 0 {
+				builder.ClearProductDetails()
+			} else {
+				builder.SetProductDetails(p.ProductDetails)
+			}
+		case biz.ProductFieldURL:
+			if p.URL == "" {
+				builder.ClearURL()
+			} else {
+				builder.SetURL(p.URL)
+			}
+		case biz.ProductFieldStyleCode:
+			if p.StyleCode == "" {
+				builder.ClearStyleCode()
+			} else {
+				builder.SetStyleCode(p.StyleCode)
+			}
+		}
 	}
-
-	return convertEntToBiz(row), nil
 }
 
 func (r *productRepo) Delete(ctx context.Context, id int64) (*biz.Product, error) {
@@ -190,16 +409,24 @@ func (r *productRepo) Delete(ctx context.Context, id int64) (*biz.Product, error
 		return nil, err
 	}
 
+	// The product may be deleted concurrently after it was read
 	err = r.data.ent.Product.DeleteOneID(int(id)).Exec(ctx)
 	if err != nil {
-		return nil, err
+		return nil, convertEntError(err)
 	}
 
+	r.unindexProduct(id)
 	return p, nil
 }
 
 func (r *productRepo) GetProduct(ctx context.Context, id int64) (*biz.Product, error) {
-	row, err := r.data.ent.Product.Get(ctx, int(id))
+	row, err := r.data.ent.Product.
+		Query().
+		Where(product.ID(int(id))).
+		WithAttributes(func(q *ent.ProductAttributeQuery) {
+			q.Order(ent.Asc(productattribute.FieldKey), ent.Asc(productattribute.FieldValue))
+		}).
+		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return nil, biz.ErrProductNotFound
@@ -214,6 +441,9 @@ func (r *productRepo) GetProductByPID(ctx context.Context, pid string) (*biz.Pro
 	row, err := r.data.ent.Product.
 		Query().
 		Where(product.Pid(pid)).
+		WithAttributes(func(q *ent.ProductAttributeQuery) {
+			q.Order(ent.Asc(productattribute.FieldKey), ent.Asc(productattribute.FieldValue))
+		}).
 		First(ctx)
 
 	if err != nil {
@@ -226,6 +456,45 @@ func (r *productRepo) GetProductByPID(ctx context.Context, pid string) (*biz.Pro
 	return convertEntToBiz(row), nil
 }
 
+func (r *productRepo) FindProductIDs(ctx context.Context, products []*biz.Product) ([]int64, error) {
+	var pids, originalIDs []string
+	for _, p := range products {
+		if p.PID != "" {
+			pids = append(pids, p.PID)
+		}
+		if p.OriginalID != "" {
+			originalIDs = append(originalIDs, p.OriginalID)
+		}
+	}
+	ids := make([]int64, len(products))
+	if len(pids) == 0 && len(originalIDs) == 0 {
+		return ids, nil
+	}
+
+	rows, err := r.data.ent.Product.Query().
+		Where(product.Or(product.PidIn(pids...), product.OriginalIDIn(originalIDs...))).
+		Select(product.FieldID, product.FieldPid, product.FieldOriginalID).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byPID := make(map[string]int64, len(rows))
+	byOriginalID := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		byPID[row.Pid] = int64(row.ID)
+		byOriginalID[row.OriginalID] = int64(row.ID)
+	}
+
+	for i, p := range products {
+		if id, ok := byPID[p.PID]; ok && p.PID != "" {
+			ids[i] = id
+		} else if id, ok := byOriginalID[p.OriginalID]; ok && p.OriginalID != "" {
+			ids[i] = id
+		}
+	}
+	return ids, nil
+}
+
 func (r *productRepo) ListAllProducts(ctx context.Context) ([]*biz.Product, error) {
 	rows, err := r.data.ent.Product.
 		Query().
@@ -243,136 +512,211 @@ func (r *productRepo) ListAllProducts(ctx context.Context) ([]*biz.Product, erro
 	return rv, nil
 }
 
-func (r *productRepo) ListProducts(ctx context.Context, params *biz.ListProductsParams) ([]*biz.Product, int64, error) {
-	query := r.data.ent.Product.Query()
+// ListProducts pages through the filtered products. Without a page token
+// it uses offset pagination and always counts the total; with one it seeks
+// past the token's position and counts only when asked.
+func (r *productRepo) ListProducts(ctx context.Context, params *biz.ListProductsParams) (*biz.ProductPage, error) {
+	key := listSortKey(params)
+	filters := listFiltersHash(params)
+
+	query := r.data.ent.Product.Query().
+		Where(listFilters(params, "")...)
+
+	page := &biz.ProductPage{Total: -1}
+	if params.PageToken == "" || params.IncludeTotal {
+		total, err := query.Clone().Count(ctx)
+		if err != nil {
+			return nil, err
+		}
+		page.Total = int64(total)
+	}
+
+	if params.PageToken != "" {
+		token, value, err := decodePageToken(params.PageToken, key, filters)
+		if err != nil {
+			return nil, err
+		}
+		query = query.Where(key.after(value, token.ID))
+	} else {
+		query = query.Offset((int(params.Page) - 1) * int(params.PageSize))
+	}
+
+	// One extra row tells whether another page follows
+	rows, err := query.
+		Order(key.order()).
+		Limit(int(params.PageSize) + 1).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) > int(params.PageSize) {
+		rows = rows[:params.PageSize]
+		page.NextPageToken, err = r.nextPageToken(ctx, key, filters, rows[len(rows)-1])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	page.Products = make([]*biz.Product, 0, len(rows))
+	for _, row := range rows {
+		page.Products = append(page.Products, convertEntToBiz(row))
+	}
+	return page, nil
+}
 
-	// Apply filters
-	if params.Category != "" {
-		query = query.Where(product.Category(params.Category))
+// listFilters returns the predicates of the params filters, leaving out the
+// filter of the named facet.
+func listFilters(params *biz.ListProductsParams, except string) []predicate.Product {
+	var preds []predicate.Product
+	if params == nil {
+		return preds
 	}
-	if params.SubCategory != "" {
-		query = query.Where(product.SubCategory(params.SubCategory))
+
+	if params.Category != "" && except != facetCategory {
+		preds = append(preds, product.Category(params.Category))
 	}
-	if params.Brand != "" {
-		query = query.Where(product.Brand(params.Brand))
+	if params.SubCategory != "" && except != facetSubCategory {
+		preds = append(preds, product.SubCategory(params.SubCategory))
 	}
-	if params.Seller != "" {
-		query = query.Where(product.Seller(params.Seller))
+	if params.Brand != "" && except != facetBrand {
+		preds = append(preds, product.Brand(params.Brand))
 	}
-	if params.MinPrice > 0 {
-		query = query.Where(product.PriceNumericGTE(int(params.MinPrice)))
+	if params.Seller != "" && except != facetSeller {
+		preds = append(preds, product.Seller(params.Seller))
 	}
-	if params.MaxPrice > 0 {
-		query = query.Where(product.PriceNumericLTE(int(params.MaxPrice)))
+	if except != facetPrice {
+		if params.MinPrice > 0 {
+			preds = append(preds, product.PriceNumericGTE(int(params.MinPrice)))
+		}
+		if params.MaxPrice > 0 {
+			preds = append(preds, product.PriceNumericLTE(int(params.MaxPrice)))
+		}
 	}
-	if params.MinRating > 0 {
-		query = query.Where(product.RatingNumericGTE(float64(params.MinRating)))
+	if params.MinRating > 0 && except != facetRating {
+		preds = append(preds, product.RatingNumericGTE(float64(params.MinRating)))
 	}
-	if params.InStock {
-		query = query.Where(product.OutOfStock(false))
+	if params.InStock && except != facetStock {
+		preds = append(preds, product.OutOfStock(false))
 	}
 	if params.Featured {
-		query = query.Where(product.Featured(true))
+		preds = append(preds, product.Featured(true))
+	}
+	if params.ProductIDs != nil {
+		ids := make([]int, len(params.ProductIDs))
+		for i, id := range params.ProductIDs {
+			ids[i] = int(id)
+		}
+		preds = append(preds, product.IDIn(ids...))
+	}
+	for _, f := range params.AttributeFilters {
+		if except != attributeFacet(f.Key) {
+			preds = append(preds, attributeFilter(f))
+		}
 	}
 
 	// Apply search query if provided
 	if params.SearchQuery != "" {
 		// Simple text search (could be enhanced with full-text search)
-		query = query.Where(
-			product.Or(
-				product.TitleContains(params.SearchQuery),
-				product.DescriptionContains(params.SearchQuery),
-				product.BrandContains(params.SearchQuery),
-			),
-		)
+		preds = append(preds, product.Or(
+			product.TitleContains(params.SearchQuery),
+			product.DescriptionContains(params.SearchQuery),
+			product.BrandContains(params.SearchQuery),
+		))
 	}
+	return preds
+}
 
-	// Apply sorting
-	switch strings.ToLower(params.SortBy) {
-	case "price":
-		if strings.ToLower(params.SortOrder) == "asc" {
-			query = query.Order(ent.Asc(product.FieldPriceNumeric))
-		} else {
-			query = query.Order(ent.Desc(product.FieldPriceNumeric))
+// attributeFilter matches products by their attribute rows. Every check is
+// a semi-join answered by the (key, value, product_id) index, so filters stay
+// cheap however many products carry an attribute.
+func attributeFilter(f *biz.AttributeFilter) predicate.Product {
+	switch f.Match {
+	case biz.AttributeMatchAll:
+		preds := make([]predicate.Product, len(f.Values))
+		for i, v := range f.Values {
+			preds[i] = product.HasAttributesWith(productattribute.Key(f.Key), productattribute.Value(v))
 		}
-	case "rating":
-		if strings.ToLower(params.SortOrder) == "asc" {
-			query = query.Order(ent.Asc(product.FieldRatingNumeric))
-		} else {
-			query = query.Order(ent.Desc(product.FieldRatingNumeric))
-		}
-	case "newest":
-		query = query.Order(ent.Desc(product.FieldCreateTime))
-	case "popular":
-		query = query.Order(ent.Desc(product.FieldViewCount))
+		return product.And(preds...)
+	case biz.AttributeMatchNone:
+		return product.Not(product.HasAttributesWith(productattribute.Key(f.Key), productattribute.ValueIn(f.Values...)))
 	default:
-		query = query.Order(ent.Desc(product.FieldCreateTime))
+		return product.HasAttributesWith(productattribute.Key(f.Key), productattribute.ValueIn(f.Values...))
 	}
+}
 
-	// Get total count
-	total, err := query.Count(ctx)
+// SearchProducts ranks products by full-text relevance on MySQL. The query
+// uses boolean-mode syntax: "quoted phrases", +required, -excluded and
+// prefix* terms. Other databases, and MySQL queries the FULLTEXT index
+// cannot answer (e.g. only words below the minimum token size), fall back to
+// LIKE matching ordered by newest.
+func (r *productRepo) SearchProducts(ctx context.Context, queryStr string, params *biz.ListProductsParams) ([]*biz.Product, int64, error) {
+	match, order, total, err := r.textMatch(ctx, queryStr, params)
 	if err != nil {
 		return nil, 0, err
 	}
+	return r.searchProducts(ctx, params, match, order, total)
+}
 
-	// Apply pagination
-	offset := (int(params.Page) - 1) * int(params.PageSize)
-	rows, err := query.
-		Offset(offset).
-		Limit(int(params.PageSize)).
-		All(ctx)
-
-	if err != nil {
-		return nil, 0, err
+// textMatch picks the SearchProducts predicate and order for a query. The
+// predicate is nil when the query has no terms. Deciding on the FULLTEXT
+// match counts its products, so total is their count under the params
+// filters; it is -1 for the other predicates, which are not counted.
+func (r *productRepo) textMatch(ctx context.Context, queryStr string, params *biz.ListProductsParams) (predicate.Product, product.OrderOption, int, error) {
+	terms := parseSearchQuery(queryStr)
+	if len(terms) == 0 {
+		return nil, ent.Desc(product.FieldCreateTime), -1, nil
+	}
+
+	if r.data.dialect == dialect.MySQL {
+		against := booleanModeQuery(terms)
+		total, err := r.data.ent.Product.Query().
+			Where(fullTextMatch(against)).
+			Where(searchFilters(params)...).
+			Count(ctx)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		if total > 0 {
+			return fullTextMatch(against), orderByRelevance(against), total, nil
+		}
 	}
 
-	rv := make([]*biz.Product, 0, len(rows))
-	for _, row := range rows {
-		rv = append(rv, convertEntToBiz(row))
+	return likeMatch(terms), ent.Desc(product.FieldCreateTime), -1, nil
+}
+
+// searchParams keeps the params filters SearchProducts honours.
+func searchParams(params *biz.ListProductsParams) *biz.ListProductsParams {
+	if params == nil {
+		return nil
+	}
+	return &biz.ListProductsParams{
+		Category: params.Category,
+		Brand:    params.Brand,
+		MinPrice: params.MinPrice,
+		MaxPrice: params.MaxPrice,
+		InStock:  params.InStock,
 	}
-	return rv, int64(total), nil
 }
 
-func (r *productRepo) SearchProducts(ctx context.Context, queryStr string, params *biz.ListProductsParams) ([]*biz.Product, int64, error) {
-	query := r.data.ent.Product.Query()
+func searchFilters(params *biz.ListProductsParams) []predicate.Product {
+	return listFilters(searchParams(params), "")
+}
 
-	// Apply search filters
-	if queryStr != "" {
-		// Search in title, description, brand, and category
-		query = query.Where(
-			product.Or(
-				product.TitleContainsFold(queryStr),
-				product.DescriptionContainsFold(queryStr),
-				product.BrandContainsFold(queryStr),
-				product.CategoryContainsFold(queryStr),
-				product.SubCategoryContainsFold(queryStr),
-			),
-		)
+// searchProducts applies the text match, when set, and the params filters.
+// The matching products are counted unless total already holds their count.
+func (r *productRepo) searchProducts(ctx context.Context, params *biz.ListProductsParams, match predicate.Product, order product.OrderOption, total int) ([]*biz.Product, int64, error) {
+	query := r.data.ent.Product.Query().
+		Where(searchFilters(params)...)
+	if match != nil {
+		query = query.Where(match)
 	}
 
-	// Apply additional filters from params
-	if params != nil {
-		if params.Category != "" {
-			query = query.Where(product.Category(params.Category))
+	if total < 0 {
+		var err error
+		if total, err = query.Count(ctx); err != nil {
+			return nil, 0, err
 		}
-		if params.Brand != "" {
-			query = query.Where(product.Brand(params.Brand))
-		}
-		if params.MinPrice > 0 {
-			query = query.Where(product.PriceNumericGTE(int(params.MinPrice)))
-		}
-		if params.MaxPrice > 0 {
-			query = query.Where(product.PriceNumericLTE(int(params.MaxPrice)))
-		}
-		if params.InStock {
-			query = query.Where(product.OutOfStock(false))
-		}
-	}
-
-	// Get total count
-	total, err := query.Count(ctx)
-	if err != nil {
-		return nil, 0, err
 	}
 
 	// Apply pagination
@@ -382,7 +726,7 @@ func (r *productRepo) SearchProducts(ctx context.Context, queryStr string, param
 	}
 
 	rows, err := query.
-		Order(ent.Desc(product.FieldCreateTime)).
+		Order(order).
 		Limit(limit).
 		All(ctx)
 
@@ -497,150 +841,122 @@ func (r *productRepo) IncrementClickCount(ctx context.Context, id int64) error {
 
 // ========== EMBEDDING OPERATIONS ==========
 
-// GenerateEmbedding generates embedding for a product or query
-func (r *productRepo) GenerateEmbedding(ctx context.Context, p *biz.Product) ([]float32, error) {
-	if r.aiClient == nil {
-		return nil, biz.ErrEmbeddingsNotEnabled
-	}
-
-	// Generate text representation
-	text := ""
-	if p.Description != "" {
-		// For products with description
-		text = fmt.Sprintf("%s %s %s %s", p.Title, p.Brand, p.Category, p.Description)
-	} else {
-		// For simple queries
-		text = fmt.Sprintf("%s %s", p.Title, p.Brand)
-	}
-
-	if len(text) > 8000 {
-		text = text[:8000]
+// SearchSimilarProducts searches products using the vector index
+func (r *productRepo) SearchSimilarProducts(ctx context.Context, queryEmbedding []float32, limit int, category string, priceRange *biz.PriceRange) ([]*biz.ScoredProduct, error) {
+	// Wait for the startup load so early requests don't see a partial index
+	select {
+	case <-r.indexReady:
+	case <-ctx.Done():
+		return nil, ctx.Err()
 	}
 
-	// Call OpenAI/DeepSeek API
-	resp, err := r.aiClient.CreateEmbeddings(ctx, openai.EmbeddingRequest{
-		Model: openai.AdaEmbeddingV2,
-		Input: []string{text},
-	})
-
-	if err != nil {
-		return nil, fmt.Errorf("failed to create embedding: %w", err)
-	}
-
-	if len(resp.Data) == 0 {
-		return nil, fmt.Errorf("no embedding data returned")
-	}
-
-	return resp.Data[0].Embedding, nil
-}
-
-// SearchSimilarProducts searches products using vector similarity
-func (r *productRepo) SearchSimilarProducts(ctx context.Context, queryEmbedding []float32, limit int, category string, priceRange *biz.PriceRange) ([]*biz.Product, error) {
-	if r.aiClient == nil {
-		return nil, biz.ErrEmbeddingsNotEnabled
-	}
-
-	// Get all products with embeddings
-	query := r.data.ent.Product.Query().
-		Where(product.EmbeddingNotNil()).
-		Limit(1000) // Limit for in-memory similarity calculation
-
-	if category != "" {
-		query = query.Where(product.Category(category))
-	}
+	matches := r.index.Search(queryEmbedding, limit, biz.NewVectorFilter(category, priceRange))
 
-	if priceRange != nil {
-		if priceRange.Min > 0 {
-			query = query.Where(product.PriceNumericGTE(int(priceRange.Min)))
-		}
-		if priceRange.Max > 0 {
-			query = query.Where(product.PriceNumericLTE(int(priceRange.Max)))
+	ids := make([]int, 0, len(matches))
+	for _, m := range matches {
+		if m.Score <= minSimilarity {
+			matches = matches[:len(ids)]
+			break
 		}
+		ids = append(ids, int(m.ID))
+	}
+	if len(ids) == 0 {
+		return []*biz.ScoredProduct{}, nil
 	}
 
-	products, err := query.All(ctx)
+	rows, err := r.data.ent.Product.
+		Query().
+		Where(product.IDIn(ids...)).
+		All(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	// Calculate similarity scores
-	type scoredProduct struct {
-		product *biz.Product
-		score   float32
-	}
-
-	scoredProducts := make([]scoredProduct, 0, len(products))
-
-	for _, p := range products {
-		if len(p.Embedding) == 0 || len(p.Embedding) != len(queryEmbedding) {
-			continue
-		}
-
-		// Convert to float32 slice
-		embedding := make([]float32, len(p.Embedding))
-		for i, v := range p.Embedding {
-			embedding[i] = float32(v)
-		}
-
-		score := biz.CosineSimilarity(embedding, queryEmbedding)
-		if score > 0.3 { // Threshold
-			scoredProducts = append(scoredProducts, scoredProduct{
-				product: convertEntToBiz(p),
-				score:   score,
-			})
-		}
+	// Restore the similarity order lost by the IN query
+	byID := make(map[int]*ent.Product, len(rows))
+	for _, row := range rows {
+		byID[row.ID] = row
 	}
 
-	// Sort by similarity score (descending)
-	for i := 0; i < len(scoredProducts); i++ {
-		for j := i + 1; j < len(scoredProducts); j++ {
-			if scoredProducts[i].score < scoredProducts[j].score {
-				scoredProducts[i], scoredProducts[j] = scoredProducts[j], scoredProducts[i]
-			}
+	results := make([]*biz.ScoredProduct, 0, len(matches))
+	for _, m := range matches {
+		if row, ok := byID[int(m.ID)]; ok {
+			results = append(results, &biz.ScoredProduct{Product: convertEntToBiz(row), Score: m.Score})
 		}
 	}
 
-	// Return top results
-	resultCount := limit
-	if len(scoredProducts) < limit {
-		resultCount = len(scoredProducts)
-	}
-
-	results := make([]*biz.Product, resultCount)
-	for i := 0; i < resultCount; i++ {
-		results[i] = scoredProducts[i].product
-	}
-
 	return results, nil
 }
 
 // UpdateProductEmbedding updates embedding for a single product
-func (r *productRepo) UpdateProductEmbedding(ctx context.Context, id int64, embedding []float32) error {
-	_, err := r.data.ent.Product.
+func (r *productRepo) UpdateProductEmbedding(ctx context.Context, id int64, embedding *biz.ProductEmbedding) error {
+	row, err := r.data.ent.Product.
 		UpdateOneID(int(id)).
-		SetEmbedding(embedding).
+		SetEmbedding(embedding.Vector).
+		SetEmbeddingModel(r.model).
+		SetEmbeddingHash(embedding.ContentHash).
 		Save(ctx)
+	if err != nil {
+		return err
+	}
 
-	return err
+	// Rows written before content hashing have none stored; fill it in so
+	// the product no longer counts as changed.
+	if row.ContentHash == "" {
+		err := r.data.ent.Product.
+			Update().
+			Where(product.ID(row.ID), product.ContentHashIsNil()).
+			SetContentHash(embedding.ContentHash).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		row.ContentHash = embedding.ContentHash
+	}
+
+	r.indexProduct(convertEntToBiz(row))
+	return nil
 }
 
-// BatchUpdateEmbeddings updates embeddings for multiple products
-func (r *productRepo) BatchUpdateEmbeddings(ctx context.Context, productEmbeddings map[int64][]float32) error {
+// BatchUpdateEmbeddings updates embeddings for multiple products. Every
+// update is attempted; the failures are returned joined.
+func (r *productRepo) BatchUpdateEmbeddings(ctx context.Context, productEmbeddings map[int64]*biz.ProductEmbedding) error {
+	var errs []error
 	for productID, embedding := range productEmbeddings {
 		if err := r.UpdateProductEmbedding(ctx, productID, embedding); err != nil {
 			r.log.Errorf("Failed to update embedding for product %d: %v", productID, err)
-			continue
+			errs = append(errs, fmt.Errorf("product %d: %w", productID, err))
 		}
 	}
 
-	return nil
+	return errors.Join(errs...)
 }
 
-// GetProductsWithoutEmbeddings returns products that don't have embeddings
-func (r *productRepo) GetProductsWithoutEmbeddings(ctx context.Context, limit int) ([]*biz.Product, error) {
+// needsEmbedding matches products whose embedding is missing, from another
+// model, or generated from content that has since changed.
+func (r *productRepo) needsEmbedding() predicate.Product {
+	return product.Or(
+		product.EmbeddingIsNil(),
+		product.EmbeddingModelIsNil(),
+		product.EmbeddingModelNEQ(r.model),
+		product.EmbeddingHashIsNil(),
+		product.ContentHashIsNil(),
+		func(s *sql.Selector) {
+			s.Where(sql.ColumnsNEQ(s.C(product.FieldEmbeddingHash), s.C(product.FieldContentHash)))
+		},
+	)
+}
+
+// GetProductsNeedingEmbeddings returns products needing an embedding whose
+// ID is greater than afterID, in ID order.
+func (r *productRepo) GetProductsNeedingEmbeddings(ctx context.Context, afterID int64, limit int) ([]*biz.Product, error) {
 	rows, err := r.data.ent.Product.
 		Query().
-		Where(product.EmbeddingIsNil()).
+		Where(
+			r.needsEmbedding(),
+			product.IDGT(int(afterID)),
+		).
+		Order(ent.Asc(product.FieldID)).
 		Limit(limit).
 		All(ctx)
 
@@ -656,13 +972,74 @@ func (r *productRepo) GetProductsWithoutEmbeddings(ctx context.Context, limit in
 	return products, nil
 }
 
-// GetProductsWithEmbeddings returns products that have embeddings
+// ListProductsAfter returns the products matching the filters of params
+// whose ID is greater than afterID, in ID order. Without embeddings the
+// embedding column is not read.
+func (r *productRepo) ListProductsAfter(ctx context.Context, params *biz.ListProductsParams, afterID int64, limit int, withEmbeddings bool) ([]*biz.Product, error) {
+	query := r.data.ent.Product.
+		Query().
+		Where(listFilters(params, "")...).
+		Where(product.IDGT(int(afterID))).
+		Order(ent.Asc(product.FieldID)).
+		Limit(limit)
+	if !withEmbeddings {
+		columns := make([]string, 0, len(product.Columns))
+		for _, c := range product.Columns {
+			if c != product.FieldEmbedding {
+				columns = append(columns, c)
+			}
+		}
+		query.Select(columns...)
+	}
+
+	rows, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	products := make([]*biz.Product, 0, len(rows))
+	for _, row := range rows {
+		products = append(products, convertEntToBiz(row))
+	}
+	return products, nil
+}
+
+// CountProductsNeedingEmbeddings counts products needing an embedding
+func (r *productRepo) CountProductsNeedingEmbeddings(ctx context.Context) (int, error) {
+	return r.data.ent.Product.
+		Query().
+		Where(r.needsEmbedding()).
+		Count(ctx)
+}
+
+// CountStaleModelEmbeddings counts embeddings made by another model
+func (r *productRepo) CountStaleModelEmbeddings(ctx context.Context) (int, error) {
+	return r.data.ent.Product.
+		Query().
+		Where(
+			product.EmbeddingNotNil(),
+			product.Or(
+				product.EmbeddingModelIsNil(),
+				product.EmbeddingModelNEQ(r.model),
+			),
+		).
+		Count(ctx)
+}
+
+// GetProductsWithEmbeddings returns products that have embeddings from the
+// current model. A non-positive limit returns all of them.
 func (r *productRepo) GetProductsWithEmbeddings(ctx context.Context, limit int) ([]*biz.Product, error) {
-	rows, err := r.data.ent.Product.
+	query := r.data.ent.Product.
 		Query().
-		Where(product.EmbeddingNotNil()).
-		Limit(limit).
-		All(ctx)
+		Where(
+			product.EmbeddingNotNil(),
+			product.EmbeddingModel(r.model),
+		)
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	rows, err := query.All(ctx)
 
 	if err != nil {
 		return nil, err
@@ -676,6 +1053,20 @@ func (r *productRepo) GetProductsWithEmbeddings(ctx context.Context, limit int)
 	return products, nil
 }
 
+// convertEntError maps ent write errors onto the product error reasons.
+func convertEntError(err error) error {
+	switch {
+	case ent.IsNotFound(err):
+		return biz.ErrProductNotFound
+	case ent.IsConstraintError(err):
+		return biz.ErrProductAlreadyExists
+	case ent.IsValidationError(err):
+		return biz.ErrInvalidParameters.WithCause(err)
+	default:
+		return err
+	}
+}
+
 // Helper function to convert ent.Product to biz.Product
 func convertEntToBiz(p *ent.Product) *biz.Product {
 	if p == nil {
@@ -691,6 +1082,12 @@ func convertEntToBiz(p *ent.Product) *biz.Product {
 		}
 	}
 
+	// Rows written before content hashing have no stored hash
+	contentHash := p.ContentHash
+	if contentHash == "" {
+		contentHash = productContent(p).Hash()
+	}
+
 	return &biz.Product{
 		ID:             int64(p.ID),
 		OriginalID:     p.OriginalID,
@@ -720,5 +1117,39 @@ func convertEntToBiz(p *ent.Product) *biz.Product {
 		Featured:       p.Featured,
 		Embedding:      embedding,
 		SearchKeywords: p.SearchKeywords,
+		EmbeddingModel: p.EmbeddingModel,
+		EmbeddingHash:  p.EmbeddingHash,
+		ContentHash:    contentHash,
+
+		ActualPriceNumeric: p.ActualPriceNumeric,
+		DiscountPercent:    p.DiscountPercent,
+		Attributes:         convertAttributes(p.Edges.Attributes),
+	}
+}
+
+func convertAttributes(rows []*ent.ProductAttribute) []*biz.ProductAttribute {
+	if rows == nil {
+		return nil
+	}
+	attrs := make([]*biz.ProductAttribute, len(rows))
+	for i, a := range rows {
+		attrs[i] = &biz.ProductAttribute{Key: a.Key, Value: a.Value}
+	}
+	return attrs
+}
+
+// productContent returns the embedding inputs of a product row.
+func productContent(p *ent.Product) *schema.ProductContent {
+	return &schema.ProductContent{
+		Title:          p.Title,
+		Brand:          p.Brand,
+		Category:       p.Category,
+		SubCategory:    p.SubCategory,
+		Description:    p.Description,
+		ProductDetails: p.ProductDetails,
+		ActualPrice:    p.ActualPrice,
+		SellingPrice:   p.SellingPrice,
+		AverageRating:  p.AverageRating,
+		Seller:         p.Seller,
 	}
 }
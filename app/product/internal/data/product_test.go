@@ -0,0 +1,406 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	"yinni_backend/app/product/internal/biz"
+	"yinni_backend/ent"
+	"yinni_backend/ent/productattribute"
+)
+
+func TestDeleteProduct(t *testing.T) {
+	r := newTestRepo(t)
+	seedProducts(t, r, 2)
+	ctx := context.Background()
+	id := int64(r.data.ent.Product.Query().FirstIDX(ctx))
+
+	p, err := r.Delete(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.ID != id {
+		t.Errorf("deleted product %d, want %d", p.ID, id)
+	}
+	if _, err := r.GetProduct(ctx, id); !errors.Is(err, biz.ErrProductNotFound) {
+		t.Errorf("deleted product still found: err = %v", err)
+	}
+
+	// Missing products are not found, not internal errors
+	for _, missing := range []int64{id, 9999} {
+		if _, err := r.Delete(ctx, missing); !errors.Is(err, biz.ErrProductNotFound) {
+			t.Errorf("Delete(%d): err = %v, want ErrProductNotFound", missing, err)
+		}
+	}
+	if n := r.data.ent.Product.Query().CountX(ctx); n != 1 {
+		t.Errorf("%d products left, want 1", n)
+	}
+}
+
+func TestLoadVectorIndexKeepsWrites(t *testing.T) {
+	r := newTestRepo(t)
+	r.model = "test-model"
+	r.indexReady = make(chan struct{})
+	r.written = make(map[int64]struct{})
+	ctx := context.Background()
+	stored := []float32{1, 0, 0}
+	for i := range 3 {
+		_, err := r.data.ent.Product.Create().
+			SetOriginalID(fmt.Sprintf("orig-%d", i)).
+			SetPid(fmt.Sprintf("PID%04d", i)).
+			SetTitle("Product").
+			SetBrand("Acme").
+			SetCategory("Clothing").
+			SetSubCategory("Shirts").
+			SetEmbedding(stored).
+			SetEmbeddingModel(r.model).
+			Save(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	ids := r.data.ent.Product.Query().IDsX(ctx)
+
+	// Writes landing before the load reads the catalog are not overwritten
+	// by what it reads
+	updated := []float32{0, 1, 0}
+	r.indexProduct(&biz.Product{ID: int64(ids[0]), Embedding: updated, EmbeddingModel: r.model, Category: "Clothing"})
+	r.unindexProduct(int64(ids[1]))
+	r.loadVectorIndex(ctx)
+
+	if n := r.index.Len(); n != 2 {
+		t.Errorf("%d products indexed, want 2", n)
+	}
+	got := r.index.Search(updated, 1, nil)
+	if len(got) != 1 || got[0].ID != int64(ids[0]) || got[0].Score < 0.99 {
+		t.Errorf("updated product: got %v, want product %d with the updated embedding", matchIDs(got), ids[0])
+	}
+	for _, m := range r.index.Search(stored, 3, nil) {
+		if m.ID == int64(ids[1]) {
+			t.Errorf("deleted product %d loaded", m.ID)
+		}
+	}
+
+	// Once loaded, writes are no longer recorded
+	r.indexProduct(&biz.Product{ID: int64(ids[2])})
+	if r.written != nil {
+		t.Errorf("writes recorded after the load: %v", r.written)
+	}
+}
+
+func TestProductsNeedingEmbeddings(t *testing.T) {
+	r := newTestRepo(t)
+	r.model = "model-v1"
+	seedProducts(t, r, 3)
+	ctx := context.Background()
+
+	needing := func() []int64 {
+		t.Helper()
+		products, err := r.GetProductsNeedingEmbeddings(ctx, 0, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return productIDs(products)
+	}
+	all := needing()
+	if len(all) != 3 {
+		t.Fatalf("%d products need embeddings before any is embedded, want 3", len(all))
+	}
+	for _, p := range mustProducts(t, r, all) {
+		if err := r.UpdateProductEmbedding(ctx, p.ID, &biz.ProductEmbedding{Vector: []float32{1, 0}, ContentHash: p.ContentHash}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if ids := needing(); len(ids) != 0 {
+		t.Fatalf("products %v still need embeddings", ids)
+	}
+
+	// Changing an embedding input marks the product; other fields do not
+	if _, err := r.Update(ctx, &biz.Product{ID: all[0], Title: "Renamed"}, []string{"title"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Update(ctx, &biz.Product{ID: all[1], Featured: true}, []string{"featured"}); err != nil {
+		t.Fatal(err)
+	}
+	if ids := needing(); !slices.Equal(ids, all[:1]) {
+		t.Errorf("after the updates products %v need embeddings, want %v", ids, all[:1])
+	}
+
+	// So does a new embedding model
+	r.model = "model-v2"
+	if n, err := r.CountStaleModelEmbeddings(ctx); err != nil || n != 3 {
+		t.Errorf("CountStaleModelEmbeddings = %d, %v; want 3", n, err)
+	}
+	if ids := needing(); len(ids) != 3 {
+		t.Errorf("%d products need embeddings from the new model, want 3", len(ids))
+	}
+}
+
+func mustProducts(t *testing.T, r *productRepo, ids []int64) []*biz.Product {
+	t.Helper()
+	products := make([]*biz.Product, len(ids))
+	for i, id := range ids {
+		p, err := r.GetProduct(context.Background(), id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		products[i] = p
+	}
+	return products
+}
+
+func TestProductPricingHook(t *testing.T) {
+	r := newTestRepo(t)
+	ctx := context.Background()
+	row, err := r.data.ent.Product.Create().
+		SetOriginalID("orig-1").
+		SetPid("PID0001").
+		SetTitle("Linen shirt").
+		SetBrand("Acme").
+		SetCategory("Clothing").
+		SetSubCategory("Shirts").
+		SetActualPrice("₹2,000").
+		SetSellingPrice("₹1,500").
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if row.PriceNumeric != 1500 || row.ActualPriceNumeric != 2000 || row.DiscountPercent != 25 {
+		t.Errorf("created: price %d, actual %d, discount %v; want 1500, 2000, 25", row.PriceNumeric, row.ActualPriceNumeric, row.DiscountPercent)
+	}
+
+	// Updating one pricing field reads the others from the stored row
+	row, err = row.Update().SetSellingPrice("₹1,000").Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if row.PriceNumeric != 1000 || row.DiscountPercent != 50 {
+		t.Errorf("updated price: price %d, discount %v; want 1000, 50", row.PriceNumeric, row.DiscountPercent)
+	}
+	// The discount text wins over the prices
+	row, err = row.Update().SetDiscount("10% off").Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if row.DiscountPercent != 10 {
+		t.Errorf("discount text: discount %v, want 10", row.DiscountPercent)
+	}
+
+	// A bulk update setting only some pricing fields leaves the discount alone
+	if err := r.data.ent.Product.Update().SetDiscount("").Exec(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if got := r.data.ent.Product.GetX(ctx, row.ID).DiscountPercent; got != 10 {
+		t.Errorf("partial bulk update: discount %v, want 10", got)
+	}
+	err = r.data.ent.Product.Update().
+		SetDiscount("").
+		SetActualPrice("").
+		SetSellingPrice("₹500").
+		Exec(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := r.data.ent.Product.GetX(ctx, row.ID).DiscountPercent; got != 0 {
+		t.Errorf("unknown discount: discount %v, want cleared", got)
+	}
+}
+
+func TestProductAttributesHook(t *testing.T) {
+	r := newTestRepo(t)
+	ctx := context.Background()
+	attributes := func(id int) []string {
+		t.Helper()
+		rows, err := r.data.ent.ProductAttribute.Query().
+			Where(productattribute.ProductID(id)).
+			Order(ent.Asc(productattribute.FieldKey), ent.Asc(productattribute.FieldValue)).
+			All(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var out []string
+		for _, a := range rows {
+			out = append(out, a.Key+"="+a.Value)
+		}
+		return out
+	}
+
+	var ids []int
+	for i := range 2 {
+		row, err := r.data.ent.Product.Create().
+			SetOriginalID(fmt.Sprintf("orig-%d", i)).
+			SetPid(fmt.Sprintf("PID%04d", i)).
+			SetTitle("Linen shirt").
+			SetBrand("Acme").
+			SetCategory("Clothing").
+			SetSubCategory("Shirts").
+			SetProductDetails([]map[string]string{{"Colour": "Black, White", "Sleeve": "Full"}}).
+			Save(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, row.ID)
+	}
+	if got, want := attributes(ids[0]), []string{"color=black", "color=white"}; !slices.Equal(got, want) {
+		t.Errorf("created: attributes %v, want %v", got, want)
+	}
+
+	// New details replace the attributes of the updated product only
+	err := r.data.ent.Product.UpdateOneID(ids[0]).
+		SetProductDetails([]map[string]string{{"Fabric": "Cotton"}}).
+		Exec(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := attributes(ids[0]), []string{"fabric=cotton"}; !slices.Equal(got, want) {
+		t.Errorf("updated: attributes %v, want %v", got, want)
+	}
+	if got := attributes(ids[1]); len(got) != 2 {
+		t.Errorf("other product: attributes %v, want its 2 colours", got)
+	}
+
+	// Bulk updates rewrite every matched product, and clearing drops them
+	if err := r.data.ent.Product.Update().ClearProductDetails().Exec(ctx); err != nil {
+		t.Fatal(err)
+	}
+	for _, id := range ids {
+		if got := attributes(id); len(got) != 0 {
+			t.Errorf("cleared product %d: attributes %v", id, got)
+		}
+	}
+}
+
+// seedAttributeProducts adds products with the given details and returns
+// their IDs.
+func seedAttributeProducts(t *testing.T, r *productRepo, details ...map[string]string) []int64 {
+	t.Helper()
+	ids := make([]int64, len(details))
+	for i, d := range details {
+		row, err := r.data.ent.Product.Create().
+			SetOriginalID(fmt.Sprintf("orig-%d", i)).
+			SetPid(fmt.Sprintf("PID%04d", i)).
+			SetTitle("Shirt").
+			SetBrand("Acme").
+			SetCategory("Clothing").
+			SetSubCategory("Shirts").
+			SetProductDetails([]map[string]string{d}).
+			Save(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids[i] = int64(row.ID)
+	}
+	return ids
+}
+
+func TestListProductsAttributeFilters(t *testing.T) {
+	r := newTestRepo(t)
+	ids := seedAttributeProducts(t, r,
+		map[string]string{"Color": "Black, White", "Fabric": "Cotton"},
+		map[string]string{"Color": "Black", "Fabric": "Linen"},
+		map[string]string{"Color": "White", "Fabric": "Cotton"},
+		map[string]string{"Sleeve": "Full"},
+	)
+
+	tests := []struct {
+		name    string
+		filters []*biz.AttributeFilter
+		want    []int64
+	}{
+		{"any", []*biz.AttributeFilter{{Key: "color", Values: []string{"black", "red"}}}, []int64{ids[0], ids[1]}},
+		{"all", []*biz.AttributeFilter{{Key: "color", Values: []string{"black", "white"}, Match: biz.AttributeMatchAll}}, []int64{ids[0]}},
+		// Products without the attribute have none of its values
+		{"none", []*biz.AttributeFilter{{Key: "color", Values: []string{"black"}, Match: biz.AttributeMatchNone}}, []int64{ids[2], ids[3]}},
+		{"several keys", []*biz.AttributeFilter{
+			{Key: "Color", Values: []string{"White"}},
+			{Key: "fabric", Values: []string{"cotton"}},
+		}, []int64{ids[0], ids[2]}},
+	}
+	for _, tt := range tests {
+		params := &biz.ListProductsParams{PageSize: 10, AttributeFilters: tt.filters}
+		if err := params.Validate(); err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		page, err := r.ListProducts(context.Background(), params)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		got := productIDs(page.Products)
+		slices.Sort(got)
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%s: products %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestFindProductIDs(t *testing.T) {
+	r := newTestRepo(t)
+	seedProducts(t, r, 2)
+	ctx := context.Background()
+	stored := mustProducts(t, r, []int64{1, 2})
+
+	ids, err := r.FindProductIDs(ctx, []*biz.Product{
+		{PID: stored[0].PID},
+		{OriginalID: stored[1].OriginalID},
+		// The pid wins over the original_id of another product
+		{PID: stored[0].PID, OriginalID: stored[1].OriginalID},
+		{PID: "missing", OriginalID: stored[1].OriginalID},
+		{PID: "missing"},
+		{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []int64{stored[0].ID, stored[1].ID, stored[0].ID, stored[1].ID, 0, 0}
+	if !slices.Equal(ids, want) {
+		t.Errorf("ids = %v, want %v", ids, want)
+	}
+}
+
+func TestListProductsAfter(t *testing.T) {
+	r := newTestRepo(t)
+	seedProducts(t, r, 6)
+	ctx := context.Background()
+	if err := r.data.ent.Product.Update().SetEmbedding([]float32{1, 0}).Exec(ctx); err != nil {
+		t.Fatal(err)
+	}
+	// Only the products matching the filters are walked
+	params := &biz.ListProductsParams{ProductIDs: []int64{1, 3, 4, 6}}
+	if err := params.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	var ids []int64
+	var afterID int64
+	for {
+		page, err := r.ListProductsAfter(ctx, params, afterID, 2, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, p := range page {
+			if p.Embedding != nil {
+				t.Errorf("product %d: embedding loaded", p.ID)
+			}
+		}
+		ids = append(ids, productIDs(page)...)
+		if len(page) < 2 {
+			break
+		}
+		afterID = page[len(page)-1].ID
+	}
+
+	if !slices.Equal(ids, params.ProductIDs) {
+		t.Errorf("walked %v, want %v", ids, params.ProductIDs)
+	}
+
+	page, err := r.ListProductsAfter(ctx, params, 0, 1, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page) != 1 || !slices.Equal(page[0].Embedding, []float32{1, 0}) {
+		t.Errorf("with embeddings: %d products, embedding %v", len(page), page[0].Embedding)
+	}
+}
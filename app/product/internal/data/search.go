@@ -0,0 +1,233 @@
+package data
+
+import (
+	"context"
+	"strings"
+	"unicode"
+
+	"yinni_backend/ent"
+	"yinni_backend/ent/predicate"
+	"yinni_backend/ent/product"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// searchTextBatchSize is the number of products backfillSearchText reads at
+// a time.
+const searchTextBatchSize = 500
+
+// fullTextColumns are the columns of the product_search_idx FULLTEXT index,
+// in index order; MATCH must name exactly these.
+var fullTextColumns = []string{
+	product.FieldTitle,
+	product.FieldDescription,
+	product.FieldBrand,
+	product.FieldSearchText,
+}
+
+// searchTerm is one term of a search query: a word, or a phrase when the
+// user quoted it. text holds the words the FULLTEXT parser would see; raw
+// is the term as typed, for substring matching.
+type searchTerm struct {
+	text     string
+	raw      string
+	phrase   bool
+	required bool
+	excluded bool
+	prefix   bool
+}
+
+// parseSearchQuery splits a query in MySQL boolean-mode syntax into terms.
+// It understands "quoted phrases", +required and -excluded terms and a
+// trailing * for prefix matches; other operators are dropped so arbitrary
+// input never produces a syntax error.
+func parseSearchQuery(query string) []searchTerm {
+	var terms []searchTerm
+	runes := []rune(query)
+	for i := 0; i < len(runes); {
+		if unicode.IsSpace(runes[i]) {
+			i++
+			continue
+		}
+
+		var t searchTerm
+		switch runes[i] {
+		case '+':
+			t.required = true
+			i++
+		case '-':
+			t.excluded = true
+			i++
+		}
+
+		if i < len(runes) && runes[i] == '"' {
+			end := i + 1
+			for end < len(runes) && runes[end] != '"' {
+				end++
+			}
+			t.raw = strings.TrimSpace(string(runes[i+1 : min(end, len(runes))]))
+			t.text = strings.Join(searchWords(t.raw), " ")
+			t.phrase = true
+			i = end + 1
+		} else {
+			end := i
+			for end < len(runes) && !unicode.IsSpace(runes[end]) && runes[end] != '"' {
+				end++
+			}
+			word := string(runes[i:end])
+			t.prefix = strings.HasSuffix(word, "*")
+			t.raw = strings.TrimRight(word, "*")
+			t.text = strings.Join(searchWords(word), " ")
+			t.phrase = strings.Contains(t.text, " ")
+			i = end
+		}
+
+		if t.text != "" {
+			terms = append(terms, t)
+		}
+	}
+	return terms
+}
+
+// searchWords returns the letter and digit runs of s.
+func searchWords(s string) []string {
+	return strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+}
+
+// booleanModeQuery renders terms as a MySQL boolean-mode search string.
+func booleanModeQuery(terms []searchTerm) string {
+	parts := make([]string, 0, len(terms))
+	for _, t := range terms {
+		var b strings.Builder
+		switch {
+		case t.required:
+			b.WriteByte('+')
+		case t.excluded:
+			b.WriteByte('-')
+		}
+		if t.phrase {
+			b.WriteString(`"` + t.text + `"`)
+		} else {
+			b.WriteString(t.text)
+			if t.prefix {
+				b.WriteByte('*')
+			}
+		}
+		parts = append(parts, b.String())
+	}
+	return strings.Join(parts, " ")
+}
+
+// matchAgainst writes MATCH (...) AGAINST (? IN BOOLEAN MODE).
+func matchAgainst(b *sql.Builder, s *sql.Selector, query string) {
+	b.WriteString("MATCH (")
+	for i, c := range fullTextColumns {
+		if i > 0 {
+			b.Comma()
+		}
+		b.WriteString(s.C(c))
+	}
+	b.WriteString(") AGAINST (")
+	b.Arg(query)
+	b.WriteString(" IN BOOLEAN MODE)")
+}
+
+// fullTextMatch filters products matching the boolean-mode query.
+func fullTextMatch(query string) predicate.Product {
+	return func(s *sql.Selector) {
+		s.Where(sql.P(func(b *sql.Builder) {
+			matchAgainst(b, s, query)
+		}))
+	}
+}
+
+// orderByRelevance orders by MATCH relevance, newest first among equals.
+func orderByRelevance(query string) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		s.OrderExprFunc(func(b *sql.Builder) {
+			matchAgainst(b, s, query)
+			b.WriteString(" DESC")
+		})
+		s.OrderBy(sql.Desc(s.C(product.FieldCreateTime)))
+	}
+}
+
+// likeMatch is the portable fallback for full-text search. At least one
+// optional term must match, every required term must match and no excluded
+// term may match, each in any of the searched text columns.
+func likeMatch(terms []searchTerm) predicate.Product {
+	anyField := func(text string) predicate.Product {
+		return product.Or(
+			product.TitleContainsFold(text),
+			product.DescriptionContainsFold(text),
+			product.BrandContainsFold(text),
+			product.CategoryContainsFold(text),
+			product.SubCategoryContainsFold(text),
+			product.SearchTextContainsFold(text),
+		)
+	}
+
+	var optional, preds []predicate.Product
+	for _, t := range terms {
+		switch {
+		case t.excluded:
+			preds = append(preds, product.Not(anyField(t.raw)))
+		case t.required:
+			preds = append(preds, anyField(t.raw))
+		default:
+			optional = append(optional, anyField(t.raw))
+		}
+	}
+	if len(optional) > 0 {
+		preds = append(preds, product.Or(optional...))
+	}
+	return product.And(preds...)
+}
+
+// backfillSearchText fills search_text of products written before the hook
+// maintained it, so the FULLTEXT index finds them by keyword. It updates the
+// column directly so crawled_at and update_time are left alone.
+func (r *productRepo) backfillSearchText(ctx context.Context) {
+	afterID, filled := 0, 0
+	for {
+		rows, err := r.data.ent.Product.Query().
+			Where(
+				product.IDGT(afterID),
+				product.SearchKeywordsNotNil(),
+				product.Or(product.SearchTextIsNil(), product.SearchText("")),
+			).
+			Order(ent.Asc(product.FieldID)).
+			Limit(searchTextBatchSize).
+			Select(product.FieldID, product.FieldSearchKeywords).
+			All(ctx)
+		if err != nil {
+			r.log.Errorf("Failed to backfill search text: %v", err)
+			return
+		}
+		if len(rows) == 0 {
+			break
+		}
+
+		for _, row := range rows {
+			if len(row.SearchKeywords) == 0 {
+				continue
+			}
+			query, args := sql.Dialect(r.data.dialect).
+				Update(product.Table).
+				Set(product.FieldSearchText, strings.Join(row.SearchKeywords, " ")).
+				Where(sql.EQ(product.FieldID, row.ID)).
+				Query()
+			if _, err := r.data.db.ExecContext(ctx, query, args...); err != nil {
+				r.log.Errorf("Failed to backfill search text of product %d: %v", row.ID, err)
+				return
+			}
+			filled++
+		}
+		afterID = rows[len(rows)-1].ID
+	}
+	if filled > 0 {
+		r.log.Infof("Backfilled search text of %d products", filled)
+	}
+}
@@ -0,0 +1,105 @@
+package data
+
+import (
+	"context"
+
+	"yinni_backend/app/product/internal/biz"
+	"yinni_backend/ent"
+	"yinni_backend/ent/searchrule"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type searchRuleRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewSearchRuleRepo creates a new search rule repository.
+func NewSearchRuleRepo(data *Data, logger log.Logger) biz.SearchRuleRepo {
+	return &searchRuleRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *searchRuleRepo) CreateSearchRule(ctx context.Context, rule *biz.SearchRule) (*biz.SearchRule, error) {
+	row, err := r.data.ent.SearchRule.Create().
+		SetKind(searchrule.Kind(rule.Kind)).
+		SetTerms(rule.Terms).
+		SetSynonyms(rule.Synonyms).
+		SetCategory(rule.Category).
+		SetBoost(rule.Boost).
+		SetEnabled(rule.Enabled).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return convertSearchRule(row), nil
+}
+
+func (r *searchRuleRepo) UpdateSearchRule(ctx context.Context, rule *biz.SearchRule) (*biz.SearchRule, error) {
+	row, err := r.data.ent.SearchRule.UpdateOneID(int(rule.ID)).
+		SetKind(searchrule.Kind(rule.Kind)).
+		SetTerms(rule.Terms).
+		SetSynonyms(rule.Synonyms).
+		SetCategory(rule.Category).
+		SetBoost(rule.Boost).
+		SetEnabled(rule.Enabled).
+		Save(ctx)
+	if ent.IsNotFound(err) {
+		return nil, biz.ErrSearchRuleNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return convertSearchRule(row), nil
+}
+
+func (r *searchRuleRepo) DeleteSearchRule(ctx context.Context, id int64) (*biz.SearchRule, error) {
+	row, err := r.data.ent.SearchRule.Get(ctx, int(id))
+	if ent.IsNotFound(err) {
+		return nil, biz.ErrSearchRuleNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.data.ent.SearchRule.DeleteOneID(row.ID).Exec(ctx)
+	if ent.IsNotFound(err) {
+		return nil, biz.ErrSearchRuleNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return convertSearchRule(row), nil
+}
+
+func (r *searchRuleRepo) ListSearchRules(ctx context.Context) ([]*biz.SearchRule, error) {
+	rows, err := r.data.ent.SearchRule.Query().
+		Order(ent.Desc(searchrule.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make([]*biz.SearchRule, 0, len(rows))
+	for _, row := range rows {
+		rules = append(rules, convertSearchRule(row))
+	}
+	return rules, nil
+}
+
+func convertSearchRule(row *ent.SearchRule) *biz.SearchRule {
+	return &biz.SearchRule{
+		ID:        int64(row.ID),
+		Kind:      string(row.Kind),
+		Terms:     row.Terms,
+		Synonyms:  row.Synonyms,
+		Category:  row.Category,
+		Boost:     row.Boost,
+		Enabled:   row.Enabled,
+		CreatedAt: row.CreateTime,
+		UpdatedAt: row.UpdateTime,
+	}
+}
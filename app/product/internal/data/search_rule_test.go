@@ -0,0 +1,65 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"yinni_backend/app/product/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestSearchRuleRepo(t *testing.T) {
+	repo := NewSearchRuleRepo(newTestRepo(t).data, log.DefaultLogger)
+	ctx := context.Background()
+
+	synonyms, err := repo.CreateSearchRule(ctx, &biz.SearchRule{
+		Kind: biz.SearchRuleOneWay, Terms: []string{"tee"}, Synonyms: []string{"t shirt"}, Boost: 1, Enabled: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	boost, err := repo.CreateSearchRule(ctx, &biz.SearchRule{
+		Kind: biz.SearchRuleCategoryBoost, Terms: []string{"jeans"}, Category: "Clothing", Boost: 2, Enabled: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	boost.Boost = 3
+	boost.Enabled = false
+	if _, err := repo.UpdateSearchRule(ctx, boost); err != nil {
+		t.Fatal(err)
+	}
+
+	// Rules are listed newest first
+	rules, err := repo.ListSearchRules(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rules) != 2 || rules[0].ID != boost.ID || rules[1].ID != synonyms.ID {
+		t.Fatalf("listed %d rules, want the boost then the synonyms", len(rules))
+	}
+	if got := rules[0]; got.Boost != 3 || got.Enabled || got.Category != "Clothing" {
+		t.Errorf("updated rule %+v", got)
+	}
+	if got := rules[1]; got.Kind != biz.SearchRuleOneWay || !slices.Equal(got.Terms, []string{"tee"}) || !slices.Equal(got.Synonyms, []string{"t shirt"}) {
+		t.Errorf("synonym rule %+v", got)
+	}
+
+	deleted, err := repo.DeleteSearchRule(ctx, synonyms.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deleted.ID != synonyms.ID {
+		t.Errorf("deleted rule %d, want %d", deleted.ID, synonyms.ID)
+	}
+	if _, err := repo.DeleteSearchRule(ctx, synonyms.ID); !errors.Is(err, biz.ErrSearchRuleNotFound) {
+		t.Errorf("deleting twice: err = %v, want ErrSearchRuleNotFound", err)
+	}
+	if _, err := repo.UpdateSearchRule(ctx, synonyms); !errors.Is(err, biz.ErrSearchRuleNotFound) {
+		t.Errorf("updating a deleted rule: err = %v, want ErrSearchRuleNotFound", err)
+	}
+}
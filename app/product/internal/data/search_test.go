@@ -0,0 +1,37 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestBackfillSearchText(t *testing.T) {
+	r := newTestRepo(t)
+	ctx := context.Background()
+	for i := range 3 {
+		_, err := r.data.ent.Product.Create().
+			SetOriginalID(fmt.Sprintf("orig-%d", i)).
+			SetPid(fmt.Sprintf("PID%04d", i)).
+			SetTitle("Product").
+			SetBrand("Acme").
+			SetCategory("Clothing").
+			SetSubCategory("Shirts").
+			Save(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Products written before the hook maintained search_text
+	if _, err := r.data.db.ExecContext(ctx, "UPDATE products SET search_text = NULL"); err != nil {
+		t.Fatal(err)
+	}
+
+	r.backfillSearchText(ctx)
+	for _, row := range r.data.ent.Product.Query().AllX(ctx) {
+		if want := strings.Join(row.SearchKeywords, " "); want == "" || row.SearchText != want {
+			t.Errorf("product %d: search_text %q, want %q", row.ID, row.SearchText, want)
+		}
+	}
+}
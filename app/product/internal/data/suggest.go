@@ -0,0 +1,213 @@
+package data
+
+import (
+	"container/heap"
+	"context"
+	"slices"
+	"strings"
+	"sync/atomic"
+
+	"yinni_backend/app/product/internal/biz"
+	"yinni_backend/ent/product"
+)
+
+// suggestSourceBatch is the number of products read per query while
+// collecting suggestion sources.
+const suggestSourceBatch = 1000
+
+// ListSuggestionSources reads the text and popularity fields of every
+// product, in id order and in batches to bound each query.
+func (r *productRepo) ListSuggestionSources(ctx context.Context) ([]*biz.SuggestionSource, error) {
+	var sources []*biz.SuggestionSource
+	afterID := 0
+	for {
+		rows, err := r.data.ent.Product.Query().
+			Where(product.IDGT(afterID)).
+			Order(product.ByID()).
+			Limit(suggestSourceBatch).
+			Select(
+				product.FieldTitle,
+				product.FieldBrand,
+				product.FieldCategory,
+				product.FieldSubCategory,
+				product.FieldSearchKeywords,
+				product.FieldViewCount,
+				product.FieldClickCount,
+			).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, row := range rows {
+			sources = append(sources, &biz.SuggestionSource{
+				Title:       row.Title,
+				Brand:       row.Brand,
+				Category:    row.Category,
+				SubCategory: row.SubCategory,
+				Keywords:    row.SearchKeywords,
+				Views:       int64(row.ViewCount),
+				Clicks:      int64(row.ClickCount),
+			})
+		}
+		if len(rows) < suggestSourceBatch {
+			return sources, nil
+		}
+		afterID = rows[len(rows)-1].ID
+	}
+}
+
+// suggestTrie is a radix tree over suggestion texts. Each node records the
+// highest popularity below it, so completions are found best-first without
+// visiting the whole subtree. The tree is immutable once built; Replace
+// swaps in a new one.
+type suggestTrie struct {
+	root atomic.Pointer[suggestNode]
+	size atomic.Int64
+}
+
+type suggestNode struct {
+	// label is the text on the edge from the parent; children are kept
+	// sorted by the first byte of their labels, which are all distinct.
+	label      string
+	children   []*suggestNode
+	suggestion *biz.Suggestion
+	best       int64
+}
+
+// NewSuggestIndex creates an empty in-process query suggestion index.
+func NewSuggestIndex() biz.SuggestIndex {
+	t := &suggestTrie{}
+	t.root.Store(&suggestNode{})
+	return t
+}
+
+func (t *suggestTrie) Replace(suggestions []*biz.Suggestion) {
+	root := &suggestNode{}
+	for _, s := range suggestions {
+		root.insert(s.Text, s)
+	}
+	t.root.Store(root)
+	t.size.Store(int64(len(suggestions)))
+}
+
+func (t *suggestTrie) Len() int {
+	return int(t.size.Load())
+}
+
+func (n *suggestNode) insert(key string, s *biz.Suggestion) {
+	for {
+		n.best = max(n.best, s.Popularity)
+		if key == "" {
+			n.suggestion = s
+			return
+		}
+
+		i, found := n.child(key[0])
+		if !found {
+			n.children = slices.Insert(n.children, i, &suggestNode{label: key, suggestion: s, best: s.Popularity})
+			return
+		}
+
+		child := n.children[i]
+		common := commonPrefixLen(child.label, key)
+		if common < len(child.label) {
+			split := &suggestNode{label: child.label[:common], children: []*suggestNode{child}, best: child.best}
+			child.label = child.label[common:]
+			n.children[i] = split
+			child = split
+		}
+		key = key[common:]
+		n = child
+	}
+}
+
+// child returns the position of the child whose label starts with b, or
+// where it would be inserted.
+func (n *suggestNode) child(b byte) (int, bool) {
+	i, found := slices.BinarySearchFunc(n.children, b, func(c *suggestNode, b byte) int {
+		return int(c.label[0]) - int(b)
+	})
+	return i, found
+}
+
+func commonPrefixLen(a, b string) int {
+	n := min(len(a), len(b))
+	for i := 0; i < n; i++ {
+		if a[i] != b[i] {
+			return i
+		}
+	}
+	return n
+}
+
+func (t *suggestTrie) Complete(prefix string, limit int) []*biz.Suggestion {
+	n := t.root.Load()
+	for rest := prefix; rest != ""; {
+		i, found := n.child(rest[0])
+		if !found {
+			return nil
+		}
+		child := n.children[i]
+		switch {
+		case strings.HasPrefix(rest, child.label):
+			rest = rest[len(child.label):]
+		case strings.HasPrefix(child.label, rest):
+			rest = ""
+		default:
+			return nil
+		}
+		n = child
+	}
+
+	// Expand the most promising node or emit the most popular suggestion
+	// until limit suggestions are out. Nodes never beat an equally popular
+	// suggestion, so results come out in popularity order.
+	results := make([]*biz.Suggestion, 0, limit)
+	q := &suggestQueue{{node: n, score: n.best}}
+	for q.Len() > 0 && len(results) < limit {
+		item := heap.Pop(q).(suggestItem)
+		if item.suggestion != nil {
+			results = append(results, item.suggestion)
+			continue
+		}
+		if s := item.node.suggestion; s != nil {
+			heap.Push(q, suggestItem{suggestion: s, score: s.Popularity})
+		}
+		for _, c := range item.node.children {
+			heap.Push(q, suggestItem{node: c, score: c.best})
+		}
+	}
+	return results
+}
+
+// suggestItem is a node to expand or a suggestion to emit.
+type suggestItem struct {
+	node       *suggestNode
+	suggestion *biz.Suggestion
+	score      int64
+}
+
+// suggestQueue is a max-heap of items by score. Among equal scores,
+// suggestions come before nodes and are ordered by text.
+type suggestQueue []suggestItem
+
+func (q suggestQueue) Len() int { return len(q) }
+func (q suggestQueue) Less(i, j int) bool {
+	a, b := q[i], q[j]
+	if a.score != b.score {
+		return a.score > b.score
+	}
+	if (a.suggestion != nil) != (b.suggestion != nil) {
+		return a.suggestion != nil
+	}
+	return a.suggestion != nil && a.suggestion.Text < b.suggestion.Text
+}
+func (q suggestQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+func (q *suggestQueue) Push(x any)   { *q = append(*q, x.(suggestItem)) }
+func (q *suggestQueue) Pop() any {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
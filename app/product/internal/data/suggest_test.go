@@ -0,0 +1,132 @@
+package data
+
+import (
+	"math/rand/v2"
+	"slices"
+	"sort"
+	"strings"
+	"testing"
+
+	"yinni_backend/app/product/internal/biz"
+)
+
+func suggestionTexts(suggestions []*biz.Suggestion) []string {
+	texts := make([]string, len(suggestions))
+	for i, s := range suggestions {
+		texts[i] = s.Text
+	}
+	return texts
+}
+
+func TestSuggestTrieComplete(t *testing.T) {
+	index := NewSuggestIndex()
+	index.Replace([]*biz.Suggestion{
+		{Text: "red shirt", Popularity: 5},
+		{Text: "red shoes", Popularity: 9},
+		{Text: "red", Popularity: 1},
+		{Text: "reebok", Popularity: 7},
+		{Text: "jeans", Popularity: 3},
+	})
+	if index.Len() != 5 {
+		t.Fatalf("Len() = %d, want 5", index.Len())
+	}
+
+	tests := []struct {
+		prefix string
+		limit  int
+		want   []string
+	}{
+		{"", 10, []string{"red shoes", "reebok", "red shirt", "jeans", "red"}},
+		{"", 2, []string{"red shoes", "reebok"}},
+		{"re", 10, []string{"red shoes", "reebok", "red shirt", "red"}},
+		// Ends inside an edge label
+		{"red sh", 10, []string{"red shoes", "red shirt"}},
+		{"red s", 1, []string{"red shoes"}},
+		{"red", 10, []string{"red shoes", "red shirt", "red"}},
+		{"red shoes", 10, []string{"red shoes"}},
+		{"red shoesx", 10, nil},
+		{"rex", 10, nil},
+		{"x", 10, nil},
+	}
+	for _, tt := range tests {
+		if got := suggestionTexts(index.Complete(tt.prefix, tt.limit)); !slices.Equal(got, tt.want) {
+			t.Errorf("Complete(%q, %d) = %q, want %q", tt.prefix, tt.limit, got, tt.want)
+		}
+	}
+}
+
+func TestSuggestTrieTiesOrderedByText(t *testing.T) {
+	index := NewSuggestIndex()
+	index.Replace([]*biz.Suggestion{
+		{Text: "b", Popularity: 1},
+		{Text: "ab", Popularity: 1},
+		{Text: "a", Popularity: 1},
+	})
+	if got := suggestionTexts(index.Complete("", 10)); !slices.Equal(got, []string{"a", "ab", "b"}) {
+		t.Errorf("Complete = %q, want ties ordered by text", got)
+	}
+}
+
+// TestSuggestTrieMatchesScan checks completions against a scan of every
+// suggestion, over texts that share many prefixes.
+func TestSuggestTrieMatchesScan(t *testing.T) {
+	rng := rand.New(rand.NewPCG(4, 2))
+	seen := make(map[string]bool)
+	var suggestions []*biz.Suggestion
+	for len(suggestions) < 2000 {
+		var b strings.Builder
+		for range 1 + rng.IntN(8) {
+			b.WriteByte("abc "[rng.IntN(4)])
+		}
+		text := strings.TrimSpace(b.String())
+		if text == "" || seen[text] {
+			continue
+		}
+		seen[text] = true
+		suggestions = append(suggestions, &biz.Suggestion{Text: text, Popularity: rng.Int64N(50)})
+	}
+
+	index := NewSuggestIndex()
+	index.Replace(suggestions)
+
+	for _, prefix := range []string{"", "a", "ab", "abc", "c a", "bb", "cab c", "acbacb"} {
+		var matching []int64
+		for _, s := range suggestions {
+			if strings.HasPrefix(s.Text, prefix) {
+				matching = append(matching, s.Popularity)
+			}
+		}
+		sort.Slice(matching, func(i, j int) bool { return matching[i] > matching[j] })
+
+		const limit = 15
+		got := index.Complete(prefix, limit)
+		if len(got) != min(limit, len(matching)) {
+			t.Fatalf("Complete(%q) returned %d suggestions, want %d", prefix, len(got), min(limit, len(matching)))
+		}
+		for i, s := range got {
+			if !strings.HasPrefix(s.Text, prefix) {
+				t.Errorf("Complete(%q) returned %q", prefix, s.Text)
+			}
+			// Ties may come out in any order, popularities may not
+			if s.Popularity != matching[i] {
+				t.Errorf("Complete(%q)[%d] has popularity %d, want %d", prefix, i, s.Popularity, matching[i])
+			}
+		}
+	}
+}
+
+func TestSuggestTrieReplace(t *testing.T) {
+	index := NewSuggestIndex()
+	if got := index.Complete("", 10); len(got) != 0 {
+		t.Errorf("empty index completed %q", suggestionTexts(got))
+	}
+
+	index.Replace([]*biz.Suggestion{{Text: "shirt", Popularity: 1}})
+	index.Replace([]*biz.Suggestion{{Text: "shoes", Popularity: 1}})
+	if got := suggestionTexts(index.Complete("sh", 10)); !slices.Equal(got, []string{"shoes"}) {
+		t.Errorf("Complete after Replace = %q, want only the new suggestions", got)
+	}
+	if index.Len() != 1 {
+		t.Errorf("Len() = %d, want 1", index.Len())
+	}
+}
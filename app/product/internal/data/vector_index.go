@@ -0,0 +1,526 @@
+package data
+
+import (
+	"container/heap"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"sync"
+
+	"yinni_backend/app/product/internal/biz"
+)
+
+// HNSW tuning. M bounds the links per node on upper layers (layer 0 keeps
+// twice as many); the ef values are the beam widths used while building the
+// graph and while searching it.
+const (
+	hnswM              = 16
+	hnswEfConstruction = 200
+	hnswEfSearch       = 64
+
+	// Filters matching at most this many products are answered with an exact
+	// scan, which is both cheaper and more accurate than a filtered graph walk.
+	hnswBruteForceLimit = 2048
+
+	// The graph is rebuilt once tombstones make up this share of the nodes.
+	hnswMaxTombstoneRatio = 0.3
+)
+
+// hnswIndex is an in-process Hierarchical Navigable Small World graph over
+// L2-normalised embeddings, so the inner product equals cosine similarity.
+// Replaced or deleted entries are tombstoned and skipped in results until the
+// next compaction, which rebuilds the graph in the background.
+type hnswIndex struct {
+	mu sync.RWMutex
+
+	levelMult float64
+	rng       *rand.Rand
+
+	dim        int
+	nodes      []*hnswNode
+	byID       map[int64]int32
+	byCategory map[string]map[int32]struct{}
+	entry      int32
+	maxLevel   int
+	tombstones int
+
+	// While a compaction builds the new graph off-lock, the writes it has
+	// not seen are logged and replayed onto it before it is swapped in.
+	compacting  bool
+	pending     []indexWrite
+	compactions sync.WaitGroup
+}
+
+// indexWrite is a logged Upsert, or a Delete when node is nil.
+type indexWrite struct {
+	id   int64
+	node *hnswNode
+}
+
+type hnswNode struct {
+	id       int64
+	vector   []float32
+	category string
+	price    int
+	links    [][]int32
+	deleted  bool
+}
+
+// NewVectorIndex creates an empty in-process HNSW vector index.
+func NewVectorIndex() biz.VectorIndex {
+	h := &hnswIndex{
+		levelMult: 1 / math.Log(hnswM),
+		rng:       rand.New(rand.NewPCG(1, 2)),
+	}
+	h.reset()
+	return h
+}
+
+func (h *hnswIndex) reset() {
+	h.dim = 0
+	h.nodes = nil
+	h.byID = make(map[int64]int32)
+	h.byCategory = make(map[string]map[int32]struct{})
+	h.entry = -1
+	h.maxLevel = 0
+	h.tombstones = 0
+}
+
+// Upsert adds or replaces the entry for a product. Attribute-only changes are
+// applied in place; a new vector re-inserts the product.
+func (h *hnswIndex) Upsert(e *biz.VectorEntry) error {
+	if len(e.Vector) == 0 {
+		return fmt.Errorf("empty embedding for product %d", e.ID)
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.dim == 0 {
+		h.dim = len(e.Vector)
+	}
+	if len(e.Vector) != h.dim {
+		return fmt.Errorf("embedding dimension %d for product %d does not match index dimension %d", len(e.Vector), e.ID, h.dim)
+	}
+
+	vector := normalize(e.Vector)
+	h.upsert(&hnswNode{id: e.ID, vector: vector, category: e.Category, price: e.PriceNumeric})
+	if h.compacting {
+		h.pending = append(h.pending, indexWrite{
+			id:   e.ID,
+			node: &hnswNode{id: e.ID, vector: vector, category: e.Category, price: e.PriceNumeric},
+		})
+	}
+	h.maybeCompact()
+	return nil
+}
+
+// upsert adds n, or applies it to the node of the same product.
+func (h *hnswIndex) upsert(n *hnswNode) {
+	if idx, ok := h.byID[n.id]; ok {
+		cur := h.nodes[idx]
+		if slices.Equal(cur.vector, n.vector) {
+			h.untrackCategory(cur.category, idx)
+			cur.category, cur.price = n.category, n.price
+			h.trackCategory(cur.category, idx)
+			return
+		}
+		h.remove(idx)
+	}
+	h.insert(n)
+}
+
+// Delete removes a product from the index.
+func (h *hnswIndex) Delete(id int64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if idx, ok := h.byID[id]; ok {
+		h.remove(idx)
+		if h.compacting {
+			h.pending = append(h.pending, indexWrite{id: id})
+		}
+		h.maybeCompact()
+	}
+}
+
+// Len returns the number of live entries.
+func (h *hnswIndex) Len() int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return len(h.byID)
+}
+
+// Search returns up to k live entries passing filter, most similar first.
+func (h *hnswIndex) Search(query []float32, k int, filter *biz.VectorFilter) []*biz.VectorMatch {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	if h.entry < 0 || k <= 0 || len(query) != h.dim {
+		return nil
+	}
+
+	q := normalize(query)
+	accept := func(n *hnswNode) bool {
+		return !n.deleted && filter.Match(n.category, n.price)
+	}
+
+	var found []scoredNode
+	if subset, ok := h.smallSubset(filter); ok {
+		found = h.scan(q, subset, accept)
+	} else {
+		ep := h.entry
+		for l := h.maxLevel; l > 0; l-- {
+			ep = h.greedyClosest(q, ep, l)
+		}
+		found = h.searchLayer(q, ep, max(hnswEfSearch, k), 0, accept)
+
+		// A selective filter can starve the graph walk; fall back to an exact
+		// scan rather than return a short page.
+		if len(found) < k && filter != nil {
+			found = h.scan(q, nil, accept)
+		}
+	}
+
+	if len(found) > k {
+		found = found[:k]
+	}
+	matches := make([]*biz.VectorMatch, len(found))
+	for i, f := range found {
+		matches[i] = &biz.VectorMatch{ID: h.nodes[f.node].id, Score: f.sim}
+	}
+	return matches
+}
+
+// smallSubset returns the nodes of the filtered category when it is small
+// enough to scan exactly.
+func (h *hnswIndex) smallSubset(filter *biz.VectorFilter) (map[int32]struct{}, bool) {
+	if filter == nil || filter.Category == "" {
+		return nil, false
+	}
+	subset := h.byCategory[filter.Category]
+	return subset, len(subset) <= hnswBruteForceLimit
+}
+
+// scan scores every accepted node of subset, or of the whole index when
+// subset is nil.
+func (h *hnswIndex) scan(q []float32, subset map[int32]struct{}, accept func(*hnswNode) bool) []scoredNode {
+	var found []scoredNode
+	score := func(idx int32) {
+		if n := h.nodes[idx]; accept(n) {
+			found = append(found, scoredNode{node: idx, sim: dot(q, n.vector)})
+		}
+	}
+	if subset != nil {
+		for idx := range subset {
+			score(idx)
+		}
+	} else {
+		for idx := range h.nodes {
+			score(int32(idx))
+		}
+	}
+	slices.SortFunc(found, func(a, b scoredNode) int {
+		switch {
+		case a.sim > b.sim:
+			return -1
+		case a.sim < b.sim:
+			return 1
+		}
+		return 0
+	})
+	return found
+}
+
+func (h *hnswIndex) insert(n *hnswNode) {
+	idx := int32(len(h.nodes))
+	level := h.randomLevel()
+	n.links = make([][]int32, level+1)
+	h.nodes = append(h.nodes, n)
+	h.byID[n.id] = idx
+	h.trackCategory(n.category, idx)
+
+	if h.entry < 0 {
+		h.entry, h.maxLevel = idx, level
+		return
+	}
+
+	ep := h.entry
+	for l := h.maxLevel; l > level; l-- {
+		ep = h.greedyClosest(n.vector, ep, l)
+	}
+	for l := min(level, h.maxLevel); l >= 0; l-- {
+		found := h.searchLayer(n.vector, ep, hnswEfConstruction, l, nil)
+		limit := maxLinks(l)
+		for i := 0; i < len(found) && len(n.links[l]) < limit; i++ {
+			if found[i].node == idx {
+				continue
+			}
+			n.links[l] = append(n.links[l], found[i].node)
+			h.link(found[i].node, idx, l)
+		}
+		if len(found) > 0 {
+			ep = found[0].node
+		}
+	}
+
+	if level > h.maxLevel {
+		h.entry, h.maxLevel = idx, level
+	}
+}
+
+// link adds a directed edge from -> to on level l, dropping the least similar
+// neighbour when from exceeds its link budget.
+func (h *hnswIndex) link(from, to int32, l int) {
+	n := h.nodes[from]
+	n.links[l] = append(n.links[l], to)
+	if len(n.links[l]) <= maxLinks(l) {
+		return
+	}
+
+	worst, worstSim := 0, float32(math.Inf(1))
+	for i, nb := range n.links[l] {
+		if s := dot(n.vector, h.nodes[nb].vector); s < worstSim {
+			worst, worstSim = i, s
+		}
+	}
+	n.links[l] = slices.Delete(n.links[l], worst, worst+1)
+}
+
+// remove tombstones a node. It stays in the graph for navigation only.
+func (h *hnswIndex) remove(idx int32) {
+	n := h.nodes[idx]
+	n.deleted = true
+	delete(h.byID, n.id)
+	h.untrackCategory(n.category, idx)
+	h.tombstones++
+}
+
+// maybeCompact starts rebuilding the graph from live nodes once tombstones
+// dominate. Searches and writes carry on against the current graph until
+// the rebuilt one is swapped in.
+func (h *hnswIndex) maybeCompact() {
+	if h.compacting || h.tombstones == 0 || float64(h.tombstones) < hnswMaxTombstoneRatio*float64(len(h.nodes)) {
+		return
+	}
+
+	// Copy the nodes: the current graph keeps updating their attributes
+	live := make([]*hnswNode, 0, len(h.byID))
+	for _, n := range h.nodes {
+		if !n.deleted {
+			live = append(live, &hnswNode{id: n.id, vector: n.vector, category: n.category, price: n.price})
+		}
+	}
+	next := &hnswIndex{
+		levelMult: h.levelMult,
+		rng:       rand.New(rand.NewPCG(h.rng.Uint64(), h.rng.Uint64())),
+	}
+	next.reset()
+	next.dim = h.dim
+
+	h.compacting = true
+	h.compactions.Add(1)
+	go h.compact(next, live)
+}
+
+// compact builds next from live without holding the lock, then replays the
+// writes logged meanwhile onto it and swaps it in.
+func (h *hnswIndex) compact(next *hnswIndex, live []*hnswNode) {
+	defer h.compactions.Done()
+	for _, n := range live {
+		next.insert(n)
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, w := range h.pending {
+		if w.node != nil {
+			next.upsert(w.node)
+		} else if idx, ok := next.byID[w.id]; ok {
+			next.remove(idx)
+		}
+	}
+	h.nodes, h.byID, h.byCategory = next.nodes, next.byID, next.byCategory
+	h.entry, h.maxLevel, h.tombstones = next.entry, next.maxLevel, next.tombstones
+	h.compacting, h.pending = false, nil
+	h.maybeCompact()
+}
+
+func (h *hnswIndex) trackCategory(category string, idx int32) {
+	set, ok := h.byCategory[category]
+	if !ok {
+		set = make(map[int32]struct{})
+		h.byCategory[category] = set
+	}
+	set[idx] = struct{}{}
+}
+
+func (h *hnswIndex) untrackCategory(category string, idx int32) {
+	if set, ok := h.byCategory[category]; ok {
+		delete(set, idx)
+		if len(set) == 0 {
+			delete(h.byCategory, category)
+		}
+	}
+}
+
+// greedyClosest walks level l towards q and returns the closest node reached.
+func (h *hnswIndex) greedyClosest(q []float32, ep int32, l int) int32 {
+	cur, curSim := ep, dot(q, h.nodes[ep].vector)
+	for changed := true; changed; {
+		changed = false
+		for _, nb := range h.nodes[cur].links[l] {
+			if s := dot(q, h.nodes[nb].vector); s > curSim {
+				cur, curSim, changed = nb, s, true
+			}
+		}
+	}
+	return cur
+}
+
+// searchLayer runs a beam search of width ef on level l. Every reachable node
+// is used for navigation, but only nodes passing accept (all when nil) are
+// returned. Results are ordered most similar first.
+func (h *hnswIndex) searchLayer(q []float32, ep int32, ef, l int, accept func(*hnswNode) bool) []scoredNode {
+	visited := acquireVisited(len(h.nodes))
+	defer visitedPool.Put(visited)
+	visited.visit(ep)
+	epSim := dot(q, h.nodes[ep].vector)
+
+	candidates := &maxSimHeap{{node: ep, sim: epSim}}
+	results := &minSimHeap{}
+	if accept == nil || accept(h.nodes[ep]) {
+		heap.Push(results, scoredNode{node: ep, sim: epSim})
+	}
+
+	for candidates.Len() > 0 {
+		c := heap.Pop(candidates).(scoredNode)
+		if results.Len() >= ef && c.sim < (*results)[0].sim {
+			break
+		}
+		for _, nb := range h.nodes[c.node].links[l] {
+			if !visited.visit(nb) {
+				continue
+			}
+
+			s := dot(q, h.nodes[nb].vector)
+			if results.Len() < ef || s > (*results)[0].sim {
+				heap.Push(candidates, scoredNode{node: nb, sim: s})
+				if accept == nil || accept(h.nodes[nb]) {
+					heap.Push(results, scoredNode{node: nb, sim: s})
+					if results.Len() > ef {
+						heap.Pop(results)
+					}
+				}
+			}
+		}
+	}
+
+	out := make([]scoredNode, results.Len())
+	for i := len(out) - 1; i >= 0; i-- {
+		out[i] = heap.Pop(results).(scoredNode)
+	}
+	return out
+}
+
+func (h *hnswIndex) randomLevel() int {
+	return int(-math.Log(1-h.rng.Float64()) * h.levelMult)
+}
+
+func maxLinks(l int) int {
+	if l == 0 {
+		return 2 * hnswM
+	}
+	return hnswM
+}
+
+func normalize(v []float32) []float32 {
+	var norm float64
+	for _, x := range v {
+		norm += float64(x) * float64(x)
+	}
+	out := make([]float32, len(v))
+	if norm == 0 {
+		return out
+	}
+	inv := float32(1 / math.Sqrt(norm))
+	for i, x := range v {
+		out[i] = x * inv
+	}
+	return out
+}
+
+func dot(a, b []float32) float32 {
+	var s float32
+	for i := range a {
+		s += a[i] * b[i]
+	}
+	return s
+}
+
+// visitedSet marks nodes seen by one search. Marks are stamped with an epoch
+// so a pooled set can be reused without clearing it.
+type visitedSet struct {
+	marks []uint32
+	epoch uint32
+}
+
+var visitedPool = sync.Pool{New: func() any { return &visitedSet{} }}
+
+func acquireVisited(n int) *visitedSet {
+	v := visitedPool.Get().(*visitedSet)
+	if len(v.marks) < n {
+		v.marks = make([]uint32, n)
+		v.epoch = 0
+	}
+	v.epoch++
+	if v.epoch == 0 {
+		clear(v.marks)
+		v.epoch = 1
+	}
+	return v
+}
+
+// visit marks idx and reports whether it was unvisited.
+func (v *visitedSet) visit(idx int32) bool {
+	if v.marks[idx] == v.epoch {
+		return false
+	}
+	v.marks[idx] = v.epoch
+	return true
+}
+
+type scoredNode struct {
+	node int32
+	sim  float32
+}
+
+// maxSimHeap pops the most similar node first.
+type maxSimHeap []scoredNode
+
+func (h maxSimHeap) Len() int           { return len(h) }
+func (h maxSimHeap) Less(i, j int) bool { return h[i].sim > h[j].sim }
+func (h maxSimHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *maxSimHeap) Push(x any)        { *h = append(*h, x.(scoredNode)) }
+func (h *maxSimHeap) Pop() any {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
+
+// minSimHeap pops the least similar node first.
+type minSimHeap []scoredNode
+
+func (h minSimHeap) Len() int           { return len(h) }
+func (h minSimHeap) Less(i, j int) bool { return h[i].sim < h[j].sim }
+func (h minSimHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *minSimHeap) Push(x any)        { *h = append(*h, x.(scoredNode)) }
+func (h *minSimHeap) Pop() any {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
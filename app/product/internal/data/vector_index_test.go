@@ -0,0 +1,276 @@
+package data
+
+import (
+	"math/rand/v2"
+	"sort"
+	"testing"
+
+	"yinni_backend/app/product/internal/biz"
+)
+
+func randomVector(rng *rand.Rand, dim int) []float32 {
+	v := make([]float32, dim)
+	for i := range v {
+		v[i] = rng.Float32()*2 - 1
+	}
+	return v
+}
+
+// exactSearch returns the IDs of the k entries most similar to q.
+func exactSearch(entries []*biz.VectorEntry, q []float32, k int, accept func(*biz.VectorEntry) bool) []int64 {
+	type hit struct {
+		id  int64
+		sim float32
+	}
+	qn := normalize(q)
+	var hits []hit
+	for _, e := range entries {
+		if accept == nil || accept(e) {
+			hits = append(hits, hit{e.ID, dot(qn, normalize(e.Vector))})
+		}
+	}
+	sort.Slice(hits, func(i, j int) bool { return hits[i].sim > hits[j].sim })
+	ids := make([]int64, 0, k)
+	for _, h := range hits[:min(k, len(hits))] {
+		ids = append(ids, h.id)
+	}
+	return ids
+}
+
+func matchIDs(matches []*biz.VectorMatch) []int64 {
+	ids := make([]int64, len(matches))
+	for i, m := range matches {
+		ids[i] = m.ID
+	}
+	return ids
+}
+
+func buildIndex(t *testing.T, n, dim int) (biz.VectorIndex, []*biz.VectorEntry) {
+	t.Helper()
+	rng := rand.New(rand.NewPCG(7, 11))
+	categories := []string{"shirts", "jeans", "shoes"}
+	index := NewVectorIndex()
+	entries := make([]*biz.VectorEntry, n)
+	for i := range entries {
+		entries[i] = &biz.VectorEntry{
+			ID:           int64(i + 1),
+			Vector:       randomVector(rng, dim),
+			Category:     categories[i%len(categories)],
+			PriceNumeric: 100 + i%1000,
+		}
+		if err := index.Upsert(entries[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return index, entries
+}
+
+func TestHNSWRecall(t *testing.T) {
+	const k = 10
+	index, entries := buildIndex(t, 3000, 32)
+	if index.Len() != len(entries) {
+		t.Fatalf("Len() = %d, want %d", index.Len(), len(entries))
+	}
+
+	rng := rand.New(rand.NewPCG(3, 5))
+	found, total := 0, 0
+	for range 50 {
+		q := randomVector(rng, 32)
+		want := exactSearch(entries, q, k, nil)
+		got := matchIDs(index.Search(q, k, nil))
+		if len(got) != k {
+			t.Fatalf("got %d matches, want %d", len(got), k)
+		}
+		wanted := make(map[int64]bool)
+		for _, id := range want {
+			wanted[id] = true
+		}
+		for _, id := range got {
+			if wanted[id] {
+				found++
+			}
+		}
+		total += k
+	}
+	if recall := float64(found) / float64(total); recall < 0.9 {
+		t.Errorf("recall@%d = %.2f, want at least 0.9", k, recall)
+	}
+}
+
+func TestHNSWResultsOrderedBySimilarity(t *testing.T) {
+	index, _ := buildIndex(t, 500, 16)
+	matches := index.Search(randomVector(rand.New(rand.NewPCG(1, 1)), 16), 20, nil)
+	for i := 1; i < len(matches); i++ {
+		if matches[i].Score > matches[i-1].Score {
+			t.Fatalf("match %d scores %v, above match %d at %v", i, matches[i].Score, i-1, matches[i-1].Score)
+		}
+	}
+}
+
+func TestHNSWFilters(t *testing.T) {
+	index, entries := buildIndex(t, 3000, 16)
+	q := randomVector(rand.New(rand.NewPCG(2, 2)), 16)
+
+	tests := []struct {
+		name   string
+		filter *biz.VectorFilter
+		accept func(*biz.VectorEntry) bool
+	}{
+		{"category", &biz.VectorFilter{Category: "jeans"},
+			func(e *biz.VectorEntry) bool { return e.Category == "jeans" }},
+		{"price", &biz.VectorFilter{MinPrice: 200, MaxPrice: 210},
+			func(e *biz.VectorEntry) bool { return e.PriceNumeric >= 200 && e.PriceNumeric <= 210 }},
+		{"category and price", &biz.VectorFilter{Category: "shoes", MinPrice: 900},
+			func(e *biz.VectorEntry) bool { return e.Category == "shoes" && e.PriceNumeric >= 900 }},
+	}
+	byID := make(map[int64]*biz.VectorEntry)
+	for _, e := range entries {
+		byID[e.ID] = e
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := index.Search(q, 10, tt.filter)
+			if len(got) != 10 {
+				t.Fatalf("got %d matches, want 10", len(got))
+			}
+			for _, m := range got {
+				if !tt.accept(byID[m.ID]) {
+					t.Errorf("product %d does not pass the filter", m.ID)
+				}
+			}
+			// Filters matching at most hnswBruteForceLimit products are scanned exactly
+			want := exactSearch(entries, q, 10, tt.accept)
+			for i, id := range matchIDs(got) {
+				if id != want[i] {
+					t.Errorf("match %d is product %d, want %d", i, id, want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestHNSWUpsertAndDelete(t *testing.T) {
+	index, entries := buildIndex(t, 200, 8)
+	target := entries[42]
+
+	// The product's own vector finds it first
+	if got := index.Search(target.Vector, 1, nil); len(got) != 1 || got[0].ID != target.ID {
+		t.Fatalf("search for product %d returned %v", target.ID, matchIDs(got))
+	}
+
+	// Attribute-only updates apply in place
+	moved := &biz.VectorEntry{ID: target.ID, Vector: target.Vector, Category: "hats", PriceNumeric: 5}
+	if err := index.Upsert(moved); err != nil {
+		t.Fatal(err)
+	}
+	if got := index.Search(target.Vector, 5, &biz.VectorFilter{Category: "hats"}); len(got) != 1 || got[0].ID != target.ID {
+		t.Fatalf("category filter after update returned %v", matchIDs(got))
+	}
+	if index.Len() != len(entries) {
+		t.Errorf("Len() = %d after an update, want %d", index.Len(), len(entries))
+	}
+
+	// A new vector replaces the old one
+	replaced := &biz.VectorEntry{ID: target.ID, Vector: entries[7].Vector, Category: "hats"}
+	if err := index.Upsert(replaced); err != nil {
+		t.Fatal(err)
+	}
+	for _, m := range index.Search(target.Vector, 3, nil) {
+		if m.ID == target.ID && m.Score > 0.999 {
+			t.Errorf("product %d still found by its old vector", target.ID)
+		}
+	}
+
+	index.Delete(target.ID)
+	if index.Len() != len(entries)-1 {
+		t.Errorf("Len() = %d after a delete, want %d", index.Len(), len(entries)-1)
+	}
+	for _, m := range index.Search(entries[7].Vector, 10, nil) {
+		if m.ID == target.ID {
+			t.Errorf("deleted product %d returned", target.ID)
+		}
+	}
+}
+
+func TestHNSWCompaction(t *testing.T) {
+	index, entries := buildIndex(t, 300, 8)
+	// Deleting most products compacts the graph; the rest stay searchable
+	for _, e := range entries[:250] {
+		index.Delete(e.ID)
+	}
+	if index.Len() != 50 {
+		t.Fatalf("Len() = %d, want 50", index.Len())
+	}
+	index.(*hnswIndex).compactions.Wait()
+	if h := index.(*hnswIndex); h.tombstones != 0 || len(h.nodes) != 50 {
+		t.Fatalf("compacted graph has %d nodes and %d tombstones, want 50 and 0", len(h.nodes), h.tombstones)
+	}
+	for _, e := range entries[250:] {
+		got := index.Search(e.Vector, 1, nil)
+		if len(got) != 1 || got[0].ID != e.ID {
+			t.Fatalf("product %d not found after compaction: %v", e.ID, matchIDs(got))
+		}
+	}
+}
+
+func TestHNSWCompactionKeepsConcurrentWrites(t *testing.T) {
+	index, entries := buildIndex(t, 2000, 32)
+	h := index.(*hnswIndex)
+	for _, e := range entries[:1000] {
+		index.Delete(e.ID)
+	}
+
+	// Writes made while the graph is rebuilt reach the rebuilt graph
+	h.mu.RLock()
+	compacting := h.compacting
+	h.mu.RUnlock()
+	if !compacting {
+		t.Fatal("deletes did not start a compaction")
+	}
+	rng := rand.New(rand.NewPCG(13, 17))
+	moved := &biz.VectorEntry{ID: entries[1000].ID, Vector: randomVector(rng, 32), Category: "socks"}
+	added := &biz.VectorEntry{ID: 5000, Vector: randomVector(rng, 32), Category: "socks"}
+	recategorised := &biz.VectorEntry{ID: entries[1001].ID, Vector: entries[1001].Vector, Category: "socks"}
+	for _, e := range []*biz.VectorEntry{moved, added, recategorised} {
+		if err := index.Upsert(e); err != nil {
+			t.Fatal(err)
+		}
+	}
+	index.Delete(entries[1002].ID)
+	h.compactions.Wait()
+
+	if h.compacting || len(h.pending) != 0 {
+		t.Fatal("compaction did not finish")
+	}
+	if index.Len() != 1000 {
+		t.Errorf("Len() = %d, want 1000", index.Len())
+	}
+	socks := &biz.VectorFilter{Category: "socks"}
+	for _, e := range []*biz.VectorEntry{moved, added, recategorised} {
+		got := index.Search(e.Vector, 1, socks)
+		if len(got) != 1 || got[0].ID != e.ID {
+			t.Errorf("product %d written during compaction: got %v", e.ID, matchIDs(got))
+		}
+	}
+	for _, m := range index.Search(entries[1002].Vector, 10, nil) {
+		if m.ID == entries[1002].ID {
+			t.Errorf("product %d deleted during compaction returned", m.ID)
+		}
+	}
+}
+
+func TestHNSWRejectsBadVectors(t *testing.T) {
+	index := NewVectorIndex()
+	if err := index.Upsert(&biz.VectorEntry{ID: 1}); err == nil {
+		t.Error("empty vector accepted")
+	}
+	if err := index.Upsert(&biz.VectorEntry{ID: 1, Vector: []float32{1, 0, 0}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := index.Upsert(&biz.VectorEntry{ID: 2, Vector: []float32{1, 0}}); err == nil {
+		t.Error("vector of another dimension accepted")
+	}
+	if got := index.Search([]float32{1, 0}, 5, nil); got != nil {
+		t.Errorf("query of another dimension returned %v", matchIDs(got))
+	}
+}
@@ -0,0 +1,61 @@
+package server
+
+import (
+	v1 "yinni_backend/api/product/v1"
+	"yinni_backend/internal/conf"
+	"yinni_backend/pkg/auth"
+	"yinni_backend/pkg/jwks"
+	"yinni_backend/pkg/middleware"
+	"yinni_backend/pkg/revocation"
+
+	kmiddleware "github.com/go-kratos/kratos/v2/middleware"
+)
+
+// publicOperations browse and search the catalog, open to anonymous
+// shoppers.
+var publicOperations = []string{
+	v1.OperationProductGetProduct,
+	v1.OperationProductGetProductByPID,
+	v1.OperationProductListProducts,
+	v1.OperationProductSearchProducts,
+	v1.OperationProductGetFeaturedProducts,
+	v1.OperationProductGetSimilarProducts,
+	v1.OperationProductSemanticSearch,
+	v1.OperationProductHybridSearch,
+	v1.OperationProductSuggestQueries,
+	v1.OperationProductAskCatalog,
+	v1.Product_StreamAskCatalog_FullMethodName,
+}
+
+// catalogWriteOperations change the catalog.
+var catalogWriteOperations = []string{
+	v1.OperationProductCreateProduct,
+	v1.OperationProductUpdateProduct,
+	v1.OperationProductDeleteProduct,
+}
+
+// adminOperations export the catalog and manage embeddings and search
+// rules.
+var adminOperations = []string{
+	v1.Product_ExportProducts_FullMethodName,
+	v1.OperationProductStartEmbeddingBackfill,
+	v1.OperationProductStopEmbeddingBackfill,
+	v1.OperationProductGetEmbeddingBackfillStatus,
+	v1.OperationProductListSearchRules,
+	v1.OperationProductCreateSearchRule,
+	v1.OperationProductUpdateSearchRule,
+	v1.OperationProductDeleteSearchRule,
+}
+
+// authMiddleware authenticates the calls to every operation, letting
+// anonymous callers through to the public ones, and checks the scopes of the
+// protected ones.
+func authMiddleware(authConf *conf.Auth, revocations revocation.Store, keys *jwks.Cache) kmiddleware.Middleware {
+	return middleware.JWT(authConf.JwtSecret,
+		middleware.WithRevocationStore(revocations),
+		middleware.WithJWKS(keys),
+		middleware.WithPublic(publicOperations...),
+		middleware.WithScope(auth.ScopeCatalogWrite, catalogWriteOperations...),
+		middleware.WithScope(auth.ScopeAdmin, adminOperations...),
+	)
+}
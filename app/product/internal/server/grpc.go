@@ -1,23 +1,32 @@
 package server
 
 import (
+	"context"
+
 	v1 "yinni_backend/api/product/v1"
 	"yinni_backend/app/product/internal/service"
 	"yinni_backend/internal/conf"
-	"yinni_backend/pkg/middleware"
+	"yinni_backend/pkg/jwks"
+	"yinni_backend/pkg/revocation"
 
 	"github.com/go-kratos/kratos/v2/log"
+	kmiddleware "github.com/go-kratos/kratos/v2/middleware"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
+	grpcgo "google.golang.org/grpc"
 )
 
 // NewGRPCServer new a gRPC server.
-func NewGRPCServer(c *conf.Server, authConf *conf.Auth, product *service.ProductService, logger log.Logger) *grpc.Server {
+func NewGRPCServer(c *conf.Server, authConf *conf.Auth, revocations revocation.Store, keys *jwks.Cache, product *service.ProductService, logger log.Logger) *grpc.Server {
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(
 			recovery.Recovery(),
-			middleware.JWT(authConf.JwtSecret),
+			authMiddleware(authConf, revocations, keys),
 		),
+		grpc.StreamInterceptor(streamMiddleware(
+			recovery.Recovery(),
+			authMiddleware(authConf, revocations, keys),
+		)),
 	}
 	if c.Grpc.Network != "" {
 		opts = append(opts, grpc.Network(c.Grpc.Network))
@@ -32,3 +41,27 @@ func NewGRPCServer(c *conf.Server, authConf *conf.Auth, product *service.Product
 	v1.RegisterProductServer(srv, product)
 	return srv
 }
+
+// streamMiddleware runs unary middleware once at the start of each stream,
+// so streaming RPCs get the same checks as unary ones. Context values added
+// by the middleware are visible through the stream's Context.
+func streamMiddleware(m ...kmiddleware.Middleware) grpcgo.StreamServerInterceptor {
+	chain := kmiddleware.Chain(m...)
+	return func(srv any, ss grpcgo.ServerStream, info *grpcgo.StreamServerInfo, handler grpcgo.StreamHandler) error {
+		h := chain(func(ctx context.Context, _ any) (any, error) {
+			return nil, handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+		})
+		_, err := h(ss.Context(), nil)
+		return err
+	}
+}
+
+// serverStream overrides the context of a grpc.ServerStream.
+type serverStream struct {
+	grpcgo.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
@@ -1,20 +1,35 @@
 package server
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	stdhttp "net/http"
+	"time"
+
 	v1 "yinni_backend/api/product/v1"
 	"yinni_backend/app/product/internal/service"
 	"yinni_backend/internal/conf"
-	"yinni_backend/pkg/middleware"
+	"yinni_backend/pkg/jwks"
+	"yinni_backend/pkg/revocation"
 
+	"github.com/go-kratos/kratos/v2/encoding"
+	"github.com/go-kratos/kratos/v2/encoding/json"
+	kerrors "github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/logging"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	"github.com/go-kratos/kratos/v2/transport/http"
 	"github.com/rs/cors"
+	"google.golang.org/grpc/metadata"
 )
 
+// sseMaxDuration bounds a server-sent event stream. Streams are exempt from
+// the server timeout, which is sized for unary calls.
+const sseMaxDuration = 2 * time.Minute
+
 // NewHTTPServer new an HTTP server.
-func NewHTTPServer(c *conf.Server, authConf *conf.Auth, product *service.ProductService, logger log.Logger) *http.Server {
+func NewHTTPServer(c *conf.Server, authConf *conf.Auth, revocations revocation.Store, keys *jwks.Cache, product *service.ProductService, logger log.Logger) *http.Server {
 	corsHandler := cors.New(cors.Options{
 		AllowedOrigins:   []string{"*"},
 		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
@@ -26,7 +41,7 @@ func NewHTTPServer(c *conf.Server, authConf *conf.Auth, product *service.Product
 		http.Middleware(
 			recovery.Recovery(),
 			logging.Server(logger),
-			middleware.JWT(authConf.JwtSecret),
+			authMiddleware(authConf, revocations, keys),
 		),
 		http.Filter(corsHandler.Handler),
 	}
@@ -42,5 +57,131 @@ func NewHTTPServer(c *conf.Server, authConf *conf.Auth, product *service.Product
 
 	srv := http.NewServer(opts...)
 	v1.RegisterProductHTTPServer(srv, product)
+	srv.Route("/").GET("/v1/products/ask/stream", streamAskCatalogHandler(product))
 	return srv
 }
+
+// streamAskCatalogHandler serves StreamAskCatalog as server-sent events. Each
+// event is named after the AskCatalogEvent case it carries ("products",
+// "delta" or "citations"). Errors raised before the first event get a normal
+// error response; later ones are sent as an "error" event.
+func streamAskCatalogHandler(product *service.ProductService) http.HandlerFunc {
+	return func(ctx http.Context) error {
+		var in v1.AskCatalogRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, v1.Product_StreamAskCatalog_FullMethodName)
+
+		streamCtx, cancel := sseContext(ctx)
+		defer cancel()
+
+		stream := &sseStream{ctx: streamCtx, w: ctx.Response()}
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			stream.ctx = ctx
+			return nil, product.StreamAskCatalog(req.(*v1.AskCatalogRequest), stream)
+		})
+
+		_, err := h(streamCtx, &in)
+		if err != nil && stream.started {
+			stream.sendError(err)
+			return nil
+		}
+		return err
+	}
+}
+
+// sseContext detaches ctx from the server timeout while keeping its values.
+// The returned context ends when the client disconnects within the timeout
+// window or after sseMaxDuration; later disconnects surface as write errors.
+func sseContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	streamCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), sseMaxDuration)
+	stop := context.AfterFunc(ctx, func() {
+		if !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			cancel()
+		}
+	})
+	return streamCtx, func() {
+		stop()
+		cancel()
+	}
+}
+
+// sseStream adapts an HTTP response to the StreamAskCatalog server stream.
+type sseStream struct {
+	ctx     context.Context
+	w       stdhttp.ResponseWriter
+	started bool
+}
+
+func (s *sseStream) Send(ev *v1.AskCatalogEvent) error {
+	var name string
+	switch ev.Event.(type) {
+	case *v1.AskCatalogEvent_Products_:
+		name = "products"
+	case *v1.AskCatalogEvent_Delta:
+		name = "delta"
+	case *v1.AskCatalogEvent_Citations_:
+		name = "citations"
+	default:
+		return fmt.Errorf("unknown ask catalog event %T", ev.Event)
+	}
+
+	data, err := encoding.GetCodec(json.Name).Marshal(ev)
+	if err != nil {
+		return err
+	}
+	return s.write(name, data)
+}
+
+// sendError reports a failure on a stream that has already started.
+func (s *sseStream) sendError(err error) {
+	data, merr := encoding.GetCodec(json.Name).Marshal(kerrors.FromError(err))
+	if merr != nil {
+		return
+	}
+	_ = s.write("error", data)
+}
+
+func (s *sseStream) write(event string, data []byte) error {
+	if err := s.ctx.Err(); err != nil {
+		return err
+	}
+
+	if !s.started {
+		header := s.w.Header()
+		header.Set("Content-Type", "text/event-stream")
+		header.Set("Cache-Control", "no-cache")
+		header.Set("Connection", "keep-alive")
+		header.Set("X-Accel-Buffering", "no")
+		s.w.WriteHeader(stdhttp.StatusOK)
+		s.started = true
+	}
+
+	if _, err := fmt.Fprintf(s.w, "event: %s\ndata: %s\n\n", event, data); err != nil {
+		return err
+	}
+	return stdhttp.NewResponseController(s.w).Flush()
+}
+
+func (s *sseStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *sseStream) SendMsg(m any) error {
+	ev, ok := m.(*v1.AskCatalogEvent)
+	if !ok {
+		return fmt.Errorf("unexpected message type %T", m)
+	}
+	return s.Send(ev)
+}
+
+func (s *sseStream) RecvMsg(any) error {
+	return errors.New("server-sent event streams do not receive messages")
+}
+
+// Headers and trailers have no place in an event stream once it has started.
+
+func (s *sseStream) SetHeader(metadata.MD) error  { return nil }
+func (s *sseStream) SendHeader(metadata.MD) error { return nil }
+func (s *sseStream) SetTrailer(metadata.MD)       {}
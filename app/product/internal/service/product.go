@@ -1,12 +1,16 @@
 package service
 
 import (
+	"bufio"
 	"context"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	pb "yinni_backend/api/product/v1"
 	"yinni_backend/app/product/internal/biz"
+	"yinni_backend/pkg/auth"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -14,14 +18,22 @@ import (
 
 type ProductService struct {
 	pb.UnimplementedProductServer
-	uc  *biz.ProductUsecase
-	log *log.Helper
+	uc       *biz.ProductUsecase
+	backfill *biz.EmbeddingBackfill
+	suggest  *biz.QuerySuggester
+	rules    *biz.SearchRules
+	exporter *biz.CatalogExporter
+	log      *log.Helper
 }
 
-func NewProductService(uc *biz.ProductUsecase, logger log.Logger) *ProductService {
+func NewProductService(uc *biz.ProductUsecase, backfill *biz.EmbeddingBackfill, suggest *biz.QuerySuggester, rules *biz.SearchRules, exporter *biz.CatalogExporter, logger log.Logger) *ProductService {
 	return &ProductService{
-		uc:  uc,
-		log: log.NewHelper(logger),
+		uc:       uc,
+		backfill: backfill,
+		suggest:  suggest,
+		rules:    rules,
+		exporter: exporter,
+		log:      log.NewHelper(logger),
 	}
 }
 
@@ -37,6 +49,56 @@ func (s *ProductService) GetProduct(ctx context.Context, req *pb.GetProductReque
 	return s.convertToProductInfo(product), nil
 }
 
+func (s *ProductService) CreateProduct(ctx context.Context, req *pb.CreateProductRequest) (*pb.ProductInfo, error) {
+	if req.Product == nil {
+		return nil, biz.ErrInvalidParameters
+	}
+	s.log.WithContext(ctx).Infof("CreateProduct called with pid: %s by user %d", req.Product.Pid, callerID(ctx))
+
+	product, err := s.uc.CreateProduct(ctx, s.convertFromProductInfo(req.Product))
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("CreateProduct failed: %v", err)
+		return nil, err
+	}
+
+	return s.convertToProductInfo(product), nil
+}
+
+func (s *ProductService) UpdateProduct(ctx context.Context, req *pb.UpdateProductRequest) (*pb.ProductInfo, error) {
+	if req.Product == nil {
+		return nil, biz.ErrInvalidParameters
+	}
+	s.log.WithContext(ctx).Infof("UpdateProduct called with id: %d, mask: %v by user %d", req.Product.Id, req.UpdateMask.GetPaths(), callerID(ctx))
+
+	product, err := s.uc.UpdateProduct(ctx, s.convertFromProductInfo(req.Product), req.UpdateMask.GetPaths())
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("UpdateProduct failed: %v", err)
+		return nil, err
+	}
+
+	return s.convertToProductInfo(product), nil
+}
+
+func (s *ProductService) DeleteProduct(ctx context.Context, req *pb.DeleteProductRequest) (*pb.ProductInfo, error) {
+	s.log.WithContext(ctx).Infof("DeleteProduct called with id: %d by user %d", req.Id, callerID(ctx))
+
+	product, err := s.uc.DeleteProduct(ctx, req.Id)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("DeleteProduct failed: %v", err)
+		return nil, err
+	}
+
+	return s.convertToProductInfo(product), nil
+}
+
+// callerID returns the ID of the user calling, 0 for anonymous calls.
+func callerID(ctx context.Context) int64 {
+	if p, ok := auth.FromContext(ctx); ok {
+		return p.UserID
+	}
+	return 0
+}
+
 func (s *ProductService) GetProductByPID(ctx context.Context, req *pb.GetProductByPIDRequest) (*pb.ProductInfo, error) {
 	s.log.WithContext(ctx).Infof("GetProductByPID called with pid: %s", req.Pid)
 
@@ -52,35 +114,29 @@ func (s *ProductService) GetProductByPID(ctx context.Context, req *pb.GetProduct
 func (s *ProductService) ListProducts(ctx context.Context, req *pb.ListProductsRequest) (*pb.ListProductsReply, error) {
 	s.log.WithContext(ctx).Infof("ListProducts called: page=%d, pageSize=%d", req.Page, req.PageSize)
 
-	params := &biz.ListProductsParams{
-		Page:        req.Page,
-		PageSize:    req.PageSize,
-		Category:    req.Category,
-		Brand:       req.Brand,
-		SubCategory: req.SubCategory,
-		MinPrice:    req.MinPrice,
-		MaxPrice:    req.MaxPrice,
-		MinRating:   req.MinRating,
-		InStock:     req.InStock,
-		Featured:    req.FeaturedOnly,
-		Seller:      req.Seller,
-		SortBy:      req.SortBy,
-		SortOrder:   req.SortOrder,
-		SearchQuery: req.SearchQuery,
-	}
-
-	products, total, err := s.uc.ListProducts(ctx, params)
+	params := convertFromListProductsRequest(req)
+	page, err := s.uc.ListProducts(ctx, params)
 	if err != nil {
 		s.log.WithContext(ctx).Errorf("ListProducts failed: %v", err)
 		return nil, err
 	}
 
-	return &pb.ListProductsReply{
-		Products: s.convertToProductList(products),
-		Total:    int32(total),
-		Page:     req.Page,
-		PageSize: req.PageSize,
-	}, nil
+	reply := &pb.ListProductsReply{
+		Products:      s.convertToProductList(page.Products),
+		Total:         int32(page.Total),
+		Page:          req.Page,
+		PageSize:      req.PageSize,
+		NextPageToken: page.NextPageToken,
+	}
+	if req.IncludeFacets {
+		facets, err := s.uc.GetFacets(ctx, "", params)
+		if err != nil {
+			s.log.WithContext(ctx).Errorf("ListProducts facets failed: %v", err)
+			return nil, err
+		}
+		reply.Facets = s.convertToFacets(facets)
+	}
+	return reply, nil
 }
 
 func (s *ProductService) SearchProducts(ctx context.Context, req *pb.SearchProductsRequest) (*pb.ListProductsReply, error) {
@@ -94,46 +150,73 @@ func (s *ProductService) SearchProducts(ctx context.Context, req *pb.SearchProdu
 		limit = 100
 	}
 
-	var products []*biz.Product
-	var err error
+	priceRange := convertPriceRange(req.PriceRange)
+	params := &biz.ListProductsParams{
+		PageSize: int32(limit),
+		Category: req.Category,
+	}
+	if priceRange != nil {
+		params.MinPrice = priceRange.Min
+		params.MaxPrice = priceRange.Max
+	}
 
-	// Convert PriceRange
-	var priceRange *biz.PriceRange
-	if req.PriceRange != nil {
-		priceRange = &biz.PriceRange{
-			Min: req.PriceRange.Min,
-			Max: req.PriceRange.Max,
+	// Without a query there is nothing to rank; list the filtered products
+	if strings.TrimSpace(req.Query) == "" {
+		products, _, err := s.uc.SearchProducts(ctx, "", params)
+		if err != nil {
+			s.log.WithContext(ctx).Errorf("SearchProducts failed: %v", err)
+			return nil, err
+		}
+		reply := &pb.ListProductsReply{
+			Products: s.convertToProductList(products),
+			Total:    int32(len(products)),
+			PageSize: req.Limit,
 		}
+		if req.IncludeFacets {
+			facets, err := s.uc.GetFacets(ctx, "", params)
+			if err != nil {
+				s.log.WithContext(ctx).Errorf("SearchProducts facets failed: %v", err)
+				return nil, err
+			}
+			reply.Facets = s.convertToFacets(facets)
+		}
+		return reply, nil
 	}
 
-	// Try RAG search if embeddings are enabled
-	products, err = s.uc.RAGSearch(ctx, req.Query, limit, req.Category, priceRange)
+	result, err := s.uc.HybridSearch(ctx, req.Query, limit, &biz.HybridSearchParams{
+		Category:   req.Category,
+		PriceRange: priceRange,
+	})
 	if err != nil {
-		s.log.WithContext(ctx).Warnf("RAG search failed: %v, falling back to traditional search", err)
-		// Fallback to traditional search
-		params := &biz.ListProductsParams{
-			PageSize:    int32(limit),
-			Category:    req.Category,
-			SearchQuery: req.Query,
-		}
+		s.log.WithContext(ctx).Errorf("SearchProducts failed: %v", err)
+		return nil, err
+	}
 
-		if priceRange != nil {
-			params.MinPrice = priceRange.Min
-			params.MaxPrice = priceRange.Max
-		}
+	products := make([]*biz.Product, len(result.Hits))
+	for i, hit := range result.Hits {
+		products[i] = hit.Product
+	}
 
-		products, _, err = s.uc.SearchProducts(ctx, req.Query, params)
+	reply := &pb.ListProductsReply{
+		Products:       s.convertToProductList(products),
+		Total:          int32(len(products)),
+		PageSize:       req.Limit,
+		CorrectedQuery: result.CorrectedQuery,
+	}
+	if req.IncludeFacets {
+		// Count every keyword match under the filters, not just the page
+		query := req.Query
+		if result.CorrectedQuery != "" {
+			query = result.CorrectedQuery
+		}
+		facets, err := s.uc.GetFacets(ctx, query, params)
 		if err != nil {
-			s.log.WithContext(ctx).Errorf("Traditional search failed: %v", err)
+			s.log.WithContext(ctx).Errorf("SearchProducts facets failed: %v", err)
 			return nil, err
 		}
+		reply.Facets = s.convertToFacets(facets)
 	}
-
-	return &pb.ListProductsReply{
-		Products: s.convertToProductList(products),
-		Total:    int32(len(products)),
-		PageSize: req.Limit,
-	}, nil
+	return reply, nil
 }
 
 func (s *ProductService) GetFeaturedProducts(ctx context.Context, req *pb.GetFeaturedProductsRequest) (*pb.ListProductsReply, error) {
@@ -182,8 +265,253 @@ func (s *ProductService) GetSimilarProducts(ctx context.Context, req *pb.GetSimi
 	}, nil
 }
 
+func (s *ProductService) SemanticSearch(ctx context.Context, req *pb.SemanticSearchRequest) (*pb.SemanticSearchReply, error) {
+	s.log.WithContext(ctx).Infof("SemanticSearch called: query=%s, limit=%d", req.Query, req.Limit)
+
+	hits, err := s.uc.SearchWithEmbeddings(ctx, req.Query, clampLimit(req.Limit, 10, 100), req.Category, convertPriceRange(req.PriceRange))
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("SemanticSearch failed: %v", err)
+		return nil, err
+	}
+
+	return &pb.SemanticSearchReply{
+		Results: s.convertToScoredProductList(hits),
+	}, nil
+}
+
+func (s *ProductService) HybridSearch(ctx context.Context, req *pb.HybridSearchRequest) (*pb.HybridSearchReply, error) {
+	s.log.WithContext(ctx).Infof("HybridSearch called: query=%s, limit=%d", req.Query, req.Limit)
+
+	result, err := s.uc.HybridSearch(ctx, req.Query, clampLimit(req.Limit, 10, 100), &biz.HybridSearchParams{
+		Category:      req.Category,
+		PriceRange:    convertPriceRange(req.PriceRange),
+		LexicalWeight: float64(req.LexicalWeight),
+		VectorWeight:  float64(req.VectorWeight),
+	})
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("HybridSearch failed: %v", err)
+		return nil, err
+	}
+
+	results := make([]*pb.HybridSearchResult, len(result.Hits))
+	for i, hit := range result.Hits {
+		results[i] = &pb.HybridSearchResult{
+			Product:     s.convertToProductInfo(hit.Product),
+			Score:       hit.Score,
+			LexicalRank: int32(hit.LexicalRank),
+			VectorRank:  int32(hit.VectorRank),
+			VectorScore: hit.VectorScore,
+		}
+	}
+
+	return &pb.HybridSearchReply{
+		Results:        results,
+		Mode:           result.Mode,
+		CorrectedQuery: result.CorrectedQuery,
+	}, nil
+}
+
+func (s *ProductService) SuggestQueries(ctx context.Context, req *pb.SuggestQueriesRequest) (*pb.SuggestQueriesReply, error) {
+	// Called on every keystroke, so not logged at info level
+	s.log.WithContext(ctx).Debugf("SuggestQueries called: prefix=%s, limit=%d", req.Prefix, req.Limit)
+
+	suggestions, err := s.suggest.Suggest(ctx, req.Prefix, int(req.Limit))
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("SuggestQueries failed: %v", err)
+		return nil, err
+	}
+
+	reply := &pb.SuggestQueriesReply{
+		Suggestions: make([]*pb.QuerySuggestion, 0, len(suggestions)),
+	}
+	for _, sg := range suggestions {
+		reply.Suggestions = append(reply.Suggestions, &pb.QuerySuggestion{
+			Text:       sg.Text,
+			Kind:       sg.Kind,
+			Popularity: sg.Popularity,
+		})
+	}
+	return reply, nil
+}
+
+func (s *ProductService) AskCatalog(ctx context.Context, req *pb.AskCatalogRequest) (*pb.AskCatalogReply, error) {
+	s.log.WithContext(ctx).Infof("AskCatalog called: query=%s, limit=%d", req.Query, req.Limit)
+
+	result, err := s.uc.RAGSearch(ctx, req.Query, clampLimit(req.Limit, 5, 20), req.Category, convertPriceRange(req.PriceRange))
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("AskCatalog failed: %v", err)
+		return nil, err
+	}
+
+	return &pb.AskCatalogReply{
+		Results:   s.convertToScoredProductList(result.Products),
+		Answer:    result.Answer,
+		CitedPids: result.CitedPIDs,
+	}, nil
+}
+
+func (s *ProductService) StreamAskCatalog(req *pb.AskCatalogRequest, stream pb.Product_StreamAskCatalogServer) error {
+	ctx := stream.Context()
+	s.log.WithContext(ctx).Infof("StreamAskCatalog called: query=%s, limit=%d", req.Query, req.Limit)
+
+	err := s.uc.StreamRAGSearch(ctx, req.Query, clampLimit(req.Limit, 5, 20), req.Category, convertPriceRange(req.PriceRange), func(ev *biz.RAGEvent) error {
+		return stream.Send(s.convertToAskCatalogEvent(ev))
+	})
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("StreamAskCatalog failed: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (s *ProductService) ExportProducts(req *pb.ExportProductsRequest, stream pb.Product_ExportProductsServer) error {
+	ctx := stream.Context()
+	s.log.WithContext(ctx).Infof("ExportProducts called: format=%s, fields=%v, embeddings=%t", req.Format, req.Fields, req.IncludeEmbeddings)
+
+	var params *biz.ListProductsParams
+	if req.Filter != nil {
+		params = convertFromListProductsRequest(req.Filter)
+	}
+	opts := biz.ExportOptions{
+		Format:            strings.ToLower(req.Format.String()),
+		Fields:            req.Fields,
+		IncludeEmbeddings: req.IncludeEmbeddings,
+		Limit:             int(req.Limit),
+	}
+
+	// Chunks are sent as they fill, so the stream holds one chunk at a time
+	w := bufio.NewWriterSize(exportChunkWriter{stream}, exportChunkSize)
+	n, err := s.exporter.Export(ctx, params, opts, w)
+	if err == nil {
+		err = w.Flush()
+	}
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("ExportProducts failed after %d products: %v", n, err)
+		return err
+	}
+
+	return nil
+}
+
+// exportChunkSize is the size of the chunks of an export stream.
+const exportChunkSize = 64 << 10
+
+// exportChunkWriter sends what is written to it as export chunks.
+type exportChunkWriter struct {
+	stream pb.Product_ExportProductsServer
+}
+
+func (w exportChunkWriter) Write(p []byte) (int, error) {
+	if err := w.stream.Send(&pb.ExportProductsChunk{Data: p}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (s *ProductService) StartEmbeddingBackfill(ctx context.Context, req *pb.StartEmbeddingBackfillRequest) (*pb.EmbeddingBackfillStatus, error) {
+	s.log.WithContext(ctx).Infof("StartEmbeddingBackfill called: restart=%t", req.Restart)
+
+	status, err := s.backfill.Start(ctx, req.Restart)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("StartEmbeddingBackfill failed: %v", err)
+		return nil, err
+	}
+
+	return s.convertToBackfillStatus(status), nil
+}
+
+func (s *ProductService) StopEmbeddingBackfill(ctx context.Context, req *pb.StopEmbeddingBackfillRequest) (*pb.EmbeddingBackfillStatus, error) {
+	s.log.WithContext(ctx).Info("StopEmbeddingBackfill called")
+
+	status, err := s.backfill.Stop(ctx)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("StopEmbeddingBackfill failed: %v", err)
+		return nil, err
+	}
+
+	return s.convertToBackfillStatus(status), nil
+}
+
+func (s *ProductService) GetEmbeddingBackfillStatus(ctx context.Context, req *pb.GetEmbeddingBackfillStatusRequest) (*pb.EmbeddingBackfillStatus, error) {
+	status, err := s.backfill.Status(ctx)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("GetEmbeddingBackfillStatus failed: %v", err)
+		return nil, err
+	}
+
+	return s.convertToBackfillStatus(status), nil
+}
+
+func (s *ProductService) ListSearchRules(ctx context.Context, req *pb.ListSearchRulesRequest) (*pb.ListSearchRulesReply, error) {
+	rules, err := s.rules.ListRules(ctx)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("ListSearchRules failed: %v", err)
+		return nil, err
+	}
+
+	reply := &pb.ListSearchRulesReply{Rules: make([]*pb.SearchRule, len(rules))}
+	for i, r := range rules {
+		reply.Rules[i] = convertToSearchRule(r)
+	}
+	return reply, nil
+}
+
+func (s *ProductService) CreateSearchRule(ctx context.Context, req *pb.CreateSearchRuleRequest) (*pb.SearchRule, error) {
+	if req.Rule == nil {
+		return nil, biz.ErrInvalidParameters
+	}
+
+	rule, err := s.rules.CreateRule(ctx, convertFromSearchRule(req.Rule))
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("CreateSearchRule failed: %v", err)
+		return nil, err
+	}
+	return convertToSearchRule(rule), nil
+}
+
+func (s *ProductService) UpdateSearchRule(ctx context.Context, req *pb.UpdateSearchRuleRequest) (*pb.SearchRule, error) {
+	if req.Rule == nil {
+		return nil, biz.ErrInvalidParameters
+	}
+
+	rule, err := s.rules.UpdateRule(ctx, convertFromSearchRule(req.Rule))
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("UpdateSearchRule failed: %v", err)
+		return nil, err
+	}
+	return convertToSearchRule(rule), nil
+}
+
+func (s *ProductService) DeleteSearchRule(ctx context.Context, req *pb.DeleteSearchRuleRequest) (*pb.SearchRule, error) {
+	rule, err := s.rules.DeleteRule(ctx, req.Id)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("DeleteSearchRule failed: %v", err)
+		return nil, err
+	}
+	return convertToSearchRule(rule), nil
+}
+
+// clampLimit applies the default to a zero limit and caps it at maxLimit.
+func clampLimit(limit int32, defaultLimit, maxLimit int) int {
+	if limit <= 0 {
+		return defaultLimit
+	}
+	return min(int(limit), maxLimit)
+}
+
 // Helper methods for conversion
 
+func convertPriceRange(pr *pb.PriceRange) *biz.PriceRange {
+	if pr == nil {
+		return nil
+	}
+	return &biz.PriceRange{
+		Min: pr.Min,
+		Max: pr.Max,
+	}
+}
+
 func (s *ProductService) convertToProductInfo(p *biz.Product) *pb.ProductInfo {
 	if p == nil {
 		return nil
@@ -203,8 +531,11 @@ func (s *ProductService) convertToProductInfo(p *biz.Product) *pb.ProductInfo {
 		primaryImage = p.Images[0]
 	}
 
-	// Calculate discount percentage
-	discountPct := s.calculateDiscountPercentage(p.ActualPrice, p.SellingPrice)
+	// Rows written before discounts were derived have none stored
+	discountPct := p.DiscountPercent
+	if discountPct == 0 {
+		discountPct = s.calculateDiscountPercentage(p.ActualPrice, p.SellingPrice)
+	}
 
 	return &pb.ProductInfo{
 		Id:                 p.ID,
@@ -235,6 +566,116 @@ func (s *ProductService) convertToProductInfo(p *biz.Product) *pb.ProductInfo {
 		ViewCount:          int32(p.ViewCount),
 		ClickCount:         int32(p.ClickCount),
 		Featured:           p.Featured,
+		ActualPriceNumeric: int32(p.ActualPriceNumeric),
+		Attributes:         convertToProductAttributes(p.Attributes),
+	}
+}
+
+func convertFromListProductsRequest(req *pb.ListProductsRequest) *biz.ListProductsParams {
+	return &biz.ListProductsParams{
+		Page:        req.Page,
+		PageSize:    req.PageSize,
+		Category:    req.Category,
+		Brand:       req.Brand,
+		SubCategory: req.SubCategory,
+		MinPrice:    req.MinPrice,
+		MaxPrice:    req.MaxPrice,
+		MinRating:   req.MinRating,
+		InStock:     req.InStock,
+		Featured:    req.FeaturedOnly,
+		Seller:      req.Seller,
+		SortBy:      req.SortBy,
+		SortOrder:   req.SortOrder,
+		SearchQuery: req.SearchQuery,
+
+		AttributeFilters: convertFromAttributeFilters(req.Attributes, req.AttributeFilters),
+		PageToken:        req.PageToken,
+		IncludeTotal:     req.IncludeTotal,
+	}
+}
+
+// convertFromAttributeFilters merges the single-value attributes map, sorted
+// by key so page tokens stay stable, with the attribute filters.
+func convertFromAttributeFilters(attrs map[string]string, filters []*pb.AttributeFilter) []*biz.AttributeFilter {
+	keys := make([]string, 0, len(attrs))
+	for k := range attrs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	rv := make([]*biz.AttributeFilter, 0, len(attrs)+len(filters))
+	for _, k := range keys {
+		rv = append(rv, &biz.AttributeFilter{Key: k, Values: []string{attrs[k]}, Match: biz.AttributeMatchAny})
+	}
+	for _, f := range filters {
+		match := biz.AttributeMatchAny
+		switch f.Match {
+		case pb.AttributeFilter_ALL:
+			match = biz.AttributeMatchAll
+		case pb.AttributeFilter_NONE:
+			match = biz.AttributeMatchNone
+		}
+		rv = append(rv, &biz.AttributeFilter{Key: f.Key, Values: f.Values, Match: match})
+	}
+	return rv
+}
+
+func convertToProductAttributes(attrs []*biz.ProductAttribute) []*pb.ProductAttribute {
+	if len(attrs) == 0 {
+		return nil
+	}
+	rv := make([]*pb.ProductAttribute, len(attrs))
+	for i, a := range attrs {
+		rv[i] = &pb.ProductAttribute{Key: a.Key, Value: a.Value}
+	}
+	return rv
+}
+
+// convertFromProductInfo maps the writable fields of a ProductInfo onto a
+// biz.Product. Derived and output-only fields are ignored.
+func (s *ProductService) convertFromProductInfo(info *pb.ProductInfo) *biz.Product {
+	// Store each detail as its own single-entry map, ordered by key, to match
+	// the shape of the crawled dataset.
+	var productDetails []map[string]string
+	if len(info.ProductDetails) > 0 {
+		keys := make([]string, 0, len(info.ProductDetails))
+		for k := range info.ProductDetails {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		productDetails = make([]map[string]string, 0, len(keys))
+		for _, k := range keys {
+			productDetails = append(productDetails, map[string]string{k: info.ProductDetails[k]})
+		}
+	}
+
+	var crawledAt time.Time
+	if info.CrawledAt != nil {
+		crawledAt = info.CrawledAt.AsTime()
+	}
+
+	return &biz.Product{
+		ID:             info.Id,
+		OriginalID:     info.OriginalId,
+		Title:          info.Title,
+		Brand:          info.Brand,
+		Description:    info.Description,
+		ActualPrice:    info.ActualPrice,
+		SellingPrice:   info.SellingPrice,
+		Discount:       info.Discount,
+		Category:       info.Category,
+		SubCategory:    info.SubCategory,
+		OutOfStock:     info.OutOfStock,
+		Seller:         info.Seller,
+		AverageRating:  info.AverageRating,
+		Images:         info.Images,
+		ProductDetails: productDetails,
+		URL:            info.Url,
+		PID:            info.Pid,
+		StyleCode:      info.StyleCode,
+		CrawledAt:      crawledAt,
+		Featured:       info.Featured,
 	}
 }
 
@@ -246,6 +687,119 @@ func (s *ProductService) convertToProductList(products []*biz.Product) []*pb.Pro
 	return result
 }
 
+func (s *ProductService) convertToScoredProductList(hits []*biz.ScoredProduct) []*pb.ScoredProduct {
+	result := make([]*pb.ScoredProduct, len(hits))
+	for i, hit := range hits {
+		result[i] = &pb.ScoredProduct{
+			Product: s.convertToProductInfo(hit.Product),
+			Score:   hit.Score,
+		}
+	}
+	return result
+}
+
+func (s *ProductService) convertToAskCatalogEvent(ev *biz.RAGEvent) *pb.AskCatalogEvent {
+	switch ev.Kind {
+	case biz.RAGEventProducts:
+		return &pb.AskCatalogEvent{Event: &pb.AskCatalogEvent_Products_{
+			Products: &pb.AskCatalogEvent_Products{Results: s.convertToScoredProductList(ev.Products)},
+		}}
+	case biz.RAGEventDelta:
+		return &pb.AskCatalogEvent{Event: &pb.AskCatalogEvent_Delta{Delta: ev.Delta}}
+	default:
+		return &pb.AskCatalogEvent{Event: &pb.AskCatalogEvent_Citations_{
+			Citations: &pb.AskCatalogEvent_Citations{CitedPids: ev.CitedPIDs},
+		}}
+	}
+}
+
+func (s *ProductService) convertToFacets(f *biz.Facets) *pb.Facets {
+	values := func(counts []*biz.FacetCount) []*pb.FacetValue {
+		rv := make([]*pb.FacetValue, len(counts))
+		for i, c := range counts {
+			rv[i] = &pb.FacetValue{Value: c.Value, Count: c.Count}
+		}
+		return rv
+	}
+	ranges := func(counts []*biz.RangeCount) []*pb.RangeFacet {
+		rv := make([]*pb.RangeFacet, len(counts))
+		for i, c := range counts {
+			rv[i] = &pb.RangeFacet{Min: c.Min, Max: c.Max, Count: c.Count}
+		}
+		return rv
+	}
+	attributes := make([]*pb.AttributeFacet, len(f.Attributes))
+	for i, a := range f.Attributes {
+		attributes[i] = &pb.AttributeFacet{Key: a.Key, Values: values(a.Values)}
+	}
+
+	return &pb.Facets{
+		Brands:        values(f.Brands),
+		Categories:    values(f.Categories),
+		SubCategories: values(f.SubCategories),
+		Sellers:       values(f.Sellers),
+		PriceBuckets:  ranges(f.PriceBuckets),
+		RatingBands:   ranges(f.RatingBands),
+		InStock:       f.InStock,
+		OutOfStock:    f.OutOfStock,
+		Attributes:    attributes,
+	}
+}
+
+func (s *ProductService) convertToBackfillStatus(status *biz.BackfillStatus) *pb.EmbeddingBackfillStatus {
+	rv := &pb.EmbeddingBackfillStatus{
+		Status:    status.Status,
+		Cursor:    status.Cursor,
+		Processed: status.Processed,
+		Failed:    status.Failed,
+		Remaining: status.Remaining,
+		LastError: status.LastError,
+	}
+	if !status.StartedAt.IsZero() {
+		rv.StartedAt = timestamppb.New(status.StartedAt)
+	}
+	if !status.FinishedAt.IsZero() {
+		rv.FinishedAt = timestamppb.New(status.FinishedAt)
+	}
+
+	rv.DeadLetters = make([]*pb.EmbeddingFailure, len(status.DeadLetters))
+	for i, f := range status.DeadLetters {
+		rv.DeadLetters[i] = &pb.EmbeddingFailure{
+			ProductId: f.ProductID,
+			Attempts:  int32(f.Attempts),
+			LastError: f.LastError,
+			UpdatedAt: timestamppb.New(f.UpdatedAt),
+		}
+	}
+	return rv
+}
+
+func convertToSearchRule(r *biz.SearchRule) *pb.SearchRule {
+	return &pb.SearchRule{
+		Id:        r.ID,
+		Kind:      r.Kind,
+		Terms:     r.Terms,
+		Synonyms:  r.Synonyms,
+		Category:  r.Category,
+		Boost:     r.Boost,
+		Disabled:  !r.Enabled,
+		CreatedAt: timestamppb.New(r.CreatedAt),
+		UpdatedAt: timestamppb.New(r.UpdatedAt),
+	}
+}
+
+func convertFromSearchRule(r *pb.SearchRule) *biz.SearchRule {
+	return &biz.SearchRule{
+		ID:       r.Id,
+		Kind:     r.Kind,
+		Terms:    r.Terms,
+		Synonyms: r.Synonyms,
+		Category: r.Category,
+		Boost:    r.Boost,
+		Enabled:  !r.Disabled,
+	}
+}
+
 func (s *ProductService) calculateDiscountPercentage(actualPrice, sellingPrice string) float64 {
 	if actualPrice == "" || sellingPrice == "" {
 		return 0
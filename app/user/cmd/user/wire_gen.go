@@ -14,6 +14,8 @@ import (
 	"yinni_backend/app/user/internal/server"
 	"yinni_backend/app/user/internal/service"
 	"yinni_backend/internal/conf"
+	"yinni_backend/pkg/jwks"
+	"yinni_backend/pkg/revocation"
 )
 
 import (
@@ -32,10 +34,23 @@ func wireApp(confServer *conf.Server, auth *conf.Auth, confData *conf.Data, logg
 	userRepo := data.NewUserRepo(dataData, logger)
 	userUsecase := biz.NewUserUsecase(userRepo, logger)
 	userService := service.NewUserService(userUsecase)
-	grpcServer := server.NewGRPCServer(confServer, auth, userService, logger)
-	httpServer := server.NewHTTPServer(confServer, auth, userService, logger)
+	store, cleanup2, err := revocation.NewStore(confData, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	cache, cleanup3, err := jwks.NewCache(auth, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	grpcServer := server.NewGRPCServer(confServer, auth, store, cache, userService, logger)
+	httpServer := server.NewHTTPServer(confServer, auth, store, cache, userService, logger)
 	app := newApp(logger, grpcServer, httpServer)
 	return app, func() {
+		cleanup3()
+		cleanup2()
 		cleanup()
 	}, nil
 }
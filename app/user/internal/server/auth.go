@@ -0,0 +1,18 @@
+package server
+
+import (
+	"yinni_backend/internal/conf"
+	"yinni_backend/pkg/jwks"
+	"yinni_backend/pkg/middleware"
+	"yinni_backend/pkg/revocation"
+
+	kmiddleware "github.com/go-kratos/kratos/v2/middleware"
+)
+
+// authMiddleware authenticates the calls to every operation.
+func authMiddleware(authConf *conf.Auth, revocations revocation.Store, keys *jwks.Cache) kmiddleware.Middleware {
+	return middleware.JWT(authConf.JwtSecret,
+		middleware.WithRevocationStore(revocations),
+		middleware.WithJWKS(keys),
+	)
+}
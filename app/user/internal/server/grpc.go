@@ -4,7 +4,8 @@ import (
 	v1 "yinni_backend/api/user/v1"
 	"yinni_backend/app/user/internal/service"
 	"yinni_backend/internal/conf"
-	"yinni_backend/pkg/middleware"
+	"yinni_backend/pkg/jwks"
+	"yinni_backend/pkg/revocation"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
@@ -12,11 +13,11 @@ import (
 )
 
 // NewGRPCServer new a gRPC server.
-func NewGRPCServer(c *conf.Server, authConf *conf.Auth, user *service.UserService, logger log.Logger) *grpc.Server {
+func NewGRPCServer(c *conf.Server, authConf *conf.Auth, revocations revocation.Store, keys *jwks.Cache, user *service.UserService, logger log.Logger) *grpc.Server {
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(
 			recovery.Recovery(),
-			middleware.JWT(authConf.JwtSecret),
+			authMiddleware(authConf, revocations, keys),
 		),
 	}
 	if c.Grpc.Network != "" {
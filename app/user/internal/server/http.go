@@ -4,7 +4,8 @@ import (
 	v1 "yinni_backend/api/user/v1"
 	"yinni_backend/app/user/internal/service"
 	"yinni_backend/internal/conf"
-	"yinni_backend/pkg/middleware"
+	"yinni_backend/pkg/jwks"
+	"yinni_backend/pkg/revocation"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/logging"
@@ -14,7 +15,7 @@ import (
 )
 
 // NewHTTPServer new an HTTP server.
-func NewHTTPServer(c *conf.Server, authConf *conf.Auth, user *service.UserService, logger log.Logger) *http.Server {
+func NewHTTPServer(c *conf.Server, authConf *conf.Auth, revocations revocation.Store, keys *jwks.Cache, user *service.UserService, logger log.Logger) *http.Server {
 	corsHandler := cors.New(cors.Options{
 		AllowedOrigins:   []string{"*"},
 		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
@@ -26,7 +27,7 @@ func NewHTTPServer(c *conf.Server, authConf *conf.Auth, user *service.UserServic
 		http.Middleware(
 			recovery.Recovery(),
 			logging.Server(logger),
-			middleware.JWT(authConf.JwtSecret),
+			authMiddleware(authConf, revocations, keys),
 		),
 		http.Filter(corsHandler.Handler),
 	}
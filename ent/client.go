@@ -11,12 +11,19 @@ import (
 
 	"yinni_backend/ent/migrate"
 
+	"yinni_backend/ent/embeddingfailure"
+	"yinni_backend/ent/embeddingjob"
 	"yinni_backend/ent/product"
+	"yinni_backend/ent/productattribute"
+	"yinni_backend/ent/refreshtoken"
+	"yinni_backend/ent/schemamigration"
+	"yinni_backend/ent/searchrule"
 	"yinni_backend/ent/user"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 // Client is the client that holds all ent builders.
@@ -24,8 +31,20 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// EmbeddingFailure is the client for interacting with the EmbeddingFailure builders.
+	EmbeddingFailure *EmbeddingFailureClient
+	// EmbeddingJob is the client for interacting with the EmbeddingJob builders.
+	EmbeddingJob *EmbeddingJobClient
 	// Product is the client for interacting with the Product builders.
 	Product *ProductClient
+	// ProductAttribute is the client for interacting with the ProductAttribute builders.
+	ProductAttribute *ProductAttributeClient
+	// RefreshToken is the client for interacting with the RefreshToken builders.
+	RefreshToken *RefreshTokenClient
+	// SchemaMigration is the client for interacting with the SchemaMigration builders.
+	SchemaMigration *SchemaMigrationClient
+	// SearchRule is the client for interacting with the SearchRule builders.
+	SearchRule *SearchRuleClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 }
@@ -39,7 +58,13 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.EmbeddingFailure = NewEmbeddingFailureClient(c.config)
+	c.EmbeddingJob = NewEmbeddingJobClient(c.config)
 	c.Product = NewProductClient(c.config)
+	c.ProductAttribute = NewProductAttributeClient(c.config)
+	c.RefreshToken = NewRefreshTokenClient(c.config)
+	c.SchemaMigration = NewSchemaMigrationClient(c.config)
+	c.SearchRule = NewSearchRuleClient(c.config)
 	c.User = NewUserClient(c.config)
 }
 
@@ -131,10 +156,16 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:     ctx,
-		config:  cfg,
-		Product: NewProductClient(cfg),
-		User:    NewUserClient(cfg),
+		ctx:              ctx,
+		config:           cfg,
+		EmbeddingFailure: NewEmbeddingFailureClient(cfg),
+		EmbeddingJob:     NewEmbeddingJobClient(cfg),
+		Product:          NewProductClient(cfg),
+		ProductAttribute: NewProductAttributeClient(cfg),
+		RefreshToken:     NewRefreshTokenClient(cfg),
+		SchemaMigration:  NewSchemaMigrationClient(cfg),
+		SearchRule:       NewSearchRuleClient(cfg),
+		User:             NewUserClient(cfg),
 	}, nil
 }
 
@@ -152,17 +183,23 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:     ctx,
-		config:  cfg,
-		Product: NewProductClient(cfg),
-		User:    NewUserClient(cfg),
+		ctx:              ctx,
+		config:           cfg,
+		EmbeddingFailure: NewEmbeddingFailureClient(cfg),
+		EmbeddingJob:     NewEmbeddingJobClient(cfg),
+		Product:          NewProductClient(cfg),
+		ProductAttribute: NewProductAttributeClient(cfg),
+		RefreshToken:     NewRefreshTokenClient(cfg),
+		SchemaMigration:  NewSchemaMigrationClient(cfg),
+		SearchRule:       NewSearchRuleClient(cfg),
+		User:             NewUserClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Product.
+//		EmbeddingFailure.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -184,22 +221,42 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.Product.Use(hooks...)
-	c.User.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.EmbeddingFailure, c.EmbeddingJob, c.Product, c.ProductAttribute,
+		c.RefreshToken, c.SchemaMigration, c.SearchRule, c.User,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.Product.Intercept(interceptors...)
-	c.User.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.EmbeddingFailure, c.EmbeddingJob, c.Product, c.ProductAttribute,
+		c.RefreshToken, c.SchemaMigration, c.SearchRule, c.User,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *EmbeddingFailureMutation:
+		return c.EmbeddingFailure.mutate(ctx, m)
+	case *EmbeddingJobMutation:
+		return c.EmbeddingJob.mutate(ctx, m)
 	case *ProductMutation:
 		return c.Product.mutate(ctx, m)
+	case *ProductAttributeMutation:
+		return c.ProductAttribute.mutate(ctx, m)
+	case *RefreshTokenMutation:
+		return c.RefreshToken.mutate(ctx, m)
+	case *SchemaMigrationMutation:
+		return c.SchemaMigration.mutate(ctx, m)
+	case *SearchRuleMutation:
+		return c.SearchRule.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
 	default:
@@ -207,6 +264,272 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	}
 }
 
+// EmbeddingFailureClient is a client for the EmbeddingFailure schema.
+type EmbeddingFailureClient struct {
+	config
+}
+
+// NewEmbeddingFailureClient returns a client for the EmbeddingFailure from the given config.
+func NewEmbeddingFailureClient(c config) *EmbeddingFailureClient {
+	return &EmbeddingFailureClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `embeddingfailure.Hooks(f(g(h())))`.
+func (c *EmbeddingFailureClient) Use(hooks ...Hook) {
+	c.hooks.EmbeddingFailure = append(c.hooks.EmbeddingFailure, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `embeddingfailure.Intercept(f(g(h())))`.
+func (c *EmbeddingFailureClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EmbeddingFailure = append(c.inters.EmbeddingFailure, interceptors...)
+}
+
+// Create returns a builder for creating a EmbeddingFailure entity.
+func (c *EmbeddingFailureClient) Create() *EmbeddingFailureCreate {
+	mutation := newEmbeddingFailureMutation(c.config, OpCreate)
+	return &EmbeddingFailureCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EmbeddingFailure entities.
+func (c *EmbeddingFailureClient) CreateBulk(builders ...*EmbeddingFailureCreate) *EmbeddingFailureCreateBulk {
+	return &EmbeddingFailureCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EmbeddingFailureClient) MapCreateBulk(slice any, setFunc func(*EmbeddingFailureCreate, int)) *EmbeddingFailureCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EmbeddingFailureCreateBulk{err: fmt.Errorf("calling to EmbeddingFailureClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EmbeddingFailureCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EmbeddingFailureCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EmbeddingFailure.
+func (c *EmbeddingFailureClient) Update() *EmbeddingFailureUpdate {
+	mutation := newEmbeddingFailureMutation(c.config, OpUpdate)
+	return &EmbeddingFailureUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EmbeddingFailureClient) UpdateOne(_m *EmbeddingFailure) *EmbeddingFailureUpdateOne {
+	mutation := newEmbeddingFailureMutation(c.config, OpUpdateOne, withEmbeddingFailure(_m))
+	return &EmbeddingFailureUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EmbeddingFailureClient) UpdateOneID(id int) *EmbeddingFailureUpdateOne {
+	mutation := newEmbeddingFailureMutation(c.config, OpUpdateOne, withEmbeddingFailureID(id))
+	return &EmbeddingFailureUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EmbeddingFailure.
+func (c *EmbeddingFailureClient) Delete() *EmbeddingFailureDelete {
+	mutation := newEmbeddingFailureMutation(c.config, OpDelete)
+	return &EmbeddingFailureDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EmbeddingFailureClient) DeleteOne(_m *EmbeddingFailure) *EmbeddingFailureDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EmbeddingFailureClient) DeleteOneID(id int) *EmbeddingFailureDeleteOne {
+	builder := c.Delete().Where(embeddingfailure.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EmbeddingFailureDeleteOne{builder}
+}
+
+// Query returns a query builder for EmbeddingFailure.
+func (c *EmbeddingFailureClient) Query() *EmbeddingFailureQuery {
+	return &EmbeddingFailureQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEmbeddingFailure},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EmbeddingFailure entity by its id.
+func (c *EmbeddingFailureClient) Get(ctx context.Context, id int) (*EmbeddingFailure, error) {
+	return c.Query().Where(embeddingfailure.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EmbeddingFailureClient) GetX(ctx context.Context, id int) *EmbeddingFailure {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *EmbeddingFailureClient) Hooks() []Hook {
+	return c.hooks.EmbeddingFailure
+}
+
+// Interceptors returns the client interceptors.
+func (c *EmbeddingFailureClient) Interceptors() []Interceptor {
+	return c.inters.EmbeddingFailure
+}
+
+func (c *EmbeddingFailureClient) mutate(ctx context.Context, m *EmbeddingFailureMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EmbeddingFailureCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EmbeddingFailureUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EmbeddingFailureUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EmbeddingFailureDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EmbeddingFailure mutation op: %q", m.Op())
+	}
+}
+
+// EmbeddingJobClient is a client for the EmbeddingJob schema.
+type EmbeddingJobClient struct {
+	config
+}
+
+// NewEmbeddingJobClient returns a client for the EmbeddingJob from the given config.
+func NewEmbeddingJobClient(c config) *EmbeddingJobClient {
+	return &EmbeddingJobClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `embeddingjob.Hooks(f(g(h())))`.
+func (c *EmbeddingJobClient) Use(hooks ...Hook) {
+	c.hooks.EmbeddingJob = append(c.hooks.EmbeddingJob, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `embeddingjob.Intercept(f(g(h())))`.
+func (c *EmbeddingJobClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EmbeddingJob = append(c.inters.EmbeddingJob, interceptors...)
+}
+
+// Create returns a builder for creating a EmbeddingJob entity.
+func (c *EmbeddingJobClient) Create() *EmbeddingJobCreate {
+	mutation := newEmbeddingJobMutation(c.config, OpCreate)
+	return &EmbeddingJobCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EmbeddingJob entities.
+func (c *EmbeddingJobClient) CreateBulk(builders ...*EmbeddingJobCreate) *EmbeddingJobCreateBulk {
+	return &EmbeddingJobCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EmbeddingJobClient) MapCreateBulk(slice any, setFunc func(*EmbeddingJobCreate, int)) *EmbeddingJobCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EmbeddingJobCreateBulk{err: fmt.Errorf("calling to EmbeddingJobClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EmbeddingJobCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EmbeddingJobCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EmbeddingJob.
+func (c *EmbeddingJobClient) Update() *EmbeddingJobUpdate {
+	mutation := newEmbeddingJobMutation(c.config, OpUpdate)
+	return &EmbeddingJobUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EmbeddingJobClient) UpdateOne(_m *EmbeddingJob) *EmbeddingJobUpdateOne {
+	mutation := newEmbeddingJobMutation(c.config, OpUpdateOne, withEmbeddingJob(_m))
+	return &EmbeddingJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EmbeddingJobClient) UpdateOneID(id int) *EmbeddingJobUpdateOne {
+	mutation := newEmbeddingJobMutation(c.config, OpUpdateOne, withEmbeddingJobID(id))
+	return &EmbeddingJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EmbeddingJob.
+func (c *EmbeddingJobClient) Delete() *EmbeddingJobDelete {
+	mutation := newEmbeddingJobMutation(c.config, OpDelete)
+	return &EmbeddingJobDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EmbeddingJobClient) DeleteOne(_m *EmbeddingJob) *EmbeddingJobDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EmbeddingJobClient) DeleteOneID(id int) *EmbeddingJobDeleteOne {
+	builder := c.Delete().Where(embeddingjob.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EmbeddingJobDeleteOne{builder}
+}
+
+// Query returns a query builder for EmbeddingJob.
+func (c *EmbeddingJobClient) Query() *EmbeddingJobQuery {
+	return &EmbeddingJobQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEmbeddingJob},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EmbeddingJob entity by its id.
+func (c *EmbeddingJobClient) Get(ctx context.Context, id int) (*EmbeddingJob, error) {
+	return c.Query().Where(embeddingjob.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EmbeddingJobClient) GetX(ctx context.Context, id int) *EmbeddingJob {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *EmbeddingJobClient) Hooks() []Hook {
+	return c.hooks.EmbeddingJob
+}
+
+// Interceptors returns the client interceptors.
+func (c *EmbeddingJobClient) Interceptors() []Interceptor {
+	return c.inters.EmbeddingJob
+}
+
+func (c *EmbeddingJobClient) mutate(ctx context.Context, m *EmbeddingJobMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EmbeddingJobCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EmbeddingJobUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EmbeddingJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EmbeddingJobDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EmbeddingJob mutation op: %q", m.Op())
+	}
+}
+
 // ProductClient is a client for the Product schema.
 type ProductClient struct {
 	config
@@ -315,6 +638,22 @@ func (c *ProductClient) GetX(ctx context.Context, id int) *Product {
 	return obj
 }
 
+// QueryAttributes queries the attributes edge of a Product.
+func (c *ProductClient) QueryAttributes(_m *Product) *ProductAttributeQuery {
+	query := (&ProductAttributeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(product.Table, product.FieldID, id),
+			sqlgraph.To(productattribute.Table, productattribute.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, product.AttributesTable, product.AttributesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *ProductClient) Hooks() []Hook {
 	hooks := c.hooks.Product
@@ -341,6 +680,554 @@ func (c *ProductClient) mutate(ctx context.Context, m *ProductMutation) (Value,
 	}
 }
 
+// ProductAttributeClient is a client for the ProductAttribute schema.
+type ProductAttributeClient struct {
+	config
+}
+
+// NewProductAttributeClient returns a client for the ProductAttribute from the given config.
+func NewProductAttributeClient(c config) *ProductAttributeClient {
+	return &ProductAttributeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `productattribute.Hooks(f(g(h())))`.
+func (c *ProductAttributeClient) Use(hooks ...Hook) {
+	c.hooks.ProductAttribute = append(c.hooks.ProductAttribute, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `productattribute.Intercept(f(g(h())))`.
+func (c *ProductAttributeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProductAttribute = append(c.inters.ProductAttribute, interceptors...)
+}
+
+// Create returns a builder for creating a ProductAttribute entity.
+func (c *ProductAttributeClient) Create() *ProductAttributeCreate {
+	mutation := newProductAttributeMutation(c.config, OpCreate)
+	return &ProductAttributeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ProductAttribute entities.
+func (c *ProductAttributeClient) CreateBulk(builders ...*ProductAttributeCreate) *ProductAttributeCreateBulk {
+	return &ProductAttributeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ProductAttributeClient) MapCreateBulk(slice any, setFunc func(*ProductAttributeCreate, int)) *ProductAttributeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ProductAttributeCreateBulk{err: fmt.Errorf("calling to ProductAttributeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ProductAttributeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ProductAttributeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ProductAttribute.
+func (c *ProductAttributeClient) Update() *ProductAttributeUpdate {
+	mutation := newProductAttributeMutation(c.config, OpUpdate)
+	return &ProductAttributeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProductAttributeClient) UpdateOne(_m *ProductAttribute) *ProductAttributeUpdateOne {
+	mutation := newProductAttributeMutation(c.config, OpUpdateOne, withProductAttribute(_m))
+	return &ProductAttributeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProductAttributeClient) UpdateOneID(id int) *ProductAttributeUpdateOne {
+	mutation := newProductAttributeMutation(c.config, OpUpdateOne, withProductAttributeID(id))
+	return &ProductAttributeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ProductAttribute.
+func (c *ProductAttributeClient) Delete() *ProductAttributeDelete {
+	mutation := newProductAttributeMutation(c.config, OpDelete)
+	return &ProductAttributeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProductAttributeClient) DeleteOne(_m *ProductAttribute) *ProductAttributeDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ProductAttributeClient) DeleteOneID(id int) *ProductAttributeDeleteOne {
+	builder := c.Delete().Where(productattribute.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProductAttributeDeleteOne{builder}
+}
+
+// Query returns a query builder for ProductAttribute.
+func (c *ProductAttributeClient) Query() *ProductAttributeQuery {
+	return &ProductAttributeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeProductAttribute},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ProductAttribute entity by its id.
+func (c *ProductAttributeClient) Get(ctx context.Context, id int) (*ProductAttribute, error) {
+	return c.Query().Where(productattribute.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProductAttributeClient) GetX(ctx context.Context, id int) *ProductAttribute {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProduct queries the product edge of a ProductAttribute.
+func (c *ProductAttributeClient) QueryProduct(_m *ProductAttribute) *ProductQuery {
+	query := (&ProductClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(productattribute.Table, productattribute.FieldID, id),
+			sqlgraph.To(product.Table, product.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, productattribute.ProductTable, productattribute.ProductColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ProductAttributeClient) Hooks() []Hook {
+	return c.hooks.ProductAttribute
+}
+
+// Interceptors returns the client interceptors.
+func (c *ProductAttributeClient) Interceptors() []Interceptor {
+	return c.inters.ProductAttribute
+}
+
+func (c *ProductAttributeClient) mutate(ctx context.Context, m *ProductAttributeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ProductAttributeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ProductAttributeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ProductAttributeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ProductAttributeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ProductAttribute mutation op: %q", m.Op())
+	}
+}
+
+// RefreshTokenClient is a client for the RefreshToken schema.
+type RefreshTokenClient struct {
+	config
+}
+
+// NewRefreshTokenClient returns a client for the RefreshToken from the given config.
+func NewRefreshTokenClient(c config) *RefreshTokenClient {
+	return &RefreshTokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `refreshtoken.Hooks(f(g(h())))`.
+func (c *RefreshTokenClient) Use(hooks ...Hook) {
+	c.hooks.RefreshToken = append(c.hooks.RefreshToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `refreshtoken.Intercept(f(g(h())))`.
+func (c *RefreshTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RefreshToken = append(c.inters.RefreshToken, interceptors...)
+}
+
+// Create returns a builder for creating a RefreshToken entity.
+func (c *RefreshTokenClient) Create() *RefreshTokenCreate {
+	mutation := newRefreshTokenMutation(c.config, OpCreate)
+	return &RefreshTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RefreshToken entities.
+func (c *RefreshTokenClient) CreateBulk(builders ...*RefreshTokenCreate) *RefreshTokenCreateBulk {
+	return &RefreshTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RefreshTokenClient) MapCreateBulk(slice any, setFunc func(*RefreshTokenCreate, int)) *RefreshTokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RefreshTokenCreateBulk{err: fmt.Errorf("calling to RefreshTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RefreshTokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RefreshTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RefreshToken.
+func (c *RefreshTokenClient) Update() *RefreshTokenUpdate {
+	mutation := newRefreshTokenMutation(c.config, OpUpdate)
+	return &RefreshTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RefreshTokenClient) UpdateOne(_m *RefreshToken) *RefreshTokenUpdateOne {
+	mutation := newRefreshTokenMutation(c.config, OpUpdateOne, withRefreshToken(_m))
+	return &RefreshTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RefreshTokenClient) UpdateOneID(id int) *RefreshTokenUpdateOne {
+	mutation := newRefreshTokenMutation(c.config, OpUpdateOne, withRefreshTokenID(id))
+	return &RefreshTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RefreshToken.
+func (c *RefreshTokenClient) Delete() *RefreshTokenDelete {
+	mutation := newRefreshTokenMutation(c.config, OpDelete)
+	return &RefreshTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RefreshTokenClient) DeleteOne(_m *RefreshToken) *RefreshTokenDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RefreshTokenClient) DeleteOneID(id int) *RefreshTokenDeleteOne {
+	builder := c.Delete().Where(refreshtoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RefreshTokenDeleteOne{builder}
+}
+
+// Query returns a query builder for RefreshToken.
+func (c *RefreshTokenClient) Query() *RefreshTokenQuery {
+	return &RefreshTokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRefreshToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RefreshToken entity by its id.
+func (c *RefreshTokenClient) Get(ctx context.Context, id int) (*RefreshToken, error) {
+	return c.Query().Where(refreshtoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RefreshTokenClient) GetX(ctx context.Context, id int) *RefreshToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RefreshTokenClient) Hooks() []Hook {
+	return c.hooks.RefreshToken
+}
+
+// Interceptors returns the client interceptors.
+func (c *RefreshTokenClient) Interceptors() []Interceptor {
+	return c.inters.RefreshToken
+}
+
+func (c *RefreshTokenClient) mutate(ctx context.Context, m *RefreshTokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RefreshTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RefreshTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RefreshTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RefreshTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RefreshToken mutation op: %q", m.Op())
+	}
+}
+
+// SchemaMigrationClient is a client for the SchemaMigration schema.
+type SchemaMigrationClient struct {
+	config
+}
+
+// NewSchemaMigrationClient returns a client for the SchemaMigration from the given config.
+func NewSchemaMigrationClient(c config) *SchemaMigrationClient {
+	return &SchemaMigrationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `schemamigration.Hooks(f(g(h())))`.
+func (c *SchemaMigrationClient) Use(hooks ...Hook) {
+	c.hooks.SchemaMigration = append(c.hooks.SchemaMigration, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `schemamigration.Intercept(f(g(h())))`.
+func (c *SchemaMigrationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SchemaMigration = append(c.inters.SchemaMigration, interceptors...)
+}
+
+// Create returns a builder for creating a SchemaMigration entity.
+func (c *SchemaMigrationClient) Create() *SchemaMigrationCreate {
+	mutation := newSchemaMigrationMutation(c.config, OpCreate)
+	return &SchemaMigrationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SchemaMigration entities.
+func (c *SchemaMigrationClient) CreateBulk(builders ...*SchemaMigrationCreate) *SchemaMigrationCreateBulk {
+	return &SchemaMigrationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SchemaMigrationClient) MapCreateBulk(slice any, setFunc func(*SchemaMigrationCreate, int)) *SchemaMigrationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SchemaMigrationCreateBulk{err: fmt.Errorf("calling to SchemaMigrationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SchemaMigrationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SchemaMigrationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SchemaMigration.
+func (c *SchemaMigrationClient) Update() *SchemaMigrationUpdate {
+	mutation := newSchemaMigrationMutation(c.config, OpUpdate)
+	return &SchemaMigrationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SchemaMigrationClient) UpdateOne(_m *SchemaMigration) *SchemaMigrationUpdateOne {
+	mutation := newSchemaMigrationMutation(c.config, OpUpdateOne, withSchemaMigration(_m))
+	return &SchemaMigrationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SchemaMigrationClient) UpdateOneID(id int64) *SchemaMigrationUpdateOne {
+	mutation := newSchemaMigrationMutation(c.config, OpUpdateOne, withSchemaMigrationID(id))
+	return &SchemaMigrationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SchemaMigration.
+func (c *SchemaMigrationClient) Delete() *SchemaMigrationDelete {
+	mutation := newSchemaMigrationMutation(c.config, OpDelete)
+	return &SchemaMigrationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SchemaMigrationClient) DeleteOne(_m *SchemaMigration) *SchemaMigrationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SchemaMigrationClient) DeleteOneID(id int64) *SchemaMigrationDeleteOne {
+	builder := c.Delete().Where(schemamigration.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SchemaMigrationDeleteOne{builder}
+}
+
+// Query returns a query builder for SchemaMigration.
+func (c *SchemaMigrationClient) Query() *SchemaMigrationQuery {
+	return &SchemaMigrationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSchemaMigration},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SchemaMigration entity by its id.
+func (c *SchemaMigrationClient) Get(ctx context.Context, id int64) (*SchemaMigration, error) {
+	return c.Query().Where(schemamigration.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SchemaMigrationClient) GetX(ctx context.Context, id int64) *SchemaMigration {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SchemaMigrationClient) Hooks() []Hook {
+	return c.hooks.SchemaMigration
+}
+
+// Interceptors returns the client interceptors.
+func (c *SchemaMigrationClient) Interceptors() []Interceptor {
+	return c.inters.SchemaMigration
+}
+
+func (c *SchemaMigrationClient) mutate(ctx context.Context, m *SchemaMigrationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SchemaMigrationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SchemaMigrationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SchemaMigrationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SchemaMigrationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SchemaMigration mutation op: %q", m.Op())
+	}
+}
+
+// SearchRuleClient is a client for the SearchRule schema.
+type SearchRuleClient struct {
+	config
+}
+
+// NewSearchRuleClient returns a client for the SearchRule from the given config.
+func NewSearchRuleClient(c config) *SearchRuleClient {
+	return &SearchRuleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `searchrule.Hooks(f(g(h())))`.
+func (c *SearchRuleClient) Use(hooks ...Hook) {
+	c.hooks.SearchRule = append(c.hooks.SearchRule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `searchrule.Intercept(f(g(h())))`.
+func (c *SearchRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SearchRule = append(c.inters.SearchRule, interceptors...)
+}
+
+// Create returns a builder for creating a SearchRule entity.
+func (c *SearchRuleClient) Create() *SearchRuleCreate {
+	mutation := newSearchRuleMutation(c.config, OpCreate)
+	return &SearchRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SearchRule entities.
+func (c *SearchRuleClient) CreateBulk(builders ...*SearchRuleCreate) *SearchRuleCreateBulk {
+	return &SearchRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SearchRuleClient) MapCreateBulk(slice any, setFunc func(*SearchRuleCreate, int)) *SearchRuleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SearchRuleCreateBulk{err: fmt.Errorf("calling to SearchRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SearchRuleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SearchRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SearchRule.
+func (c *SearchRuleClient) Update() *SearchRuleUpdate {
+	mutation := newSearchRuleMutation(c.config, OpUpdate)
+	return &SearchRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SearchRuleClient) UpdateOne(_m *SearchRule) *SearchRuleUpdateOne {
+	mutation := newSearchRuleMutation(c.config, OpUpdateOne, withSearchRule(_m))
+	return &SearchRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SearchRuleClient) UpdateOneID(id int) *SearchRuleUpdateOne {
+	mutation := newSearchRuleMutation(c.config, OpUpdateOne, withSearchRuleID(id))
+	return &SearchRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SearchRule.
+func (c *SearchRuleClient) Delete() *SearchRuleDelete {
+	mutation := newSearchRuleMutation(c.config, OpDelete)
+	return &SearchRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SearchRuleClient) DeleteOne(_m *SearchRule) *SearchRuleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SearchRuleClient) DeleteOneID(id int) *SearchRuleDeleteOne {
+	builder := c.Delete().Where(searchrule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SearchRuleDeleteOne{builder}
+}
+
+// Query returns a query builder for SearchRule.
+func (c *SearchRuleClient) Query() *SearchRuleQuery {
+	return &SearchRuleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSearchRule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SearchRule entity by its id.
+func (c *SearchRuleClient) Get(ctx context.Context, id int) (*SearchRule, error) {
+	return c.Query().Where(searchrule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SearchRuleClient) GetX(ctx context.Context, id int) *SearchRule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SearchRuleClient) Hooks() []Hook {
+	return c.hooks.SearchRule
+}
+
+// Interceptors returns the client interceptors.
+func (c *SearchRuleClient) Interceptors() []Interceptor {
+	return c.inters.SearchRule
+}
+
+func (c *SearchRuleClient) mutate(ctx context.Context, m *SearchRuleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SearchRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SearchRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SearchRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SearchRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SearchRule mutation op: %q", m.Op())
+	}
+}
+
 // UserClient is a client for the User schema.
 type UserClient struct {
 	config
@@ -477,9 +1364,11 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Product, User []ent.Hook
+		EmbeddingFailure, EmbeddingJob, Product, ProductAttribute, RefreshToken,
+		SchemaMigration, SearchRule, User []ent.Hook
 	}
 	inters struct {
-		Product, User []ent.Interceptor
+		EmbeddingFailure, EmbeddingJob, Product, ProductAttribute, RefreshToken,
+		SchemaMigration, SearchRule, User []ent.Interceptor
 	}
 )
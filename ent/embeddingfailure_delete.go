@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"yinni_backend/ent/embeddingfailure"
+	"yinni_backend/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EmbeddingFailureDelete is the builder for deleting a EmbeddingFailure entity.
+type EmbeddingFailureDelete struct {
+	config
+	hooks    []Hook
+	mutation *EmbeddingFailureMutation
+}
+
+// Where appends a list predicates to the EmbeddingFailureDelete builder.
+func (_d *EmbeddingFailureDelete) Where(ps ...predicate.EmbeddingFailure) *EmbeddingFailureDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *EmbeddingFailureDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *EmbeddingFailureDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *EmbeddingFailureDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(embeddingfailure.Table, sqlgraph.NewFieldSpec(embeddingfailure.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// EmbeddingFailureDeleteOne is the builder for deleting a single EmbeddingFailure entity.
+type EmbeddingFailureDeleteOne struct {
+	_d *EmbeddingFailureDelete
+}
+
+// Where appends a list predicates to the EmbeddingFailureDelete builder.
+func (_d *EmbeddingFailureDeleteOne) Where(ps ...predicate.EmbeddingFailure) *EmbeddingFailureDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *EmbeddingFailureDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{embeddingfailure.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *EmbeddingFailureDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,372 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"yinni_backend/ent/embeddingfailure"
+	"yinni_backend/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EmbeddingFailureUpdate is the builder for updating EmbeddingFailure entities.
+type EmbeddingFailureUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EmbeddingFailureMutation
+}
+
+// Where appends a list predicates to the EmbeddingFailureUpdate builder.
+func (_u *EmbeddingFailureUpdate) Where(ps ...predicate.EmbeddingFailure) *EmbeddingFailureUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *EmbeddingFailureUpdate) SetUpdateTime(v time.Time) *EmbeddingFailureUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetProductID sets the "product_id" field.
+func (_u *EmbeddingFailureUpdate) SetProductID(v int) *EmbeddingFailureUpdate {
+	_u.mutation.ResetProductID()
+	_u.mutation.SetProductID(v)
+	return _u
+}
+
+// SetNillableProductID sets the "product_id" field if the given value is not nil.
+func (_u *EmbeddingFailureUpdate) SetNillableProductID(v *int) *EmbeddingFailureUpdate {
+	if v != nil {
+		_u.SetProductID(*v)
+	}
+	return _u
+}
+
+// AddProductID adds value to the "product_id" field.
+func (_u *EmbeddingFailureUpdate) AddProductID(v int) *EmbeddingFailureUpdate {
+	_u.mutation.AddProductID(v)
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *EmbeddingFailureUpdate) SetAttempts(v int) *EmbeddingFailureUpdate {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *EmbeddingFailureUpdate) SetNillableAttempts(v *int) *EmbeddingFailureUpdate {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *EmbeddingFailureUpdate) AddAttempts(v int) *EmbeddingFailureUpdate {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *EmbeddingFailureUpdate) SetLastError(v string) *EmbeddingFailureUpdate {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *EmbeddingFailureUpdate) SetNillableLastError(v *string) *EmbeddingFailureUpdate {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *EmbeddingFailureUpdate) ClearLastError() *EmbeddingFailureUpdate {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// Mutation returns the EmbeddingFailureMutation object of the builder.
+func (_u *EmbeddingFailureUpdate) Mutation() *EmbeddingFailureMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EmbeddingFailureUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EmbeddingFailureUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EmbeddingFailureUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EmbeddingFailureUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EmbeddingFailureUpdate) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := embeddingfailure.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+func (_u *EmbeddingFailureUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(embeddingfailure.Table, embeddingfailure.Columns, sqlgraph.NewFieldSpec(embeddingfailure.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(embeddingfailure.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ProductID(); ok {
+		_spec.SetField(embeddingfailure.FieldProductID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedProductID(); ok {
+		_spec.AddField(embeddingfailure.FieldProductID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(embeddingfailure.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(embeddingfailure.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(embeddingfailure.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(embeddingfailure.FieldLastError, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{embeddingfailure.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EmbeddingFailureUpdateOne is the builder for updating a single EmbeddingFailure entity.
+type EmbeddingFailureUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EmbeddingFailureMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *EmbeddingFailureUpdateOne) SetUpdateTime(v time.Time) *EmbeddingFailureUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetProductID sets the "product_id" field.
+func (_u *EmbeddingFailureUpdateOne) SetProductID(v int) *EmbeddingFailureUpdateOne {
+	_u.mutation.ResetProductID()
+	_u.mutation.SetProductID(v)
+	return _u
+}
+
+// SetNillableProductID sets the "product_id" field if the given value is not nil.
+func (_u *EmbeddingFailureUpdateOne) SetNillableProductID(v *int) *EmbeddingFailureUpdateOne {
+	if v != nil {
+		_u.SetProductID(*v)
+	}
+	return _u
+}
+
+// AddProductID adds value to the "product_id" field.
+func (_u *EmbeddingFailureUpdateOne) AddProductID(v int) *EmbeddingFailureUpdateOne {
+	_u.mutation.AddProductID(v)
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *EmbeddingFailureUpdateOne) SetAttempts(v int) *EmbeddingFailureUpdateOne {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *EmbeddingFailureUpdateOne) SetNillableAttempts(v *int) *EmbeddingFailureUpdateOne {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *EmbeddingFailureUpdateOne) AddAttempts(v int) *EmbeddingFailureUpdateOne {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *EmbeddingFailureUpdateOne) SetLastError(v string) *EmbeddingFailureUpdateOne {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *EmbeddingFailureUpdateOne) SetNillableLastError(v *string) *EmbeddingFailureUpdateOne {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *EmbeddingFailureUpdateOne) ClearLastError() *EmbeddingFailureUpdateOne {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// Mutation returns the EmbeddingFailureMutation object of the builder.
+func (_u *EmbeddingFailureUpdateOne) Mutation() *EmbeddingFailureMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the EmbeddingFailureUpdate builder.
+func (_u *EmbeddingFailureUpdateOne) Where(ps ...predicate.EmbeddingFailure) *EmbeddingFailureUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EmbeddingFailureUpdateOne) Select(field string, fields ...string) *EmbeddingFailureUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated EmbeddingFailure entity.
+func (_u *EmbeddingFailureUpdateOne) Save(ctx context.Context) (*EmbeddingFailure, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EmbeddingFailureUpdateOne) SaveX(ctx context.Context) *EmbeddingFailure {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EmbeddingFailureUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EmbeddingFailureUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EmbeddingFailureUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := embeddingfailure.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+func (_u *EmbeddingFailureUpdateOne) sqlSave(ctx context.Context) (_node *EmbeddingFailure, err error) {
+	_spec := sqlgraph.NewUpdateSpec(embeddingfailure.Table, embeddingfailure.Columns, sqlgraph.NewFieldSpec(embeddingfailure.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EmbeddingFailure.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, embeddingfailure.FieldID)
+		for _, f := range fields {
+			if !embeddingfailure.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != embeddingfailure.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(embeddingfailure.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ProductID(); ok {
+		_spec.SetField(embeddingfailure.FieldProductID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedProductID(); ok {
+		_spec.AddField(embeddingfailure.FieldProductID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(embeddingfailure.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(embeddingfailure.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(embeddingfailure.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(embeddingfailure.FieldLastError, field.TypeString)
+	}
+	_node = &EmbeddingFailure{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{embeddingfailure.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
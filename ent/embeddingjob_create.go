@@ -0,0 +1,404 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"yinni_backend/ent/embeddingjob"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EmbeddingJobCreate is the builder for creating a EmbeddingJob entity.
+type EmbeddingJobCreate struct {
+	config
+	mutation *EmbeddingJobMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *EmbeddingJobCreate) SetCreateTime(v time.Time) *EmbeddingJobCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *EmbeddingJobCreate) SetNillableCreateTime(v *time.Time) *EmbeddingJobCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *EmbeddingJobCreate) SetUpdateTime(v time.Time) *EmbeddingJobCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *EmbeddingJobCreate) SetNillableUpdateTime(v *time.Time) *EmbeddingJobCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *EmbeddingJobCreate) SetName(v string) *EmbeddingJobCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *EmbeddingJobCreate) SetStatus(v embeddingjob.Status) *EmbeddingJobCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *EmbeddingJobCreate) SetNillableStatus(v *embeddingjob.Status) *EmbeddingJobCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetCursor sets the "cursor" field.
+func (_c *EmbeddingJobCreate) SetCursor(v int) *EmbeddingJobCreate {
+	_c.mutation.SetCursor(v)
+	return _c
+}
+
+// SetNillableCursor sets the "cursor" field if the given value is not nil.
+func (_c *EmbeddingJobCreate) SetNillableCursor(v *int) *EmbeddingJobCreate {
+	if v != nil {
+		_c.SetCursor(*v)
+	}
+	return _c
+}
+
+// SetProcessed sets the "processed" field.
+func (_c *EmbeddingJobCreate) SetProcessed(v int) *EmbeddingJobCreate {
+	_c.mutation.SetProcessed(v)
+	return _c
+}
+
+// SetNillableProcessed sets the "processed" field if the given value is not nil.
+func (_c *EmbeddingJobCreate) SetNillableProcessed(v *int) *EmbeddingJobCreate {
+	if v != nil {
+		_c.SetProcessed(*v)
+	}
+	return _c
+}
+
+// SetFailed sets the "failed" field.
+func (_c *EmbeddingJobCreate) SetFailed(v int) *EmbeddingJobCreate {
+	_c.mutation.SetFailed(v)
+	return _c
+}
+
+// SetNillableFailed sets the "failed" field if the given value is not nil.
+func (_c *EmbeddingJobCreate) SetNillableFailed(v *int) *EmbeddingJobCreate {
+	if v != nil {
+		_c.SetFailed(*v)
+	}
+	return _c
+}
+
+// SetLastError sets the "last_error" field.
+func (_c *EmbeddingJobCreate) SetLastError(v string) *EmbeddingJobCreate {
+	_c.mutation.SetLastError(v)
+	return _c
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_c *EmbeddingJobCreate) SetNillableLastError(v *string) *EmbeddingJobCreate {
+	if v != nil {
+		_c.SetLastError(*v)
+	}
+	return _c
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_c *EmbeddingJobCreate) SetStartedAt(v time.Time) *EmbeddingJobCreate {
+	_c.mutation.SetStartedAt(v)
+	return _c
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_c *EmbeddingJobCreate) SetNillableStartedAt(v *time.Time) *EmbeddingJobCreate {
+	if v != nil {
+		_c.SetStartedAt(*v)
+	}
+	return _c
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_c *EmbeddingJobCreate) SetFinishedAt(v time.Time) *EmbeddingJobCreate {
+	_c.mutation.SetFinishedAt(v)
+	return _c
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_c *EmbeddingJobCreate) SetNillableFinishedAt(v *time.Time) *EmbeddingJobCreate {
+	if v != nil {
+		_c.SetFinishedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the EmbeddingJobMutation object of the builder.
+func (_c *EmbeddingJobCreate) Mutation() *EmbeddingJobMutation {
+	return _c.mutation
+}
+
+// Save creates the EmbeddingJob in the database.
+func (_c *EmbeddingJobCreate) Save(ctx context.Context) (*EmbeddingJob, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EmbeddingJobCreate) SaveX(ctx context.Context) *EmbeddingJob {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EmbeddingJobCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EmbeddingJobCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EmbeddingJobCreate) defaults() {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		v := embeddingjob.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		v := embeddingjob.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := embeddingjob.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.Cursor(); !ok {
+		v := embeddingjob.DefaultCursor
+		_c.mutation.SetCursor(v)
+	}
+	if _, ok := _c.mutation.Processed(); !ok {
+		v := embeddingjob.DefaultProcessed
+		_c.mutation.SetProcessed(v)
+	}
+	if _, ok := _c.mutation.Failed(); !ok {
+		v := embeddingjob.DefaultFailed
+		_c.mutation.SetFailed(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EmbeddingJobCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "EmbeddingJob.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "EmbeddingJob.update_time"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "EmbeddingJob.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := embeddingjob.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "EmbeddingJob.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "EmbeddingJob.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := embeddingjob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "EmbeddingJob.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Cursor(); !ok {
+		return &ValidationError{Name: "cursor", err: errors.New(`ent: missing required field "EmbeddingJob.cursor"`)}
+	}
+	if _, ok := _c.mutation.Processed(); !ok {
+		return &ValidationError{Name: "processed", err: errors.New(`ent: missing required field "EmbeddingJob.processed"`)}
+	}
+	if _, ok := _c.mutation.Failed(); !ok {
+		return &ValidationError{Name: "failed", err: errors.New(`ent: missing required field "EmbeddingJob.failed"`)}
+	}
+	return nil
+}
+
+func (_c *EmbeddingJobCreate) sqlSave(ctx context.Context) (*EmbeddingJob, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EmbeddingJobCreate) createSpec() (*EmbeddingJob, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EmbeddingJob{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(embeddingjob.Table, sqlgraph.NewFieldSpec(embeddingjob.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(embeddingjob.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(embeddingjob.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(embeddingjob.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(embeddingjob.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Cursor(); ok {
+		_spec.SetField(embeddingjob.FieldCursor, field.TypeInt, value)
+		_node.Cursor = value
+	}
+	if value, ok := _c.mutation.Processed(); ok {
+		_spec.SetField(embeddingjob.FieldProcessed, field.TypeInt, value)
+		_node.Processed = value
+	}
+	if value, ok := _c.mutation.Failed(); ok {
+		_spec.SetField(embeddingjob.FieldFailed, field.TypeInt, value)
+		_node.Failed = value
+	}
+	if value, ok := _c.mutation.LastError(); ok {
+		_spec.SetField(embeddingjob.FieldLastError, field.TypeString, value)
+		_node.LastError = value
+	}
+	if value, ok := _c.mutation.StartedAt(); ok {
+		_spec.SetField(embeddingjob.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = &value
+	}
+	if value, ok := _c.mutation.FinishedAt(); ok {
+		_spec.SetField(embeddingjob.FieldFinishedAt, field.TypeTime, value)
+		_node.FinishedAt = &value
+	}
+	return _node, _spec
+}
+
+// EmbeddingJobCreateBulk is the builder for creating many EmbeddingJob entities in bulk.
+type EmbeddingJobCreateBulk struct {
+	config
+	err      error
+	builders []*EmbeddingJobCreate
+}
+
+// Save creates the EmbeddingJob entities in the database.
+func (_c *EmbeddingJobCreateBulk) Save(ctx context.Context) ([]*EmbeddingJob, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*EmbeddingJob, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EmbeddingJobMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EmbeddingJobCreateBulk) SaveX(ctx context.Context) []*EmbeddingJob {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EmbeddingJobCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EmbeddingJobCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
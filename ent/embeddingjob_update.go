@@ -0,0 +1,634 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"yinni_backend/ent/embeddingjob"
+	"yinni_backend/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EmbeddingJobUpdate is the builder for updating EmbeddingJob entities.
+type EmbeddingJobUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EmbeddingJobMutation
+}
+
+// Where appends a list predicates to the EmbeddingJobUpdate builder.
+func (_u *EmbeddingJobUpdate) Where(ps ...predicate.EmbeddingJob) *EmbeddingJobUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *EmbeddingJobUpdate) SetUpdateTime(v time.Time) *EmbeddingJobUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *EmbeddingJobUpdate) SetName(v string) *EmbeddingJobUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *EmbeddingJobUpdate) SetNillableName(v *string) *EmbeddingJobUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *EmbeddingJobUpdate) SetStatus(v embeddingjob.Status) *EmbeddingJobUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *EmbeddingJobUpdate) SetNillableStatus(v *embeddingjob.Status) *EmbeddingJobUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetCursor sets the "cursor" field.
+func (_u *EmbeddingJobUpdate) SetCursor(v int) *EmbeddingJobUpdate {
+	_u.mutation.ResetCursor()
+	_u.mutation.SetCursor(v)
+	return _u
+}
+
+// SetNillableCursor sets the "cursor" field if the given value is not nil.
+func (_u *EmbeddingJobUpdate) SetNillableCursor(v *int) *EmbeddingJobUpdate {
+	if v != nil {
+		_u.SetCursor(*v)
+	}
+	return _u
+}
+
+// AddCursor adds value to the "cursor" field.
+func (_u *EmbeddingJobUpdate) AddCursor(v int) *EmbeddingJobUpdate {
+	_u.mutation.AddCursor(v)
+	return _u
+}
+
+// SetProcessed sets the "processed" field.
+func (_u *EmbeddingJobUpdate) SetProcessed(v int) *EmbeddingJobUpdate {
+	_u.mutation.ResetProcessed()
+	_u.mutation.SetProcessed(v)
+	return _u
+}
+
+// SetNillableProcessed sets the "processed" field if the given value is not nil.
+func (_u *EmbeddingJobUpdate) SetNillableProcessed(v *int) *EmbeddingJobUpdate {
+	if v != nil {
+		_u.SetProcessed(*v)
+	}
+	return _u
+}
+
+// AddProcessed adds value to the "processed" field.
+func (_u *EmbeddingJobUpdate) AddProcessed(v int) *EmbeddingJobUpdate {
+	_u.mutation.AddProcessed(v)
+	return _u
+}
+
+// SetFailed sets the "failed" field.
+func (_u *EmbeddingJobUpdate) SetFailed(v int) *EmbeddingJobUpdate {
+	_u.mutation.ResetFailed()
+	_u.mutation.SetFailed(v)
+	return _u
+}
+
+// SetNillableFailed sets the "failed" field if the given value is not nil.
+func (_u *EmbeddingJobUpdate) SetNillableFailed(v *int) *EmbeddingJobUpdate {
+	if v != nil {
+		_u.SetFailed(*v)
+	}
+	return _u
+}
+
+// AddFailed adds value to the "failed" field.
+func (_u *EmbeddingJobUpdate) AddFailed(v int) *EmbeddingJobUpdate {
+	_u.mutation.AddFailed(v)
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *EmbeddingJobUpdate) SetLastError(v string) *EmbeddingJobUpdate {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *EmbeddingJobUpdate) SetNillableLastError(v *string) *EmbeddingJobUpdate {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *EmbeddingJobUpdate) ClearLastError() *EmbeddingJobUpdate {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *EmbeddingJobUpdate) SetStartedAt(v time.Time) *EmbeddingJobUpdate {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *EmbeddingJobUpdate) SetNillableStartedAt(v *time.Time) *EmbeddingJobUpdate {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (_u *EmbeddingJobUpdate) ClearStartedAt() *EmbeddingJobUpdate {
+	_u.mutation.ClearStartedAt()
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *EmbeddingJobUpdate) SetFinishedAt(v time.Time) *EmbeddingJobUpdate {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *EmbeddingJobUpdate) SetNillableFinishedAt(v *time.Time) *EmbeddingJobUpdate {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *EmbeddingJobUpdate) ClearFinishedAt() *EmbeddingJobUpdate {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// Mutation returns the EmbeddingJobMutation object of the builder.
+func (_u *EmbeddingJobUpdate) Mutation() *EmbeddingJobMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EmbeddingJobUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EmbeddingJobUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EmbeddingJobUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EmbeddingJobUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EmbeddingJobUpdate) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := embeddingjob.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EmbeddingJobUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := embeddingjob.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "EmbeddingJob.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := embeddingjob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "EmbeddingJob.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *EmbeddingJobUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(embeddingjob.Table, embeddingjob.Columns, sqlgraph.NewFieldSpec(embeddingjob.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(embeddingjob.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(embeddingjob.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(embeddingjob.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Cursor(); ok {
+		_spec.SetField(embeddingjob.FieldCursor, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCursor(); ok {
+		_spec.AddField(embeddingjob.FieldCursor, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Processed(); ok {
+		_spec.SetField(embeddingjob.FieldProcessed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedProcessed(); ok {
+		_spec.AddField(embeddingjob.FieldProcessed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Failed(); ok {
+		_spec.SetField(embeddingjob.FieldFailed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFailed(); ok {
+		_spec.AddField(embeddingjob.FieldFailed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(embeddingjob.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(embeddingjob.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(embeddingjob.FieldStartedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StartedAtCleared() {
+		_spec.ClearField(embeddingjob.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(embeddingjob.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(embeddingjob.FieldFinishedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{embeddingjob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EmbeddingJobUpdateOne is the builder for updating a single EmbeddingJob entity.
+type EmbeddingJobUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EmbeddingJobMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *EmbeddingJobUpdateOne) SetUpdateTime(v time.Time) *EmbeddingJobUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *EmbeddingJobUpdateOne) SetName(v string) *EmbeddingJobUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *EmbeddingJobUpdateOne) SetNillableName(v *string) *EmbeddingJobUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *EmbeddingJobUpdateOne) SetStatus(v embeddingjob.Status) *EmbeddingJobUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *EmbeddingJobUpdateOne) SetNillableStatus(v *embeddingjob.Status) *EmbeddingJobUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetCursor sets the "cursor" field.
+func (_u *EmbeddingJobUpdateOne) SetCursor(v int) *EmbeddingJobUpdateOne {
+	_u.mutation.ResetCursor()
+	_u.mutation.SetCursor(v)
+	return _u
+}
+
+// SetNillableCursor sets the "cursor" field if the given value is not nil.
+func (_u *EmbeddingJobUpdateOne) SetNillableCursor(v *int) *EmbeddingJobUpdateOne {
+	if v != nil {
+		_u.SetCursor(*v)
+	}
+	return _u
+}
+
+// AddCursor adds value to the "cursor" field.
+func (_u *EmbeddingJobUpdateOne) AddCursor(v int) *EmbeddingJobUpdateOne {
+	_u.mutation.AddCursor(v)
+	return _u
+}
+
+// SetProcessed sets the "processed" field.
+func (_u *EmbeddingJobUpdateOne) SetProcessed(v int) *EmbeddingJobUpdateOne {
+	_u.mutation.ResetProcessed()
+	_u.mutation.SetProcessed(v)
+	return _u
+}
+
+// SetNillableProcessed sets the "processed" field if the given value is not nil.
+func (_u *EmbeddingJobUpdateOne) SetNillableProcessed(v *int) *EmbeddingJobUpdateOne {
+	if v != nil {
+		_u.SetProcessed(*v)
+	}
+	return _u
+}
+
+// AddProcessed adds value to the "processed" field.
+func (_u *EmbeddingJobUpdateOne) AddProcessed(v int) *EmbeddingJobUpdateOne {
+	_u.mutation.AddProcessed(v)
+	return _u
+}
+
+// SetFailed sets the "failed" field.
+func (_u *EmbeddingJobUpdateOne) SetFailed(v int) *EmbeddingJobUpdateOne {
+	_u.mutation.ResetFailed()
+	_u.mutation.SetFailed(v)
+	return _u
+}
+
+// SetNillableFailed sets the "failed" field if the given value is not nil.
+func (_u *EmbeddingJobUpdateOne) SetNillableFailed(v *int) *EmbeddingJobUpdateOne {
+	if v != nil {
+		_u.SetFailed(*v)
+	}
+	return _u
+}
+
+// AddFailed adds value to the "failed" field.
+func (_u *EmbeddingJobUpdateOne) AddFailed(v int) *EmbeddingJobUpdateOne {
+	_u.mutation.AddFailed(v)
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *EmbeddingJobUpdateOne) SetLastError(v string) *EmbeddingJobUpdateOne {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *EmbeddingJobUpdateOne) SetNillableLastError(v *string) *EmbeddingJobUpdateOne {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *EmbeddingJobUpdateOne) ClearLastError() *EmbeddingJobUpdateOne {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *EmbeddingJobUpdateOne) SetStartedAt(v time.Time) *EmbeddingJobUpdateOne {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *EmbeddingJobUpdateOne) SetNillableStartedAt(v *time.Time) *EmbeddingJobUpdateOne {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (_u *EmbeddingJobUpdateOne) ClearStartedAt() *EmbeddingJobUpdateOne {
+	_u.mutation.ClearStartedAt()
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *EmbeddingJobUpdateOne) SetFinishedAt(v time.Time) *EmbeddingJobUpdateOne {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *EmbeddingJobUpdateOne) SetNillableFinishedAt(v *time.Time) *EmbeddingJobUpdateOne {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *EmbeddingJobUpdateOne) ClearFinishedAt() *EmbeddingJobUpdateOne {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// Mutation returns the EmbeddingJobMutation object of the builder.
+func (_u *EmbeddingJobUpdateOne) Mutation() *EmbeddingJobMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the EmbeddingJobUpdate builder.
+func (_u *EmbeddingJobUpdateOne) Where(ps ...predicate.EmbeddingJob) *EmbeddingJobUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EmbeddingJobUpdateOne) Select(field string, fields ...string) *EmbeddingJobUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated EmbeddingJob entity.
+func (_u *EmbeddingJobUpdateOne) Save(ctx context.Context) (*EmbeddingJob, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EmbeddingJobUpdateOne) SaveX(ctx context.Context) *EmbeddingJob {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EmbeddingJobUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EmbeddingJobUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EmbeddingJobUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := embeddingjob.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EmbeddingJobUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := embeddingjob.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "EmbeddingJob.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := embeddingjob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "EmbeddingJob.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *EmbeddingJobUpdateOne) sqlSave(ctx context.Context) (_node *EmbeddingJob, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(embeddingjob.Table, embeddingjob.Columns, sqlgraph.NewFieldSpec(embeddingjob.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EmbeddingJob.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, embeddingjob.FieldID)
+		for _, f := range fields {
+			if !embeddingjob.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != embeddingjob.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(embeddingjob.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(embeddingjob.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(embeddingjob.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Cursor(); ok {
+		_spec.SetField(embeddingjob.FieldCursor, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCursor(); ok {
+		_spec.AddField(embeddingjob.FieldCursor, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Processed(); ok {
+		_spec.SetField(embeddingjob.FieldProcessed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedProcessed(); ok {
+		_spec.AddField(embeddingjob.FieldProcessed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Failed(); ok {
+		_spec.SetField(embeddingjob.FieldFailed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFailed(); ok {
+		_spec.AddField(embeddingjob.FieldFailed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(embeddingjob.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(embeddingjob.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(embeddingjob.FieldStartedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StartedAtCleared() {
+		_spec.ClearField(embeddingjob.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(embeddingjob.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(embeddingjob.FieldFinishedAt, field.TypeTime)
+	}
+	_node = &EmbeddingJob{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{embeddingjob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
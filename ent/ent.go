@@ -8,7 +8,13 @@ import (
 	"fmt"
 	"reflect"
 	"sync"
+	"yinni_backend/ent/embeddingfailure"
+	"yinni_backend/ent/embeddingjob"
 	"yinni_backend/ent/product"
+	"yinni_backend/ent/productattribute"
+	"yinni_backend/ent/refreshtoken"
+	"yinni_backend/ent/schemamigration"
+	"yinni_backend/ent/searchrule"
 	"yinni_backend/ent/user"
 
 	"entgo.io/ent"
@@ -74,8 +80,14 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			product.Table: product.ValidColumn,
-			user.Table:    user.ValidColumn,
+			embeddingfailure.Table: embeddingfailure.ValidColumn,
+			embeddingjob.Table:     embeddingjob.ValidColumn,
+			product.Table:          product.ValidColumn,
+			productattribute.Table: productattribute.ValidColumn,
+			refreshtoken.Table:     refreshtoken.ValidColumn,
+			schemamigration.Table:  schemamigration.ValidColumn,
+			searchrule.Table:       searchrule.ValidColumn,
+			user.Table:             user.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -8,6 +8,30 @@ import (
 	"yinni_backend/ent"
 )
 
+// The EmbeddingFailureFunc type is an adapter to allow the use of ordinary
+// function as EmbeddingFailure mutator.
+type EmbeddingFailureFunc func(context.Context, *ent.EmbeddingFailureMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EmbeddingFailureFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.EmbeddingFailureMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EmbeddingFailureMutation", m)
+}
+
+// The EmbeddingJobFunc type is an adapter to allow the use of ordinary
+// function as EmbeddingJob mutator.
+type EmbeddingJobFunc func(context.Context, *ent.EmbeddingJobMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EmbeddingJobFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.EmbeddingJobMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EmbeddingJobMutation", m)
+}
+
 // The ProductFunc type is an adapter to allow the use of ordinary
 // function as Product mutator.
 type ProductFunc func(context.Context, *ent.ProductMutation) (ent.Value, error)
@@ -20,6 +44,54 @@ func (f ProductFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProductMutation", m)
 }
 
+// The ProductAttributeFunc type is an adapter to allow the use of ordinary
+// function as ProductAttribute mutator.
+type ProductAttributeFunc func(context.Context, *ent.ProductAttributeMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ProductAttributeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ProductAttributeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProductAttributeMutation", m)
+}
+
+// The RefreshTokenFunc type is an adapter to allow the use of ordinary
+// function as RefreshToken mutator.
+type RefreshTokenFunc func(context.Context, *ent.RefreshTokenMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RefreshTokenFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RefreshTokenMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RefreshTokenMutation", m)
+}
+
+// The SchemaMigrationFunc type is an adapter to allow the use of ordinary
+// function as SchemaMigration mutator.
+type SchemaMigrationFunc func(context.Context, *ent.SchemaMigrationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SchemaMigrationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SchemaMigrationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SchemaMigrationMutation", m)
+}
+
+// The SearchRuleFunc type is an adapter to allow the use of ordinary
+// function as SearchRule mutator.
+type SearchRuleFunc func(context.Context, *ent.SearchRuleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SearchRuleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SearchRuleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SearchRuleMutation", m)
+}
+
 // The UserFunc type is an adapter to allow the use of ordinary
 // function as User mutator.
 type UserFunc func(context.Context, *ent.UserMutation) (ent.Value, error)
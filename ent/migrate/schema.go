@@ -3,11 +3,54 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
 
 var (
+	// EmbeddingFailuresColumns holds the columns for the "embedding_failures" table.
+	EmbeddingFailuresColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "product_id", Type: field.TypeInt, Unique: true},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+	}
+	// EmbeddingFailuresTable holds the schema information for the "embedding_failures" table.
+	EmbeddingFailuresTable = &schema.Table{
+		Name:       "embedding_failures",
+		Columns:    EmbeddingFailuresColumns,
+		PrimaryKey: []*schema.Column{EmbeddingFailuresColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "embeddingfailure_attempts",
+				Unique:  false,
+				Columns: []*schema.Column{EmbeddingFailuresColumns[4]},
+			},
+		},
+	}
+	// EmbeddingJobsColumns holds the columns for the "embedding_jobs" table.
+	EmbeddingJobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"idle", "running", "stopped", "completed", "failed"}, Default: "idle"},
+		{Name: "cursor", Type: field.TypeInt, Default: 0},
+		{Name: "processed", Type: field.TypeInt, Default: 0},
+		{Name: "failed", Type: field.TypeInt, Default: 0},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "started_at", Type: field.TypeTime, Nullable: true},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
+	}
+	// EmbeddingJobsTable holds the schema information for the "embedding_jobs" table.
+	EmbeddingJobsTable = &schema.Table{
+		Name:       "embedding_jobs",
+		Columns:    EmbeddingJobsColumns,
+		PrimaryKey: []*schema.Column{EmbeddingJobsColumns[0]},
+	}
 	// ProductsColumns holds the columns for the "products" table.
 	ProductsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -32,12 +75,18 @@ var (
 		{Name: "style_code", Type: field.TypeString, Nullable: true},
 		{Name: "crawled_at", Type: field.TypeTime, Nullable: true},
 		{Name: "embedding", Type: field.TypeJSON, Nullable: true},
+		{Name: "embedding_model", Type: field.TypeString, Nullable: true},
+		{Name: "embedding_hash", Type: field.TypeString, Nullable: true},
+		{Name: "content_hash", Type: field.TypeString, Nullable: true},
 		{Name: "search_keywords", Type: field.TypeJSON, Nullable: true},
+		{Name: "search_text", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "featured", Type: field.TypeBool, Default: false},
 		{Name: "view_count", Type: field.TypeInt, Default: 0},
 		{Name: "click_count", Type: field.TypeInt, Default: 0},
 		{Name: "price_numeric", Type: field.TypeInt, Nullable: true},
 		{Name: "rating_numeric", Type: field.TypeFloat64, Nullable: true},
+		{Name: "actual_price_numeric", Type: field.TypeInt, Nullable: true},
+		{Name: "discount_percent", Type: field.TypeFloat64, Nullable: true},
 	}
 	// ProductsTable holds the schema information for the "products" table.
 	ProductsTable = &schema.Table{
@@ -73,12 +122,17 @@ var (
 			{
 				Name:    "product_price_numeric",
 				Unique:  false,
-				Columns: []*schema.Column{ProductsColumns[26]},
+				Columns: []*schema.Column{ProductsColumns[30]},
 			},
 			{
 				Name:    "product_rating_numeric",
 				Unique:  false,
-				Columns: []*schema.Column{ProductsColumns[27]},
+				Columns: []*schema.Column{ProductsColumns[31]},
+			},
+			{
+				Name:    "product_discount_percent",
+				Unique:  false,
+				Columns: []*schema.Column{ProductsColumns[33]},
 			},
 			{
 				Name:    "product_out_of_stock",
@@ -88,7 +142,7 @@ var (
 			{
 				Name:    "product_featured",
 				Unique:  false,
-				Columns: []*schema.Column{ProductsColumns[23]},
+				Columns: []*schema.Column{ProductsColumns[27]},
 			},
 			{
 				Name:    "product_category_sub_category",
@@ -103,12 +157,122 @@ var (
 			{
 				Name:    "product_category_price_numeric",
 				Unique:  false,
-				Columns: []*schema.Column{ProductsColumns[10], ProductsColumns[26]},
+				Columns: []*schema.Column{ProductsColumns[10], ProductsColumns[30]},
 			},
 			{
 				Name:    "product_category_rating_numeric",
 				Unique:  false,
-				Columns: []*schema.Column{ProductsColumns[10], ProductsColumns[27]},
+				Columns: []*schema.Column{ProductsColumns[10], ProductsColumns[31]},
+			},
+			{
+				Name:    "product_search_idx",
+				Unique:  false,
+				Columns: []*schema.Column{ProductsColumns[4], ProductsColumns[6], ProductsColumns[5], ProductsColumns[26]},
+				Annotation: &entsql.IndexAnnotation{
+					Types: map[string]string{
+						"mysql": "FULLTEXT",
+					},
+				},
+			},
+		},
+	}
+	// ProductAttributesColumns holds the columns for the "product_attributes" table.
+	ProductAttributesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "key", Type: field.TypeString},
+		{Name: "value", Type: field.TypeString},
+		{Name: "product_id", Type: field.TypeInt},
+	}
+	// ProductAttributesTable holds the schema information for the "product_attributes" table.
+	ProductAttributesTable = &schema.Table{
+		Name:       "product_attributes",
+		Columns:    ProductAttributesColumns,
+		PrimaryKey: []*schema.Column{ProductAttributesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "product_attributes_products_attributes",
+				Columns:    []*schema.Column{ProductAttributesColumns[3]},
+				RefColumns: []*schema.Column{ProductsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "productattribute_key_value_product_id",
+				Unique:  true,
+				Columns: []*schema.Column{ProductAttributesColumns[1], ProductAttributesColumns[2], ProductAttributesColumns[3]},
+			},
+			{
+				Name:    "productattribute_product_id",
+				Unique:  false,
+				Columns: []*schema.Column{ProductAttributesColumns[3]},
+			},
+		},
+	}
+	// RefreshTokensColumns holds the columns for the "refresh_tokens" table.
+	RefreshTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "family_id", Type: field.TypeString},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoke_reason", Type: field.TypeEnum, Nullable: true, Enums: []string{"rotated", "sign_out", "reuse"}},
+	}
+	// RefreshTokensTable holds the schema information for the "refresh_tokens" table.
+	RefreshTokensTable = &schema.Table{
+		Name:       "refresh_tokens",
+		Columns:    RefreshTokensColumns,
+		PrimaryKey: []*schema.Column{RefreshTokensColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "refreshtoken_family_id",
+				Unique:  false,
+				Columns: []*schema.Column{RefreshTokensColumns[5]},
+			},
+			{
+				Name:    "refreshtoken_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{RefreshTokensColumns[3]},
+			},
+		},
+	}
+	// SchemaMigrationsColumns holds the columns for the "schema_migrations" table.
+	SchemaMigrationsColumns = []*schema.Column{
+		{Name: "version", Type: field.TypeInt64, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "applied_at", Type: field.TypeTime},
+	}
+	// SchemaMigrationsTable holds the schema information for the "schema_migrations" table.
+	SchemaMigrationsTable = &schema.Table{
+		Name:       "schema_migrations",
+		Columns:    SchemaMigrationsColumns,
+		PrimaryKey: []*schema.Column{SchemaMigrationsColumns[0]},
+	}
+	// SearchRulesColumns holds the columns for the "search_rules" table.
+	SearchRulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"two_way", "one_way", "category_boost"}},
+		{Name: "terms", Type: field.TypeJSON},
+		{Name: "synonyms", Type: field.TypeJSON, Nullable: true},
+		{Name: "category", Type: field.TypeString, Nullable: true},
+		{Name: "boost", Type: field.TypeFloat64, Default: 1},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+	}
+	// SearchRulesTable holds the schema information for the "search_rules" table.
+	SearchRulesTable = &schema.Table{
+		Name:       "search_rules",
+		Columns:    SearchRulesColumns,
+		PrimaryKey: []*schema.Column{SearchRulesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "searchrule_enabled",
+				Unique:  false,
+				Columns: []*schema.Column{SearchRulesColumns[8]},
 			},
 		},
 	}
@@ -123,6 +287,7 @@ var (
 		{Name: "phone", Type: field.TypeString, Nullable: true},
 		{Name: "username", Type: field.TypeString, Nullable: true},
 		{Name: "password", Type: field.TypeString},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"customer", "editor", "admin"}, Default: "customer"},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
@@ -132,10 +297,17 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		EmbeddingFailuresTable,
+		EmbeddingJobsTable,
 		ProductsTable,
+		ProductAttributesTable,
+		RefreshTokensTable,
+		SchemaMigrationsTable,
+		SearchRulesTable,
 		UsersTable,
 	}
 )
 
 func init() {
+	ProductAttributesTable.ForeignKeys[0].RefTable = ProductsTable
 }
@@ -8,8 +8,14 @@ import (
 	"fmt"
 	"sync"
 	"time"
+	"yinni_backend/ent/embeddingfailure"
+	"yinni_backend/ent/embeddingjob"
 	"yinni_backend/ent/predicate"
 	"yinni_backend/ent/product"
+	"yinni_backend/ent/productattribute"
+	"yinni_backend/ent/refreshtoken"
+	"yinni_backend/ent/schemamigration"
+	"yinni_backend/ent/searchrule"
 	"yinni_backend/ent/user"
 
 	"entgo.io/ent"
@@ -25,68 +31,46 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeProduct = "Product"
-	TypeUser    = "User"
+	TypeEmbeddingFailure = "EmbeddingFailure"
+	TypeEmbeddingJob     = "EmbeddingJob"
+	TypeProduct          = "Product"
+	TypeProductAttribute = "ProductAttribute"
+	TypeRefreshToken     = "RefreshToken"
+	TypeSchemaMigration  = "SchemaMigration"
+	TypeSearchRule       = "SearchRule"
+	TypeUser             = "User"
 )
 
-// ProductMutation represents an operation that mutates the Product nodes in the graph.
-type ProductMutation struct {
+// EmbeddingFailureMutation represents an operation that mutates the EmbeddingFailure nodes in the graph.
+type EmbeddingFailureMutation struct {
 	config
-	op                    Op
-	typ                   string
-	id                    *int
-	create_time           *time.Time
-	update_time           *time.Time
-	original_id           *string
-	title                 *string
-	brand                 *string
-	description           *string
-	actual_price          *string
-	selling_price         *string
-	discount              *string
-	category              *string
-	sub_category          *string
-	out_of_stock          *bool
-	seller                *string
-	average_rating        *string
-	images                *[]string
-	appendimages          []string
-	product_details       *[]map[string]string
-	appendproduct_details []map[string]string
-	url                   *string
-	pid                   *string
-	style_code            *string
-	crawled_at            *time.Time
-	embedding             *[]float32
-	appendembedding       []float32
-	search_keywords       *[]string
-	appendsearch_keywords []string
-	featured              *bool
-	view_count            *int
-	addview_count         *int
-	click_count           *int
-	addclick_count        *int
-	price_numeric         *int
-	addprice_numeric      *int
-	rating_numeric        *float64
-	addrating_numeric     *float64
-	clearedFields         map[string]struct{}
-	done                  bool
-	oldValue              func(context.Context) (*Product, error)
-	predicates            []predicate.Product
+	op            Op
+	typ           string
+	id            *int
+	create_time   *time.Time
+	update_time   *time.Time
+	product_id    *int
+	addproduct_id *int
+	attempts      *int
+	addattempts   *int
+	last_error    *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*EmbeddingFailure, error)
+	predicates    []predicate.EmbeddingFailure
 }
 
-var _ ent.Mutation = (*ProductMutation)(nil)
+var _ ent.Mutation = (*EmbeddingFailureMutation)(nil)
 
-// productOption allows management of the mutation configuration using functional options.
-type productOption func(*ProductMutation)
+// embeddingfailureOption allows management of the mutation configuration using functional options.
+type embeddingfailureOption func(*EmbeddingFailureMutation)
 
-// newProductMutation creates new mutation for the Product entity.
-func newProductMutation(c config, op Op, opts ...productOption) *ProductMutation {
-	m := &ProductMutation{
+// newEmbeddingFailureMutation creates new mutation for the EmbeddingFailure entity.
+func newEmbeddingFailureMutation(c config, op Op, opts ...embeddingfailureOption) *EmbeddingFailureMutation {
+	m := &EmbeddingFailureMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeProduct,
+		typ:           TypeEmbeddingFailure,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -95,20 +79,20 @@ func newProductMutation(c config, op Op, opts ...productOption) *ProductMutation
 	return m
 }
 
-// withProductID sets the ID field of the mutation.
-func withProductID(id int) productOption {
-	return func(m *ProductMutation) {
+// withEmbeddingFailureID sets the ID field of the mutation.
+func withEmbeddingFailureID(id int) embeddingfailureOption {
+	return func(m *EmbeddingFailureMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Product
+			value *EmbeddingFailure
 		)
-		m.oldValue = func(ctx context.Context) (*Product, error) {
+		m.oldValue = func(ctx context.Context) (*EmbeddingFailure, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Product.Get(ctx, id)
+					value, err = m.Client().EmbeddingFailure.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -117,10 +101,10 @@ func withProductID(id int) productOption {
 	}
 }
 
-// withProduct sets the old Product of the mutation.
-func withProduct(node *Product) productOption {
-	return func(m *ProductMutation) {
-		m.oldValue = func(context.Context) (*Product, error) {
+// withEmbeddingFailure sets the old EmbeddingFailure of the mutation.
+func withEmbeddingFailure(node *EmbeddingFailure) embeddingfailureOption {
+	return func(m *EmbeddingFailureMutation) {
+		m.oldValue = func(context.Context) (*EmbeddingFailure, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -129,7 +113,7 @@ func withProduct(node *Product) productOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ProductMutation) Client() *Client {
+func (m EmbeddingFailureMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -137,7 +121,7 @@ func (m ProductMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ProductMutation) Tx() (*Tx, error) {
+func (m EmbeddingFailureMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -148,7 +132,7 @@ func (m ProductMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ProductMutation) ID() (id int, exists bool) {
+func (m *EmbeddingFailureMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -159,7 +143,7 @@ func (m *ProductMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ProductMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *EmbeddingFailureMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -168,19 +152,19 @@ func (m *ProductMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Product.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().EmbeddingFailure.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *ProductMutation) SetCreateTime(t time.Time) {
+func (m *EmbeddingFailureMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *ProductMutation) CreateTime() (r time.Time, exists bool) {
+func (m *EmbeddingFailureMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -188,10 +172,10 @@ func (m *ProductMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the Product entity.
-// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the EmbeddingFailure entity.
+// If the EmbeddingFailure object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProductMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *EmbeddingFailureMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -206,17 +190,17 @@ func (m *ProductMutation) OldCreateTime(ctx context.Context) (v time.Time, err e
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *ProductMutation) ResetCreateTime() {
+func (m *EmbeddingFailureMutation) ResetCreateTime() {
 	m.create_time = nil
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *ProductMutation) SetUpdateTime(t time.Time) {
+func (m *EmbeddingFailureMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *ProductMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *EmbeddingFailureMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -224,10 +208,10 @@ func (m *ProductMutation) UpdateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the Product entity.
-// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the EmbeddingFailure entity.
+// If the EmbeddingFailure object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProductMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *EmbeddingFailureMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -242,1260 +226,6403 @@ func (m *ProductMutation) OldUpdateTime(ctx context.Context) (v time.Time, err e
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *ProductMutation) ResetUpdateTime() {
+func (m *EmbeddingFailureMutation) ResetUpdateTime() {
 	m.update_time = nil
 }
 
-// SetOriginalID sets the "original_id" field.
-func (m *ProductMutation) SetOriginalID(s string) {
-	m.original_id = &s
+// SetProductID sets the "product_id" field.
+func (m *EmbeddingFailureMutation) SetProductID(i int) {
+	m.product_id = &i
+	m.addproduct_id = nil
 }
 
-// OriginalID returns the value of the "original_id" field in the mutation.
-func (m *ProductMutation) OriginalID() (r string, exists bool) {
-	v := m.original_id
+// ProductID returns the value of the "product_id" field in the mutation.
+func (m *EmbeddingFailureMutation) ProductID() (r int, exists bool) {
+	v := m.product_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOriginalID returns the old "original_id" field's value of the Product entity.
-// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// OldProductID returns the old "product_id" field's value of the EmbeddingFailure entity.
+// If the EmbeddingFailure object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProductMutation) OldOriginalID(ctx context.Context) (v string, err error) {
+func (m *EmbeddingFailureMutation) OldProductID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOriginalID is only allowed on UpdateOne operations")
+		return v, errors.New("OldProductID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOriginalID requires an ID field in the mutation")
+		return v, errors.New("OldProductID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOriginalID: %w", err)
+		return v, fmt.Errorf("querying old value for OldProductID: %w", err)
 	}
-	return oldValue.OriginalID, nil
+	return oldValue.ProductID, nil
 }
 
-// ResetOriginalID resets all changes to the "original_id" field.
-func (m *ProductMutation) ResetOriginalID() {
-	m.original_id = nil
-}
-
-// SetTitle sets the "title" field.
-func (m *ProductMutation) SetTitle(s string) {
-	m.title = &s
+// AddProductID adds i to the "product_id" field.
+func (m *EmbeddingFailureMutation) AddProductID(i int) {
+	if m.addproduct_id != nil {
+		*m.addproduct_id += i
+	} else {
+		m.addproduct_id = &i
+	}
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *ProductMutation) Title() (r string, exists bool) {
-	v := m.title
+// AddedProductID returns the value that was added to the "product_id" field in this mutation.
+func (m *EmbeddingFailureMutation) AddedProductID() (r int, exists bool) {
+	v := m.addproduct_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTitle returns the old "title" field's value of the Product entity.
-// If the Product object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProductMutation) OldTitle(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
-	}
-	return oldValue.Title, nil
-}
-
-// ResetTitle resets all changes to the "title" field.
-func (m *ProductMutation) ResetTitle() {
-	m.title = nil
+// ResetProductID resets all changes to the "product_id" field.
+func (m *EmbeddingFailureMutation) ResetProductID() {
+	m.product_id = nil
+	m.addproduct_id = nil
 }
 
-// SetBrand sets the "brand" field.
-func (m *ProductMutation) SetBrand(s string) {
-	m.brand = &s
+// SetAttempts sets the "attempts" field.
+func (m *EmbeddingFailureMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
 }
 
-// Brand returns the value of the "brand" field in the mutation.
-func (m *ProductMutation) Brand() (r string, exists bool) {
-	v := m.brand
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *EmbeddingFailureMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBrand returns the old "brand" field's value of the Product entity.
-// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// OldAttempts returns the old "attempts" field's value of the EmbeddingFailure entity.
+// If the EmbeddingFailure object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProductMutation) OldBrand(ctx context.Context) (v string, err error) {
+func (m *EmbeddingFailureMutation) OldAttempts(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBrand is only allowed on UpdateOne operations")
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBrand requires an ID field in the mutation")
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBrand: %w", err)
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
 	}
-	return oldValue.Brand, nil
-}
-
-// ResetBrand resets all changes to the "brand" field.
-func (m *ProductMutation) ResetBrand() {
-	m.brand = nil
+	return oldValue.Attempts, nil
 }
 
-// SetDescription sets the "description" field.
-func (m *ProductMutation) SetDescription(s string) {
-	m.description = &s
+// AddAttempts adds i to the "attempts" field.
+func (m *EmbeddingFailureMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *ProductMutation) Description() (r string, exists bool) {
-	v := m.description
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *EmbeddingFailureMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Product entity.
-// If the Product object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProductMutation) OldDescription(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
-	}
-	return oldValue.Description, nil
-}
-
-// ClearDescription clears the value of the "description" field.
-func (m *ProductMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[product.FieldDescription] = struct{}{}
-}
-
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *ProductMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[product.FieldDescription]
-	return ok
-}
-
-// ResetDescription resets all changes to the "description" field.
-func (m *ProductMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, product.FieldDescription)
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *EmbeddingFailureMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
 }
 
-// SetActualPrice sets the "actual_price" field.
-func (m *ProductMutation) SetActualPrice(s string) {
-	m.actual_price = &s
+// SetLastError sets the "last_error" field.
+func (m *EmbeddingFailureMutation) SetLastError(s string) {
+	m.last_error = &s
 }
 
-// ActualPrice returns the value of the "actual_price" field in the mutation.
-func (m *ProductMutation) ActualPrice() (r string, exists bool) {
-	v := m.actual_price
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *EmbeddingFailureMutation) LastError() (r string, exists bool) {
+	v := m.last_error
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldActualPrice returns the old "actual_price" field's value of the Product entity.
-// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// OldLastError returns the old "last_error" field's value of the EmbeddingFailure entity.
+// If the EmbeddingFailure object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProductMutation) OldActualPrice(ctx context.Context) (v string, err error) {
+func (m *EmbeddingFailureMutation) OldLastError(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldActualPrice is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldActualPrice requires an ID field in the mutation")
+		return v, errors.New("OldLastError requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldActualPrice: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
 	}
-	return oldValue.ActualPrice, nil
+	return oldValue.LastError, nil
 }
 
-// ClearActualPrice clears the value of the "actual_price" field.
-func (m *ProductMutation) ClearActualPrice() {
-	m.actual_price = nil
-	m.clearedFields[product.FieldActualPrice] = struct{}{}
+// ClearLastError clears the value of the "last_error" field.
+func (m *EmbeddingFailureMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[embeddingfailure.FieldLastError] = struct{}{}
 }
 
-// ActualPriceCleared returns if the "actual_price" field was cleared in this mutation.
-func (m *ProductMutation) ActualPriceCleared() bool {
-	_, ok := m.clearedFields[product.FieldActualPrice]
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *EmbeddingFailureMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[embeddingfailure.FieldLastError]
 	return ok
 }
 
-// ResetActualPrice resets all changes to the "actual_price" field.
-func (m *ProductMutation) ResetActualPrice() {
-	m.actual_price = nil
-	delete(m.clearedFields, product.FieldActualPrice)
+// ResetLastError resets all changes to the "last_error" field.
+func (m *EmbeddingFailureMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, embeddingfailure.FieldLastError)
 }
 
-// SetSellingPrice sets the "selling_price" field.
-func (m *ProductMutation) SetSellingPrice(s string) {
-	m.selling_price = &s
+// Where appends a list predicates to the EmbeddingFailureMutation builder.
+func (m *EmbeddingFailureMutation) Where(ps ...predicate.EmbeddingFailure) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// SellingPrice returns the value of the "selling_price" field in the mutation.
-func (m *ProductMutation) SellingPrice() (r string, exists bool) {
-	v := m.selling_price
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the EmbeddingFailureMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EmbeddingFailureMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EmbeddingFailure, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldSellingPrice returns the old "selling_price" field's value of the Product entity.
-// If the Product object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProductMutation) OldSellingPrice(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSellingPrice is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSellingPrice requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSellingPrice: %w", err)
-	}
-	return oldValue.SellingPrice, nil
+// Op returns the operation name.
+func (m *EmbeddingFailureMutation) Op() Op {
+	return m.op
 }
 
-// ClearSellingPrice clears the value of the "selling_price" field.
-func (m *ProductMutation) ClearSellingPrice() {
-	m.selling_price = nil
-	m.clearedFields[product.FieldSellingPrice] = struct{}{}
+// SetOp allows setting the mutation operation.
+func (m *EmbeddingFailureMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SellingPriceCleared returns if the "selling_price" field was cleared in this mutation.
-func (m *ProductMutation) SellingPriceCleared() bool {
-	_, ok := m.clearedFields[product.FieldSellingPrice]
-	return ok
+// Type returns the node type of this mutation (EmbeddingFailure).
+func (m *EmbeddingFailureMutation) Type() string {
+	return m.typ
 }
 
-// ResetSellingPrice resets all changes to the "selling_price" field.
-func (m *ProductMutation) ResetSellingPrice() {
-	m.selling_price = nil
-	delete(m.clearedFields, product.FieldSellingPrice)
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EmbeddingFailureMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.create_time != nil {
+		fields = append(fields, embeddingfailure.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, embeddingfailure.FieldUpdateTime)
+	}
+	if m.product_id != nil {
+		fields = append(fields, embeddingfailure.FieldProductID)
+	}
+	if m.attempts != nil {
+		fields = append(fields, embeddingfailure.FieldAttempts)
+	}
+	if m.last_error != nil {
+		fields = append(fields, embeddingfailure.FieldLastError)
+	}
+	return fields
 }
 
-// SetDiscount sets the "discount" field.
-func (m *ProductMutation) SetDiscount(s string) {
-	m.discount = &s
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EmbeddingFailureMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case embeddingfailure.FieldCreateTime:
+		return m.CreateTime()
+	case embeddingfailure.FieldUpdateTime:
+		return m.UpdateTime()
+	case embeddingfailure.FieldProductID:
+		return m.ProductID()
+	case embeddingfailure.FieldAttempts:
+		return m.Attempts()
+	case embeddingfailure.FieldLastError:
+		return m.LastError()
+	}
+	return nil, false
 }
 
-// Discount returns the value of the "discount" field in the mutation.
-func (m *ProductMutation) Discount() (r string, exists bool) {
-	v := m.discount
-	if v == nil {
-		return
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EmbeddingFailureMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case embeddingfailure.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case embeddingfailure.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case embeddingfailure.FieldProductID:
+		return m.OldProductID(ctx)
+	case embeddingfailure.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case embeddingfailure.FieldLastError:
+		return m.OldLastError(ctx)
 	}
-	return *v, true
+	return nil, fmt.Errorf("unknown EmbeddingFailure field %s", name)
 }
 
-// OldDiscount returns the old "discount" field's value of the Product entity.
-// If the Product object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProductMutation) OldDiscount(ctx context.Context) (v string, err error) {
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EmbeddingFailureMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case embeddingfailure.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case embeddingfailure.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case embeddingfailure.FieldProductID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProductID(v)
+		return nil
+	case embeddingfailure.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case embeddingfailure.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EmbeddingFailure field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EmbeddingFailureMutation) AddedFields() []string {
+	var fields []string
+	if m.addproduct_id != nil {
+		fields = append(fields, embeddingfailure.FieldProductID)
+	}
+	if m.addattempts != nil {
+		fields = append(fields, embeddingfailure.FieldAttempts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EmbeddingFailureMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case embeddingfailure.FieldProductID:
+		return m.AddedProductID()
+	case embeddingfailure.FieldAttempts:
+		return m.AddedAttempts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EmbeddingFailureMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case embeddingfailure.FieldProductID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddProductID(v)
+		return nil
+	case embeddingfailure.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EmbeddingFailure numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EmbeddingFailureMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(embeddingfailure.FieldLastError) {
+		fields = append(fields, embeddingfailure.FieldLastError)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EmbeddingFailureMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EmbeddingFailureMutation) ClearField(name string) error {
+	switch name {
+	case embeddingfailure.FieldLastError:
+		m.ClearLastError()
+		return nil
+	}
+	return fmt.Errorf("unknown EmbeddingFailure nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EmbeddingFailureMutation) ResetField(name string) error {
+	switch name {
+	case embeddingfailure.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case embeddingfailure.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case embeddingfailure.FieldProductID:
+		m.ResetProductID()
+		return nil
+	case embeddingfailure.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case embeddingfailure.FieldLastError:
+		m.ResetLastError()
+		return nil
+	}
+	return fmt.Errorf("unknown EmbeddingFailure field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EmbeddingFailureMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EmbeddingFailureMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EmbeddingFailureMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EmbeddingFailureMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EmbeddingFailureMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EmbeddingFailureMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EmbeddingFailureMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown EmbeddingFailure unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EmbeddingFailureMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown EmbeddingFailure edge %s", name)
+}
+
+// EmbeddingJobMutation represents an operation that mutates the EmbeddingJob nodes in the graph.
+type EmbeddingJobMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	create_time   *time.Time
+	update_time   *time.Time
+	name          *string
+	status        *embeddingjob.Status
+	cursor        *int
+	addcursor     *int
+	processed     *int
+	addprocessed  *int
+	failed        *int
+	addfailed     *int
+	last_error    *string
+	started_at    *time.Time
+	finished_at   *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*EmbeddingJob, error)
+	predicates    []predicate.EmbeddingJob
+}
+
+var _ ent.Mutation = (*EmbeddingJobMutation)(nil)
+
+// embeddingjobOption allows management of the mutation configuration using functional options.
+type embeddingjobOption func(*EmbeddingJobMutation)
+
+// newEmbeddingJobMutation creates new mutation for the EmbeddingJob entity.
+func newEmbeddingJobMutation(c config, op Op, opts ...embeddingjobOption) *EmbeddingJobMutation {
+	m := &EmbeddingJobMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEmbeddingJob,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEmbeddingJobID sets the ID field of the mutation.
+func withEmbeddingJobID(id int) embeddingjobOption {
+	return func(m *EmbeddingJobMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *EmbeddingJob
+		)
+		m.oldValue = func(ctx context.Context) (*EmbeddingJob, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().EmbeddingJob.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEmbeddingJob sets the old EmbeddingJob of the mutation.
+func withEmbeddingJob(node *EmbeddingJob) embeddingjobOption {
+	return func(m *EmbeddingJobMutation) {
+		m.oldValue = func(context.Context) (*EmbeddingJob, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EmbeddingJobMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EmbeddingJobMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EmbeddingJobMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EmbeddingJobMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().EmbeddingJob.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *EmbeddingJobMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *EmbeddingJobMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the EmbeddingJob entity.
+// If the EmbeddingJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmbeddingJobMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *EmbeddingJobMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *EmbeddingJobMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *EmbeddingJobMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the EmbeddingJob entity.
+// If the EmbeddingJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmbeddingJobMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *EmbeddingJobMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetName sets the "name" field.
+func (m *EmbeddingJobMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *EmbeddingJobMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the EmbeddingJob entity.
+// If the EmbeddingJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmbeddingJobMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *EmbeddingJobMutation) ResetName() {
+	m.name = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *EmbeddingJobMutation) SetStatus(e embeddingjob.Status) {
+	m.status = &e
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *EmbeddingJobMutation) Status() (r embeddingjob.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the EmbeddingJob entity.
+// If the EmbeddingJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmbeddingJobMutation) OldStatus(ctx context.Context) (v embeddingjob.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *EmbeddingJobMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetCursor sets the "cursor" field.
+func (m *EmbeddingJobMutation) SetCursor(i int) {
+	m.cursor = &i
+	m.addcursor = nil
+}
+
+// Cursor returns the value of the "cursor" field in the mutation.
+func (m *EmbeddingJobMutation) Cursor() (r int, exists bool) {
+	v := m.cursor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCursor returns the old "cursor" field's value of the EmbeddingJob entity.
+// If the EmbeddingJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmbeddingJobMutation) OldCursor(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCursor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCursor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCursor: %w", err)
+	}
+	return oldValue.Cursor, nil
+}
+
+// AddCursor adds i to the "cursor" field.
+func (m *EmbeddingJobMutation) AddCursor(i int) {
+	if m.addcursor != nil {
+		*m.addcursor += i
+	} else {
+		m.addcursor = &i
+	}
+}
+
+// AddedCursor returns the value that was added to the "cursor" field in this mutation.
+func (m *EmbeddingJobMutation) AddedCursor() (r int, exists bool) {
+	v := m.addcursor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCursor resets all changes to the "cursor" field.
+func (m *EmbeddingJobMutation) ResetCursor() {
+	m.cursor = nil
+	m.addcursor = nil
+}
+
+// SetProcessed sets the "processed" field.
+func (m *EmbeddingJobMutation) SetProcessed(i int) {
+	m.processed = &i
+	m.addprocessed = nil
+}
+
+// Processed returns the value of the "processed" field in the mutation.
+func (m *EmbeddingJobMutation) Processed() (r int, exists bool) {
+	v := m.processed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProcessed returns the old "processed" field's value of the EmbeddingJob entity.
+// If the EmbeddingJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmbeddingJobMutation) OldProcessed(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProcessed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProcessed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProcessed: %w", err)
+	}
+	return oldValue.Processed, nil
+}
+
+// AddProcessed adds i to the "processed" field.
+func (m *EmbeddingJobMutation) AddProcessed(i int) {
+	if m.addprocessed != nil {
+		*m.addprocessed += i
+	} else {
+		m.addprocessed = &i
+	}
+}
+
+// AddedProcessed returns the value that was added to the "processed" field in this mutation.
+func (m *EmbeddingJobMutation) AddedProcessed() (r int, exists bool) {
+	v := m.addprocessed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetProcessed resets all changes to the "processed" field.
+func (m *EmbeddingJobMutation) ResetProcessed() {
+	m.processed = nil
+	m.addprocessed = nil
+}
+
+// SetFailed sets the "failed" field.
+func (m *EmbeddingJobMutation) SetFailed(i int) {
+	m.failed = &i
+	m.addfailed = nil
+}
+
+// Failed returns the value of the "failed" field in the mutation.
+func (m *EmbeddingJobMutation) Failed() (r int, exists bool) {
+	v := m.failed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFailed returns the old "failed" field's value of the EmbeddingJob entity.
+// If the EmbeddingJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmbeddingJobMutation) OldFailed(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFailed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFailed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFailed: %w", err)
+	}
+	return oldValue.Failed, nil
+}
+
+// AddFailed adds i to the "failed" field.
+func (m *EmbeddingJobMutation) AddFailed(i int) {
+	if m.addfailed != nil {
+		*m.addfailed += i
+	} else {
+		m.addfailed = &i
+	}
+}
+
+// AddedFailed returns the value that was added to the "failed" field in this mutation.
+func (m *EmbeddingJobMutation) AddedFailed() (r int, exists bool) {
+	v := m.addfailed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFailed resets all changes to the "failed" field.
+func (m *EmbeddingJobMutation) ResetFailed() {
+	m.failed = nil
+	m.addfailed = nil
+}
+
+// SetLastError sets the "last_error" field.
+func (m *EmbeddingJobMutation) SetLastError(s string) {
+	m.last_error = &s
+}
+
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *EmbeddingJobMutation) LastError() (r string, exists bool) {
+	v := m.last_error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastError returns the old "last_error" field's value of the EmbeddingJob entity.
+// If the EmbeddingJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmbeddingJobMutation) OldLastError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
+	}
+	return oldValue.LastError, nil
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (m *EmbeddingJobMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[embeddingjob.FieldLastError] = struct{}{}
+}
+
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *EmbeddingJobMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[embeddingjob.FieldLastError]
+	return ok
+}
+
+// ResetLastError resets all changes to the "last_error" field.
+func (m *EmbeddingJobMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, embeddingjob.FieldLastError)
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *EmbeddingJobMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *EmbeddingJobMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedAt returns the old "started_at" field's value of the EmbeddingJob entity.
+// If the EmbeddingJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmbeddingJobMutation) OldStartedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (m *EmbeddingJobMutation) ClearStartedAt() {
+	m.started_at = nil
+	m.clearedFields[embeddingjob.FieldStartedAt] = struct{}{}
+}
+
+// StartedAtCleared returns if the "started_at" field was cleared in this mutation.
+func (m *EmbeddingJobMutation) StartedAtCleared() bool {
+	_, ok := m.clearedFields[embeddingjob.FieldStartedAt]
+	return ok
+}
+
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *EmbeddingJobMutation) ResetStartedAt() {
+	m.started_at = nil
+	delete(m.clearedFields, embeddingjob.FieldStartedAt)
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (m *EmbeddingJobMutation) SetFinishedAt(t time.Time) {
+	m.finished_at = &t
+}
+
+// FinishedAt returns the value of the "finished_at" field in the mutation.
+func (m *EmbeddingJobMutation) FinishedAt() (r time.Time, exists bool) {
+	v := m.finished_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFinishedAt returns the old "finished_at" field's value of the EmbeddingJob entity.
+// If the EmbeddingJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmbeddingJobMutation) OldFinishedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFinishedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFinishedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFinishedAt: %w", err)
+	}
+	return oldValue.FinishedAt, nil
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (m *EmbeddingJobMutation) ClearFinishedAt() {
+	m.finished_at = nil
+	m.clearedFields[embeddingjob.FieldFinishedAt] = struct{}{}
+}
+
+// FinishedAtCleared returns if the "finished_at" field was cleared in this mutation.
+func (m *EmbeddingJobMutation) FinishedAtCleared() bool {
+	_, ok := m.clearedFields[embeddingjob.FieldFinishedAt]
+	return ok
+}
+
+// ResetFinishedAt resets all changes to the "finished_at" field.
+func (m *EmbeddingJobMutation) ResetFinishedAt() {
+	m.finished_at = nil
+	delete(m.clearedFields, embeddingjob.FieldFinishedAt)
+}
+
+// Where appends a list predicates to the EmbeddingJobMutation builder.
+func (m *EmbeddingJobMutation) Where(ps ...predicate.EmbeddingJob) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EmbeddingJobMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EmbeddingJobMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EmbeddingJob, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EmbeddingJobMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EmbeddingJobMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (EmbeddingJob).
+func (m *EmbeddingJobMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EmbeddingJobMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.create_time != nil {
+		fields = append(fields, embeddingjob.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, embeddingjob.FieldUpdateTime)
+	}
+	if m.name != nil {
+		fields = append(fields, embeddingjob.FieldName)
+	}
+	if m.status != nil {
+		fields = append(fields, embeddingjob.FieldStatus)
+	}
+	if m.cursor != nil {
+		fields = append(fields, embeddingjob.FieldCursor)
+	}
+	if m.processed != nil {
+		fields = append(fields, embeddingjob.FieldProcessed)
+	}
+	if m.failed != nil {
+		fields = append(fields, embeddingjob.FieldFailed)
+	}
+	if m.last_error != nil {
+		fields = append(fields, embeddingjob.FieldLastError)
+	}
+	if m.started_at != nil {
+		fields = append(fields, embeddingjob.FieldStartedAt)
+	}
+	if m.finished_at != nil {
+		fields = append(fields, embeddingjob.FieldFinishedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EmbeddingJobMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case embeddingjob.FieldCreateTime:
+		return m.CreateTime()
+	case embeddingjob.FieldUpdateTime:
+		return m.UpdateTime()
+	case embeddingjob.FieldName:
+		return m.Name()
+	case embeddingjob.FieldStatus:
+		return m.Status()
+	case embeddingjob.FieldCursor:
+		return m.Cursor()
+	case embeddingjob.FieldProcessed:
+		return m.Processed()
+	case embeddingjob.FieldFailed:
+		return m.Failed()
+	case embeddingjob.FieldLastError:
+		return m.LastError()
+	case embeddingjob.FieldStartedAt:
+		return m.StartedAt()
+	case embeddingjob.FieldFinishedAt:
+		return m.FinishedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EmbeddingJobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case embeddingjob.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case embeddingjob.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case embeddingjob.FieldName:
+		return m.OldName(ctx)
+	case embeddingjob.FieldStatus:
+		return m.OldStatus(ctx)
+	case embeddingjob.FieldCursor:
+		return m.OldCursor(ctx)
+	case embeddingjob.FieldProcessed:
+		return m.OldProcessed(ctx)
+	case embeddingjob.FieldFailed:
+		return m.OldFailed(ctx)
+	case embeddingjob.FieldLastError:
+		return m.OldLastError(ctx)
+	case embeddingjob.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case embeddingjob.FieldFinishedAt:
+		return m.OldFinishedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown EmbeddingJob field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EmbeddingJobMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case embeddingjob.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case embeddingjob.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case embeddingjob.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case embeddingjob.FieldStatus:
+		v, ok := value.(embeddingjob.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case embeddingjob.FieldCursor:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCursor(v)
+		return nil
+	case embeddingjob.FieldProcessed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProcessed(v)
+		return nil
+	case embeddingjob.FieldFailed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFailed(v)
+		return nil
+	case embeddingjob.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	case embeddingjob.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case embeddingjob.FieldFinishedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinishedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EmbeddingJob field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EmbeddingJobMutation) AddedFields() []string {
+	var fields []string
+	if m.addcursor != nil {
+		fields = append(fields, embeddingjob.FieldCursor)
+	}
+	if m.addprocessed != nil {
+		fields = append(fields, embeddingjob.FieldProcessed)
+	}
+	if m.addfailed != nil {
+		fields = append(fields, embeddingjob.FieldFailed)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EmbeddingJobMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case embeddingjob.FieldCursor:
+		return m.AddedCursor()
+	case embeddingjob.FieldProcessed:
+		return m.AddedProcessed()
+	case embeddingjob.FieldFailed:
+		return m.AddedFailed()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EmbeddingJobMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case embeddingjob.FieldCursor:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCursor(v)
+		return nil
+	case embeddingjob.FieldProcessed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddProcessed(v)
+		return nil
+	case embeddingjob.FieldFailed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFailed(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EmbeddingJob numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EmbeddingJobMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(embeddingjob.FieldLastError) {
+		fields = append(fields, embeddingjob.FieldLastError)
+	}
+	if m.FieldCleared(embeddingjob.FieldStartedAt) {
+		fields = append(fields, embeddingjob.FieldStartedAt)
+	}
+	if m.FieldCleared(embeddingjob.FieldFinishedAt) {
+		fields = append(fields, embeddingjob.FieldFinishedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EmbeddingJobMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EmbeddingJobMutation) ClearField(name string) error {
+	switch name {
+	case embeddingjob.FieldLastError:
+		m.ClearLastError()
+		return nil
+	case embeddingjob.FieldStartedAt:
+		m.ClearStartedAt()
+		return nil
+	case embeddingjob.FieldFinishedAt:
+		m.ClearFinishedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown EmbeddingJob nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EmbeddingJobMutation) ResetField(name string) error {
+	switch name {
+	case embeddingjob.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case embeddingjob.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case embeddingjob.FieldName:
+		m.ResetName()
+		return nil
+	case embeddingjob.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case embeddingjob.FieldCursor:
+		m.ResetCursor()
+		return nil
+	case embeddingjob.FieldProcessed:
+		m.ResetProcessed()
+		return nil
+	case embeddingjob.FieldFailed:
+		m.ResetFailed()
+		return nil
+	case embeddingjob.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case embeddingjob.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case embeddingjob.FieldFinishedAt:
+		m.ResetFinishedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown EmbeddingJob field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EmbeddingJobMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EmbeddingJobMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EmbeddingJobMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EmbeddingJobMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EmbeddingJobMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EmbeddingJobMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EmbeddingJobMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown EmbeddingJob unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EmbeddingJobMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown EmbeddingJob edge %s", name)
+}
+
+// ProductMutation represents an operation that mutates the Product nodes in the graph.
+type ProductMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *int
+	create_time             *time.Time
+	update_time             *time.Time
+	original_id             *string
+	title                   *string
+	brand                   *string
+	description             *string
+	actual_price            *string
+	selling_price           *string
+	discount                *string
+	category                *string
+	sub_category            *string
+	out_of_stock            *bool
+	seller                  *string
+	average_rating          *string
+	images                  *[]string
+	appendimages            []string
+	product_details         *[]map[string]string
+	appendproduct_details   []map[string]string
+	url                     *string
+	pid                     *string
+	style_code              *string
+	crawled_at              *time.Time
+	embedding               *[]float32
+	appendembedding         []float32
+	embedding_model         *string
+	embedding_hash          *string
+	content_hash            *string
+	search_keywords         *[]string
+	appendsearch_keywords   []string
+	search_text             *string
+	featured                *bool
+	view_count              *int
+	addview_count           *int
+	click_count             *int
+	addclick_count          *int
+	price_numeric           *int
+	addprice_numeric        *int
+	rating_numeric          *float64
+	addrating_numeric       *float64
+	actual_price_numeric    *int
+	addactual_price_numeric *int
+	discount_percent        *float64
+	adddiscount_percent     *float64
+	clearedFields           map[string]struct{}
+	attributes              map[int]struct{}
+	removedattributes       map[int]struct{}
+	clearedattributes       bool
+	done                    bool
+	oldValue                func(context.Context) (*Product, error)
+	predicates              []predicate.Product
+}
+
+var _ ent.Mutation = (*ProductMutation)(nil)
+
+// productOption allows management of the mutation configuration using functional options.
+type productOption func(*ProductMutation)
+
+// newProductMutation creates new mutation for the Product entity.
+func newProductMutation(c config, op Op, opts ...productOption) *ProductMutation {
+	m := &ProductMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeProduct,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withProductID sets the ID field of the mutation.
+func withProductID(id int) productOption {
+	return func(m *ProductMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Product
+		)
+		m.oldValue = func(ctx context.Context) (*Product, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Product.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withProduct sets the old Product of the mutation.
+func withProduct(node *Product) productOption {
+	return func(m *ProductMutation) {
+		m.oldValue = func(context.Context) (*Product, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ProductMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ProductMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ProductMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ProductMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Product.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *ProductMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *ProductMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *ProductMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *ProductMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *ProductMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *ProductMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetOriginalID sets the "original_id" field.
+func (m *ProductMutation) SetOriginalID(s string) {
+	m.original_id = &s
+}
+
+// OriginalID returns the value of the "original_id" field in the mutation.
+func (m *ProductMutation) OriginalID() (r string, exists bool) {
+	v := m.original_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOriginalID returns the old "original_id" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductMutation) OldOriginalID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOriginalID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOriginalID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOriginalID: %w", err)
+	}
+	return oldValue.OriginalID, nil
+}
+
+// ResetOriginalID resets all changes to the "original_id" field.
+func (m *ProductMutation) ResetOriginalID() {
+	m.original_id = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *ProductMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *ProductMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *ProductMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetBrand sets the "brand" field.
+func (m *ProductMutation) SetBrand(s string) {
+	m.brand = &s
+}
+
+// Brand returns the value of the "brand" field in the mutation.
+func (m *ProductMutation) Brand() (r string, exists bool) {
+	v := m.brand
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBrand returns the old "brand" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductMutation) OldBrand(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBrand is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBrand requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBrand: %w", err)
+	}
+	return oldValue.Brand, nil
+}
+
+// ResetBrand resets all changes to the "brand" field.
+func (m *ProductMutation) ResetBrand() {
+	m.brand = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *ProductMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *ProductMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *ProductMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[product.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *ProductMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[product.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *ProductMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, product.FieldDescription)
+}
+
+// SetActualPrice sets the "actual_price" field.
+func (m *ProductMutation) SetActualPrice(s string) {
+	m.actual_price = &s
+}
+
+// ActualPrice returns the value of the "actual_price" field in the mutation.
+func (m *ProductMutation) ActualPrice() (r string, exists bool) {
+	v := m.actual_price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActualPrice returns the old "actual_price" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductMutation) OldActualPrice(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActualPrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActualPrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActualPrice: %w", err)
+	}
+	return oldValue.ActualPrice, nil
+}
+
+// ClearActualPrice clears the value of the "actual_price" field.
+func (m *ProductMutation) ClearActualPrice() {
+	m.actual_price = nil
+	m.clearedFields[product.FieldActualPrice] = struct{}{}
+}
+
+// ActualPriceCleared returns if the "actual_price" field was cleared in this mutation.
+func (m *ProductMutation) ActualPriceCleared() bool {
+	_, ok := m.clearedFields[product.FieldActualPrice]
+	return ok
+}
+
+// ResetActualPrice resets all changes to the "actual_price" field.
+func (m *ProductMutation) ResetActualPrice() {
+	m.actual_price = nil
+	delete(m.clearedFields, product.FieldActualPrice)
+}
+
+// SetSellingPrice sets the "selling_price" field.
+func (m *ProductMutation) SetSellingPrice(s string) {
+	m.selling_price = &s
+}
+
+// SellingPrice returns the value of the "selling_price" field in the mutation.
+func (m *ProductMutation) SellingPrice() (r string, exists bool) {
+	v := m.selling_price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSellingPrice returns the old "selling_price" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductMutation) OldSellingPrice(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSellingPrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSellingPrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSellingPrice: %w", err)
+	}
+	return oldValue.SellingPrice, nil
+}
+
+// ClearSellingPrice clears the value of the "selling_price" field.
+func (m *ProductMutation) ClearSellingPrice() {
+	m.selling_price = nil
+	m.clearedFields[product.FieldSellingPrice] = struct{}{}
+}
+
+// SellingPriceCleared returns if the "selling_price" field was cleared in this mutation.
+func (m *ProductMutation) SellingPriceCleared() bool {
+	_, ok := m.clearedFields[product.FieldSellingPrice]
+	return ok
+}
+
+// ResetSellingPrice resets all changes to the "selling_price" field.
+func (m *ProductMutation) ResetSellingPrice() {
+	m.selling_price = nil
+	delete(m.clearedFields, product.FieldSellingPrice)
+}
+
+// SetDiscount sets the "discount" field.
+func (m *ProductMutation) SetDiscount(s string) {
+	m.discount = &s
+}
+
+// Discount returns the value of the "discount" field in the mutation.
+func (m *ProductMutation) Discount() (r string, exists bool) {
+	v := m.discount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDiscount returns the old "discount" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductMutation) OldDiscount(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDiscount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDiscount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDiscount: %w", err)
+	}
+	return oldValue.Discount, nil
+}
+
+// ClearDiscount clears the value of the "discount" field.
+func (m *ProductMutation) ClearDiscount() {
+	m.discount = nil
+	m.clearedFields[product.FieldDiscount] = struct{}{}
+}
+
+// DiscountCleared returns if the "discount" field was cleared in this mutation.
+func (m *ProductMutation) DiscountCleared() bool {
+	_, ok := m.clearedFields[product.FieldDiscount]
+	return ok
+}
+
+// ResetDiscount resets all changes to the "discount" field.
+func (m *ProductMutation) ResetDiscount() {
+	m.discount = nil
+	delete(m.clearedFields, product.FieldDiscount)
+}
+
+// SetCategory sets the "category" field.
+func (m *ProductMutation) SetCategory(s string) {
+	m.category = &s
+}
+
+// Category returns the value of the "category" field in the mutation.
+func (m *ProductMutation) Category() (r string, exists bool) {
+	v := m.category
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCategory returns the old "category" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductMutation) OldCategory(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCategory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
+	}
+	return oldValue.Category, nil
+}
+
+// ResetCategory resets all changes to the "category" field.
+func (m *ProductMutation) ResetCategory() {
+	m.category = nil
+}
+
+// SetSubCategory sets the "sub_category" field.
+func (m *ProductMutation) SetSubCategory(s string) {
+	m.sub_category = &s
+}
+
+// SubCategory returns the value of the "sub_category" field in the mutation.
+func (m *ProductMutation) SubCategory() (r string, exists bool) {
+	v := m.sub_category
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubCategory returns the old "sub_category" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductMutation) OldSubCategory(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubCategory is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubCategory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubCategory: %w", err)
+	}
+	return oldValue.SubCategory, nil
+}
+
+// ResetSubCategory resets all changes to the "sub_category" field.
+func (m *ProductMutation) ResetSubCategory() {
+	m.sub_category = nil
+}
+
+// SetOutOfStock sets the "out_of_stock" field.
+func (m *ProductMutation) SetOutOfStock(b bool) {
+	m.out_of_stock = &b
+}
+
+// OutOfStock returns the value of the "out_of_stock" field in the mutation.
+func (m *ProductMutation) OutOfStock() (r bool, exists bool) {
+	v := m.out_of_stock
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOutOfStock returns the old "out_of_stock" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductMutation) OldOutOfStock(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOutOfStock is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOutOfStock requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOutOfStock: %w", err)
+	}
+	return oldValue.OutOfStock, nil
+}
+
+// ResetOutOfStock resets all changes to the "out_of_stock" field.
+func (m *ProductMutation) ResetOutOfStock() {
+	m.out_of_stock = nil
+}
+
+// SetSeller sets the "seller" field.
+func (m *ProductMutation) SetSeller(s string) {
+	m.seller = &s
+}
+
+// Seller returns the value of the "seller" field in the mutation.
+func (m *ProductMutation) Seller() (r string, exists bool) {
+	v := m.seller
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeller returns the old "seller" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductMutation) OldSeller(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeller is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeller requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeller: %w", err)
+	}
+	return oldValue.Seller, nil
+}
+
+// ClearSeller clears the value of the "seller" field.
+func (m *ProductMutation) ClearSeller() {
+	m.seller = nil
+	m.clearedFields[product.FieldSeller] = struct{}{}
+}
+
+// SellerCleared returns if the "seller" field was cleared in this mutation.
+func (m *ProductMutation) SellerCleared() bool {
+	_, ok := m.clearedFields[product.FieldSeller]
+	return ok
+}
+
+// ResetSeller resets all changes to the "seller" field.
+func (m *ProductMutation) ResetSeller() {
+	m.seller = nil
+	delete(m.clearedFields, product.FieldSeller)
+}
+
+// SetAverageRating sets the "average_rating" field.
+func (m *ProductMutation) SetAverageRating(s string) {
+	m.average_rating = &s
+}
+
+// AverageRating returns the value of the "average_rating" field in the mutation.
+func (m *ProductMutation) AverageRating() (r string, exists bool) {
+	v := m.average_rating
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAverageRating returns the old "average_rating" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductMutation) OldAverageRating(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAverageRating is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAverageRating requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAverageRating: %w", err)
+	}
+	return oldValue.AverageRating, nil
+}
+
+// ClearAverageRating clears the value of the "average_rating" field.
+func (m *ProductMutation) ClearAverageRating() {
+	m.average_rating = nil
+	m.clearedFields[product.FieldAverageRating] = struct{}{}
+}
+
+// AverageRatingCleared returns if the "average_rating" field was cleared in this mutation.
+func (m *ProductMutation) AverageRatingCleared() bool {
+	_, ok := m.clearedFields[product.FieldAverageRating]
+	return ok
+}
+
+// ResetAverageRating resets all changes to the "average_rating" field.
+func (m *ProductMutation) ResetAverageRating() {
+	m.average_rating = nil
+	delete(m.clearedFields, product.FieldAverageRating)
+}
+
+// SetImages sets the "images" field.
+func (m *ProductMutation) SetImages(s []string) {
+	m.images = &s
+	m.appendimages = nil
+}
+
+// Images returns the value of the "images" field in the mutation.
+func (m *ProductMutation) Images() (r []string, exists bool) {
+	v := m.images
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldImages returns the old "images" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductMutation) OldImages(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImages is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImages requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImages: %w", err)
+	}
+	return oldValue.Images, nil
+}
+
+// AppendImages adds s to the "images" field.
+func (m *ProductMutation) AppendImages(s []string) {
+	m.appendimages = append(m.appendimages, s...)
+}
+
+// AppendedImages returns the list of values that were appended to the "images" field in this mutation.
+func (m *ProductMutation) AppendedImages() ([]string, bool) {
+	if len(m.appendimages) == 0 {
+		return nil, false
+	}
+	return m.appendimages, true
+}
+
+// ClearImages clears the value of the "images" field.
+func (m *ProductMutation) ClearImages() {
+	m.images = nil
+	m.appendimages = nil
+	m.clearedFields[product.FieldImages] = struct{}{}
+}
+
+// ImagesCleared returns if the "images" field was cleared in this mutation.
+func (m *ProductMutation) ImagesCleared() bool {
+	_, ok := m.clearedFields[product.FieldImages]
+	return ok
+}
+
+// ResetImages resets all changes to the "images" field.
+func (m *ProductMutation) ResetImages() {
+	m.images = nil
+	m.appendimages = nil
+	delete(m.clearedFields, product.FieldImages)
+}
+
+// SetProductDetails sets the "product_details" field.
+func (m *ProductMutation) SetProductDetails(value []map[string]string) {
+	m.product_details = &value
+	m.appendproduct_details = nil
+}
+
+// ProductDetails returns the value of the "product_details" field in the mutation.
+func (m *ProductMutation) ProductDetails() (r []map[string]string, exists bool) {
+	v := m.product_details
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProductDetails returns the old "product_details" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductMutation) OldProductDetails(ctx context.Context) (v []map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProductDetails is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProductDetails requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProductDetails: %w", err)
+	}
+	return oldValue.ProductDetails, nil
+}
+
+// AppendProductDetails adds value to the "product_details" field.
+func (m *ProductMutation) AppendProductDetails(value []map[string]string) {
+	m.appendproduct_details = append(m.appendproduct_details, value...)
+}
+
+// AppendedProductDetails returns the list of values that were appended to the "product_details" field in this mutation.
+func (m *ProductMutation) AppendedProductDetails() ([]map[string]string, bool) {
+	if len(m.appendproduct_details) == 0 {
+		return nil, false
+	}
+	return m.appendproduct_details, true
+}
+
+// ClearProductDetails clears the value of the "product_details" field.
+func (m *ProductMutation) ClearProductDetails() {
+	m.product_details = nil
+	m.appendproduct_details = nil
+	m.clearedFields[product.FieldProductDetails] = struct{}{}
+}
+
+// ProductDetailsCleared returns if the "product_details" field was cleared in this mutation.
+func (m *ProductMutation) ProductDetailsCleared() bool {
+	_, ok := m.clearedFields[product.FieldProductDetails]
+	return ok
+}
+
+// ResetProductDetails resets all changes to the "product_details" field.
+func (m *ProductMutation) ResetProductDetails() {
+	m.product_details = nil
+	m.appendproduct_details = nil
+	delete(m.clearedFields, product.FieldProductDetails)
+}
+
+// SetURL sets the "url" field.
+func (m *ProductMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the value of the "url" field in the mutation.
+func (m *ProductMutation) URL() (r string, exists bool) {
+	v := m.url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURL returns the old "url" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductMutation) OldURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
+}
+
+// ClearURL clears the value of the "url" field.
+func (m *ProductMutation) ClearURL() {
+	m.url = nil
+	m.clearedFields[product.FieldURL] = struct{}{}
+}
+
+// URLCleared returns if the "url" field was cleared in this mutation.
+func (m *ProductMutation) URLCleared() bool {
+	_, ok := m.clearedFields[product.FieldURL]
+	return ok
+}
+
+// ResetURL resets all changes to the "url" field.
+func (m *ProductMutation) ResetURL() {
+	m.url = nil
+	delete(m.clearedFields, product.FieldURL)
+}
+
+// SetPid sets the "pid" field.
+func (m *ProductMutation) SetPid(s string) {
+	m.pid = &s
+}
+
+// Pid returns the value of the "pid" field in the mutation.
+func (m *ProductMutation) Pid() (r string, exists bool) {
+	v := m.pid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPid returns the old "pid" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductMutation) OldPid(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPid is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPid requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPid: %w", err)
+	}
+	return oldValue.Pid, nil
+}
+
+// ResetPid resets all changes to the "pid" field.
+func (m *ProductMutation) ResetPid() {
+	m.pid = nil
+}
+
+// SetStyleCode sets the "style_code" field.
+func (m *ProductMutation) SetStyleCode(s string) {
+	m.style_code = &s
+}
+
+// StyleCode returns the value of the "style_code" field in the mutation.
+func (m *ProductMutation) StyleCode() (r string, exists bool) {
+	v := m.style_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStyleCode returns the old "style_code" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductMutation) OldStyleCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStyleCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStyleCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStyleCode: %w", err)
+	}
+	return oldValue.StyleCode, nil
+}
+
+// ClearStyleCode clears the value of the "style_code" field.
+func (m *ProductMutation) ClearStyleCode() {
+	m.style_code = nil
+	m.clearedFields[product.FieldStyleCode] = struct{}{}
+}
+
+// StyleCodeCleared returns if the "style_code" field was cleared in this mutation.
+func (m *ProductMutation) StyleCodeCleared() bool {
+	_, ok := m.clearedFields[product.FieldStyleCode]
+	return ok
+}
+
+// ResetStyleCode resets all changes to the "style_code" field.
+func (m *ProductMutation) ResetStyleCode() {
+	m.style_code = nil
+	delete(m.clearedFields, product.FieldStyleCode)
+}
+
+// SetCrawledAt sets the "crawled_at" field.
+func (m *ProductMutation) SetCrawledAt(t time.Time) {
+	m.crawled_at = &t
+}
+
+// CrawledAt returns the value of the "crawled_at" field in the mutation.
+func (m *ProductMutation) CrawledAt() (r time.Time, exists bool) {
+	v := m.crawled_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCrawledAt returns the old "crawled_at" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductMutation) OldCrawledAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCrawledAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCrawledAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCrawledAt: %w", err)
+	}
+	return oldValue.CrawledAt, nil
+}
+
+// ClearCrawledAt clears the value of the "crawled_at" field.
+func (m *ProductMutation) ClearCrawledAt() {
+	m.crawled_at = nil
+	m.clearedFields[product.FieldCrawledAt] = struct{}{}
+}
+
+// CrawledAtCleared returns if the "crawled_at" field was cleared in this mutation.
+func (m *ProductMutation) CrawledAtCleared() bool {
+	_, ok := m.clearedFields[product.FieldCrawledAt]
+	return ok
+}
+
+// ResetCrawledAt resets all changes to the "crawled_at" field.
+func (m *ProductMutation) ResetCrawledAt() {
+	m.crawled_at = nil
+	delete(m.clearedFields, product.FieldCrawledAt)
+}
+
+// SetEmbedding sets the "embedding" field.
+func (m *ProductMutation) SetEmbedding(f []float32) {
+	m.embedding = &f
+	m.appendembedding = nil
+}
+
+// Embedding returns the value of the "embedding" field in the mutation.
+func (m *ProductMutation) Embedding() (r []float32, exists bool) {
+	v := m.embedding
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmbedding returns the old "embedding" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductMutation) OldEmbedding(ctx context.Context) (v []float32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmbedding is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmbedding requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmbedding: %w", err)
+	}
+	return oldValue.Embedding, nil
+}
+
+// AppendEmbedding adds f to the "embedding" field.
+func (m *ProductMutation) AppendEmbedding(f []float32) {
+	m.appendembedding = append(m.appendembedding, f...)
+}
+
+// AppendedEmbedding returns the list of values that were appended to the "embedding" field in this mutation.
+func (m *ProductMutation) AppendedEmbedding() ([]float32, bool) {
+	if len(m.appendembedding) == 0 {
+		return nil, false
+	}
+	return m.appendembedding, true
+}
+
+// ClearEmbedding clears the value of the "embedding" field.
+func (m *ProductMutation) ClearEmbedding() {
+	m.embedding = nil
+	m.appendembedding = nil
+	m.clearedFields[product.FieldEmbedding] = struct{}{}
+}
+
+// EmbeddingCleared returns if the "embedding" field was cleared in this mutation.
+func (m *ProductMutation) EmbeddingCleared() bool {
+	_, ok := m.clearedFields[product.FieldEmbedding]
+	return ok
+}
+
+// ResetEmbedding resets all changes to the "embedding" field.
+func (m *ProductMutation) ResetEmbedding() {
+	m.embedding = nil
+	m.appendembedding = nil
+	delete(m.clearedFields, product.FieldEmbedding)
+}
+
+// SetEmbeddingModel sets the "embedding_model" field.
+func (m *ProductMutation) SetEmbeddingModel(s string) {
+	m.embedding_model = &s
+}
+
+// EmbeddingModel returns the value of the "embedding_model" field in the mutation.
+func (m *ProductMutation) EmbeddingModel() (r string, exists bool) {
+	v := m.embedding_model
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmbeddingModel returns the old "embedding_model" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductMutation) OldEmbeddingModel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmbeddingModel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmbeddingModel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmbeddingModel: %w", err)
+	}
+	return oldValue.EmbeddingModel, nil
+}
+
+// ClearEmbeddingModel clears the value of the "embedding_model" field.
+func (m *ProductMutation) ClearEmbeddingModel() {
+	m.embedding_model = nil
+	m.clearedFields[product.FieldEmbeddingModel] = struct{}{}
+}
+
+// EmbeddingModelCleared returns if the "embedding_model" field was cleared in this mutation.
+func (m *ProductMutation) EmbeddingModelCleared() bool {
+	_, ok := m.clearedFields[product.FieldEmbeddingModel]
+	return ok
+}
+
+// ResetEmbeddingModel resets all changes to the "embedding_model" field.
+func (m *ProductMutation) ResetEmbeddingModel() {
+	m.embedding_model = nil
+	delete(m.clearedFields, product.FieldEmbeddingModel)
+}
+
+// SetEmbeddingHash sets the "embedding_hash" field.
+func (m *ProductMutation) SetEmbeddingHash(s string) {
+	m.embedding_hash = &s
+}
+
+// EmbeddingHash returns the value of the "embedding_hash" field in the mutation.
+func (m *ProductMutation) EmbeddingHash() (r string, exists bool) {
+	v := m.embedding_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmbeddingHash returns the old "embedding_hash" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductMutation) OldEmbeddingHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmbeddingHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmbeddingHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmbeddingHash: %w", err)
+	}
+	return oldValue.EmbeddingHash, nil
+}
+
+// ClearEmbeddingHash clears the value of the "embedding_hash" field.
+func (m *ProductMutation) ClearEmbeddingHash() {
+	m.embedding_hash = nil
+	m.clearedFields[product.FieldEmbeddingHash] = struct{}{}
+}
+
+// EmbeddingHashCleared returns if the "embedding_hash" field was cleared in this mutation.
+func (m *ProductMutation) EmbeddingHashCleared() bool {
+	_, ok := m.clearedFields[product.FieldEmbeddingHash]
+	return ok
+}
+
+// ResetEmbeddingHash resets all changes to the "embedding_hash" field.
+func (m *ProductMutation) ResetEmbeddingHash() {
+	m.embedding_hash = nil
+	delete(m.clearedFields, product.FieldEmbeddingHash)
+}
+
+// SetContentHash sets the "content_hash" field.
+func (m *ProductMutation) SetContentHash(s string) {
+	m.content_hash = &s
+}
+
+// ContentHash returns the value of the "content_hash" field in the mutation.
+func (m *ProductMutation) ContentHash() (r string, exists bool) {
+	v := m.content_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContentHash returns the old "content_hash" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductMutation) OldContentHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContentHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContentHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContentHash: %w", err)
+	}
+	return oldValue.ContentHash, nil
+}
+
+// ClearContentHash clears the value of the "content_hash" field.
+func (m *ProductMutation) ClearContentHash() {
+	m.content_hash = nil
+	m.clearedFields[product.FieldContentHash] = struct{}{}
+}
+
+// ContentHashCleared returns if the "content_hash" field was cleared in this mutation.
+func (m *ProductMutation) ContentHashCleared() bool {
+	_, ok := m.clearedFields[product.FieldContentHash]
+	return ok
+}
+
+// ResetContentHash resets all changes to the "content_hash" field.
+func (m *ProductMutation) ResetContentHash() {
+	m.content_hash = nil
+	delete(m.clearedFields, product.FieldContentHash)
+}
+
+// SetSearchKeywords sets the "search_keywords" field.
+func (m *ProductMutation) SetSearchKeywords(s []string) {
+	m.search_keywords = &s
+	m.appendsearch_keywords = nil
+}
+
+// SearchKeywords returns the value of the "search_keywords" field in the mutation.
+func (m *ProductMutation) SearchKeywords() (r []string, exists bool) {
+	v := m.search_keywords
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSearchKeywords returns the old "search_keywords" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductMutation) OldSearchKeywords(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSearchKeywords is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSearchKeywords requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSearchKeywords: %w", err)
+	}
+	return oldValue.SearchKeywords, nil
+}
+
+// AppendSearchKeywords adds s to the "search_keywords" field.
+func (m *ProductMutation) AppendSearchKeywords(s []string) {
+	m.appendsearch_keywords = append(m.appendsearch_keywords, s...)
+}
+
+// AppendedSearchKeywords returns the list of values that were appended to the "search_keywords" field in this mutation.
+func (m *ProductMutation) AppendedSearchKeywords() ([]string, bool) {
+	if len(m.appendsearch_keywords) == 0 {
+		return nil, false
+	}
+	return m.appendsearch_keywords, true
+}
+
+// ClearSearchKeywords clears the value of the "search_keywords" field.
+func (m *ProductMutation) ClearSearchKeywords() {
+	m.search_keywords = nil
+	m.appendsearch_keywords = nil
+	m.clearedFields[product.FieldSearchKeywords] = struct{}{}
+}
+
+// SearchKeywordsCleared returns if the "search_keywords" field was cleared in this mutation.
+func (m *ProductMutation) SearchKeywordsCleared() bool {
+	_, ok := m.clearedFields[product.FieldSearchKeywords]
+	return ok
+}
+
+// ResetSearchKeywords resets all changes to the "search_keywords" field.
+func (m *ProductMutation) ResetSearchKeywords() {
+	m.search_keywords = nil
+	m.appendsearch_keywords = nil
+	delete(m.clearedFields, product.FieldSearchKeywords)
+}
+
+// SetSearchText sets the "search_text" field.
+func (m *ProductMutation) SetSearchText(s string) {
+	m.search_text = &s
+}
+
+// SearchText returns the value of the "search_text" field in the mutation.
+func (m *ProductMutation) SearchText() (r string, exists bool) {
+	v := m.search_text
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSearchText returns the old "search_text" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductMutation) OldSearchText(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSearchText is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSearchText requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSearchText: %w", err)
+	}
+	return oldValue.SearchText, nil
+}
+
+// ClearSearchText clears the value of the "search_text" field.
+func (m *ProductMutation) ClearSearchText() {
+	m.search_text = nil
+	m.clearedFields[product.FieldSearchText] = struct{}{}
+}
+
+// SearchTextCleared returns if the "search_text" field was cleared in this mutation.
+func (m *ProductMutation) SearchTextCleared() bool {
+	_, ok := m.clearedFields[product.FieldSearchText]
+	return ok
+}
+
+// ResetSearchText resets all changes to the "search_text" field.
+func (m *ProductMutation) ResetSearchText() {
+	m.search_text = nil
+	delete(m.clearedFields, product.FieldSearchText)
+}
+
+// SetFeatured sets the "featured" field.
+func (m *ProductMutation) SetFeatured(b bool) {
+	m.featured = &b
+}
+
+// Featured returns the value of the "featured" field in the mutation.
+func (m *ProductMutation) Featured() (r bool, exists bool) {
+	v := m.featured
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFeatured returns the old "featured" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductMutation) OldFeatured(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFeatured is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFeatured requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFeatured: %w", err)
+	}
+	return oldValue.Featured, nil
+}
+
+// ResetFeatured resets all changes to the "featured" field.
+func (m *ProductMutation) ResetFeatured() {
+	m.featured = nil
+}
+
+// SetViewCount sets the "view_count" field.
+func (m *ProductMutation) SetViewCount(i int) {
+	m.view_count = &i
+	m.addview_count = nil
+}
+
+// ViewCount returns the value of the "view_count" field in the mutation.
+func (m *ProductMutation) ViewCount() (r int, exists bool) {
+	v := m.view_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldViewCount returns the old "view_count" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductMutation) OldViewCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldViewCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldViewCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldViewCount: %w", err)
+	}
+	return oldValue.ViewCount, nil
+}
+
+// AddViewCount adds i to the "view_count" field.
+func (m *ProductMutation) AddViewCount(i int) {
+	if m.addview_count != nil {
+		*m.addview_count += i
+	} else {
+		m.addview_count = &i
+	}
+}
+
+// AddedViewCount returns the value that was added to the "view_count" field in this mutation.
+func (m *ProductMutation) AddedViewCount() (r int, exists bool) {
+	v := m.addview_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetViewCount resets all changes to the "view_count" field.
+func (m *ProductMutation) ResetViewCount() {
+	m.view_count = nil
+	m.addview_count = nil
+}
+
+// SetClickCount sets the "click_count" field.
+func (m *ProductMutation) SetClickCount(i int) {
+	m.click_count = &i
+	m.addclick_count = nil
+}
+
+// ClickCount returns the value of the "click_count" field in the mutation.
+func (m *ProductMutation) ClickCount() (r int, exists bool) {
+	v := m.click_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClickCount returns the old "click_count" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductMutation) OldClickCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClickCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClickCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClickCount: %w", err)
+	}
+	return oldValue.ClickCount, nil
+}
+
+// AddClickCount adds i to the "click_count" field.
+func (m *ProductMutation) AddClickCount(i int) {
+	if m.addclick_count != nil {
+		*m.addclick_count += i
+	} else {
+		m.addclick_count = &i
+	}
+}
+
+// AddedClickCount returns the value that was added to the "click_count" field in this mutation.
+func (m *ProductMutation) AddedClickCount() (r int, exists bool) {
+	v := m.addclick_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetClickCount resets all changes to the "click_count" field.
+func (m *ProductMutation) ResetClickCount() {
+	m.click_count = nil
+	m.addclick_count = nil
+}
+
+// SetPriceNumeric sets the "price_numeric" field.
+func (m *ProductMutation) SetPriceNumeric(i int) {
+	m.price_numeric = &i
+	m.addprice_numeric = nil
+}
+
+// PriceNumeric returns the value of the "price_numeric" field in the mutation.
+func (m *ProductMutation) PriceNumeric() (r int, exists bool) {
+	v := m.price_numeric
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPriceNumeric returns the old "price_numeric" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductMutation) OldPriceNumeric(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPriceNumeric is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPriceNumeric requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPriceNumeric: %w", err)
+	}
+	return oldValue.PriceNumeric, nil
+}
+
+// AddPriceNumeric adds i to the "price_numeric" field.
+func (m *ProductMutation) AddPriceNumeric(i int) {
+	if m.addprice_numeric != nil {
+		*m.addprice_numeric += i
+	} else {
+		m.addprice_numeric = &i
+	}
+}
+
+// AddedPriceNumeric returns the value that was added to the "price_numeric" field in this mutation.
+func (m *ProductMutation) AddedPriceNumeric() (r int, exists bool) {
+	v := m.addprice_numeric
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearPriceNumeric clears the value of the "price_numeric" field.
+func (m *ProductMutation) ClearPriceNumeric() {
+	m.price_numeric = nil
+	m.addprice_numeric = nil
+	m.clearedFields[product.FieldPriceNumeric] = struct{}{}
+}
+
+// PriceNumericCleared returns if the "price_numeric" field was cleared in this mutation.
+func (m *ProductMutation) PriceNumericCleared() bool {
+	_, ok := m.clearedFields[product.FieldPriceNumeric]
+	return ok
+}
+
+// ResetPriceNumeric resets all changes to the "price_numeric" field.
+func (m *ProductMutation) ResetPriceNumeric() {
+	m.price_numeric = nil
+	m.addprice_numeric = nil
+	delete(m.clearedFields, product.FieldPriceNumeric)
+}
+
+// SetRatingNumeric sets the "rating_numeric" field.
+func (m *ProductMutation) SetRatingNumeric(f float64) {
+	m.rating_numeric = &f
+	m.addrating_numeric = nil
+}
+
+// RatingNumeric returns the value of the "rating_numeric" field in the mutation.
+func (m *ProductMutation) RatingNumeric() (r float64, exists bool) {
+	v := m.rating_numeric
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRatingNumeric returns the old "rating_numeric" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductMutation) OldRatingNumeric(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRatingNumeric is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRatingNumeric requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRatingNumeric: %w", err)
+	}
+	return oldValue.RatingNumeric, nil
+}
+
+// AddRatingNumeric adds f to the "rating_numeric" field.
+func (m *ProductMutation) AddRatingNumeric(f float64) {
+	if m.addrating_numeric != nil {
+		*m.addrating_numeric += f
+	} else {
+		m.addrating_numeric = &f
+	}
+}
+
+// AddedRatingNumeric returns the value that was added to the "rating_numeric" field in this mutation.
+func (m *ProductMutation) AddedRatingNumeric() (r float64, exists bool) {
+	v := m.addrating_numeric
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearRatingNumeric clears the value of the "rating_numeric" field.
+func (m *ProductMutation) ClearRatingNumeric() {
+	m.rating_numeric = nil
+	m.addrating_numeric = nil
+	m.clearedFields[product.FieldRatingNumeric] = struct{}{}
+}
+
+// RatingNumericCleared returns if the "rating_numeric" field was cleared in this mutation.
+func (m *ProductMutation) RatingNumericCleared() bool {
+	_, ok := m.clearedFields[product.FieldRatingNumeric]
+	return ok
+}
+
+// ResetRatingNumeric resets all changes to the "rating_numeric" field.
+func (m *ProductMutation) ResetRatingNumeric() {
+	m.rating_numeric = nil
+	m.addrating_numeric = nil
+	delete(m.clearedFields, product.FieldRatingNumeric)
+}
+
+// SetActualPriceNumeric sets the "actual_price_numeric" field.
+func (m *ProductMutation) SetActualPriceNumeric(i int) {
+	m.actual_price_numeric = &i
+	m.addactual_price_numeric = nil
+}
+
+// ActualPriceNumeric returns the value of the "actual_price_numeric" field in the mutation.
+func (m *ProductMutation) ActualPriceNumeric() (r int, exists bool) {
+	v := m.actual_price_numeric
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActualPriceNumeric returns the old "actual_price_numeric" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductMutation) OldActualPriceNumeric(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActualPriceNumeric is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActualPriceNumeric requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActualPriceNumeric: %w", err)
+	}
+	return oldValue.ActualPriceNumeric, nil
+}
+
+// AddActualPriceNumeric adds i to the "actual_price_numeric" field.
+func (m *ProductMutation) AddActualPriceNumeric(i int) {
+	if m.addactual_price_numeric != nil {
+		*m.addactual_price_numeric += i
+	} else {
+		m.addactual_price_numeric = &i
+	}
+}
+
+// AddedActualPriceNumeric returns the value that was added to the "actual_price_numeric" field in this mutation.
+func (m *ProductMutation) AddedActualPriceNumeric() (r int, exists bool) {
+	v := m.addactual_price_numeric
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearActualPriceNumeric clears the value of the "actual_price_numeric" field.
+func (m *ProductMutation) ClearActualPriceNumeric() {
+	m.actual_price_numeric = nil
+	m.addactual_price_numeric = nil
+	m.clearedFields[product.FieldActualPriceNumeric] = struct{}{}
+}
+
+// ActualPriceNumericCleared returns if the "actual_price_numeric" field was cleared in this mutation.
+func (m *ProductMutation) ActualPriceNumericCleared() bool {
+	_, ok := m.clearedFields[product.FieldActualPriceNumeric]
+	return ok
+}
+
+// ResetActualPriceNumeric resets all changes to the "actual_price_numeric" field.
+func (m *ProductMutation) ResetActualPriceNumeric() {
+	m.actual_price_numeric = nil
+	m.addactual_price_numeric = nil
+	delete(m.clearedFields, product.FieldActualPriceNumeric)
+}
+
+// SetDiscountPercent sets the "discount_percent" field.
+func (m *ProductMutation) SetDiscountPercent(f float64) {
+	m.discount_percent = &f
+	m.adddiscount_percent = nil
+}
+
+// DiscountPercent returns the value of the "discount_percent" field in the mutation.
+func (m *ProductMutation) DiscountPercent() (r float64, exists bool) {
+	v := m.discount_percent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDiscountPercent returns the old "discount_percent" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductMutation) OldDiscountPercent(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDiscountPercent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDiscountPercent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDiscountPercent: %w", err)
+	}
+	return oldValue.DiscountPercent, nil
+}
+
+// AddDiscountPercent adds f to the "discount_percent" field.
+func (m *ProductMutation) AddDiscountPercent(f float64) {
+	if m.adddiscount_percent != nil {
+		*m.adddiscount_percent += f
+	} else {
+		m.adddiscount_percent = &f
+	}
+}
+
+// AddedDiscountPercent returns the value that was added to the "discount_percent" field in this mutation.
+func (m *ProductMutation) AddedDiscountPercent() (r float64, exists bool) {
+	v := m.adddiscount_percent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDiscountPercent clears the value of the "discount_percent" field.
+func (m *ProductMutation) ClearDiscountPercent() {
+	m.discount_percent = nil
+	m.adddiscount_percent = nil
+	m.clearedFields[product.FieldDiscountPercent] = struct{}{}
+}
+
+// DiscountPercentCleared returns if the "discount_percent" field was cleared in this mutation.
+func (m *ProductMutation) DiscountPercentCleared() bool {
+	_, ok := m.clearedFields[product.FieldDiscountPercent]
+	return ok
+}
+
+// ResetDiscountPercent resets all changes to the "discount_percent" field.
+func (m *ProductMutation) ResetDiscountPercent() {
+	m.discount_percent = nil
+	m.adddiscount_percent = nil
+	delete(m.clearedFields, product.FieldDiscountPercent)
+}
+
+// AddAttributeIDs adds the "attributes" edge to the ProductAttribute entity by ids.
+func (m *ProductMutation) AddAttributeIDs(ids ...int) {
+	if m.attributes == nil {
+		m.attributes = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.attributes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAttributes clears the "attributes" edge to the ProductAttribute entity.
+func (m *ProductMutation) ClearAttributes() {
+	m.clearedattributes = true
+}
+
+// AttributesCleared reports if the "attributes" edge to the ProductAttribute entity was cleared.
+func (m *ProductMutation) AttributesCleared() bool {
+	return m.clearedattributes
+}
+
+// RemoveAttributeIDs removes the "attributes" edge to the ProductAttribute entity by IDs.
+func (m *ProductMutation) RemoveAttributeIDs(ids ...int) {
+	if m.removedattributes == nil {
+		m.removedattributes = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.attributes, ids[i])
+		m.removedattributes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAttributes returns the removed IDs of the "attributes" edge to the ProductAttribute entity.
+func (m *ProductMutation) RemovedAttributesIDs() (ids []int) {
+	for id := range m.removedattributes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AttributesIDs returns the "attributes" edge IDs in the mutation.
+func (m *ProductMutation) AttributesIDs() (ids []int) {
+	for id := range m.attributes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAttributes resets all changes to the "attributes" edge.
+func (m *ProductMutation) ResetAttributes() {
+	m.attributes = nil
+	m.clearedattributes = false
+	m.removedattributes = nil
+}
+
+// Where appends a list predicates to the ProductMutation builder.
+func (m *ProductMutation) Where(ps ...predicate.Product) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ProductMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ProductMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Product, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ProductMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ProductMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Product).
+func (m *ProductMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ProductMutation) Fields() []string {
+	fields := make([]string, 0, 33)
+	if m.create_time != nil {
+		fields = append(fields, product.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, product.FieldUpdateTime)
+	}
+	if m.original_id != nil {
+		fields = append(fields, product.FieldOriginalID)
+	}
+	if m.title != nil {
+		fields = append(fields, product.FieldTitle)
+	}
+	if m.brand != nil {
+		fields = append(fields, product.FieldBrand)
+	}
+	if m.description != nil {
+		fields = append(fields, product.FieldDescription)
+	}
+	if m.actual_price != nil {
+		fields = append(fields, product.FieldActualPrice)
+	}
+	if m.selling_price != nil {
+		fields = append(fields, product.FieldSellingPrice)
+	}
+	if m.discount != nil {
+		fields = append(fields, product.FieldDiscount)
+	}
+	if m.category != nil {
+		fields = append(fields, product.FieldCategory)
+	}
+	if m.sub_category != nil {
+		fields = append(fields, product.FieldSubCategory)
+	}
+	if m.out_of_stock != nil {
+		fields = append(fields, product.FieldOutOfStock)
+	}
+	if m.seller != nil {
+		fields = append(fields, product.FieldSeller)
+	}
+	if m.average_rating != nil {
+		fields = append(fields, product.FieldAverageRating)
+	}
+	if m.images != nil {
+		fields = append(fields, product.FieldImages)
+	}
+	if m.product_details != nil {
+		fields = append(fields, product.FieldProductDetails)
+	}
+	if m.url != nil {
+		fields = append(fields, product.FieldURL)
+	}
+	if m.pid != nil {
+		fields = append(fields, product.FieldPid)
+	}
+	if m.style_code != nil {
+		fields = append(fields, product.FieldStyleCode)
+	}
+	if m.crawled_at != nil {
+		fields = append(fields, product.FieldCrawledAt)
+	}
+	if m.embedding != nil {
+		fields = append(fields, product.FieldEmbedding)
+	}
+	if m.embedding_model != nil {
+		fields = append(fields, product.FieldEmbeddingModel)
+	}
+	if m.embedding_hash != nil {
+		fields = append(fields, product.FieldEmbeddingHash)
+	}
+	if m.content_hash != nil {
+		fields = append(fields, product.FieldContentHash)
+	}
+	if m.search_keywords != nil {
+		fields = append(fields, product.FieldSearchKeywords)
+	}
+	if m.search_text != nil {
+		fields = append(fields, product.FieldSearchText)
+	}
+	if m.featured != nil {
+		fields = append(fields, product.FieldFeatured)
+	}
+	if m.view_count != nil {
+		fields = append(fields, product.FieldViewCount)
+	}
+	if m.click_count != nil {
+		fields = append(fields, product.FieldClickCount)
+	}
+	if m.price_numeric != nil {
+		fields = append(fields, product.FieldPriceNumeric)
+	}
+	if m.rating_numeric != nil {
+		fields = append(fields, product.FieldRatingNumeric)
+	}
+	if m.actual_price_numeric != nil {
+		fields = append(fields, product.FieldActualPriceNumeric)
+	}
+	if m.discount_percent != nil {
+		fields = append(fields, product.FieldDiscountPercent)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ProductMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case product.FieldCreateTime:
+		return m.CreateTime()
+	case product.FieldUpdateTime:
+		return m.UpdateTime()
+	case product.FieldOriginalID:
+		return m.OriginalID()
+	case product.FieldTitle:
+		return m.Title()
+	case product.FieldBrand:
+		return m.Brand()
+	case product.FieldDescription:
+		return m.Description()
+	case product.FieldActualPrice:
+		return m.ActualPrice()
+	case product.FieldSellingPrice:
+		return m.SellingPrice()
+	case product.FieldDiscount:
+		return m.Discount()
+	case product.FieldCategory:
+		return m.Category()
+	case product.FieldSubCategory:
+		return m.SubCategory()
+	case product.FieldOutOfStock:
+		return m.OutOfStock()
+	case product.FieldSeller:
+		return m.Seller()
+	case product.FieldAverageRating:
+		return m.AverageRating()
+	case product.FieldImages:
+		return m.Images()
+	case product.FieldProductDetails:
+		return m.ProductDetails()
+	case product.FieldURL:
+		return m.URL()
+	case product.FieldPid:
+		return m.Pid()
+	case product.FieldStyleCode:
+		return m.StyleCode()
+	case product.FieldCrawledAt:
+		return m.CrawledAt()
+	case product.FieldEmbedding:
+		return m.Embedding()
+	case product.FieldEmbeddingModel:
+		return m.EmbeddingModel()
+	case product.FieldEmbeddingHash:
+		return m.EmbeddingHash()
+	case product.FieldContentHash:
+		return m.ContentHash()
+	case product.FieldSearchKeywords:
+		return m.SearchKeywords()
+	case product.FieldSearchText:
+		return m.SearchText()
+	case product.FieldFeatured:
+		return m.Featured()
+	case product.FieldViewCount:
+		return m.ViewCount()
+	case product.FieldClickCount:
+		return m.ClickCount()
+	case product.FieldPriceNumeric:
+		return m.PriceNumeric()
+	case product.FieldRatingNumeric:
+		return m.RatingNumeric()
+	case product.FieldActualPriceNumeric:
+		return m.ActualPriceNumeric()
+	case product.FieldDiscountPercent:
+		return m.DiscountPercent()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ProductMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case product.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case product.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case product.FieldOriginalID:
+		return m.OldOriginalID(ctx)
+	case product.FieldTitle:
+		return m.OldTitle(ctx)
+	case product.FieldBrand:
+		return m.OldBrand(ctx)
+	case product.FieldDescription:
+		return m.OldDescription(ctx)
+	case product.FieldActualPrice:
+		return m.OldActualPrice(ctx)
+	case product.FieldSellingPrice:
+		return m.OldSellingPrice(ctx)
+	case product.FieldDiscount:
+		return m.OldDiscount(ctx)
+	case product.FieldCategory:
+		return m.OldCategory(ctx)
+	case product.FieldSubCategory:
+		return m.OldSubCategory(ctx)
+	case product.FieldOutOfStock:
+		return m.OldOutOfStock(ctx)
+	case product.FieldSeller:
+		return m.OldSeller(ctx)
+	case product.FieldAverageRating:
+		return m.OldAverageRating(ctx)
+	case product.FieldImages:
+		return m.OldImages(ctx)
+	case product.FieldProductDetails:
+		return m.OldProductDetails(ctx)
+	case product.FieldURL:
+		return m.OldURL(ctx)
+	case product.FieldPid:
+		return m.OldPid(ctx)
+	case product.FieldStyleCode:
+		return m.OldStyleCode(ctx)
+	case product.FieldCrawledAt:
+		return m.OldCrawledAt(ctx)
+	case product.FieldEmbedding:
+		return m.OldEmbedding(ctx)
+	case product.FieldEmbeddingModel:
+		return m.OldEmbeddingModel(ctx)
+	case product.FieldEmbeddingHash:
+		return m.OldEmbeddingHash(ctx)
+	case product.FieldContentHash:
+		return m.OldContentHash(ctx)
+	case product.FieldSearchKeywords:
+		return m.OldSearchKeywords(ctx)
+	case product.FieldSearchText:
+		return m.OldSearchText(ctx)
+	case product.FieldFeatured:
+		return m.OldFeatured(ctx)
+	case product.FieldViewCount:
+		return m.OldViewCount(ctx)
+	case product.FieldClickCount:
+		return m.OldClickCount(ctx)
+	case product.FieldPriceNumeric:
+		return m.OldPriceNumeric(ctx)
+	case product.FieldRatingNumeric:
+		return m.OldRatingNumeric(ctx)
+	case product.FieldActualPriceNumeric:
+		return m.OldActualPriceNumeric(ctx)
+	case product.FieldDiscountPercent:
+		return m.OldDiscountPercent(ctx)
+	}
+	return nil, fmt.Errorf("unknown Product field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProductMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case product.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case product.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case product.FieldOriginalID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOriginalID(v)
+		return nil
+	case product.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case product.FieldBrand:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBrand(v)
+		return nil
+	case product.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case product.FieldActualPrice:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActualPrice(v)
+		return nil
+	case product.FieldSellingPrice:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSellingPrice(v)
+		return nil
+	case product.FieldDiscount:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDiscount(v)
+		return nil
+	case product.FieldCategory:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCategory(v)
+		return nil
+	case product.FieldSubCategory:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubCategory(v)
+		return nil
+	case product.FieldOutOfStock:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOutOfStock(v)
+		return nil
+	case product.FieldSeller:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeller(v)
+		return nil
+	case product.FieldAverageRating:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAverageRating(v)
+		return nil
+	case product.FieldImages:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImages(v)
+		return nil
+	case product.FieldProductDetails:
+		v, ok := value.([]map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProductDetails(v)
+		return nil
+	case product.FieldURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
+		return nil
+	case product.FieldPid:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPid(v)
+		return nil
+	case product.FieldStyleCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStyleCode(v)
+		return nil
+	case product.FieldCrawledAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCrawledAt(v)
+		return nil
+	case product.FieldEmbedding:
+		v, ok := value.([]float32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmbedding(v)
+		return nil
+	case product.FieldEmbeddingModel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmbeddingModel(v)
+		return nil
+	case product.FieldEmbeddingHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmbeddingHash(v)
+		return nil
+	case product.FieldContentHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentHash(v)
+		return nil
+	case product.FieldSearchKeywords:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSearchKeywords(v)
+		return nil
+	case product.FieldSearchText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSearchText(v)
+		return nil
+	case product.FieldFeatured:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFeatured(v)
+		return nil
+	case product.FieldViewCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetViewCount(v)
+		return nil
+	case product.FieldClickCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClickCount(v)
+		return nil
+	case product.FieldPriceNumeric:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPriceNumeric(v)
+		return nil
+	case product.FieldRatingNumeric:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRatingNumeric(v)
+		return nil
+	case product.FieldActualPriceNumeric:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActualPriceNumeric(v)
+		return nil
+	case product.FieldDiscountPercent:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDiscountPercent(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Product field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ProductMutation) AddedFields() []string {
+	var fields []string
+	if m.addview_count != nil {
+		fields = append(fields, product.FieldViewCount)
+	}
+	if m.addclick_count != nil {
+		fields = append(fields, product.FieldClickCount)
+	}
+	if m.addprice_numeric != nil {
+		fields = append(fields, product.FieldPriceNumeric)
+	}
+	if m.addrating_numeric != nil {
+		fields = append(fields, product.FieldRatingNumeric)
+	}
+	if m.addactual_price_numeric != nil {
+		fields = append(fields, product.FieldActualPriceNumeric)
+	}
+	if m.adddiscount_percent != nil {
+		fields = append(fields, product.FieldDiscountPercent)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ProductMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case product.FieldViewCount:
+		return m.AddedViewCount()
+	case product.FieldClickCount:
+		return m.AddedClickCount()
+	case product.FieldPriceNumeric:
+		return m.AddedPriceNumeric()
+	case product.FieldRatingNumeric:
+		return m.AddedRatingNumeric()
+	case product.FieldActualPriceNumeric:
+		return m.AddedActualPriceNumeric()
+	case product.FieldDiscountPercent:
+		return m.AddedDiscountPercent()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProductMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case product.FieldViewCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddViewCount(v)
+		return nil
+	case product.FieldClickCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddClickCount(v)
+		return nil
+	case product.FieldPriceNumeric:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPriceNumeric(v)
+		return nil
+	case product.FieldRatingNumeric:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRatingNumeric(v)
+		return nil
+	case product.FieldActualPriceNumeric:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddActualPriceNumeric(v)
+		return nil
+	case product.FieldDiscountPercent:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDiscountPercent(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Product numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ProductMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(product.FieldDescription) {
+		fields = append(fields, product.FieldDescription)
+	}
+	if m.FieldCleared(product.FieldActualPrice) {
+		fields = append(fields, product.FieldActualPrice)
+	}
+	if m.FieldCleared(product.FieldSellingPrice) {
+		fields = append(fields, product.FieldSellingPrice)
+	}
+	if m.FieldCleared(product.FieldDiscount) {
+		fields = append(fields, product.FieldDiscount)
+	}
+	if m.FieldCleared(product.FieldSeller) {
+		fields = append(fields, product.FieldSeller)
+	}
+	if m.FieldCleared(product.FieldAverageRating) {
+		fields = append(fields, product.FieldAverageRating)
+	}
+	if m.FieldCleared(product.FieldImages) {
+		fields = append(fields, product.FieldImages)
+	}
+	if m.FieldCleared(product.FieldProductDetails) {
+		fields = append(fields, product.FieldProductDetails)
+	}
+	if m.FieldCleared(product.FieldURL) {
+		fields = append(fields, product.FieldURL)
+	}
+	if m.FieldCleared(product.FieldStyleCode) {
+		fields = append(fields, product.FieldStyleCode)
+	}
+	if m.FieldCleared(product.FieldCrawledAt) {
+		fields = append(fields, product.FieldCrawledAt)
+	}
+	if m.FieldCleared(product.FieldEmbedding) {
+		fields = append(fields, product.FieldEmbedding)
+	}
+	if m.FieldCleared(product.FieldEmbeddingModel) {
+		fields = append(fields, product.FieldEmbeddingModel)
+	}
+	if m.FieldCleared(product.FieldEmbeddingHash) {
+		fields = append(fields, product.FieldEmbeddingHash)
+	}
+	if m.FieldCleared(product.FieldContentHash) {
+		fields = append(fields, product.FieldContentHash)
+	}
+	if m.FieldCleared(product.FieldSearchKeywords) {
+		fields = append(fields, product.FieldSearchKeywords)
+	}
+	if m.FieldCleared(product.FieldSearchText) {
+		fields = append(fields, product.FieldSearchText)
+	}
+	if m.FieldCleared(product.FieldPriceNumeric) {
+		fields = append(fields, product.FieldPriceNumeric)
+	}
+	if m.FieldCleared(product.FieldRatingNumeric) {
+		fields = append(fields, product.FieldRatingNumeric)
+	}
+	if m.FieldCleared(product.FieldActualPriceNumeric) {
+		fields = append(fields, product.FieldActualPriceNumeric)
+	}
+	if m.FieldCleared(product.FieldDiscountPercent) {
+		fields = append(fields, product.FieldDiscountPercent)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ProductMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ProductMutation) ClearField(name string) error {
+	switch name {
+	case product.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case product.FieldActualPrice:
+		m.ClearActualPrice()
+		return nil
+	case product.FieldSellingPrice:
+		m.ClearSellingPrice()
+		return nil
+	case product.FieldDiscount:
+		m.ClearDiscount()
+		return nil
+	case product.FieldSeller:
+		m.ClearSeller()
+		return nil
+	case product.FieldAverageRating:
+		m.ClearAverageRating()
+		return nil
+	case product.FieldImages:
+		m.ClearImages()
+		return nil
+	case product.FieldProductDetails:
+		m.ClearProductDetails()
+		return nil
+	case product.FieldURL:
+		m.ClearURL()
+		return nil
+	case product.FieldStyleCode:
+		m.ClearStyleCode()
+		return nil
+	case product.FieldCrawledAt:
+		m.ClearCrawledAt()
+		return nil
+	case product.FieldEmbedding:
+		m.ClearEmbedding()
+		return nil
+	case product.FieldEmbeddingModel:
+		m.ClearEmbeddingModel()
+		return nil
+	case product.FieldEmbeddingHash:
+		m.ClearEmbeddingHash()
+		return nil
+	case product.FieldContentHash:
+		m.ClearContentHash()
+		return nil
+	case product.FieldSearchKeywords:
+		m.ClearSearchKeywords()
+		return nil
+	case product.FieldSearchText:
+		m.ClearSearchText()
+		return nil
+	case product.FieldPriceNumeric:
+		m.ClearPriceNumeric()
+		return nil
+	case product.FieldRatingNumeric:
+		m.ClearRatingNumeric()
+		return nil
+	case product.FieldActualPriceNumeric:
+		m.ClearActualPriceNumeric()
+		return nil
+	case product.FieldDiscountPercent:
+		m.ClearDiscountPercent()
+		return nil
+	}
+	return fmt.Errorf("unknown Product nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ProductMutation) ResetField(name string) error {
+	switch name {
+	case product.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case product.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case product.FieldOriginalID:
+		m.ResetOriginalID()
+		return nil
+	case product.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case product.FieldBrand:
+		m.ResetBrand()
+		return nil
+	case product.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case product.FieldActualPrice:
+		m.ResetActualPrice()
+		return nil
+	case product.FieldSellingPrice:
+		m.ResetSellingPrice()
+		return nil
+	case product.FieldDiscount:
+		m.ResetDiscount()
+		return nil
+	case product.FieldCategory:
+		m.ResetCategory()
+		return nil
+	case product.FieldSubCategory:
+		m.ResetSubCategory()
+		return nil
+	case product.FieldOutOfStock:
+		m.ResetOutOfStock()
+		return nil
+	case product.FieldSeller:
+		m.ResetSeller()
+		return nil
+	case product.FieldAverageRating:
+		m.ResetAverageRating()
+		return nil
+	case product.FieldImages:
+		m.ResetImages()
+		return nil
+	case product.FieldProductDetails:
+		m.ResetProductDetails()
+		return nil
+	case product.FieldURL:
+		m.ResetURL()
+		return nil
+	case product.FieldPid:
+		m.ResetPid()
+		return nil
+	case product.FieldStyleCode:
+		m.ResetStyleCode()
+		return nil
+	case product.FieldCrawledAt:
+		m.ResetCrawledAt()
+		return nil
+	case product.FieldEmbedding:
+		m.ResetEmbedding()
+		return nil
+	case product.FieldEmbeddingModel:
+		m.ResetEmbeddingModel()
+		return nil
+	case product.FieldEmbeddingHash:
+		m.ResetEmbeddingHash()
+		return nil
+	case product.FieldContentHash:
+		m.ResetContentHash()
+		return nil
+	case product.FieldSearchKeywords:
+		m.ResetSearchKeywords()
+		return nil
+	case product.FieldSearchText:
+		m.ResetSearchText()
+		return nil
+	case product.FieldFeatured:
+		m.ResetFeatured()
+		return nil
+	case product.FieldViewCount:
+		m.ResetViewCount()
+		return nil
+	case product.FieldClickCount:
+		m.ResetClickCount()
+		return nil
+	case product.FieldPriceNumeric:
+		m.ResetPriceNumeric()
+		return nil
+	case product.FieldRatingNumeric:
+		m.ResetRatingNumeric()
+		return nil
+	case product.FieldActualPriceNumeric:
+		m.ResetActualPriceNumeric()
+		return nil
+	case product.FieldDiscountPercent:
+		m.ResetDiscountPercent()
+		return nil
+	}
+	return fmt.Errorf("unknown Product field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ProductMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.attributes != nil {
+		edges = append(edges, product.EdgeAttributes)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ProductMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case product.EdgeAttributes:
+		ids := make([]ent.Value, 0, len(m.attributes))
+		for id := range m.attributes {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ProductMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedattributes != nil {
+		edges = append(edges, product.EdgeAttributes)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ProductMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case product.EdgeAttributes:
+		ids := make([]ent.Value, 0, len(m.removedattributes))
+		for id := range m.removedattributes {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ProductMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedattributes {
+		edges = append(edges, product.EdgeAttributes)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ProductMutation) EdgeCleared(name string) bool {
+	switch name {
+	case product.EdgeAttributes:
+		return m.clearedattributes
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ProductMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Product unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ProductMutation) ResetEdge(name string) error {
+	switch name {
+	case product.EdgeAttributes:
+		m.ResetAttributes()
+		return nil
+	}
+	return fmt.Errorf("unknown Product edge %s", name)
+}
+
+// ProductAttributeMutation represents an operation that mutates the ProductAttribute nodes in the graph.
+type ProductAttributeMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	key            *string
+	value          *string
+	clearedFields  map[string]struct{}
+	product        *int
+	clearedproduct bool
+	done           bool
+	oldValue       func(context.Context) (*ProductAttribute, error)
+	predicates     []predicate.ProductAttribute
+}
+
+var _ ent.Mutation = (*ProductAttributeMutation)(nil)
+
+// productattributeOption allows management of the mutation configuration using functional options.
+type productattributeOption func(*ProductAttributeMutation)
+
+// newProductAttributeMutation creates new mutation for the ProductAttribute entity.
+func newProductAttributeMutation(c config, op Op, opts ...productattributeOption) *ProductAttributeMutation {
+	m := &ProductAttributeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeProductAttribute,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withProductAttributeID sets the ID field of the mutation.
+func withProductAttributeID(id int) productattributeOption {
+	return func(m *ProductAttributeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ProductAttribute
+		)
+		m.oldValue = func(ctx context.Context) (*ProductAttribute, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ProductAttribute.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withProductAttribute sets the old ProductAttribute of the mutation.
+func withProductAttribute(node *ProductAttribute) productattributeOption {
+	return func(m *ProductAttributeMutation) {
+		m.oldValue = func(context.Context) (*ProductAttribute, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ProductAttributeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ProductAttributeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ProductAttributeMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ProductAttributeMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ProductAttribute.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetProductID sets the "product_id" field.
+func (m *ProductAttributeMutation) SetProductID(i int) {
+	m.product = &i
+}
+
+// ProductID returns the value of the "product_id" field in the mutation.
+func (m *ProductAttributeMutation) ProductID() (r int, exists bool) {
+	v := m.product
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProductID returns the old "product_id" field's value of the ProductAttribute entity.
+// If the ProductAttribute object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductAttributeMutation) OldProductID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProductID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProductID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProductID: %w", err)
+	}
+	return oldValue.ProductID, nil
+}
+
+// ResetProductID resets all changes to the "product_id" field.
+func (m *ProductAttributeMutation) ResetProductID() {
+	m.product = nil
+}
+
+// SetKey sets the "key" field.
+func (m *ProductAttributeMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *ProductAttributeMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKey returns the old "key" field's value of the ProductAttribute entity.
+// If the ProductAttribute object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductAttributeMutation) OldKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
+}
+
+// ResetKey resets all changes to the "key" field.
+func (m *ProductAttributeMutation) ResetKey() {
+	m.key = nil
+}
+
+// SetValue sets the "value" field.
+func (m *ProductAttributeMutation) SetValue(s string) {
+	m.value = &s
+}
+
+// Value returns the value of the "value" field in the mutation.
+func (m *ProductAttributeMutation) Value() (r string, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValue returns the old "value" field's value of the ProductAttribute entity.
+// If the ProductAttribute object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductAttributeMutation) OldValue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+	}
+	return oldValue.Value, nil
+}
+
+// ResetValue resets all changes to the "value" field.
+func (m *ProductAttributeMutation) ResetValue() {
+	m.value = nil
+}
+
+// ClearProduct clears the "product" edge to the Product entity.
+func (m *ProductAttributeMutation) ClearProduct() {
+	m.clearedproduct = true
+	m.clearedFields[productattribute.FieldProductID] = struct{}{}
+}
+
+// ProductCleared reports if the "product" edge to the Product entity was cleared.
+func (m *ProductAttributeMutation) ProductCleared() bool {
+	return m.clearedproduct
+}
+
+// ProductIDs returns the "product" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ProductID instead. It exists only for internal usage by the builders.
+func (m *ProductAttributeMutation) ProductIDs() (ids []int) {
+	if id := m.product; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetProduct resets all changes to the "product" edge.
+func (m *ProductAttributeMutation) ResetProduct() {
+	m.product = nil
+	m.clearedproduct = false
+}
+
+// Where appends a list predicates to the ProductAttributeMutation builder.
+func (m *ProductAttributeMutation) Where(ps ...predicate.ProductAttribute) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ProductAttributeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ProductAttributeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ProductAttribute, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ProductAttributeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ProductAttributeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ProductAttribute).
+func (m *ProductAttributeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ProductAttributeMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.product != nil {
+		fields = append(fields, productattribute.FieldProductID)
+	}
+	if m.key != nil {
+		fields = append(fields, productattribute.FieldKey)
+	}
+	if m.value != nil {
+		fields = append(fields, productattribute.FieldValue)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ProductAttributeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case productattribute.FieldProductID:
+		return m.ProductID()
+	case productattribute.FieldKey:
+		return m.Key()
+	case productattribute.FieldValue:
+		return m.Value()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ProductAttributeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case productattribute.FieldProductID:
+		return m.OldProductID(ctx)
+	case productattribute.FieldKey:
+		return m.OldKey(ctx)
+	case productattribute.FieldValue:
+		return m.OldValue(ctx)
+	}
+	return nil, fmt.Errorf("unknown ProductAttribute field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProductAttributeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case productattribute.FieldProductID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProductID(v)
+		return nil
+	case productattribute.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case productattribute.FieldValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ProductAttribute field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ProductAttributeMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ProductAttributeMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProductAttributeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ProductAttribute numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ProductAttributeMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ProductAttributeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ProductAttributeMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ProductAttribute nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ProductAttributeMutation) ResetField(name string) error {
+	switch name {
+	case productattribute.FieldProductID:
+		m.ResetProductID()
+		return nil
+	case productattribute.FieldKey:
+		m.ResetKey()
+		return nil
+	case productattribute.FieldValue:
+		m.ResetValue()
+		return nil
+	}
+	return fmt.Errorf("unknown ProductAttribute field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ProductAttributeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.product != nil {
+		edges = append(edges, productattribute.EdgeProduct)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ProductAttributeMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case productattribute.EdgeProduct:
+		if id := m.product; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ProductAttributeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ProductAttributeMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ProductAttributeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedproduct {
+		edges = append(edges, productattribute.EdgeProduct)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ProductAttributeMutation) EdgeCleared(name string) bool {
+	switch name {
+	case productattribute.EdgeProduct:
+		return m.clearedproduct
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ProductAttributeMutation) ClearEdge(name string) error {
+	switch name {
+	case productattribute.EdgeProduct:
+		m.ClearProduct()
+		return nil
+	}
+	return fmt.Errorf("unknown ProductAttribute unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ProductAttributeMutation) ResetEdge(name string) error {
+	switch name {
+	case productattribute.EdgeProduct:
+		m.ResetProduct()
+		return nil
+	}
+	return fmt.Errorf("unknown ProductAttribute edge %s", name)
+}
+
+// RefreshTokenMutation represents an operation that mutates the RefreshToken nodes in the graph.
+type RefreshTokenMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	create_time   *time.Time
+	update_time   *time.Time
+	user_id       *int
+	adduser_id    *int
+	token_hash    *string
+	family_id     *string
+	expires_at    *time.Time
+	revoked_at    *time.Time
+	revoke_reason *refreshtoken.RevokeReason
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*RefreshToken, error)
+	predicates    []predicate.RefreshToken
+}
+
+var _ ent.Mutation = (*RefreshTokenMutation)(nil)
+
+// refreshtokenOption allows management of the mutation configuration using functional options.
+type refreshtokenOption func(*RefreshTokenMutation)
+
+// newRefreshTokenMutation creates new mutation for the RefreshToken entity.
+func newRefreshTokenMutation(c config, op Op, opts ...refreshtokenOption) *RefreshTokenMutation {
+	m := &RefreshTokenMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRefreshToken,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRefreshTokenID sets the ID field of the mutation.
+func withRefreshTokenID(id int) refreshtokenOption {
+	return func(m *RefreshTokenMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RefreshToken
+		)
+		m.oldValue = func(ctx context.Context) (*RefreshToken, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RefreshToken.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRefreshToken sets the old RefreshToken of the mutation.
+func withRefreshToken(node *RefreshToken) refreshtokenOption {
+	return func(m *RefreshTokenMutation) {
+		m.oldValue = func(context.Context) (*RefreshToken, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RefreshTokenMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RefreshTokenMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RefreshTokenMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RefreshTokenMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RefreshToken.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *RefreshTokenMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *RefreshTokenMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *RefreshTokenMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *RefreshTokenMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *RefreshTokenMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *RefreshTokenMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *RefreshTokenMutation) SetUserID(i int) {
+	m.user_id = &i
+	m.adduser_id = nil
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *RefreshTokenMutation) UserID() (r int, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldUserID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDiscount is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDiscount requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDiscount: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.Discount, nil
+	return oldValue.UserID, nil
 }
 
-// ClearDiscount clears the value of the "discount" field.
-func (m *ProductMutation) ClearDiscount() {
-	m.discount = nil
-	m.clearedFields[product.FieldDiscount] = struct{}{}
+// AddUserID adds i to the "user_id" field.
+func (m *RefreshTokenMutation) AddUserID(i int) {
+	if m.adduser_id != nil {
+		*m.adduser_id += i
+	} else {
+		m.adduser_id = &i
+	}
 }
 
-// DiscountCleared returns if the "discount" field was cleared in this mutation.
-func (m *ProductMutation) DiscountCleared() bool {
-	_, ok := m.clearedFields[product.FieldDiscount]
+// AddedUserID returns the value that was added to the "user_id" field in this mutation.
+func (m *RefreshTokenMutation) AddedUserID() (r int, exists bool) {
+	v := m.adduser_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *RefreshTokenMutation) ResetUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (m *RefreshTokenMutation) SetTokenHash(s string) {
+	m.token_hash = &s
+}
+
+// TokenHash returns the value of the "token_hash" field in the mutation.
+func (m *RefreshTokenMutation) TokenHash() (r string, exists bool) {
+	v := m.token_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTokenHash returns the old "token_hash" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldTokenHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenHash: %w", err)
+	}
+	return oldValue.TokenHash, nil
+}
+
+// ResetTokenHash resets all changes to the "token_hash" field.
+func (m *RefreshTokenMutation) ResetTokenHash() {
+	m.token_hash = nil
+}
+
+// SetFamilyID sets the "family_id" field.
+func (m *RefreshTokenMutation) SetFamilyID(s string) {
+	m.family_id = &s
+}
+
+// FamilyID returns the value of the "family_id" field in the mutation.
+func (m *RefreshTokenMutation) FamilyID() (r string, exists bool) {
+	v := m.family_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFamilyID returns the old "family_id" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldFamilyID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFamilyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFamilyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFamilyID: %w", err)
+	}
+	return oldValue.FamilyID, nil
+}
+
+// ResetFamilyID resets all changes to the "family_id" field.
+func (m *RefreshTokenMutation) ResetFamilyID() {
+	m.family_id = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *RefreshTokenMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *RefreshTokenMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *RefreshTokenMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (m *RefreshTokenMutation) SetRevokedAt(t time.Time) {
+	m.revoked_at = &t
+}
+
+// RevokedAt returns the value of the "revoked_at" field in the mutation.
+func (m *RefreshTokenMutation) RevokedAt() (r time.Time, exists bool) {
+	v := m.revoked_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevokedAt returns the old "revoked_at" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldRevokedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevokedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevokedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevokedAt: %w", err)
+	}
+	return oldValue.RevokedAt, nil
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (m *RefreshTokenMutation) ClearRevokedAt() {
+	m.revoked_at = nil
+	m.clearedFields[refreshtoken.FieldRevokedAt] = struct{}{}
+}
+
+// RevokedAtCleared returns if the "revoked_at" field was cleared in this mutation.
+func (m *RefreshTokenMutation) RevokedAtCleared() bool {
+	_, ok := m.clearedFields[refreshtoken.FieldRevokedAt]
 	return ok
 }
 
-// ResetDiscount resets all changes to the "discount" field.
-func (m *ProductMutation) ResetDiscount() {
-	m.discount = nil
-	delete(m.clearedFields, product.FieldDiscount)
+// ResetRevokedAt resets all changes to the "revoked_at" field.
+func (m *RefreshTokenMutation) ResetRevokedAt() {
+	m.revoked_at = nil
+	delete(m.clearedFields, refreshtoken.FieldRevokedAt)
 }
 
-// SetCategory sets the "category" field.
-func (m *ProductMutation) SetCategory(s string) {
-	m.category = &s
+// SetRevokeReason sets the "revoke_reason" field.
+func (m *RefreshTokenMutation) SetRevokeReason(rr refreshtoken.RevokeReason) {
+	m.revoke_reason = &rr
 }
 
-// Category returns the value of the "category" field in the mutation.
-func (m *ProductMutation) Category() (r string, exists bool) {
-	v := m.category
+// RevokeReason returns the value of the "revoke_reason" field in the mutation.
+func (m *RefreshTokenMutation) RevokeReason() (r refreshtoken.RevokeReason, exists bool) {
+	v := m.revoke_reason
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCategory returns the old "category" field's value of the Product entity.
-// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// OldRevokeReason returns the old "revoke_reason" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProductMutation) OldCategory(ctx context.Context) (v string, err error) {
+func (m *RefreshTokenMutation) OldRevokeReason(ctx context.Context) (v refreshtoken.RevokeReason, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
+		return v, errors.New("OldRevokeReason is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCategory requires an ID field in the mutation")
+		return v, errors.New("OldRevokeReason requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
+		return v, fmt.Errorf("querying old value for OldRevokeReason: %w", err)
 	}
-	return oldValue.Category, nil
+	return oldValue.RevokeReason, nil
 }
 
-// ResetCategory resets all changes to the "category" field.
-func (m *ProductMutation) ResetCategory() {
-	m.category = nil
+// ClearRevokeReason clears the value of the "revoke_reason" field.
+func (m *RefreshTokenMutation) ClearRevokeReason() {
+	m.revoke_reason = nil
+	m.clearedFields[refreshtoken.FieldRevokeReason] = struct{}{}
 }
 
-// SetSubCategory sets the "sub_category" field.
-func (m *ProductMutation) SetSubCategory(s string) {
-	m.sub_category = &s
+// RevokeReasonCleared returns if the "revoke_reason" field was cleared in this mutation.
+func (m *RefreshTokenMutation) RevokeReasonCleared() bool {
+	_, ok := m.clearedFields[refreshtoken.FieldRevokeReason]
+	return ok
+}
+
+// ResetRevokeReason resets all changes to the "revoke_reason" field.
+func (m *RefreshTokenMutation) ResetRevokeReason() {
+	m.revoke_reason = nil
+	delete(m.clearedFields, refreshtoken.FieldRevokeReason)
+}
+
+// Where appends a list predicates to the RefreshTokenMutation builder.
+func (m *RefreshTokenMutation) Where(ps ...predicate.RefreshToken) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RefreshTokenMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RefreshTokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RefreshToken, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RefreshTokenMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RefreshTokenMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RefreshToken).
+func (m *RefreshTokenMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RefreshTokenMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.create_time != nil {
+		fields = append(fields, refreshtoken.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, refreshtoken.FieldUpdateTime)
+	}
+	if m.user_id != nil {
+		fields = append(fields, refreshtoken.FieldUserID)
+	}
+	if m.token_hash != nil {
+		fields = append(fields, refreshtoken.FieldTokenHash)
+	}
+	if m.family_id != nil {
+		fields = append(fields, refreshtoken.FieldFamilyID)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, refreshtoken.FieldExpiresAt)
+	}
+	if m.revoked_at != nil {
+		fields = append(fields, refreshtoken.FieldRevokedAt)
+	}
+	if m.revoke_reason != nil {
+		fields = append(fields, refreshtoken.FieldRevokeReason)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RefreshTokenMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case refreshtoken.FieldCreateTime:
+		return m.CreateTime()
+	case refreshtoken.FieldUpdateTime:
+		return m.UpdateTime()
+	case refreshtoken.FieldUserID:
+		return m.UserID()
+	case refreshtoken.FieldTokenHash:
+		return m.TokenHash()
+	case refreshtoken.FieldFamilyID:
+		return m.FamilyID()
+	case refreshtoken.FieldExpiresAt:
+		return m.ExpiresAt()
+	case refreshtoken.FieldRevokedAt:
+		return m.RevokedAt()
+	case refreshtoken.FieldRevokeReason:
+		return m.RevokeReason()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RefreshTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case refreshtoken.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case refreshtoken.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case refreshtoken.FieldUserID:
+		return m.OldUserID(ctx)
+	case refreshtoken.FieldTokenHash:
+		return m.OldTokenHash(ctx)
+	case refreshtoken.FieldFamilyID:
+		return m.OldFamilyID(ctx)
+	case refreshtoken.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case refreshtoken.FieldRevokedAt:
+		return m.OldRevokedAt(ctx)
+	case refreshtoken.FieldRevokeReason:
+		return m.OldRevokeReason(ctx)
+	}
+	return nil, fmt.Errorf("unknown RefreshToken field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RefreshTokenMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case refreshtoken.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case refreshtoken.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case refreshtoken.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case refreshtoken.FieldTokenHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenHash(v)
+		return nil
+	case refreshtoken.FieldFamilyID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFamilyID(v)
+		return nil
+	case refreshtoken.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case refreshtoken.FieldRevokedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevokedAt(v)
+		return nil
+	case refreshtoken.FieldRevokeReason:
+		v, ok := value.(refreshtoken.RevokeReason)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevokeReason(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RefreshToken field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RefreshTokenMutation) AddedFields() []string {
+	var fields []string
+	if m.adduser_id != nil {
+		fields = append(fields, refreshtoken.FieldUserID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RefreshTokenMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case refreshtoken.FieldUserID:
+		return m.AddedUserID()
+	}
+	return nil, false
 }
 
-// SubCategory returns the value of the "sub_category" field in the mutation.
-func (m *ProductMutation) SubCategory() (r string, exists bool) {
-	v := m.sub_category
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RefreshTokenMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case refreshtoken.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserID(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown RefreshToken numeric field %s", name)
 }
 
-// OldSubCategory returns the old "sub_category" field's value of the Product entity.
-// If the Product object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProductMutation) OldSubCategory(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSubCategory is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSubCategory requires an ID field in the mutation")
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RefreshTokenMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(refreshtoken.FieldRevokedAt) {
+		fields = append(fields, refreshtoken.FieldRevokedAt)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSubCategory: %w", err)
+	if m.FieldCleared(refreshtoken.FieldRevokeReason) {
+		fields = append(fields, refreshtoken.FieldRevokeReason)
 	}
-	return oldValue.SubCategory, nil
+	return fields
 }
 
-// ResetSubCategory resets all changes to the "sub_category" field.
-func (m *ProductMutation) ResetSubCategory() {
-	m.sub_category = nil
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RefreshTokenMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// SetOutOfStock sets the "out_of_stock" field.
-func (m *ProductMutation) SetOutOfStock(b bool) {
-	m.out_of_stock = &b
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RefreshTokenMutation) ClearField(name string) error {
+	switch name {
+	case refreshtoken.FieldRevokedAt:
+		m.ClearRevokedAt()
+		return nil
+	case refreshtoken.FieldRevokeReason:
+		m.ClearRevokeReason()
+		return nil
+	}
+	return fmt.Errorf("unknown RefreshToken nullable field %s", name)
 }
 
-// OutOfStock returns the value of the "out_of_stock" field in the mutation.
-func (m *ProductMutation) OutOfStock() (r bool, exists bool) {
-	v := m.out_of_stock
-	if v == nil {
-		return
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RefreshTokenMutation) ResetField(name string) error {
+	switch name {
+	case refreshtoken.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case refreshtoken.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case refreshtoken.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case refreshtoken.FieldTokenHash:
+		m.ResetTokenHash()
+		return nil
+	case refreshtoken.FieldFamilyID:
+		m.ResetFamilyID()
+		return nil
+	case refreshtoken.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case refreshtoken.FieldRevokedAt:
+		m.ResetRevokedAt()
+		return nil
+	case refreshtoken.FieldRevokeReason:
+		m.ResetRevokeReason()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown RefreshToken field %s", name)
 }
 
-// OldOutOfStock returns the old "out_of_stock" field's value of the Product entity.
-// If the Product object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProductMutation) OldOutOfStock(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOutOfStock is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOutOfStock requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOutOfStock: %w", err)
-	}
-	return oldValue.OutOfStock, nil
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RefreshTokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ResetOutOfStock resets all changes to the "out_of_stock" field.
-func (m *ProductMutation) ResetOutOfStock() {
-	m.out_of_stock = nil
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RefreshTokenMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// SetSeller sets the "seller" field.
-func (m *ProductMutation) SetSeller(s string) {
-	m.seller = &s
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RefreshTokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// Seller returns the value of the "seller" field in the mutation.
-func (m *ProductMutation) Seller() (r string, exists bool) {
-	v := m.seller
-	if v == nil {
-		return
-	}
-	return *v, true
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RefreshTokenMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// OldSeller returns the old "seller" field's value of the Product entity.
-// If the Product object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProductMutation) OldSeller(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSeller is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSeller requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSeller: %w", err)
-	}
-	return oldValue.Seller, nil
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RefreshTokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ClearSeller clears the value of the "seller" field.
-func (m *ProductMutation) ClearSeller() {
-	m.seller = nil
-	m.clearedFields[product.FieldSeller] = struct{}{}
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RefreshTokenMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// SellerCleared returns if the "seller" field was cleared in this mutation.
-func (m *ProductMutation) SellerCleared() bool {
-	_, ok := m.clearedFields[product.FieldSeller]
-	return ok
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RefreshTokenMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown RefreshToken unique edge %s", name)
 }
 
-// ResetSeller resets all changes to the "seller" field.
-func (m *ProductMutation) ResetSeller() {
-	m.seller = nil
-	delete(m.clearedFields, product.FieldSeller)
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RefreshTokenMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown RefreshToken edge %s", name)
 }
 
-// SetAverageRating sets the "average_rating" field.
-func (m *ProductMutation) SetAverageRating(s string) {
-	m.average_rating = &s
+// SchemaMigrationMutation represents an operation that mutates the SchemaMigration nodes in the graph.
+type SchemaMigrationMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int64
+	name          *string
+	applied_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*SchemaMigration, error)
+	predicates    []predicate.SchemaMigration
 }
 
-// AverageRating returns the value of the "average_rating" field in the mutation.
-func (m *ProductMutation) AverageRating() (r string, exists bool) {
-	v := m.average_rating
-	if v == nil {
-		return
+var _ ent.Mutation = (*SchemaMigrationMutation)(nil)
+
+// schemamigrationOption allows management of the mutation configuration using functional options.
+type schemamigrationOption func(*SchemaMigrationMutation)
+
+// newSchemaMigrationMutation creates new mutation for the SchemaMigration entity.
+func newSchemaMigrationMutation(c config, op Op, opts ...schemamigrationOption) *SchemaMigrationMutation {
+	m := &SchemaMigrationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSchemaMigration,
+		clearedFields: make(map[string]struct{}),
 	}
-	return *v, true
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// OldAverageRating returns the old "average_rating" field's value of the Product entity.
-// If the Product object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProductMutation) OldAverageRating(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAverageRating is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAverageRating requires an ID field in the mutation")
+// withSchemaMigrationID sets the ID field of the mutation.
+func withSchemaMigrationID(id int64) schemamigrationOption {
+	return func(m *SchemaMigrationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SchemaMigration
+		)
+		m.oldValue = func(ctx context.Context) (*SchemaMigration, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SchemaMigration.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAverageRating: %w", err)
+}
+
+// withSchemaMigration sets the old SchemaMigration of the mutation.
+func withSchemaMigration(node *SchemaMigration) schemamigrationOption {
+	return func(m *SchemaMigrationMutation) {
+		m.oldValue = func(context.Context) (*SchemaMigration, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return oldValue.AverageRating, nil
 }
 
-// ClearAverageRating clears the value of the "average_rating" field.
-func (m *ProductMutation) ClearAverageRating() {
-	m.average_rating = nil
-	m.clearedFields[product.FieldAverageRating] = struct{}{}
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SchemaMigrationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// AverageRatingCleared returns if the "average_rating" field was cleared in this mutation.
-func (m *ProductMutation) AverageRatingCleared() bool {
-	_, ok := m.clearedFields[product.FieldAverageRating]
-	return ok
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SchemaMigrationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ResetAverageRating resets all changes to the "average_rating" field.
-func (m *ProductMutation) ResetAverageRating() {
-	m.average_rating = nil
-	delete(m.clearedFields, product.FieldAverageRating)
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SchemaMigration entities.
+func (m *SchemaMigrationMutation) SetID(id int64) {
+	m.id = &id
 }
 
-// SetImages sets the "images" field.
-func (m *ProductMutation) SetImages(s []string) {
-	m.images = &s
-	m.appendimages = nil
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SchemaMigrationMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SchemaMigrationMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SchemaMigration.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *SchemaMigrationMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Images returns the value of the "images" field in the mutation.
-func (m *ProductMutation) Images() (r []string, exists bool) {
-	v := m.images
+// Name returns the value of the "name" field in the mutation.
+func (m *SchemaMigrationMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldImages returns the old "images" field's value of the Product entity.
-// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the SchemaMigration entity.
+// If the SchemaMigration object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProductMutation) OldImages(ctx context.Context) (v []string, err error) {
+func (m *SchemaMigrationMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldImages is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldImages requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldImages: %w", err)
-	}
-	return oldValue.Images, nil
-}
-
-// AppendImages adds s to the "images" field.
-func (m *ProductMutation) AppendImages(s []string) {
-	m.appendimages = append(m.appendimages, s...)
-}
-
-// AppendedImages returns the list of values that were appended to the "images" field in this mutation.
-func (m *ProductMutation) AppendedImages() ([]string, bool) {
-	if len(m.appendimages) == 0 {
-		return nil, false
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return m.appendimages, true
-}
-
-// ClearImages clears the value of the "images" field.
-func (m *ProductMutation) ClearImages() {
-	m.images = nil
-	m.appendimages = nil
-	m.clearedFields[product.FieldImages] = struct{}{}
-}
-
-// ImagesCleared returns if the "images" field was cleared in this mutation.
-func (m *ProductMutation) ImagesCleared() bool {
-	_, ok := m.clearedFields[product.FieldImages]
-	return ok
+	return oldValue.Name, nil
 }
 
-// ResetImages resets all changes to the "images" field.
-func (m *ProductMutation) ResetImages() {
-	m.images = nil
-	m.appendimages = nil
-	delete(m.clearedFields, product.FieldImages)
+// ResetName resets all changes to the "name" field.
+func (m *SchemaMigrationMutation) ResetName() {
+	m.name = nil
 }
 
-// SetProductDetails sets the "product_details" field.
-func (m *ProductMutation) SetProductDetails(value []map[string]string) {
-	m.product_details = &value
-	m.appendproduct_details = nil
+// SetAppliedAt sets the "applied_at" field.
+func (m *SchemaMigrationMutation) SetAppliedAt(t time.Time) {
+	m.applied_at = &t
 }
 
-// ProductDetails returns the value of the "product_details" field in the mutation.
-func (m *ProductMutation) ProductDetails() (r []map[string]string, exists bool) {
-	v := m.product_details
+// AppliedAt returns the value of the "applied_at" field in the mutation.
+func (m *SchemaMigrationMutation) AppliedAt() (r time.Time, exists bool) {
+	v := m.applied_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProductDetails returns the old "product_details" field's value of the Product entity.
-// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// OldAppliedAt returns the old "applied_at" field's value of the SchemaMigration entity.
+// If the SchemaMigration object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProductMutation) OldProductDetails(ctx context.Context) (v []map[string]string, err error) {
+func (m *SchemaMigrationMutation) OldAppliedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProductDetails is only allowed on UpdateOne operations")
+		return v, errors.New("OldAppliedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProductDetails requires an ID field in the mutation")
+		return v, errors.New("OldAppliedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProductDetails: %w", err)
+		return v, fmt.Errorf("querying old value for OldAppliedAt: %w", err)
 	}
-	return oldValue.ProductDetails, nil
+	return oldValue.AppliedAt, nil
 }
 
-// AppendProductDetails adds value to the "product_details" field.
-func (m *ProductMutation) AppendProductDetails(value []map[string]string) {
-	m.appendproduct_details = append(m.appendproduct_details, value...)
+// ResetAppliedAt resets all changes to the "applied_at" field.
+func (m *SchemaMigrationMutation) ResetAppliedAt() {
+	m.applied_at = nil
 }
 
-// AppendedProductDetails returns the list of values that were appended to the "product_details" field in this mutation.
-func (m *ProductMutation) AppendedProductDetails() ([]map[string]string, bool) {
-	if len(m.appendproduct_details) == 0 {
-		return nil, false
-	}
-	return m.appendproduct_details, true
+// Where appends a list predicates to the SchemaMigrationMutation builder.
+func (m *SchemaMigrationMutation) Where(ps ...predicate.SchemaMigration) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ClearProductDetails clears the value of the "product_details" field.
-func (m *ProductMutation) ClearProductDetails() {
-	m.product_details = nil
-	m.appendproduct_details = nil
-	m.clearedFields[product.FieldProductDetails] = struct{}{}
+// WhereP appends storage-level predicates to the SchemaMigrationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SchemaMigrationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SchemaMigration, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
 }
 
-// ProductDetailsCleared returns if the "product_details" field was cleared in this mutation.
-func (m *ProductMutation) ProductDetailsCleared() bool {
-	_, ok := m.clearedFields[product.FieldProductDetails]
-	return ok
+// Op returns the operation name.
+func (m *SchemaMigrationMutation) Op() Op {
+	return m.op
 }
 
-// ResetProductDetails resets all changes to the "product_details" field.
-func (m *ProductMutation) ResetProductDetails() {
-	m.product_details = nil
-	m.appendproduct_details = nil
-	delete(m.clearedFields, product.FieldProductDetails)
+// SetOp allows setting the mutation operation.
+func (m *SchemaMigrationMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SetURL sets the "url" field.
-func (m *ProductMutation) SetURL(s string) {
-	m.url = &s
+// Type returns the node type of this mutation (SchemaMigration).
+func (m *SchemaMigrationMutation) Type() string {
+	return m.typ
 }
 
-// URL returns the value of the "url" field in the mutation.
-func (m *ProductMutation) URL() (r string, exists bool) {
-	v := m.url
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SchemaMigrationMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.name != nil {
+		fields = append(fields, schemamigration.FieldName)
 	}
-	return *v, true
+	if m.applied_at != nil {
+		fields = append(fields, schemamigration.FieldAppliedAt)
+	}
+	return fields
 }
 
-// OldURL returns the old "url" field's value of the Product entity.
-// If the Product object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProductMutation) OldURL(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldURL is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldURL requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SchemaMigrationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case schemamigration.FieldName:
+		return m.Name()
+	case schemamigration.FieldAppliedAt:
+		return m.AppliedAt()
 	}
-	return oldValue.URL, nil
+	return nil, false
 }
 
-// ClearURL clears the value of the "url" field.
-func (m *ProductMutation) ClearURL() {
-	m.url = nil
-	m.clearedFields[product.FieldURL] = struct{}{}
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SchemaMigrationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case schemamigration.FieldName:
+		return m.OldName(ctx)
+	case schemamigration.FieldAppliedAt:
+		return m.OldAppliedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown SchemaMigration field %s", name)
 }
 
-// URLCleared returns if the "url" field was cleared in this mutation.
-func (m *ProductMutation) URLCleared() bool {
-	_, ok := m.clearedFields[product.FieldURL]
-	return ok
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SchemaMigrationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case schemamigration.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case schemamigration.FieldAppliedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAppliedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SchemaMigration field %s", name)
 }
 
-// ResetURL resets all changes to the "url" field.
-func (m *ProductMutation) ResetURL() {
-	m.url = nil
-	delete(m.clearedFields, product.FieldURL)
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SchemaMigrationMutation) AddedFields() []string {
+	return nil
 }
 
-// SetPid sets the "pid" field.
-func (m *ProductMutation) SetPid(s string) {
-	m.pid = &s
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SchemaMigrationMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// Pid returns the value of the "pid" field in the mutation.
-func (m *ProductMutation) Pid() (r string, exists bool) {
-	v := m.pid
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SchemaMigrationMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return *v, true
+	return fmt.Errorf("unknown SchemaMigration numeric field %s", name)
 }
 
-// OldPid returns the old "pid" field's value of the Product entity.
-// If the Product object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProductMutation) OldPid(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPid is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPid requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPid: %w", err)
-	}
-	return oldValue.Pid, nil
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SchemaMigrationMutation) ClearedFields() []string {
+	return nil
 }
 
-// ResetPid resets all changes to the "pid" field.
-func (m *ProductMutation) ResetPid() {
-	m.pid = nil
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SchemaMigrationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// SetStyleCode sets the "style_code" field.
-func (m *ProductMutation) SetStyleCode(s string) {
-	m.style_code = &s
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SchemaMigrationMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SchemaMigration nullable field %s", name)
 }
 
-// StyleCode returns the value of the "style_code" field in the mutation.
-func (m *ProductMutation) StyleCode() (r string, exists bool) {
-	v := m.style_code
-	if v == nil {
-		return
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SchemaMigrationMutation) ResetField(name string) error {
+	switch name {
+	case schemamigration.FieldName:
+		m.ResetName()
+		return nil
+	case schemamigration.FieldAppliedAt:
+		m.ResetAppliedAt()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown SchemaMigration field %s", name)
 }
 
-// OldStyleCode returns the old "style_code" field's value of the Product entity.
-// If the Product object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProductMutation) OldStyleCode(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStyleCode is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStyleCode requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStyleCode: %w", err)
-	}
-	return oldValue.StyleCode, nil
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SchemaMigrationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SchemaMigrationMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SchemaMigrationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ClearStyleCode clears the value of the "style_code" field.
-func (m *ProductMutation) ClearStyleCode() {
-	m.style_code = nil
-	m.clearedFields[product.FieldStyleCode] = struct{}{}
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SchemaMigrationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// StyleCodeCleared returns if the "style_code" field was cleared in this mutation.
-func (m *ProductMutation) StyleCodeCleared() bool {
-	_, ok := m.clearedFields[product.FieldStyleCode]
-	return ok
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SchemaMigrationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ResetStyleCode resets all changes to the "style_code" field.
-func (m *ProductMutation) ResetStyleCode() {
-	m.style_code = nil
-	delete(m.clearedFields, product.FieldStyleCode)
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SchemaMigrationMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// SetCrawledAt sets the "crawled_at" field.
-func (m *ProductMutation) SetCrawledAt(t time.Time) {
-	m.crawled_at = &t
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SchemaMigrationMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SchemaMigration unique edge %s", name)
 }
 
-// CrawledAt returns the value of the "crawled_at" field in the mutation.
-func (m *ProductMutation) CrawledAt() (r time.Time, exists bool) {
-	v := m.crawled_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SchemaMigrationMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SchemaMigration edge %s", name)
 }
 
-// OldCrawledAt returns the old "crawled_at" field's value of the Product entity.
-// If the Product object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProductMutation) OldCrawledAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCrawledAt is only allowed on UpdateOne operations")
+// SearchRuleMutation represents an operation that mutates the SearchRule nodes in the graph.
+type SearchRuleMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	create_time    *time.Time
+	update_time    *time.Time
+	kind           *searchrule.Kind
+	terms          *[]string
+	appendterms    []string
+	synonyms       *[]string
+	appendsynonyms []string
+	category       *string
+	boost          *float64
+	addboost       *float64
+	enabled        *bool
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*SearchRule, error)
+	predicates     []predicate.SearchRule
+}
+
+var _ ent.Mutation = (*SearchRuleMutation)(nil)
+
+// searchruleOption allows management of the mutation configuration using functional options.
+type searchruleOption func(*SearchRuleMutation)
+
+// newSearchRuleMutation creates new mutation for the SearchRule entity.
+func newSearchRuleMutation(c config, op Op, opts ...searchruleOption) *SearchRuleMutation {
+	m := &SearchRuleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSearchRule,
+		clearedFields: make(map[string]struct{}),
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCrawledAt requires an ID field in the mutation")
+	for _, opt := range opts {
+		opt(m)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCrawledAt: %w", err)
+	return m
+}
+
+// withSearchRuleID sets the ID field of the mutation.
+func withSearchRuleID(id int) searchruleOption {
+	return func(m *SearchRuleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SearchRule
+		)
+		m.oldValue = func(ctx context.Context) (*SearchRule, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SearchRule.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return oldValue.CrawledAt, nil
 }
 
-// ClearCrawledAt clears the value of the "crawled_at" field.
-func (m *ProductMutation) ClearCrawledAt() {
-	m.crawled_at = nil
-	m.clearedFields[product.FieldCrawledAt] = struct{}{}
+// withSearchRule sets the old SearchRule of the mutation.
+func withSearchRule(node *SearchRule) searchruleOption {
+	return func(m *SearchRuleMutation) {
+		m.oldValue = func(context.Context) (*SearchRule, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// CrawledAtCleared returns if the "crawled_at" field was cleared in this mutation.
-func (m *ProductMutation) CrawledAtCleared() bool {
-	_, ok := m.clearedFields[product.FieldCrawledAt]
-	return ok
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SearchRuleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// ResetCrawledAt resets all changes to the "crawled_at" field.
-func (m *ProductMutation) ResetCrawledAt() {
-	m.crawled_at = nil
-	delete(m.clearedFields, product.FieldCrawledAt)
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SearchRuleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// SetEmbedding sets the "embedding" field.
-func (m *ProductMutation) SetEmbedding(f []float32) {
-	m.embedding = &f
-	m.appendembedding = nil
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SearchRuleMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// Embedding returns the value of the "embedding" field in the mutation.
-func (m *ProductMutation) Embedding() (r []float32, exists bool) {
-	v := m.embedding
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SearchRuleMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SearchRule.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *SearchRuleMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *SearchRuleMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEmbedding returns the old "embedding" field's value of the Product entity.
-// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the SearchRule entity.
+// If the SearchRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProductMutation) OldEmbedding(ctx context.Context) (v []float32, err error) {
+func (m *SearchRuleMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmbedding is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmbedding requires an ID field in the mutation")
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmbedding: %w", err)
-	}
-	return oldValue.Embedding, nil
-}
-
-// AppendEmbedding adds f to the "embedding" field.
-func (m *ProductMutation) AppendEmbedding(f []float32) {
-	m.appendembedding = append(m.appendembedding, f...)
-}
-
-// AppendedEmbedding returns the list of values that were appended to the "embedding" field in this mutation.
-func (m *ProductMutation) AppendedEmbedding() ([]float32, bool) {
-	if len(m.appendembedding) == 0 {
-		return nil, false
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
 	}
-	return m.appendembedding, true
-}
-
-// ClearEmbedding clears the value of the "embedding" field.
-func (m *ProductMutation) ClearEmbedding() {
-	m.embedding = nil
-	m.appendembedding = nil
-	m.clearedFields[product.FieldEmbedding] = struct{}{}
-}
-
-// EmbeddingCleared returns if the "embedding" field was cleared in this mutation.
-func (m *ProductMutation) EmbeddingCleared() bool {
-	_, ok := m.clearedFields[product.FieldEmbedding]
-	return ok
+	return oldValue.CreateTime, nil
 }
 
-// ResetEmbedding resets all changes to the "embedding" field.
-func (m *ProductMutation) ResetEmbedding() {
-	m.embedding = nil
-	m.appendembedding = nil
-	delete(m.clearedFields, product.FieldEmbedding)
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *SearchRuleMutation) ResetCreateTime() {
+	m.create_time = nil
 }
 
-// SetSearchKeywords sets the "search_keywords" field.
-func (m *ProductMutation) SetSearchKeywords(s []string) {
-	m.search_keywords = &s
-	m.appendsearch_keywords = nil
+// SetUpdateTime sets the "update_time" field.
+func (m *SearchRuleMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
 }
 
-// SearchKeywords returns the value of the "search_keywords" field in the mutation.
-func (m *ProductMutation) SearchKeywords() (r []string, exists bool) {
-	v := m.search_keywords
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *SearchRuleMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSearchKeywords returns the old "search_keywords" field's value of the Product entity.
-// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the SearchRule entity.
+// If the SearchRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProductMutation) OldSearchKeywords(ctx context.Context) (v []string, err error) {
+func (m *SearchRuleMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSearchKeywords is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSearchKeywords requires an ID field in the mutation")
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSearchKeywords: %w", err)
-	}
-	return oldValue.SearchKeywords, nil
-}
-
-// AppendSearchKeywords adds s to the "search_keywords" field.
-func (m *ProductMutation) AppendSearchKeywords(s []string) {
-	m.appendsearch_keywords = append(m.appendsearch_keywords, s...)
-}
-
-// AppendedSearchKeywords returns the list of values that were appended to the "search_keywords" field in this mutation.
-func (m *ProductMutation) AppendedSearchKeywords() ([]string, bool) {
-	if len(m.appendsearch_keywords) == 0 {
-		return nil, false
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
 	}
-	return m.appendsearch_keywords, true
-}
-
-// ClearSearchKeywords clears the value of the "search_keywords" field.
-func (m *ProductMutation) ClearSearchKeywords() {
-	m.search_keywords = nil
-	m.appendsearch_keywords = nil
-	m.clearedFields[product.FieldSearchKeywords] = struct{}{}
-}
-
-// SearchKeywordsCleared returns if the "search_keywords" field was cleared in this mutation.
-func (m *ProductMutation) SearchKeywordsCleared() bool {
-	_, ok := m.clearedFields[product.FieldSearchKeywords]
-	return ok
+	return oldValue.UpdateTime, nil
 }
 
-// ResetSearchKeywords resets all changes to the "search_keywords" field.
-func (m *ProductMutation) ResetSearchKeywords() {
-	m.search_keywords = nil
-	m.appendsearch_keywords = nil
-	delete(m.clearedFields, product.FieldSearchKeywords)
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *SearchRuleMutation) ResetUpdateTime() {
+	m.update_time = nil
 }
 
-// SetFeatured sets the "featured" field.
-func (m *ProductMutation) SetFeatured(b bool) {
-	m.featured = &b
+// SetKind sets the "kind" field.
+func (m *SearchRuleMutation) SetKind(s searchrule.Kind) {
+	m.kind = &s
 }
 
-// Featured returns the value of the "featured" field in the mutation.
-func (m *ProductMutation) Featured() (r bool, exists bool) {
-	v := m.featured
+// Kind returns the value of the "kind" field in the mutation.
+func (m *SearchRuleMutation) Kind() (r searchrule.Kind, exists bool) {
+	v := m.kind
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFeatured returns the old "featured" field's value of the Product entity.
-// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// OldKind returns the old "kind" field's value of the SearchRule entity.
+// If the SearchRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProductMutation) OldFeatured(ctx context.Context) (v bool, err error) {
+func (m *SearchRuleMutation) OldKind(ctx context.Context) (v searchrule.Kind, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFeatured is only allowed on UpdateOne operations")
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFeatured requires an ID field in the mutation")
+		return v, errors.New("OldKind requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFeatured: %w", err)
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
 	}
-	return oldValue.Featured, nil
+	return oldValue.Kind, nil
 }
 
-// ResetFeatured resets all changes to the "featured" field.
-func (m *ProductMutation) ResetFeatured() {
-	m.featured = nil
+// ResetKind resets all changes to the "kind" field.
+func (m *SearchRuleMutation) ResetKind() {
+	m.kind = nil
 }
 
-// SetViewCount sets the "view_count" field.
-func (m *ProductMutation) SetViewCount(i int) {
-	m.view_count = &i
-	m.addview_count = nil
+// SetTerms sets the "terms" field.
+func (m *SearchRuleMutation) SetTerms(s []string) {
+	m.terms = &s
+	m.appendterms = nil
 }
 
-// ViewCount returns the value of the "view_count" field in the mutation.
-func (m *ProductMutation) ViewCount() (r int, exists bool) {
-	v := m.view_count
+// Terms returns the value of the "terms" field in the mutation.
+func (m *SearchRuleMutation) Terms() (r []string, exists bool) {
+	v := m.terms
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldViewCount returns the old "view_count" field's value of the Product entity.
-// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// OldTerms returns the old "terms" field's value of the SearchRule entity.
+// If the SearchRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProductMutation) OldViewCount(ctx context.Context) (v int, err error) {
+func (m *SearchRuleMutation) OldTerms(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldViewCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldTerms is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldViewCount requires an ID field in the mutation")
+		return v, errors.New("OldTerms requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldViewCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldTerms: %w", err)
 	}
-	return oldValue.ViewCount, nil
+	return oldValue.Terms, nil
 }
 
-// AddViewCount adds i to the "view_count" field.
-func (m *ProductMutation) AddViewCount(i int) {
-	if m.addview_count != nil {
-		*m.addview_count += i
-	} else {
-		m.addview_count = &i
-	}
+// AppendTerms adds s to the "terms" field.
+func (m *SearchRuleMutation) AppendTerms(s []string) {
+	m.appendterms = append(m.appendterms, s...)
 }
 
-// AddedViewCount returns the value that was added to the "view_count" field in this mutation.
-func (m *ProductMutation) AddedViewCount() (r int, exists bool) {
-	v := m.addview_count
-	if v == nil {
-		return
+// AppendedTerms returns the list of values that were appended to the "terms" field in this mutation.
+func (m *SearchRuleMutation) AppendedTerms() ([]string, bool) {
+	if len(m.appendterms) == 0 {
+		return nil, false
 	}
-	return *v, true
+	return m.appendterms, true
 }
 
-// ResetViewCount resets all changes to the "view_count" field.
-func (m *ProductMutation) ResetViewCount() {
-	m.view_count = nil
-	m.addview_count = nil
+// ResetTerms resets all changes to the "terms" field.
+func (m *SearchRuleMutation) ResetTerms() {
+	m.terms = nil
+	m.appendterms = nil
 }
 
-// SetClickCount sets the "click_count" field.
-func (m *ProductMutation) SetClickCount(i int) {
-	m.click_count = &i
-	m.addclick_count = nil
+// SetSynonyms sets the "synonyms" field.
+func (m *SearchRuleMutation) SetSynonyms(s []string) {
+	m.synonyms = &s
+	m.appendsynonyms = nil
 }
 
-// ClickCount returns the value of the "click_count" field in the mutation.
-func (m *ProductMutation) ClickCount() (r int, exists bool) {
-	v := m.click_count
+// Synonyms returns the value of the "synonyms" field in the mutation.
+func (m *SearchRuleMutation) Synonyms() (r []string, exists bool) {
+	v := m.synonyms
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldClickCount returns the old "click_count" field's value of the Product entity.
-// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// OldSynonyms returns the old "synonyms" field's value of the SearchRule entity.
+// If the SearchRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProductMutation) OldClickCount(ctx context.Context) (v int, err error) {
+func (m *SearchRuleMutation) OldSynonyms(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldClickCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldSynonyms is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldClickCount requires an ID field in the mutation")
+		return v, errors.New("OldSynonyms requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldClickCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldSynonyms: %w", err)
 	}
-	return oldValue.ClickCount, nil
+	return oldValue.Synonyms, nil
 }
 
-// AddClickCount adds i to the "click_count" field.
-func (m *ProductMutation) AddClickCount(i int) {
-	if m.addclick_count != nil {
-		*m.addclick_count += i
-	} else {
-		m.addclick_count = &i
-	}
+// AppendSynonyms adds s to the "synonyms" field.
+func (m *SearchRuleMutation) AppendSynonyms(s []string) {
+	m.appendsynonyms = append(m.appendsynonyms, s...)
 }
 
-// AddedClickCount returns the value that was added to the "click_count" field in this mutation.
-func (m *ProductMutation) AddedClickCount() (r int, exists bool) {
-	v := m.addclick_count
-	if v == nil {
-		return
+// AppendedSynonyms returns the list of values that were appended to the "synonyms" field in this mutation.
+func (m *SearchRuleMutation) AppendedSynonyms() ([]string, bool) {
+	if len(m.appendsynonyms) == 0 {
+		return nil, false
 	}
-	return *v, true
+	return m.appendsynonyms, true
 }
 
-// ResetClickCount resets all changes to the "click_count" field.
-func (m *ProductMutation) ResetClickCount() {
-	m.click_count = nil
-	m.addclick_count = nil
+// ClearSynonyms clears the value of the "synonyms" field.
+func (m *SearchRuleMutation) ClearSynonyms() {
+	m.synonyms = nil
+	m.appendsynonyms = nil
+	m.clearedFields[searchrule.FieldSynonyms] = struct{}{}
 }
 
-// SetPriceNumeric sets the "price_numeric" field.
-func (m *ProductMutation) SetPriceNumeric(i int) {
-	m.price_numeric = &i
-	m.addprice_numeric = nil
+// SynonymsCleared returns if the "synonyms" field was cleared in this mutation.
+func (m *SearchRuleMutation) SynonymsCleared() bool {
+	_, ok := m.clearedFields[searchrule.FieldSynonyms]
+	return ok
 }
 
-// PriceNumeric returns the value of the "price_numeric" field in the mutation.
-func (m *ProductMutation) PriceNumeric() (r int, exists bool) {
-	v := m.price_numeric
+// ResetSynonyms resets all changes to the "synonyms" field.
+func (m *SearchRuleMutation) ResetSynonyms() {
+	m.synonyms = nil
+	m.appendsynonyms = nil
+	delete(m.clearedFields, searchrule.FieldSynonyms)
+}
+
+// SetCategory sets the "category" field.
+func (m *SearchRuleMutation) SetCategory(s string) {
+	m.category = &s
+}
+
+// Category returns the value of the "category" field in the mutation.
+func (m *SearchRuleMutation) Category() (r string, exists bool) {
+	v := m.category
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPriceNumeric returns the old "price_numeric" field's value of the Product entity.
-// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// OldCategory returns the old "category" field's value of the SearchRule entity.
+// If the SearchRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProductMutation) OldPriceNumeric(ctx context.Context) (v int, err error) {
+func (m *SearchRuleMutation) OldCategory(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPriceNumeric is only allowed on UpdateOne operations")
+		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPriceNumeric requires an ID field in the mutation")
+		return v, errors.New("OldCategory requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPriceNumeric: %w", err)
-	}
-	return oldValue.PriceNumeric, nil
-}
-
-// AddPriceNumeric adds i to the "price_numeric" field.
-func (m *ProductMutation) AddPriceNumeric(i int) {
-	if m.addprice_numeric != nil {
-		*m.addprice_numeric += i
-	} else {
-		m.addprice_numeric = &i
-	}
-}
-
-// AddedPriceNumeric returns the value that was added to the "price_numeric" field in this mutation.
-func (m *ProductMutation) AddedPriceNumeric() (r int, exists bool) {
-	v := m.addprice_numeric
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
 	}
-	return *v, true
+	return oldValue.Category, nil
 }
 
-// ClearPriceNumeric clears the value of the "price_numeric" field.
-func (m *ProductMutation) ClearPriceNumeric() {
-	m.price_numeric = nil
-	m.addprice_numeric = nil
-	m.clearedFields[product.FieldPriceNumeric] = struct{}{}
+// ClearCategory clears the value of the "category" field.
+func (m *SearchRuleMutation) ClearCategory() {
+	m.category = nil
+	m.clearedFields[searchrule.FieldCategory] = struct{}{}
 }
 
-// PriceNumericCleared returns if the "price_numeric" field was cleared in this mutation.
-func (m *ProductMutation) PriceNumericCleared() bool {
-	_, ok := m.clearedFields[product.FieldPriceNumeric]
+// CategoryCleared returns if the "category" field was cleared in this mutation.
+func (m *SearchRuleMutation) CategoryCleared() bool {
+	_, ok := m.clearedFields[searchrule.FieldCategory]
 	return ok
 }
 
-// ResetPriceNumeric resets all changes to the "price_numeric" field.
-func (m *ProductMutation) ResetPriceNumeric() {
-	m.price_numeric = nil
-	m.addprice_numeric = nil
-	delete(m.clearedFields, product.FieldPriceNumeric)
+// ResetCategory resets all changes to the "category" field.
+func (m *SearchRuleMutation) ResetCategory() {
+	m.category = nil
+	delete(m.clearedFields, searchrule.FieldCategory)
 }
 
-// SetRatingNumeric sets the "rating_numeric" field.
-func (m *ProductMutation) SetRatingNumeric(f float64) {
-	m.rating_numeric = &f
-	m.addrating_numeric = nil
+// SetBoost sets the "boost" field.
+func (m *SearchRuleMutation) SetBoost(f float64) {
+	m.boost = &f
+	m.addboost = nil
 }
 
-// RatingNumeric returns the value of the "rating_numeric" field in the mutation.
-func (m *ProductMutation) RatingNumeric() (r float64, exists bool) {
-	v := m.rating_numeric
+// Boost returns the value of the "boost" field in the mutation.
+func (m *SearchRuleMutation) Boost() (r float64, exists bool) {
+	v := m.boost
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRatingNumeric returns the old "rating_numeric" field's value of the Product entity.
-// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// OldBoost returns the old "boost" field's value of the SearchRule entity.
+// If the SearchRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProductMutation) OldRatingNumeric(ctx context.Context) (v float64, err error) {
+func (m *SearchRuleMutation) OldBoost(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRatingNumeric is only allowed on UpdateOne operations")
+		return v, errors.New("OldBoost is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRatingNumeric requires an ID field in the mutation")
+		return v, errors.New("OldBoost requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRatingNumeric: %w", err)
+		return v, fmt.Errorf("querying old value for OldBoost: %w", err)
 	}
-	return oldValue.RatingNumeric, nil
+	return oldValue.Boost, nil
 }
 
-// AddRatingNumeric adds f to the "rating_numeric" field.
-func (m *ProductMutation) AddRatingNumeric(f float64) {
-	if m.addrating_numeric != nil {
-		*m.addrating_numeric += f
+// AddBoost adds f to the "boost" field.
+func (m *SearchRuleMutation) AddBoost(f float64) {
+	if m.addboost != nil {
+		*m.addboost += f
 	} else {
-		m.addrating_numeric = &f
+		m.addboost = &f
 	}
 }
 
-// AddedRatingNumeric returns the value that was added to the "rating_numeric" field in this mutation.
-func (m *ProductMutation) AddedRatingNumeric() (r float64, exists bool) {
-	v := m.addrating_numeric
+// AddedBoost returns the value that was added to the "boost" field in this mutation.
+func (m *SearchRuleMutation) AddedBoost() (r float64, exists bool) {
+	v := m.addboost
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearRatingNumeric clears the value of the "rating_numeric" field.
-func (m *ProductMutation) ClearRatingNumeric() {
-	m.rating_numeric = nil
-	m.addrating_numeric = nil
-	m.clearedFields[product.FieldRatingNumeric] = struct{}{}
+// ResetBoost resets all changes to the "boost" field.
+func (m *SearchRuleMutation) ResetBoost() {
+	m.boost = nil
+	m.addboost = nil
 }
 
-// RatingNumericCleared returns if the "rating_numeric" field was cleared in this mutation.
-func (m *ProductMutation) RatingNumericCleared() bool {
-	_, ok := m.clearedFields[product.FieldRatingNumeric]
-	return ok
+// SetEnabled sets the "enabled" field.
+func (m *SearchRuleMutation) SetEnabled(b bool) {
+	m.enabled = &b
 }
 
-// ResetRatingNumeric resets all changes to the "rating_numeric" field.
-func (m *ProductMutation) ResetRatingNumeric() {
-	m.rating_numeric = nil
-	m.addrating_numeric = nil
-	delete(m.clearedFields, product.FieldRatingNumeric)
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *SearchRuleMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Where appends a list predicates to the ProductMutation builder.
-func (m *ProductMutation) Where(ps ...predicate.Product) {
+// OldEnabled returns the old "enabled" field's value of the SearchRule entity.
+// If the SearchRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchRuleMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *SearchRuleMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// Where appends a list predicates to the SearchRuleMutation builder.
+func (m *SearchRuleMutation) Where(ps ...predicate.SearchRule) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ProductMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SearchRuleMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ProductMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Product, len(ps))
+func (m *SearchRuleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SearchRule, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1503,105 +6630,48 @@ func (m *ProductMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ProductMutation) Op() Op {
+func (m *SearchRuleMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ProductMutation) SetOp(op Op) {
+func (m *SearchRuleMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Product).
-func (m *ProductMutation) Type() string {
+// Type returns the node type of this mutation (SearchRule).
+func (m *SearchRuleMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ProductMutation) Fields() []string {
-	fields := make([]string, 0, 27)
+func (m *SearchRuleMutation) Fields() []string {
+	fields := make([]string, 0, 8)
 	if m.create_time != nil {
-		fields = append(fields, product.FieldCreateTime)
+		fields = append(fields, searchrule.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, product.FieldUpdateTime)
-	}
-	if m.original_id != nil {
-		fields = append(fields, product.FieldOriginalID)
-	}
-	if m.title != nil {
-		fields = append(fields, product.FieldTitle)
+		fields = append(fields, searchrule.FieldUpdateTime)
 	}
-	if m.brand != nil {
-		fields = append(fields, product.FieldBrand)
-	}
-	if m.description != nil {
-		fields = append(fields, product.FieldDescription)
-	}
-	if m.actual_price != nil {
-		fields = append(fields, product.FieldActualPrice)
+	if m.kind != nil {
+		fields = append(fields, searchrule.FieldKind)
 	}
-	if m.selling_price != nil {
-		fields = append(fields, product.FieldSellingPrice)
+	if m.terms != nil {
+		fields = append(fields, searchrule.FieldTerms)
 	}
-	if m.discount != nil {
-		fields = append(fields, product.FieldDiscount)
+	if m.synonyms != nil {
+		fields = append(fields, searchrule.FieldSynonyms)
 	}
 	if m.category != nil {
-		fields = append(fields, product.FieldCategory)
-	}
-	if m.sub_category != nil {
-		fields = append(fields, product.FieldSubCategory)
-	}
-	if m.out_of_stock != nil {
-		fields = append(fields, product.FieldOutOfStock)
-	}
-	if m.seller != nil {
-		fields = append(fields, product.FieldSeller)
-	}
-	if m.average_rating != nil {
-		fields = append(fields, product.FieldAverageRating)
-	}
-	if m.images != nil {
-		fields = append(fields, product.FieldImages)
-	}
-	if m.product_details != nil {
-		fields = append(fields, product.FieldProductDetails)
-	}
-	if m.url != nil {
-		fields = append(fields, product.FieldURL)
-	}
-	if m.pid != nil {
-		fields = append(fields, product.FieldPid)
-	}
-	if m.style_code != nil {
-		fields = append(fields, product.FieldStyleCode)
-	}
-	if m.crawled_at != nil {
-		fields = append(fields, product.FieldCrawledAt)
-	}
-	if m.embedding != nil {
-		fields = append(fields, product.FieldEmbedding)
-	}
-	if m.search_keywords != nil {
-		fields = append(fields, product.FieldSearchKeywords)
-	}
-	if m.featured != nil {
-		fields = append(fields, product.FieldFeatured)
-	}
-	if m.view_count != nil {
-		fields = append(fields, product.FieldViewCount)
-	}
-	if m.click_count != nil {
-		fields = append(fields, product.FieldClickCount)
+		fields = append(fields, searchrule.FieldCategory)
 	}
-	if m.price_numeric != nil {
-		fields = append(fields, product.FieldPriceNumeric)
+	if m.boost != nil {
+		fields = append(fields, searchrule.FieldBoost)
 	}
-	if m.rating_numeric != nil {
-		fields = append(fields, product.FieldRatingNumeric)
+	if m.enabled != nil {
+		fields = append(fields, searchrule.FieldEnabled)
 	}
 	return fields
 }
@@ -1609,62 +6679,24 @@ func (m *ProductMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ProductMutation) Field(name string) (ent.Value, bool) {
+func (m *SearchRuleMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case product.FieldCreateTime:
+	case searchrule.FieldCreateTime:
 		return m.CreateTime()
-	case product.FieldUpdateTime:
+	case searchrule.FieldUpdateTime:
 		return m.UpdateTime()
-	case product.FieldOriginalID:
-		return m.OriginalID()
-	case product.FieldTitle:
-		return m.Title()
-	case product.FieldBrand:
-		return m.Brand()
-	case product.FieldDescription:
-		return m.Description()
-	case product.FieldActualPrice:
-		return m.ActualPrice()
-	case product.FieldSellingPrice:
-		return m.SellingPrice()
-	case product.FieldDiscount:
-		return m.Discount()
-	case product.FieldCategory:
+	case searchrule.FieldKind:
+		return m.Kind()
+	case searchrule.FieldTerms:
+		return m.Terms()
+	case searchrule.FieldSynonyms:
+		return m.Synonyms()
+	case searchrule.FieldCategory:
 		return m.Category()
-	case product.FieldSubCategory:
-		return m.SubCategory()
-	case product.FieldOutOfStock:
-		return m.OutOfStock()
-	case product.FieldSeller:
-		return m.Seller()
-	case product.FieldAverageRating:
-		return m.AverageRating()
-	case product.FieldImages:
-		return m.Images()
-	case product.FieldProductDetails:
-		return m.ProductDetails()
-	case product.FieldURL:
-		return m.URL()
-	case product.FieldPid:
-		return m.Pid()
-	case product.FieldStyleCode:
-		return m.StyleCode()
-	case product.FieldCrawledAt:
-		return m.CrawledAt()
-	case product.FieldEmbedding:
-		return m.Embedding()
-	case product.FieldSearchKeywords:
-		return m.SearchKeywords()
-	case product.FieldFeatured:
-		return m.Featured()
-	case product.FieldViewCount:
-		return m.ViewCount()
-	case product.FieldClickCount:
-		return m.ClickCount()
-	case product.FieldPriceNumeric:
-		return m.PriceNumeric()
-	case product.FieldRatingNumeric:
-		return m.RatingNumeric()
+	case searchrule.FieldBoost:
+		return m.Boost()
+	case searchrule.FieldEnabled:
+		return m.Enabled()
 	}
 	return nil, false
 }
@@ -1672,279 +6704,99 @@ func (m *ProductMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ProductMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SearchRuleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case product.FieldCreateTime:
+	case searchrule.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case product.FieldUpdateTime:
+	case searchrule.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case product.FieldOriginalID:
-		return m.OldOriginalID(ctx)
-	case product.FieldTitle:
-		return m.OldTitle(ctx)
-	case product.FieldBrand:
-		return m.OldBrand(ctx)
-	case product.FieldDescription:
-		return m.OldDescription(ctx)
-	case product.FieldActualPrice:
-		return m.OldActualPrice(ctx)
-	case product.FieldSellingPrice:
-		return m.OldSellingPrice(ctx)
-	case product.FieldDiscount:
-		return m.OldDiscount(ctx)
-	case product.FieldCategory:
+	case searchrule.FieldKind:
+		return m.OldKind(ctx)
+	case searchrule.FieldTerms:
+		return m.OldTerms(ctx)
+	case searchrule.FieldSynonyms:
+		return m.OldSynonyms(ctx)
+	case searchrule.FieldCategory:
 		return m.OldCategory(ctx)
-	case product.FieldSubCategory:
-		return m.OldSubCategory(ctx)
-	case product.FieldOutOfStock:
-		return m.OldOutOfStock(ctx)
-	case product.FieldSeller:
-		return m.OldSeller(ctx)
-	case product.FieldAverageRating:
-		return m.OldAverageRating(ctx)
-	case product.FieldImages:
-		return m.OldImages(ctx)
-	case product.FieldProductDetails:
-		return m.OldProductDetails(ctx)
-	case product.FieldURL:
-		return m.OldURL(ctx)
-	case product.FieldPid:
-		return m.OldPid(ctx)
-	case product.FieldStyleCode:
-		return m.OldStyleCode(ctx)
-	case product.FieldCrawledAt:
-		return m.OldCrawledAt(ctx)
-	case product.FieldEmbedding:
-		return m.OldEmbedding(ctx)
-	case product.FieldSearchKeywords:
-		return m.OldSearchKeywords(ctx)
-	case product.FieldFeatured:
-		return m.OldFeatured(ctx)
-	case product.FieldViewCount:
-		return m.OldViewCount(ctx)
-	case product.FieldClickCount:
-		return m.OldClickCount(ctx)
-	case product.FieldPriceNumeric:
-		return m.OldPriceNumeric(ctx)
-	case product.FieldRatingNumeric:
-		return m.OldRatingNumeric(ctx)
+	case searchrule.FieldBoost:
+		return m.OldBoost(ctx)
+	case searchrule.FieldEnabled:
+		return m.OldEnabled(ctx)
 	}
-	return nil, fmt.Errorf("unknown Product field %s", name)
+	return nil, fmt.Errorf("unknown SearchRule field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ProductMutation) SetField(name string, value ent.Value) error {
+func (m *SearchRuleMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case product.FieldCreateTime:
+	case searchrule.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case product.FieldUpdateTime:
+	case searchrule.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case product.FieldOriginalID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetOriginalID(v)
-		return nil
-	case product.FieldTitle:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTitle(v)
-		return nil
-	case product.FieldBrand:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetBrand(v)
-		return nil
-	case product.FieldDescription:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDescription(v)
-		return nil
-	case product.FieldActualPrice:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetActualPrice(v)
-		return nil
-	case product.FieldSellingPrice:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSellingPrice(v)
-		return nil
-	case product.FieldDiscount:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDiscount(v)
-		return nil
-	case product.FieldCategory:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCategory(v)
-		return nil
-	case product.FieldSubCategory:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSubCategory(v)
-		return nil
-	case product.FieldOutOfStock:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetOutOfStock(v)
-		return nil
-	case product.FieldSeller:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSeller(v)
-		return nil
-	case product.FieldAverageRating:
-		v, ok := value.(string)
+	case searchrule.FieldKind:
+		v, ok := value.(searchrule.Kind)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAverageRating(v)
+		m.SetKind(v)
 		return nil
-	case product.FieldImages:
+	case searchrule.FieldTerms:
 		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetImages(v)
-		return nil
-	case product.FieldProductDetails:
-		v, ok := value.([]map[string]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetProductDetails(v)
-		return nil
-	case product.FieldURL:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetURL(v)
-		return nil
-	case product.FieldPid:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPid(v)
-		return nil
-	case product.FieldStyleCode:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStyleCode(v)
-		return nil
-	case product.FieldCrawledAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCrawledAt(v)
-		return nil
-	case product.FieldEmbedding:
-		v, ok := value.([]float32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEmbedding(v)
+		m.SetTerms(v)
 		return nil
-	case product.FieldSearchKeywords:
+	case searchrule.FieldSynonyms:
 		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSearchKeywords(v)
-		return nil
-	case product.FieldFeatured:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFeatured(v)
-		return nil
-	case product.FieldViewCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetViewCount(v)
-		return nil
-	case product.FieldClickCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetClickCount(v)
+		m.SetSynonyms(v)
 		return nil
-	case product.FieldPriceNumeric:
-		v, ok := value.(int)
+	case searchrule.FieldCategory:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPriceNumeric(v)
+		m.SetCategory(v)
 		return nil
-	case product.FieldRatingNumeric:
+	case searchrule.FieldBoost:
 		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRatingNumeric(v)
+		m.SetBoost(v)
+		return nil
+	case searchrule.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Product field %s", name)
+	return fmt.Errorf("unknown SearchRule field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ProductMutation) AddedFields() []string {
+func (m *SearchRuleMutation) AddedFields() []string {
 	var fields []string
-	if m.addview_count != nil {
-		fields = append(fields, product.FieldViewCount)
-	}
-	if m.addclick_count != nil {
-		fields = append(fields, product.FieldClickCount)
-	}
-	if m.addprice_numeric != nil {
-		fields = append(fields, product.FieldPriceNumeric)
-	}
-	if m.addrating_numeric != nil {
-		fields = append(fields, product.FieldRatingNumeric)
+	if m.addboost != nil {
+		fields = append(fields, searchrule.FieldBoost)
 	}
 	return fields
 }
@@ -1952,16 +6804,10 @@ func (m *ProductMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ProductMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SearchRuleMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case product.FieldViewCount:
-		return m.AddedViewCount()
-	case product.FieldClickCount:
-		return m.AddedClickCount()
-	case product.FieldPriceNumeric:
-		return m.AddedPriceNumeric()
-	case product.FieldRatingNumeric:
-		return m.AddedRatingNumeric()
+	case searchrule.FieldBoost:
+		return m.AddedBoost()
 	}
 	return nil, false
 }
@@ -1969,287 +6815,131 @@ func (m *ProductMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ProductMutation) AddField(name string, value ent.Value) error {
+func (m *SearchRuleMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case product.FieldViewCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddViewCount(v)
-		return nil
-	case product.FieldClickCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddClickCount(v)
-		return nil
-	case product.FieldPriceNumeric:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddPriceNumeric(v)
-		return nil
-	case product.FieldRatingNumeric:
+	case searchrule.FieldBoost:
 		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddRatingNumeric(v)
+		m.AddBoost(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Product numeric field %s", name)
+	return fmt.Errorf("unknown SearchRule numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ProductMutation) ClearedFields() []string {
+func (m *SearchRuleMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(product.FieldDescription) {
-		fields = append(fields, product.FieldDescription)
-	}
-	if m.FieldCleared(product.FieldActualPrice) {
-		fields = append(fields, product.FieldActualPrice)
-	}
-	if m.FieldCleared(product.FieldSellingPrice) {
-		fields = append(fields, product.FieldSellingPrice)
-	}
-	if m.FieldCleared(product.FieldDiscount) {
-		fields = append(fields, product.FieldDiscount)
-	}
-	if m.FieldCleared(product.FieldSeller) {
-		fields = append(fields, product.FieldSeller)
-	}
-	if m.FieldCleared(product.FieldAverageRating) {
-		fields = append(fields, product.FieldAverageRating)
-	}
-	if m.FieldCleared(product.FieldImages) {
-		fields = append(fields, product.FieldImages)
-	}
-	if m.FieldCleared(product.FieldProductDetails) {
-		fields = append(fields, product.FieldProductDetails)
-	}
-	if m.FieldCleared(product.FieldURL) {
-		fields = append(fields, product.FieldURL)
-	}
-	if m.FieldCleared(product.FieldStyleCode) {
-		fields = append(fields, product.FieldStyleCode)
-	}
-	if m.FieldCleared(product.FieldCrawledAt) {
-		fields = append(fields, product.FieldCrawledAt)
-	}
-	if m.FieldCleared(product.FieldEmbedding) {
-		fields = append(fields, product.FieldEmbedding)
-	}
-	if m.FieldCleared(product.FieldSearchKeywords) {
-		fields = append(fields, product.FieldSearchKeywords)
-	}
-	if m.FieldCleared(product.FieldPriceNumeric) {
-		fields = append(fields, product.FieldPriceNumeric)
+	if m.FieldCleared(searchrule.FieldSynonyms) {
+		fields = append(fields, searchrule.FieldSynonyms)
 	}
-	if m.FieldCleared(product.FieldRatingNumeric) {
-		fields = append(fields, product.FieldRatingNumeric)
+	if m.FieldCleared(searchrule.FieldCategory) {
+		fields = append(fields, searchrule.FieldCategory)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ProductMutation) FieldCleared(name string) bool {
+func (m *SearchRuleMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ProductMutation) ClearField(name string) error {
+func (m *SearchRuleMutation) ClearField(name string) error {
 	switch name {
-	case product.FieldDescription:
-		m.ClearDescription()
-		return nil
-	case product.FieldActualPrice:
-		m.ClearActualPrice()
-		return nil
-	case product.FieldSellingPrice:
-		m.ClearSellingPrice()
-		return nil
-	case product.FieldDiscount:
-		m.ClearDiscount()
-		return nil
-	case product.FieldSeller:
-		m.ClearSeller()
-		return nil
-	case product.FieldAverageRating:
-		m.ClearAverageRating()
-		return nil
-	case product.FieldImages:
-		m.ClearImages()
-		return nil
-	case product.FieldProductDetails:
-		m.ClearProductDetails()
-		return nil
-	case product.FieldURL:
-		m.ClearURL()
+	case searchrule.FieldSynonyms:
+		m.ClearSynonyms()
 		return nil
-	case product.FieldStyleCode:
-		m.ClearStyleCode()
-		return nil
-	case product.FieldCrawledAt:
-		m.ClearCrawledAt()
-		return nil
-	case product.FieldEmbedding:
-		m.ClearEmbedding()
-		return nil
-	case product.FieldSearchKeywords:
-		m.ClearSearchKeywords()
-		return nil
-	case product.FieldPriceNumeric:
-		m.ClearPriceNumeric()
-		return nil
-	case product.FieldRatingNumeric:
-		m.ClearRatingNumeric()
+	case searchrule.FieldCategory:
+		m.ClearCategory()
 		return nil
 	}
-	return fmt.Errorf("unknown Product nullable field %s", name)
+	return fmt.Errorf("unknown SearchRule nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ProductMutation) ResetField(name string) error {
+func (m *SearchRuleMutation) ResetField(name string) error {
 	switch name {
-	case product.FieldCreateTime:
+	case searchrule.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case product.FieldUpdateTime:
+	case searchrule.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case product.FieldOriginalID:
-		m.ResetOriginalID()
-		return nil
-	case product.FieldTitle:
-		m.ResetTitle()
-		return nil
-	case product.FieldBrand:
-		m.ResetBrand()
-		return nil
-	case product.FieldDescription:
-		m.ResetDescription()
-		return nil
-	case product.FieldActualPrice:
-		m.ResetActualPrice()
+	case searchrule.FieldKind:
+		m.ResetKind()
 		return nil
-	case product.FieldSellingPrice:
-		m.ResetSellingPrice()
+	case searchrule.FieldTerms:
+		m.ResetTerms()
 		return nil
-	case product.FieldDiscount:
-		m.ResetDiscount()
+	case searchrule.FieldSynonyms:
+		m.ResetSynonyms()
 		return nil
-	case product.FieldCategory:
+	case searchrule.FieldCategory:
 		m.ResetCategory()
 		return nil
-	case product.FieldSubCategory:
-		m.ResetSubCategory()
-		return nil
-	case product.FieldOutOfStock:
-		m.ResetOutOfStock()
-		return nil
-	case product.FieldSeller:
-		m.ResetSeller()
-		return nil
-	case product.FieldAverageRating:
-		m.ResetAverageRating()
-		return nil
-	case product.FieldImages:
-		m.ResetImages()
-		return nil
-	case product.FieldProductDetails:
-		m.ResetProductDetails()
-		return nil
-	case product.FieldURL:
-		m.ResetURL()
-		return nil
-	case product.FieldPid:
-		m.ResetPid()
-		return nil
-	case product.FieldStyleCode:
-		m.ResetStyleCode()
-		return nil
-	case product.FieldCrawledAt:
-		m.ResetCrawledAt()
-		return nil
-	case product.FieldEmbedding:
-		m.ResetEmbedding()
-		return nil
-	case product.FieldSearchKeywords:
-		m.ResetSearchKeywords()
-		return nil
-	case product.FieldFeatured:
-		m.ResetFeatured()
-		return nil
-	case product.FieldViewCount:
-		m.ResetViewCount()
-		return nil
-	case product.FieldClickCount:
-		m.ResetClickCount()
+	case searchrule.FieldBoost:
+		m.ResetBoost()
 		return nil
-	case product.FieldPriceNumeric:
-		m.ResetPriceNumeric()
-		return nil
-	case product.FieldRatingNumeric:
-		m.ResetRatingNumeric()
+	case searchrule.FieldEnabled:
+		m.ResetEnabled()
 		return nil
 	}
-	return fmt.Errorf("unknown Product field %s", name)
+	return fmt.Errorf("unknown SearchRule field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ProductMutation) AddedEdges() []string {
+func (m *SearchRuleMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ProductMutation) AddedIDs(name string) []ent.Value {
+func (m *SearchRuleMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ProductMutation) RemovedEdges() []string {
+func (m *SearchRuleMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ProductMutation) RemovedIDs(name string) []ent.Value {
+func (m *SearchRuleMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ProductMutation) ClearedEdges() []string {
+func (m *SearchRuleMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ProductMutation) EdgeCleared(name string) bool {
+func (m *SearchRuleMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ProductMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Product unique edge %s", name)
+func (m *SearchRuleMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SearchRule unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ProductMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Product edge %s", name)
+func (m *SearchRuleMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SearchRule edge %s", name)
 }
 
 // UserMutation represents an operation that mutates the User nodes in the graph.
@@ -2267,6 +6957,7 @@ type UserMutation struct {
 	phone         *string
 	username      *string
 	password      *string
+	role          *user.Role
 	clearedFields map[string]struct{}
 	done          bool
 	oldValue      func(context.Context) (*User, error)
@@ -2719,6 +7410,42 @@ func (m *UserMutation) ResetPassword() {
 	m.password = nil
 }
 
+// SetRole sets the "role" field.
+func (m *UserMutation) SetRole(u user.Role) {
+	m.role = &u
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *UserMutation) Role() (r user.Role, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRole returns the old "role" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldRole(ctx context.Context) (v user.Role, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	}
+	return oldValue.Role, nil
+}
+
+// ResetRole resets all changes to the "role" field.
+func (m *UserMutation) ResetRole() {
+	m.role = nil
+}
+
 // Where appends a list predicates to the UserMutation builder.
 func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
@@ -2753,7 +7480,7 @@ func (m *UserMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 8)
+	fields := make([]string, 0, 9)
 	if m.create_time != nil {
 		fields = append(fields, user.FieldCreateTime)
 	}
@@ -2778,6 +7505,9 @@ func (m *UserMutation) Fields() []string {
 	if m.password != nil {
 		fields = append(fields, user.FieldPassword)
 	}
+	if m.role != nil {
+		fields = append(fields, user.FieldRole)
+	}
 	return fields
 }
 
@@ -2802,6 +7532,8 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 		return m.Username()
 	case user.FieldPassword:
 		return m.Password()
+	case user.FieldRole:
+		return m.Role()
 	}
 	return nil, false
 }
@@ -2827,6 +7559,8 @@ func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldUsername(ctx)
 	case user.FieldPassword:
 		return m.OldPassword(ctx)
+	case user.FieldRole:
+		return m.OldRole(ctx)
 	}
 	return nil, fmt.Errorf("unknown User field %s", name)
 }
@@ -2892,6 +7626,13 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetPassword(v)
 		return nil
+	case user.FieldRole:
+		v, ok := value.(user.Role)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
+		return nil
 	}
 	return fmt.Errorf("unknown User field %s", name)
 }
@@ -3001,6 +7742,9 @@ func (m *UserMutation) ResetField(name string) error {
 	case user.FieldPassword:
 		m.ResetPassword()
 		return nil
+	case user.FieldRole:
+		m.ResetRole()
+		return nil
 	}
 	return fmt.Errorf("unknown User field %s", name)
 }
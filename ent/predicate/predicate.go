@@ -6,8 +6,26 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// EmbeddingFailure is the predicate function for embeddingfailure builders.
+type EmbeddingFailure func(*sql.Selector)
+
+// EmbeddingJob is the predicate function for embeddingjob builders.
+type EmbeddingJob func(*sql.Selector)
+
 // Product is the predicate function for product builders.
 type Product func(*sql.Selector)
 
+// ProductAttribute is the predicate function for productattribute builders.
+type ProductAttribute func(*sql.Selector)
+
+// RefreshToken is the predicate function for refreshtoken builders.
+type RefreshToken func(*sql.Selector)
+
+// SchemaMigration is the predicate function for schemamigration builders.
+type SchemaMigration func(*sql.Selector)
+
+// SearchRule is the predicate function for searchrule builders.
+type SearchRule func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
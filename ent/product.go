@@ -60,8 +60,16 @@ type Product struct {
 	CrawledAt time.Time `json:"crawled_at,omitempty"`
 	// Vector embeddings for semantic search
 	Embedding []float32 `json:"embedding,omitempty"`
+	// Embedding model and version that produced embedding
+	EmbeddingModel string `json:"embedding_model,omitempty"`
+	// content_hash the embedding was generated from
+	EmbeddingHash string `json:"embedding_hash,omitempty"`
+	// Hash of the embedding inputs, maintained by a hook
+	ContentHash string `json:"content_hash,omitempty"`
 	// Keywords for full-text search
 	SearchKeywords []string `json:"search_keywords,omitempty"`
+	// search_keywords joined by spaces for the FULLTEXT index
+	SearchText string `json:"search_text,omitempty"`
 	// Featured product
 	Featured bool `json:"featured,omitempty"`
 	// Number of views
@@ -72,7 +80,32 @@ type Product struct {
 	PriceNumeric int `json:"price_numeric,omitempty"`
 	// Rating as float for sorting
 	RatingNumeric float64 `json:"rating_numeric,omitempty"`
-	selectValues  sql.SelectValues
+	// Original price as integer
+	ActualPriceNumeric int `json:"actual_price_numeric,omitempty"`
+	// Discount off the original price, in percent
+	DiscountPercent float64 `json:"discount_percent,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ProductQuery when eager-loading is set.
+	Edges        ProductEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ProductEdges holds the relations/edges for other nodes in the graph.
+type ProductEdges struct {
+	// Attributes holds the value of the attributes edge.
+	Attributes []*ProductAttribute `json:"attributes,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// AttributesOrErr returns the Attributes value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProductEdges) AttributesOrErr() ([]*ProductAttribute, error) {
+	if e.loadedTypes[0] {
+		return e.Attributes, nil
+	}
+	return nil, &NotLoadedError{edge: "attributes"}
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -84,11 +117,11 @@ func (*Product) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case product.FieldOutOfStock, product.FieldFeatured:
 			values[i] = new(sql.NullBool)
-		case product.FieldRatingNumeric:
+		case product.FieldRatingNumeric, product.FieldDiscountPercent:
 			values[i] = new(sql.NullFloat64)
-		case product.FieldID, product.FieldViewCount, product.FieldClickCount, product.FieldPriceNumeric:
+		case product.FieldID, product.FieldViewCount, product.FieldClickCount, product.FieldPriceNumeric, product.FieldActualPriceNumeric:
 			values[i] = new(sql.NullInt64)
-		case product.FieldOriginalID, product.FieldTitle, product.FieldBrand, product.FieldDescription, product.FieldActualPrice, product.FieldSellingPrice, product.FieldDiscount, product.FieldCategory, product.FieldSubCategory, product.FieldSeller, product.FieldAverageRating, product.FieldURL, product.FieldPid, product.FieldStyleCode:
+		case product.FieldOriginalID, product.FieldTitle, product.FieldBrand, product.FieldDescription, product.FieldActualPrice, product.FieldSellingPrice, product.FieldDiscount, product.FieldCategory, product.FieldSubCategory, product.FieldSeller, product.FieldAverageRating, product.FieldURL, product.FieldPid, product.FieldStyleCode, product.FieldEmbeddingModel, product.FieldEmbeddingHash, product.FieldContentHash, product.FieldSearchText:
 			values[i] = new(sql.NullString)
 		case product.FieldCreateTime, product.FieldUpdateTime, product.FieldCrawledAt:
 			values[i] = new(sql.NullTime)
@@ -245,6 +278,24 @@ func (_m *Product) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field embedding: %w", err)
 				}
 			}
+		case product.FieldEmbeddingModel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field embedding_model", values[i])
+			} else if value.Valid {
+				_m.EmbeddingModel = value.String
+			}
+		case product.FieldEmbeddingHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field embedding_hash", values[i])
+			} else if value.Valid {
+				_m.EmbeddingHash = value.String
+			}
+		case product.FieldContentHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content_hash", values[i])
+			} else if value.Valid {
+				_m.ContentHash = value.String
+			}
 		case product.FieldSearchKeywords:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field search_keywords", values[i])
@@ -253,6 +304,12 @@ func (_m *Product) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field search_keywords: %w", err)
 				}
 			}
+		case product.FieldSearchText:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field search_text", values[i])
+			} else if value.Valid {
+				_m.SearchText = value.String
+			}
 		case product.FieldFeatured:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field featured", values[i])
@@ -283,6 +340,18 @@ func (_m *Product) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.RatingNumeric = value.Float64
 			}
+		case product.FieldActualPriceNumeric:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field actual_price_numeric", values[i])
+			} else if value.Valid {
+				_m.ActualPriceNumeric = int(value.Int64)
+			}
+		case product.FieldDiscountPercent:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field discount_percent", values[i])
+			} else if value.Valid {
+				_m.DiscountPercent = value.Float64
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -296,6 +365,11 @@ func (_m *Product) Value(name string) (ent.Value, error) {
 	return _m.selectValues.Get(name)
 }
 
+// QueryAttributes queries the "attributes" edge of the Product entity.
+func (_m *Product) QueryAttributes() *ProductAttributeQuery {
+	return NewProductClient(_m.config).QueryAttributes(_m)
+}
+
 // Update returns a builder for updating this Product.
 // Note that you need to call Product.Unwrap() before calling this method if this Product
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -382,9 +456,21 @@ func (_m *Product) String() string {
 	builder.WriteString("embedding=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Embedding))
 	builder.WriteString(", ")
+	builder.WriteString("embedding_model=")
+	builder.WriteString(_m.EmbeddingModel)
+	builder.WriteString(", ")
+	builder.WriteString("embedding_hash=")
+	builder.WriteString(_m.EmbeddingHash)
+	builder.WriteString(", ")
+	builder.WriteString("content_hash=")
+	builder.WriteString(_m.ContentHash)
+	builder.WriteString(", ")
 	builder.WriteString("search_keywords=")
 	builder.WriteString(fmt.Sprintf("%v", _m.SearchKeywords))
 	builder.WriteString(", ")
+	builder.WriteString("search_text=")
+	builder.WriteString(_m.SearchText)
+	builder.WriteString(", ")
 	builder.WriteString("featured=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Featured))
 	builder.WriteString(", ")
@@ -399,6 +485,12 @@ func (_m *Product) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("rating_numeric=")
 	builder.WriteString(fmt.Sprintf("%v", _m.RatingNumeric))
+	builder.WriteString(", ")
+	builder.WriteString("actual_price_numeric=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ActualPriceNumeric))
+	builder.WriteString(", ")
+	builder.WriteString("discount_percent=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DiscountPercent))
 	builder.WriteByte(')')
 	return builder.String()
 }
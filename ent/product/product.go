@@ -7,6 +7,7 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 const (
@@ -56,8 +57,16 @@ const (
 	FieldCrawledAt = "crawled_at"
 	// FieldEmbedding holds the string denoting the embedding field in the database.
 	FieldEmbedding = "embedding"
+	// FieldEmbeddingModel holds the string denoting the embedding_model field in the database.
+	FieldEmbeddingModel = "embedding_model"
+	// FieldEmbeddingHash holds the string denoting the embedding_hash field in the database.
+	FieldEmbeddingHash = "embedding_hash"
+	// FieldContentHash holds the string denoting the content_hash field in the database.
+	FieldContentHash = "content_hash"
 	// FieldSearchKeywords holds the string denoting the search_keywords field in the database.
 	FieldSearchKeywords = "search_keywords"
+	// FieldSearchText holds the string denoting the search_text field in the database.
+	FieldSearchText = "search_text"
 	// FieldFeatured holds the string denoting the featured field in the database.
 	FieldFeatured = "featured"
 	// FieldViewCount holds the string denoting the view_count field in the database.
@@ -68,8 +77,21 @@ const (
 	FieldPriceNumeric = "price_numeric"
 	// FieldRatingNumeric holds the string denoting the rating_numeric field in the database.
 	FieldRatingNumeric = "rating_numeric"
+	// FieldActualPriceNumeric holds the string denoting the actual_price_numeric field in the database.
+	FieldActualPriceNumeric = "actual_price_numeric"
+	// FieldDiscountPercent holds the string denoting the discount_percent field in the database.
+	FieldDiscountPercent = "discount_percent"
+	// EdgeAttributes holds the string denoting the attributes edge name in mutations.
+	EdgeAttributes = "attributes"
 	// Table holds the table name of the product in the database.
 	Table = "products"
+	// AttributesTable is the table that holds the attributes relation/edge.
+	AttributesTable = "product_attributes"
+	// AttributesInverseTable is the table name for the ProductAttribute entity.
+	// It exists in this package in order to avoid circular dependency with the "productattribute" package.
+	AttributesInverseTable = "product_attributes"
+	// AttributesColumn is the table column denoting the attributes relation/edge.
+	AttributesColumn = "product_id"
 )
 
 // Columns holds all SQL columns for product fields.
@@ -96,12 +118,18 @@ var Columns = []string{
 	FieldStyleCode,
 	FieldCrawledAt,
 	FieldEmbedding,
+	FieldEmbeddingModel,
+	FieldEmbeddingHash,
+	FieldContentHash,
 	FieldSearchKeywords,
+	FieldSearchText,
 	FieldFeatured,
 	FieldViewCount,
 	FieldClickCount,
 	FieldPriceNumeric,
 	FieldRatingNumeric,
+	FieldActualPriceNumeric,
+	FieldDiscountPercent,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -120,7 +148,7 @@ func ValidColumn(column string) bool {
 //
 //	import _ "yinni_backend/ent/runtime"
 var (
-	Hooks [2]ent.Hook
+	Hooks [4]ent.Hook
 	// DefaultCreateTime holds the default value on creation for the "create_time" field.
 	DefaultCreateTime func() time.Time
 	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
@@ -149,6 +177,10 @@ var (
 	PriceNumericValidator func(int) error
 	// RatingNumericValidator is a validator for the "rating_numeric" field. It is called by the builders before save.
 	RatingNumericValidator func(float64) error
+	// ActualPriceNumericValidator is a validator for the "actual_price_numeric" field. It is called by the builders before save.
+	ActualPriceNumericValidator func(int) error
+	// DiscountPercentValidator is a validator for the "discount_percent" field. It is called by the builders before save.
+	DiscountPercentValidator func(float64) error
 )
 
 // OrderOption defines the ordering options for the Product queries.
@@ -249,6 +281,26 @@ func ByCrawledAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCrawledAt, opts...).ToFunc()
 }
 
+// ByEmbeddingModel orders the results by the embedding_model field.
+func ByEmbeddingModel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmbeddingModel, opts...).ToFunc()
+}
+
+// ByEmbeddingHash orders the results by the embedding_hash field.
+func ByEmbeddingHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmbeddingHash, opts...).ToFunc()
+}
+
+// ByContentHash orders the results by the content_hash field.
+func ByContentHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContentHash, opts...).ToFunc()
+}
+
+// BySearchText orders the results by the search_text field.
+func BySearchText(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSearchText, opts...).ToFunc()
+}
+
 // ByFeatured orders the results by the featured field.
 func ByFeatured(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldFeatured, opts...).ToFunc()
@@ -273,3 +325,34 @@ func ByPriceNumeric(opts ...sql.OrderTermOption) OrderOption {
 func ByRatingNumeric(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRatingNumeric, opts...).ToFunc()
 }
+
+// ByActualPriceNumeric orders the results by the actual_price_numeric field.
+func ByActualPriceNumeric(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActualPriceNumeric, opts...).ToFunc()
+}
+
+// ByDiscountPercent orders the results by the discount_percent field.
+func ByDiscountPercent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDiscountPercent, opts...).ToFunc()
+}
+
+// ByAttributesCount orders the results by attributes count.
+func ByAttributesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAttributesStep(), opts...)
+	}
+}
+
+// ByAttributes orders the results by attributes terms.
+func ByAttributes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAttributesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newAttributesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AttributesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AttributesTable, AttributesColumn),
+	)
+}
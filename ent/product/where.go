@@ -7,6 +7,7 @@ import (
 	"yinni_backend/ent/predicate"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 // ID filters vertices based on their ID field.
@@ -144,6 +145,26 @@ func CrawledAt(v time.Time) predicate.Product {
 	return predicate.Product(sql.FieldEQ(FieldCrawledAt, v))
 }
 
+// EmbeddingModel applies equality check predicate on the "embedding_model" field. It's identical to EmbeddingModelEQ.
+func EmbeddingModel(v string) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldEmbeddingModel, v))
+}
+
+// EmbeddingHash applies equality check predicate on the "embedding_hash" field. It's identical to EmbeddingHashEQ.
+func EmbeddingHash(v string) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldEmbeddingHash, v))
+}
+
+// ContentHash applies equality check predicate on the "content_hash" field. It's identical to ContentHashEQ.
+func ContentHash(v string) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldContentHash, v))
+}
+
+// SearchText applies equality check predicate on the "search_text" field. It's identical to SearchTextEQ.
+func SearchText(v string) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldSearchText, v))
+}
+
 // Featured applies equality check predicate on the "featured" field. It's identical to FeaturedEQ.
 func Featured(v bool) predicate.Product {
 	return predicate.Product(sql.FieldEQ(FieldFeatured, v))
@@ -169,6 +190,16 @@ func RatingNumeric(v float64) predicate.Product {
 	return predicate.Product(sql.FieldEQ(FieldRatingNumeric, v))
 }
 
+// ActualPriceNumeric applies equality check predicate on the "actual_price_numeric" field. It's identical to ActualPriceNumericEQ.
+func ActualPriceNumeric(v int) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldActualPriceNumeric, v))
+}
+
+// DiscountPercent applies equality check predicate on the "discount_percent" field. It's identical to DiscountPercentEQ.
+func DiscountPercent(v float64) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldDiscountPercent, v))
+}
+
 // CreateTimeEQ applies the EQ predicate on the "create_time" field.
 func CreateTimeEQ(v time.Time) predicate.Product {
 	return predicate.Product(sql.FieldEQ(FieldCreateTime, v))
@@ -1329,6 +1360,231 @@ func EmbeddingNotNil() predicate.Product {
 	return predicate.Product(sql.FieldNotNull(FieldEmbedding))
 }
 
+// EmbeddingModelEQ applies the EQ predicate on the "embedding_model" field.
+func EmbeddingModelEQ(v string) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldEmbeddingModel, v))
+}
+
+// EmbeddingModelNEQ applies the NEQ predicate on the "embedding_model" field.
+func EmbeddingModelNEQ(v string) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldEmbeddingModel, v))
+}
+
+// EmbeddingModelIn applies the In predicate on the "embedding_model" field.
+func EmbeddingModelIn(vs ...string) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldEmbeddingModel, vs...))
+}
+
+// EmbeddingModelNotIn applies the NotIn predicate on the "embedding_model" field.
+func EmbeddingModelNotIn(vs ...string) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldEmbeddingModel, vs...))
+}
+
+// EmbeddingModelGT applies the GT predicate on the "embedding_model" field.
+func EmbeddingModelGT(v string) predicate.Product {
+	return predicate.Product(sql.FieldGT(FieldEmbeddingModel, v))
+}
+
+// EmbeddingModelGTE applies the GTE predicate on the "embedding_model" field.
+func EmbeddingModelGTE(v string) predicate.Product {
+	return predicate.Product(sql.FieldGTE(FieldEmbeddingModel, v))
+}
+
+// EmbeddingModelLT applies the LT predicate on the "embedding_model" field.
+func EmbeddingModelLT(v string) predicate.Product {
+	return predicate.Product(sql.FieldLT(FieldEmbeddingModel, v))
+}
+
+// EmbeddingModelLTE applies the LTE predicate on the "embedding_model" field.
+func EmbeddingModelLTE(v string) predicate.Product {
+	return predicate.Product(sql.FieldLTE(FieldEmbeddingModel, v))
+}
+
+// EmbeddingModelContains applies the Contains predicate on the "embedding_model" field.
+func EmbeddingModelContains(v string) predicate.Product {
+	return predicate.Product(sql.FieldContains(FieldEmbeddingModel, v))
+}
+
+// EmbeddingModelHasPrefix applies the HasPrefix predicate on the "embedding_model" field.
+func EmbeddingModelHasPrefix(v string) predicate.Product {
+	return predicate.Product(sql.FieldHasPrefix(FieldEmbeddingModel, v))
+}
+
+// EmbeddingModelHasSuffix applies the HasSuffix predicate on the "embedding_model" field.
+func EmbeddingModelHasSuffix(v string) predicate.Product {
+	return predicate.Product(sql.FieldHasSuffix(FieldEmbeddingModel, v))
+}
+
+// EmbeddingModelIsNil applies the IsNil predicate on the "embedding_model" field.
+func EmbeddingModelIsNil() predicate.Product {
+	return predicate.Product(sql.FieldIsNull(FieldEmbeddingModel))
+}
+
+// EmbeddingModelNotNil applies the NotNil predicate on the "embedding_model" field.
+func EmbeddingModelNotNil() predicate.Product {
+	return predicate.Product(sql.FieldNotNull(FieldEmbeddingModel))
+}
+
+// EmbeddingModelEqualFold applies the EqualFold predicate on the "embedding_model" field.
+func EmbeddingModelEqualFold(v string) predicate.Product {
+	return predicate.Product(sql.FieldEqualFold(FieldEmbeddingModel, v))
+}
+
+// EmbeddingModelContainsFold applies the ContainsFold predicate on the "embedding_model" field.
+func EmbeddingModelContainsFold(v string) predicate.Product {
+	return predicate.Product(sql.FieldContainsFold(FieldEmbeddingModel, v))
+}
+
+// EmbeddingHashEQ applies the EQ predicate on the "embedding_hash" field.
+func EmbeddingHashEQ(v string) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldEmbeddingHash, v))
+}
+
+// EmbeddingHashNEQ applies the NEQ predicate on the "embedding_hash" field.
+func EmbeddingHashNEQ(v string) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldEmbeddingHash, v))
+}
+
+// EmbeddingHashIn applies the In predicate on the "embedding_hash" field.
+func EmbeddingHashIn(vs ...string) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldEmbeddingHash, vs...))
+}
+
+// EmbeddingHashNotIn applies the NotIn predicate on the "embedding_hash" field.
+func EmbeddingHashNotIn(vs ...string) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldEmbeddingHash, vs...))
+}
+
+// EmbeddingHashGT applies the GT predicate on the "embedding_hash" field.
+func EmbeddingHashGT(v string) predicate.Product {
+	return predicate.Product(sql.FieldGT(FieldEmbeddingHash, v))
+}
+
+// EmbeddingHashGTE applies the GTE predicate on the "embedding_hash" field.
+func EmbeddingHashGTE(v string) predicate.Product {
+	return predicate.Product(sql.FieldGTE(FieldEmbeddingHash, v))
+}
+
+// EmbeddingHashLT applies the LT predicate on the "embedding_hash" field.
+func EmbeddingHashLT(v string) predicate.Product {
+	return predicate.Product(sql.FieldLT(FieldEmbeddingHash, v))
+}
+
+// EmbeddingHashLTE applies the LTE predicate on the "embedding_hash" field.
+func EmbeddingHashLTE(v string) predicate.Product {
+	return predicate.Product(sql.FieldLTE(FieldEmbeddingHash, v))
+}
+
+// EmbeddingHashContains applies the Contains predicate on the "embedding_hash" field.
+func EmbeddingHashContains(v string) predicate.Product {
+	return predicate.Product(sql.FieldContains(FieldEmbeddingHash, v))
+}
+
+// EmbeddingHashHasPrefix applies the HasPrefix predicate on the "embedding_hash" field.
+func EmbeddingHashHasPrefix(v string) predicate.Product {
+	return predicate.Product(sql.FieldHasPrefix(FieldEmbeddingHash, v))
+}
+
+// EmbeddingHashHasSuffix applies the HasSuffix predicate on the "embedding_hash" field.
+func EmbeddingHashHasSuffix(v string) predicate.Product {
+	return predicate.Product(sql.FieldHasSuffix(FieldEmbeddingHash, v))
+}
+
+// EmbeddingHashIsNil applies the IsNil predicate on the "embedding_hash" field.
+func EmbeddingHashIsNil() predicate.Product {
+	return predicate.Product(sql.FieldIsNull(FieldEmbeddingHash))
+}
+
+// EmbeddingHashNotNil applies the NotNil predicate on the "embedding_hash" field.
+func EmbeddingHashNotNil() predicate.Product {
+	return predicate.Product(sql.FieldNotNull(FieldEmbeddingHash))
+}
+
+// EmbeddingHashEqualFold applies the EqualFold predicate on the "embedding_hash" field.
+func EmbeddingHashEqualFold(v string) predicate.Product {
+	return predicate.Product(sql.FieldEqualFold(FieldEmbeddingHash, v))
+}
+
+// EmbeddingHashContainsFold applies the ContainsFold predicate on the "embedding_hash" field.
+func EmbeddingHashContainsFold(v string) predicate.Product {
+	return predicate.Product(sql.FieldContainsFold(FieldEmbeddingHash, v))
+}
+
+// ContentHashEQ applies the EQ predicate on the "content_hash" field.
+func ContentHashEQ(v string) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldContentHash, v))
+}
+
+// ContentHashNEQ applies the NEQ predicate on the "content_hash" field.
+func ContentHashNEQ(v string) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldContentHash, v))
+}
+
+// ContentHashIn applies the In predicate on the "content_hash" field.
+func ContentHashIn(vs ...string) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldContentHash, vs...))
+}
+
+// ContentHashNotIn applies the NotIn predicate on the "content_hash" field.
+func ContentHashNotIn(vs ...string) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldContentHash, vs...))
+}
+
+// ContentHashGT applies the GT predicate on the "content_hash" field.
+func ContentHashGT(v string) predicate.Product {
+	return predicate.Product(sql.FieldGT(FieldContentHash, v))
+}
+
+// ContentHashGTE applies the GTE predicate on the "content_hash" field.
+func ContentHashGTE(v string) predicate.Product {
+	return predicate.Product(sql.FieldGTE(FieldContentHash, v))
+}
+
+// ContentHashLT applies the LT predicate on the "content_hash" field.
+func ContentHashLT(v string) predicate.Product {
+	return predicate.Product(sql.FieldLT(FieldContentHash, v))
+}
+
+// ContentHashLTE applies the LTE predicate on the "content_hash" field.
+func ContentHashLTE(v string) predicate.Product {
+	return predicate.Product(sql.FieldLTE(FieldContentHash, v))
+}
+
+// ContentHashContains applies the Contains predicate on the "content_hash" field.
+func ContentHashContains(v string) predicate.Product {
+	return predicate.Product(sql.FieldContains(FieldContentHash, v))
+}
+
+// ContentHashHasPrefix applies the HasPrefix predicate on the "content_hash" field.
+func ContentHashHasPrefix(v string) predicate.Product {
+	return predicate.Product(sql.FieldHasPrefix(FieldContentHash, v))
+}
+
+// ContentHashHasSuffix applies the HasSuffix predicate on the "content_hash" field.
+func ContentHashHasSuffix(v string) predicate.Product {
+	return predicate.Product(sql.FieldHasSuffix(FieldContentHash, v))
+}
+
+// ContentHashIsNil applies the IsNil predicate on the "content_hash" field.
+func ContentHashIsNil() predicate.Product {
+	return predicate.Product(sql.FieldIsNull(FieldContentHash))
+}
+
+// ContentHashNotNil applies the NotNil predicate on the "content_hash" field.
+func ContentHashNotNil() predicate.Product {
+	return predicate.Product(sql.FieldNotNull(FieldContentHash))
+}
+
+// ContentHashEqualFold applies the EqualFold predicate on the "content_hash" field.
+func ContentHashEqualFold(v string) predicate.Product {
+	return predicate.Product(sql.FieldEqualFold(FieldContentHash, v))
+}
+
+// ContentHashContainsFold applies the ContainsFold predicate on the "content_hash" field.
+func ContentHashContainsFold(v string) predicate.Product {
+	return predicate.Product(sql.FieldContainsFold(FieldContentHash, v))
+}
+
 // SearchKeywordsIsNil applies the IsNil predicate on the "search_keywords" field.
 func SearchKeywordsIsNil() predicate.Product {
 	return predicate.Product(sql.FieldIsNull(FieldSearchKeywords))
@@ -1339,6 +1595,81 @@ func SearchKeywordsNotNil() predicate.Product {
 	return predicate.Product(sql.FieldNotNull(FieldSearchKeywords))
 }
 
+// SearchTextEQ applies the EQ predicate on the "search_text" field.
+func SearchTextEQ(v string) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldSearchText, v))
+}
+
+// SearchTextNEQ applies the NEQ predicate on the "search_text" field.
+func SearchTextNEQ(v string) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldSearchText, v))
+}
+
+// SearchTextIn applies the In predicate on the "search_text" field.
+func SearchTextIn(vs ...string) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldSearchText, vs...))
+}
+
+// SearchTextNotIn applies the NotIn predicate on the "search_text" field.
+func SearchTextNotIn(vs ...string) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldSearchText, vs...))
+}
+
+// SearchTextGT applies the GT predicate on the "search_text" field.
+func SearchTextGT(v string) predicate.Product {
+	return predicate.Product(sql.FieldGT(FieldSearchText, v))
+}
+
+// SearchTextGTE applies the GTE predicate on the "search_text" field.
+func SearchTextGTE(v string) predicate.Product {
+	return predicate.Product(sql.FieldGTE(FieldSearchText, v))
+}
+
+// SearchTextLT applies the LT predicate on the "search_text" field.
+func SearchTextLT(v string) predicate.Product {
+	return predicate.Product(sql.FieldLT(FieldSearchText, v))
+}
+
+// SearchTextLTE applies the LTE predicate on the "search_text" field.
+func SearchTextLTE(v string) predicate.Product {
+	return predicate.Product(sql.FieldLTE(FieldSearchText, v))
+}
+
+// SearchTextContains applies the Contains predicate on the "search_text" field.
+func SearchTextContains(v string) predicate.Product {
+	return predicate.Product(sql.FieldContains(FieldSearchText, v))
+}
+
+// SearchTextHasPrefix applies the HasPrefix predicate on the "search_text" field.
+func SearchTextHasPrefix(v string) predicate.Product {
+	return predicate.Product(sql.FieldHasPrefix(FieldSearchText, v))
+}
+
+// SearchTextHasSuffix applies the HasSuffix predicate on the "search_text" field.
+func SearchTextHasSuffix(v string) predicate.Product {
+	return predicate.Product(sql.FieldHasSuffix(FieldSearchText, v))
+}
+
+// SearchTextIsNil applies the IsNil predicate on the "search_text" field.
+func SearchTextIsNil() predicate.Product {
+	return predicate.Product(sql.FieldIsNull(FieldSearchText))
+}
+
+// SearchTextNotNil applies the NotNil predicate on the "search_text" field.
+func SearchTextNotNil() predicate.Product {
+	return predicate.Product(sql.FieldNotNull(FieldSearchText))
+}
+
+// SearchTextEqualFold applies the EqualFold predicate on the "search_text" field.
+func SearchTextEqualFold(v string) predicate.Product {
+	return predicate.Product(sql.FieldEqualFold(FieldSearchText, v))
+}
+
+// SearchTextContainsFold applies the ContainsFold predicate on the "search_text" field.
+func SearchTextContainsFold(v string) predicate.Product {
+	return predicate.Product(sql.FieldContainsFold(FieldSearchText, v))
+}
+
 // FeaturedEQ applies the EQ predicate on the "featured" field.
 func FeaturedEQ(v bool) predicate.Product {
 	return predicate.Product(sql.FieldEQ(FieldFeatured, v))
@@ -1529,6 +1860,129 @@ func RatingNumericNotNil() predicate.Product {
 	return predicate.Product(sql.FieldNotNull(FieldRatingNumeric))
 }
 
+// ActualPriceNumericEQ applies the EQ predicate on the "actual_price_numeric" field.
+func ActualPriceNumericEQ(v int) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldActualPriceNumeric, v))
+}
+
+// ActualPriceNumericNEQ applies the NEQ predicate on the "actual_price_numeric" field.
+func ActualPriceNumericNEQ(v int) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldActualPriceNumeric, v))
+}
+
+// ActualPriceNumericIn applies the In predicate on the "actual_price_numeric" field.
+func ActualPriceNumericIn(vs ...int) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldActualPriceNumeric, vs...))
+}
+
+// ActualPriceNumericNotIn applies the NotIn predicate on the "actual_price_numeric" field.
+func ActualPriceNumericNotIn(vs ...int) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldActualPriceNumeric, vs...))
+}
+
+// ActualPriceNumericGT applies the GT predicate on the "actual_price_numeric" field.
+func ActualPriceNumericGT(v int) predicate.Product {
+	return predicate.Product(sql.FieldGT(FieldActualPriceNumeric, v))
+}
+
+// ActualPriceNumericGTE applies the GTE predicate on the "actual_price_numeric" field.
+func ActualPriceNumericGTE(v int) predicate.Product {
+	return predicate.Product(sql.FieldGTE(FieldActualPriceNumeric, v))
+}
+
+// ActualPriceNumericLT applies the LT predicate on the "actual_price_numeric" field.
+func ActualPriceNumericLT(v int) predicate.Product {
+	return predicate.Product(sql.FieldLT(FieldActualPriceNumeric, v))
+}
+
+// ActualPriceNumericLTE applies the LTE predicate on the "actual_price_numeric" field.
+func ActualPriceNumericLTE(v int) predicate.Product {
+	return predicate.Product(sql.FieldLTE(FieldActualPriceNumeric, v))
+}
+
+// ActualPriceNumericIsNil applies the IsNil predicate on the "actual_price_numeric" field.
+func ActualPriceNumericIsNil() predicate.Product {
+	return predicate.Product(sql.FieldIsNull(FieldActualPriceNumeric))
+}
+
+// ActualPriceNumericNotNil applies the NotNil predicate on the "actual_price_numeric" field.
+func ActualPriceNumericNotNil() predicate.Product {
+	return predicate.Product(sql.FieldNotNull(FieldActualPriceNumeric))
+}
+
+// DiscountPercentEQ applies the EQ predicate on the "discount_percent" field.
+func DiscountPercentEQ(v float64) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldDiscountPercent, v))
+}
+
+// DiscountPercentNEQ applies the NEQ predicate on the "discount_percent" field.
+func DiscountPercentNEQ(v float64) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldDiscountPercent, v))
+}
+
+// DiscountPercentIn applies the In predicate on the "discount_percent" field.
+func DiscountPercentIn(vs ...float64) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldDiscountPercent, vs...))
+}
+
+// DiscountPercentNotIn applies the NotIn predicate on the "discount_percent" field.
+func DiscountPercentNotIn(vs ...float64) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldDiscountPercent, vs...))
+}
+
+// DiscountPercentGT applies the GT predicate on the "discount_percent" field.
+func DiscountPercentGT(v float64) predicate.Product {
+	return predicate.Product(sql.FieldGT(FieldDiscountPercent, v))
+}
+
+// DiscountPercentGTE applies the GTE predicate on the "discount_percent" field.
+func DiscountPercentGTE(v float64) predicate.Product {
+	return predicate.Product(sql.FieldGTE(FieldDiscountPercent, v))
+}
+
+// DiscountPercentLT applies the LT predicate on the "discount_percent" field.
+func DiscountPercentLT(v float64) predicate.Product {
+	return predicate.Product(sql.FieldLT(FieldDiscountPercent, v))
+}
+
+// DiscountPercentLTE applies the LTE predicate on the "discount_percent" field.
+func DiscountPercentLTE(v float64) predicate.Product {
+	return predicate.Product(sql.FieldLTE(FieldDiscountPercent, v))
+}
+
+// DiscountPercentIsNil applies the IsNil predicate on the "discount_percent" field.
+func DiscountPercentIsNil() predicate.Product {
+	return predicate.Product(sql.FieldIsNull(FieldDiscountPercent))
+}
+
+// DiscountPercentNotNil applies the NotNil predicate on the "discount_percent" field.
+func DiscountPercentNotNil() predicate.Product {
+	return predicate.Product(sql.FieldNotNull(FieldDiscountPercent))
+}
+
+// HasAttributes applies the HasEdge predicate on the "attributes" edge.
+func HasAttributes() predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AttributesTable, AttributesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAttributesWith applies the HasEdge predicate on the "attributes" edge with a given conditions (other predicates).
+func HasAttributesWith(preds ...predicate.ProductAttribute) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		step := newAttributesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Product) predicate.Product {
 	return predicate.Product(sql.AndPredicates(predicates...))
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 	"yinni_backend/ent/product"
+	"yinni_backend/ent/productattribute"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -242,12 +243,68 @@ func (_c *ProductCreate) SetEmbedding(v []float32) *ProductCreate {
 	return _c
 }
 
+// SetEmbeddingModel sets the "embedding_model" field.
+func (_c *ProductCreate) SetEmbeddingModel(v string) *ProductCreate {
+	_c.mutation.SetEmbeddingModel(v)
+	return _c
+}
+
+// SetNillableEmbeddingModel sets the "embedding_model" field if the given value is not nil.
+func (_c *ProductCreate) SetNillableEmbeddingModel(v *string) *ProductCreate {
+	if v != nil {
+		_c.SetEmbeddingModel(*v)
+	}
+	return _c
+}
+
+// SetEmbeddingHash sets the "embedding_hash" field.
+func (_c *ProductCreate) SetEmbeddingHash(v string) *ProductCreate {
+	_c.mutation.SetEmbeddingHash(v)
+	return _c
+}
+
+// SetNillableEmbeddingHash sets the "embedding_hash" field if the given value is not nil.
+func (_c *ProductCreate) SetNillableEmbeddingHash(v *string) *ProductCreate {
+	if v != nil {
+		_c.SetEmbeddingHash(*v)
+	}
+	return _c
+}
+
+// SetContentHash sets the "content_hash" field.
+func (_c *ProductCreate) SetContentHash(v string) *ProductCreate {
+	_c.mutation.SetContentHash(v)
+	return _c
+}
+
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (_c *ProductCreate) SetNillableContentHash(v *string) *ProductCreate {
+	if v != nil {
+		_c.SetContentHash(*v)
+	}
+	return _c
+}
+
 // SetSearchKeywords sets the "search_keywords" field.
 func (_c *ProductCreate) SetSearchKeywords(v []string) *ProductCreate {
 	_c.mutation.SetSearchKeywords(v)
 	return _c
 }
 
+// SetSearchText sets the "search_text" field.
+func (_c *ProductCreate) SetSearchText(v string) *ProductCreate {
+	_c.mutation.SetSearchText(v)
+	return _c
+}
+
+// SetNillableSearchText sets the "search_text" field if the given value is not nil.
+func (_c *ProductCreate) SetNillableSearchText(v *string) *ProductCreate {
+	if v != nil {
+		_c.SetSearchText(*v)
+	}
+	return _c
+}
+
 // SetFeatured sets the "featured" field.
 func (_c *ProductCreate) SetFeatured(v bool) *ProductCreate {
 	_c.mutation.SetFeatured(v)
@@ -318,6 +375,49 @@ func (_c *ProductCreate) SetNillableRatingNumeric(v *float64) *ProductCreate {
 	return _c
 }
 
+// SetActualPriceNumeric sets the "actual_price_numeric" field.
+func (_c *ProductCreate) SetActualPriceNumeric(v int) *ProductCreate {
+	_c.mutation.SetActualPriceNumeric(v)
+	return _c
+}
+
+// SetNillableActualPriceNumeric sets the "actual_price_numeric" field if the given value is not nil.
+func (_c *ProductCreate) SetNillableActualPriceNumeric(v *int) *ProductCreate {
+	if v != nil {
+		_c.SetActualPriceNumeric(*v)
+	}
+	return _c
+}
+
+// SetDiscountPercent sets the "discount_percent" field.
+func (_c *ProductCreate) SetDiscountPercent(v float64) *ProductCreate {
+	_c.mutation.SetDiscountPercent(v)
+	return _c
+}
+
+// SetNillableDiscountPercent sets the "discount_percent" field if the given value is not nil.
+func (_c *ProductCreate) SetNillableDiscountPercent(v *float64) *ProductCreate {
+	if v != nil {
+		_c.SetDiscountPercent(*v)
+	}
+	return _c
+}
+
+// AddAttributeIDs adds the "attributes" edge to the ProductAttribute entity by IDs.
+func (_c *ProductCreate) AddAttributeIDs(ids ...int) *ProductCreate {
+	_c.mutation.AddAttributeIDs(ids...)
+	return _c
+}
+
+// AddAttributes adds the "attributes" edges to the ProductAttribute entity.
+func (_c *ProductCreate) AddAttributes(v ...*ProductAttribute) *ProductCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAttributeIDs(ids...)
+}
+
 // Mutation returns the ProductMutation object of the builder.
 func (_c *ProductCreate) Mutation() *ProductMutation {
 	return _c.mutation
@@ -461,6 +561,16 @@ func (_c *ProductCreate) check() error {
 			return &ValidationError{Name: "rating_numeric", err: fmt.Errorf(`ent: validator failed for field "Product.rating_numeric": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.ActualPriceNumeric(); ok {
+		if err := product.ActualPriceNumericValidator(v); err != nil {
+			return &ValidationError{Name: "actual_price_numeric", err: fmt.Errorf(`ent: validator failed for field "Product.actual_price_numeric": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.DiscountPercent(); ok {
+		if err := product.DiscountPercentValidator(v); err != nil {
+			return &ValidationError{Name: "discount_percent", err: fmt.Errorf(`ent: validator failed for field "Product.discount_percent": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -571,10 +681,26 @@ func (_c *ProductCreate) createSpec() (*Product, *sqlgraph.CreateSpec) {
 		_spec.SetField(product.FieldEmbedding, field.TypeJSON, value)
 		_node.Embedding = value
 	}
+	if value, ok := _c.mutation.EmbeddingModel(); ok {
+		_spec.SetField(product.FieldEmbeddingModel, field.TypeString, value)
+		_node.EmbeddingModel = value
+	}
+	if value, ok := _c.mutation.EmbeddingHash(); ok {
+		_spec.SetField(product.FieldEmbeddingHash, field.TypeString, value)
+		_node.EmbeddingHash = value
+	}
+	if value, ok := _c.mutation.ContentHash(); ok {
+		_spec.SetField(product.FieldContentHash, field.TypeString, value)
+		_node.ContentHash = value
+	}
 	if value, ok := _c.mutation.SearchKeywords(); ok {
 		_spec.SetField(product.FieldSearchKeywords, field.TypeJSON, value)
 		_node.SearchKeywords = value
 	}
+	if value, ok := _c.mutation.SearchText(); ok {
+		_spec.SetField(product.FieldSearchText, field.TypeString, value)
+		_node.SearchText = value
+	}
 	if value, ok := _c.mutation.Featured(); ok {
 		_spec.SetField(product.FieldFeatured, field.TypeBool, value)
 		_node.Featured = value
@@ -595,6 +721,30 @@ func (_c *ProductCreate) createSpec() (*Product, *sqlgraph.CreateSpec) {
 		_spec.SetField(product.FieldRatingNumeric, field.TypeFloat64, value)
 		_node.RatingNumeric = value
 	}
+	if value, ok := _c.mutation.ActualPriceNumeric(); ok {
+		_spec.SetField(product.FieldActualPriceNumeric, field.TypeInt, value)
+		_node.ActualPriceNumeric = value
+	}
+	if value, ok := _c.mutation.DiscountPercent(); ok {
+		_spec.SetField(product.FieldDiscountPercent, field.TypeFloat64, value)
+		_node.DiscountPercent = value
+	}
+	if nodes := _c.mutation.AttributesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.AttributesTable,
+			Columns: []string{product.AttributesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productattribute.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -9,6 +9,7 @@ import (
 	"time"
 	"yinni_backend/ent/predicate"
 	"yinni_backend/ent/product"
+	"yinni_backend/ent/productattribute"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -367,6 +368,66 @@ func (_u *ProductUpdate) ClearEmbedding() *ProductUpdate {
 	return _u
 }
 
+// SetEmbeddingModel sets the "embedding_model" field.
+func (_u *ProductUpdate) SetEmbeddingModel(v string) *ProductUpdate {
+	_u.mutation.SetEmbeddingModel(v)
+	return _u
+}
+
+// SetNillableEmbeddingModel sets the "embedding_model" field if the given value is not nil.
+func (_u *ProductUpdate) SetNillableEmbeddingModel(v *string) *ProductUpdate {
+	if v != nil {
+		_u.SetEmbeddingModel(*v)
+	}
+	return _u
+}
+
+// ClearEmbeddingModel clears the value of the "embedding_model" field.
+func (_u *ProductUpdate) ClearEmbeddingModel() *ProductUpdate {
+	_u.mutation.ClearEmbeddingModel()
+	return _u
+}
+
+// SetEmbeddingHash sets the "embedding_hash" field.
+func (_u *ProductUpdate) SetEmbeddingHash(v string) *ProductUpdate {
+	_u.mutation.SetEmbeddingHash(v)
+	return _u
+}
+
+// SetNillableEmbeddingHash sets the "embedding_hash" field if the given value is not nil.
+func (_u *ProductUpdate) SetNillableEmbeddingHash(v *string) *ProductUpdate {
+	if v != nil {
+		_u.SetEmbeddingHash(*v)
+	}
+	return _u
+}
+
+// ClearEmbeddingHash clears the value of the "embedding_hash" field.
+func (_u *ProductUpdate) ClearEmbeddingHash() *ProductUpdate {
+	_u.mutation.ClearEmbeddingHash()
+	return _u
+}
+
+// SetContentHash sets the "content_hash" field.
+func (_u *ProductUpdate) SetContentHash(v string) *ProductUpdate {
+	_u.mutation.SetContentHash(v)
+	return _u
+}
+
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (_u *ProductUpdate) SetNillableContentHash(v *string) *ProductUpdate {
+	if v != nil {
+		_u.SetContentHash(*v)
+	}
+	return _u
+}
+
+// ClearContentHash clears the value of the "content_hash" field.
+func (_u *ProductUpdate) ClearContentHash() *ProductUpdate {
+	_u.mutation.ClearContentHash()
+	return _u
+}
+
 // SetSearchKeywords sets the "search_keywords" field.
 func (_u *ProductUpdate) SetSearchKeywords(v []string) *ProductUpdate {
 	_u.mutation.SetSearchKeywords(v)
@@ -385,6 +446,26 @@ func (_u *ProductUpdate) ClearSearchKeywords() *ProductUpdate {
 	return _u
 }
 
+// SetSearchText sets the "search_text" field.
+func (_u *ProductUpdate) SetSearchText(v string) *ProductUpdate {
+	_u.mutation.SetSearchText(v)
+	return _u
+}
+
+// SetNillableSearchText sets the "search_text" field if the given value is not nil.
+func (_u *ProductUpdate) SetNillableSearchText(v *string) *ProductUpdate {
+	if v != nil {
+		_u.SetSearchText(*v)
+	}
+	return _u
+}
+
+// ClearSearchText clears the value of the "search_text" field.
+func (_u *ProductUpdate) ClearSearchText() *ProductUpdate {
+	_u.mutation.ClearSearchText()
+	return _u
+}
+
 // SetFeatured sets the "featured" field.
 func (_u *ProductUpdate) SetFeatured(v bool) *ProductUpdate {
 	_u.mutation.SetFeatured(v)
@@ -495,11 +576,101 @@ func (_u *ProductUpdate) ClearRatingNumeric() *ProductUpdate {
 	return _u
 }
 
+// SetActualPriceNumeric sets the "actual_price_numeric" field.
+func (_u *ProductUpdate) SetActualPriceNumeric(v int) *ProductUpdate {
+	_u.mutation.ResetActualPriceNumeric()
+	_u.mutation.SetActualPriceNumeric(v)
+	return _u
+}
+
+// SetNillableActualPriceNumeric sets the "actual_price_numeric" field if the given value is not nil.
+func (_u *ProductUpdate) SetNillableActualPriceNumeric(v *int) *ProductUpdate {
+	if v != nil {
+		_u.SetActualPriceNumeric(*v)
+	}
+	return _u
+}
+
+// AddActualPriceNumeric adds value to the "actual_price_numeric" field.
+func (_u *ProductUpdate) AddActualPriceNumeric(v int) *ProductUpdate {
+	_u.mutation.AddActualPriceNumeric(v)
+	return _u
+}
+
+// ClearActualPriceNumeric clears the value of the "actual_price_numeric" field.
+func (_u *ProductUpdate) ClearActualPriceNumeric() *ProductUpdate {
+	_u.mutation.ClearActualPriceNumeric()
+	return _u
+}
+
+// SetDiscountPercent sets the "discount_percent" field.
+func (_u *ProductUpdate) SetDiscountPercent(v float64) *ProductUpdate {
+	_u.mutation.ResetDiscountPercent()
+	_u.mutation.SetDiscountPercent(v)
+	return _u
+}
+
+// SetNillableDiscountPercent sets the "discount_percent" field if the given value is not nil.
+func (_u *ProductUpdate) SetNillableDiscountPercent(v *float64) *ProductUpdate {
+	if v != nil {
+		_u.SetDiscountPercent(*v)
+	}
+	return _u
+}
+
+// AddDiscountPercent adds value to the "discount_percent" field.
+func (_u *ProductUpdate) AddDiscountPercent(v float64) *ProductUpdate {
+	_u.mutation.AddDiscountPercent(v)
+	return _u
+}
+
+// ClearDiscountPercent clears the value of the "discount_percent" field.
+func (_u *ProductUpdate) ClearDiscountPercent() *ProductUpdate {
+	_u.mutation.ClearDiscountPercent()
+	return _u
+}
+
+// AddAttributeIDs adds the "attributes" edge to the ProductAttribute entity by IDs.
+func (_u *ProductUpdate) AddAttributeIDs(ids ...int) *ProductUpdate {
+	_u.mutation.AddAttributeIDs(ids...)
+	return _u
+}
+
+// AddAttributes adds the "attributes" edges to the ProductAttribute entity.
+func (_u *ProductUpdate) AddAttributes(v ...*ProductAttribute) *ProductUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAttributeIDs(ids...)
+}
+
 // Mutation returns the ProductMutation object of the builder.
 func (_u *ProductUpdate) Mutation() *ProductMutation {
 	return _u.mutation
 }
 
+// ClearAttributes clears all "attributes" edges to the ProductAttribute entity.
+func (_u *ProductUpdate) ClearAttributes() *ProductUpdate {
+	_u.mutation.ClearAttributes()
+	return _u
+}
+
+// RemoveAttributeIDs removes the "attributes" edge to ProductAttribute entities by IDs.
+func (_u *ProductUpdate) RemoveAttributeIDs(ids ...int) *ProductUpdate {
+	_u.mutation.RemoveAttributeIDs(ids...)
+	return _u
+}
+
+// RemoveAttributes removes "attributes" edges to ProductAttribute entities.
+func (_u *ProductUpdate) RemoveAttributes(v ...*ProductAttribute) *ProductUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAttributeIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *ProductUpdate) Save(ctx context.Context) (int, error) {
 	if err := _u.defaults(); err != nil {
@@ -579,6 +750,16 @@ func (_u *ProductUpdate) check() error {
 			return &ValidationError{Name: "rating_numeric", err: fmt.Errorf(`ent: validator failed for field "Product.rating_numeric": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.ActualPriceNumeric(); ok {
+		if err := product.ActualPriceNumericValidator(v); err != nil {
+			return &ValidationError{Name: "actual_price_numeric", err: fmt.Errorf(`ent: validator failed for field "Product.actual_price_numeric": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DiscountPercent(); ok {
+		if err := product.DiscountPercentValidator(v); err != nil {
+			return &ValidationError{Name: "discount_percent", err: fmt.Errorf(`ent: validator failed for field "Product.discount_percent": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -705,6 +886,24 @@ func (_u *ProductUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.EmbeddingCleared() {
 		_spec.ClearField(product.FieldEmbedding, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.EmbeddingModel(); ok {
+		_spec.SetField(product.FieldEmbeddingModel, field.TypeString, value)
+	}
+	if _u.mutation.EmbeddingModelCleared() {
+		_spec.ClearField(product.FieldEmbeddingModel, field.TypeString)
+	}
+	if value, ok := _u.mutation.EmbeddingHash(); ok {
+		_spec.SetField(product.FieldEmbeddingHash, field.TypeString, value)
+	}
+	if _u.mutation.EmbeddingHashCleared() {
+		_spec.ClearField(product.FieldEmbeddingHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.ContentHash(); ok {
+		_spec.SetField(product.FieldContentHash, field.TypeString, value)
+	}
+	if _u.mutation.ContentHashCleared() {
+		_spec.ClearField(product.FieldContentHash, field.TypeString)
+	}
 	if value, ok := _u.mutation.SearchKeywords(); ok {
 		_spec.SetField(product.FieldSearchKeywords, field.TypeJSON, value)
 	}
@@ -716,6 +915,12 @@ func (_u *ProductUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.SearchKeywordsCleared() {
 		_spec.ClearField(product.FieldSearchKeywords, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.SearchText(); ok {
+		_spec.SetField(product.FieldSearchText, field.TypeString, value)
+	}
+	if _u.mutation.SearchTextCleared() {
+		_spec.ClearField(product.FieldSearchText, field.TypeString)
+	}
 	if value, ok := _u.mutation.Featured(); ok {
 		_spec.SetField(product.FieldFeatured, field.TypeBool, value)
 	}
@@ -749,6 +954,69 @@ func (_u *ProductUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.RatingNumericCleared() {
 		_spec.ClearField(product.FieldRatingNumeric, field.TypeFloat64)
 	}
+	if value, ok := _u.mutation.ActualPriceNumeric(); ok {
+		_spec.SetField(product.FieldActualPriceNumeric, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedActualPriceNumeric(); ok {
+		_spec.AddField(product.FieldActualPriceNumeric, field.TypeInt, value)
+	}
+	if _u.mutation.ActualPriceNumericCleared() {
+		_spec.ClearField(product.FieldActualPriceNumeric, field.TypeInt)
+	}
+	if value, ok := _u.mutation.DiscountPercent(); ok {
+		_spec.SetField(product.FieldDiscountPercent, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedDiscountPercent(); ok {
+		_spec.AddField(product.FieldDiscountPercent, field.TypeFloat64, value)
+	}
+	if _u.mutation.DiscountPercentCleared() {
+		_spec.ClearField(product.FieldDiscountPercent, field.TypeFloat64)
+	}
+	if _u.mutation.AttributesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.AttributesTable,
+			Columns: []string{product.AttributesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productattribute.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAttributesIDs(); len(nodes) > 0 && !_u.mutation.AttributesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.AttributesTable,
+			Columns: []string{product.AttributesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productattribute.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AttributesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.AttributesTable,
+			Columns: []string{product.AttributesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productattribute.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{product.Label}
@@ -1107,6 +1375,66 @@ func (_u *ProductUpdateOne) ClearEmbedding() *ProductUpdateOne {
 	return _u
 }
 
+// SetEmbeddingModel sets the "embedding_model" field.
+func (_u *ProductUpdateOne) SetEmbeddingModel(v string) *ProductUpdateOne {
+	_u.mutation.SetEmbeddingModel(v)
+	return _u
+}
+
+// SetNillableEmbeddingModel sets the "embedding_model" field if the given value is not nil.
+func (_u *ProductUpdateOne) SetNillableEmbeddingModel(v *string) *ProductUpdateOne {
+	if v != nil {
+		_u.SetEmbeddingModel(*v)
+	}
+	return _u
+}
+
+// ClearEmbeddingModel clears the value of the "embedding_model" field.
+func (_u *ProductUpdateOne) ClearEmbeddingModel() *ProductUpdateOne {
+	_u.mutation.ClearEmbeddingModel()
+	return _u
+}
+
+// SetEmbeddingHash sets the "embedding_hash" field.
+func (_u *ProductUpdateOne) SetEmbeddingHash(v string) *ProductUpdateOne {
+	_u.mutation.SetEmbeddingHash(v)
+	return _u
+}
+
+// SetNillableEmbeddingHash sets the "embedding_hash" field if the given value is not nil.
+func (_u *ProductUpdateOne) SetNillableEmbeddingHash(v *string) *ProductUpdateOne {
+	if v != nil {
+		_u.SetEmbeddingHash(*v)
+	}
+	return _u
+}
+
+// ClearEmbeddingHash clears the value of the "embedding_hash" field.
+func (_u *ProductUpdateOne) ClearEmbeddingHash() *ProductUpdateOne {
+	_u.mutation.ClearEmbeddingHash()
+	return _u
+}
+
+// SetContentHash sets the "content_hash" field.
+func (_u *ProductUpdateOne) SetContentHash(v string) *ProductUpdateOne {
+	_u.mutation.SetContentHash(v)
+	return _u
+}
+
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (_u *ProductUpdateOne) SetNillableContentHash(v *string) *ProductUpdateOne {
+	if v != nil {
+		_u.SetContentHash(*v)
+	}
+	return _u
+}
+
+// ClearContentHash clears the value of the "content_hash" field.
+func (_u *ProductUpdateOne) ClearContentHash() *ProductUpdateOne {
+	_u.mutation.ClearContentHash()
+	return _u
+}
+
 // SetSearchKeywords sets the "search_keywords" field.
 func (_u *ProductUpdateOne) SetSearchKeywords(v []string) *ProductUpdateOne {
 	_u.mutation.SetSearchKeywords(v)
@@ -1125,6 +1453,26 @@ func (_u *ProductUpdateOne) ClearSearchKeywords() *ProductUpdateOne {
 	return _u
 }
 
+// SetSearchText sets the "search_text" field.
+func (_u *ProductUpdateOne) SetSearchText(v string) *ProductUpdateOne {
+	_u.mutation.SetSearchText(v)
+	return _u
+}
+
+// SetNillableSearchText sets the "search_text" field if the given value is not nil.
+func (_u *ProductUpdateOne) SetNillableSearchText(v *string) *ProductUpdateOne {
+	if v != nil {
+		_u.SetSearchText(*v)
+	}
+	return _u
+}
+
+// ClearSearchText clears the value of the "search_text" field.
+func (_u *ProductUpdateOne) ClearSearchText() *ProductUpdateOne {
+	_u.mutation.ClearSearchText()
+	return _u
+}
+
 // SetFeatured sets the "featured" field.
 func (_u *ProductUpdateOne) SetFeatured(v bool) *ProductUpdateOne {
 	_u.mutation.SetFeatured(v)
@@ -1235,11 +1583,101 @@ func (_u *ProductUpdateOne) ClearRatingNumeric() *ProductUpdateOne {
 	return _u
 }
 
+// SetActualPriceNumeric sets the "actual_price_numeric" field.
+func (_u *ProductUpdateOne) SetActualPriceNumeric(v int) *ProductUpdateOne {
+	_u.mutation.ResetActualPriceNumeric()
+	_u.mutation.SetActualPriceNumeric(v)
+	return _u
+}
+
+// SetNillableActualPriceNumeric sets the "actual_price_numeric" field if the given value is not nil.
+func (_u *ProductUpdateOne) SetNillableActualPriceNumeric(v *int) *ProductUpdateOne {
+	if v != nil {
+		_u.SetActualPriceNumeric(*v)
+	}
+	return _u
+}
+
+// AddActualPriceNumeric adds value to the "actual_price_numeric" field.
+func (_u *ProductUpdateOne) AddActualPriceNumeric(v int) *ProductUpdateOne {
+	_u.mutation.AddActualPriceNumeric(v)
+	return _u
+}
+
+// ClearActualPriceNumeric clears the value of the "actual_price_numeric" field.
+func (_u *ProductUpdateOne) ClearActualPriceNumeric() *ProductUpdateOne {
+	_u.mutation.ClearActualPriceNumeric()
+	return _u
+}
+
+// SetDiscountPercent sets the "discount_percent" field.
+func (_u *ProductUpdateOne) SetDiscountPercent(v float64) *ProductUpdateOne {
+	_u.mutation.ResetDiscountPercent()
+	_u.mutation.SetDiscountPercent(v)
+	return _u
+}
+
+// SetNillableDiscountPercent sets the "discount_percent" field if the given value is not nil.
+func (_u *ProductUpdateOne) SetNillableDiscountPercent(v *float64) *ProductUpdateOne {
+	if v != nil {
+		_u.SetDiscountPercent(*v)
+	}
+	return _u
+}
+
+// AddDiscountPercent adds value to the "discount_percent" field.
+func (_u *ProductUpdateOne) AddDiscountPercent(v float64) *ProductUpdateOne {
+	_u.mutation.AddDiscountPercent(v)
+	return _u
+}
+
+// ClearDiscountPercent clears the value of the "discount_percent" field.
+func (_u *ProductUpdateOne) ClearDiscountPercent() *ProductUpdateOne {
+	_u.mutation.ClearDiscountPercent()
+	return _u
+}
+
+// AddAttributeIDs adds the "attributes" edge to the ProductAttribute entity by IDs.
+func (_u *ProductUpdateOne) AddAttributeIDs(ids ...int) *ProductUpdateOne {
+	_u.mutation.AddAttributeIDs(ids...)
+	return _u
+}
+
+// AddAttributes adds the "attributes" edges to the ProductAttribute entity.
+func (_u *ProductUpdateOne) AddAttributes(v ...*ProductAttribute) *ProductUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAttributeIDs(ids...)
+}
+
 // Mutation returns the ProductMutation object of the builder.
 func (_u *ProductUpdateOne) Mutation() *ProductMutation {
 	return _u.mutation
 }
 
+// ClearAttributes clears all "attributes" edges to the ProductAttribute entity.
+func (_u *ProductUpdateOne) ClearAttributes() *ProductUpdateOne {
+	_u.mutation.ClearAttributes()
+	return _u
+}
+
+// RemoveAttributeIDs removes the "attributes" edge to ProductAttribute entities by IDs.
+func (_u *ProductUpdateOne) RemoveAttributeIDs(ids ...int) *ProductUpdateOne {
+	_u.mutation.RemoveAttributeIDs(ids...)
+	return _u
+}
+
+// RemoveAttributes removes "attributes" edges to ProductAttribute entities.
+func (_u *ProductUpdateOne) RemoveAttributes(v ...*ProductAttribute) *ProductUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAttributeIDs(ids...)
+}
+
 // Where appends a list predicates to the ProductUpdate builder.
 func (_u *ProductUpdateOne) Where(ps ...predicate.Product) *ProductUpdateOne {
 	_u.mutation.Where(ps...)
@@ -1332,6 +1770,16 @@ func (_u *ProductUpdateOne) check() error {
 			return &ValidationError{Name: "rating_numeric", err: fmt.Errorf(`ent: validator failed for field "Product.rating_numeric": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.ActualPriceNumeric(); ok {
+		if err := product.ActualPriceNumericValidator(v); err != nil {
+			return &ValidationError{Name: "actual_price_numeric", err: fmt.Errorf(`ent: validator failed for field "Product.actual_price_numeric": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DiscountPercent(); ok {
+		if err := product.DiscountPercentValidator(v); err != nil {
+			return &ValidationError{Name: "discount_percent", err: fmt.Errorf(`ent: validator failed for field "Product.discount_percent": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -1475,6 +1923,24 @@ func (_u *ProductUpdateOne) sqlSave(ctx context.Context) (_node *Product, err er
 	if _u.mutation.EmbeddingCleared() {
 		_spec.ClearField(product.FieldEmbedding, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.EmbeddingModel(); ok {
+		_spec.SetField(product.FieldEmbeddingModel, field.TypeString, value)
+	}
+	if _u.mutation.EmbeddingModelCleared() {
+		_spec.ClearField(product.FieldEmbeddingModel, field.TypeString)
+	}
+	if value, ok := _u.mutation.EmbeddingHash(); ok {
+		_spec.SetField(product.FieldEmbeddingHash, field.TypeString, value)
+	}
+	if _u.mutation.EmbeddingHashCleared() {
+		_spec.ClearField(product.FieldEmbeddingHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.ContentHash(); ok {
+		_spec.SetField(product.FieldContentHash, field.TypeString, value)
+	}
+	if _u.mutation.ContentHashCleared() {
+		_spec.ClearField(product.FieldContentHash, field.TypeString)
+	}
 	if value, ok := _u.mutation.SearchKeywords(); ok {
 		_spec.SetField(product.FieldSearchKeywords, field.TypeJSON, value)
 	}
@@ -1486,6 +1952,12 @@ func (_u *ProductUpdateOne) sqlSave(ctx context.Context) (_node *Product, err er
 	if _u.mutation.SearchKeywordsCleared() {
 		_spec.ClearField(product.FieldSearchKeywords, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.SearchText(); ok {
+		_spec.SetField(product.FieldSearchText, field.TypeString, value)
+	}
+	if _u.mutation.SearchTextCleared() {
+		_spec.ClearField(product.FieldSearchText, field.TypeString)
+	}
 	if value, ok := _u.mutation.Featured(); ok {
 		_spec.SetField(product.FieldFeatured, field.TypeBool, value)
 	}
@@ -1519,6 +1991,69 @@ func (_u *ProductUpdateOne) sqlSave(ctx context.Context) (_node *Product, err er
 	if _u.mutation.RatingNumericCleared() {
 		_spec.ClearField(product.FieldRatingNumeric, field.TypeFloat64)
 	}
+	if value, ok := _u.mutation.ActualPriceNumeric(); ok {
+		_spec.SetField(product.FieldActualPriceNumeric, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedActualPriceNumeric(); ok {
+		_spec.AddField(product.FieldActualPriceNumeric, field.TypeInt, value)
+	}
+	if _u.mutation.ActualPriceNumericCleared() {
+		_spec.ClearField(product.FieldActualPriceNumeric, field.TypeInt)
+	}
+	if value, ok := _u.mutation.DiscountPercent(); ok {
+		_spec.SetField(product.FieldDiscountPercent, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedDiscountPercent(); ok {
+		_spec.AddField(product.FieldDiscountPercent, field.TypeFloat64, value)
+	}
+	if _u.mutation.DiscountPercentCleared() {
+		_spec.ClearField(product.FieldDiscountPercent, field.TypeFloat64)
+	}
+	if _u.mutation.AttributesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.AttributesTable,
+			Columns: []string{product.AttributesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productattribute.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAttributesIDs(); len(nodes) > 0 && !_u.mutation.AttributesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.AttributesTable,
+			Columns: []string{product.AttributesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productattribute.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AttributesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.AttributesTable,
+			Columns: []string{product.AttributesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productattribute.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Product{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
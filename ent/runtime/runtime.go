@@ -4,8 +4,14 @@ package runtime
 
 import (
 	"time"
+	"yinni_backend/ent/embeddingfailure"
+	"yinni_backend/ent/embeddingjob"
 	"yinni_backend/ent/product"
+	"yinni_backend/ent/productattribute"
+	"yinni_backend/ent/refreshtoken"
 	"yinni_backend/ent/schema"
+	"yinni_backend/ent/schemamigration"
+	"yinni_backend/ent/searchrule"
 	"yinni_backend/ent/user"
 )
 
@@ -13,10 +19,62 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	embeddingfailureMixin := schema.EmbeddingFailure{}.Mixin()
+	embeddingfailureMixinFields0 := embeddingfailureMixin[0].Fields()
+	_ = embeddingfailureMixinFields0
+	embeddingfailureFields := schema.EmbeddingFailure{}.Fields()
+	_ = embeddingfailureFields
+	// embeddingfailureDescCreateTime is the schema descriptor for create_time field.
+	embeddingfailureDescCreateTime := embeddingfailureMixinFields0[0].Descriptor()
+	// embeddingfailure.DefaultCreateTime holds the default value on creation for the create_time field.
+	embeddingfailure.DefaultCreateTime = embeddingfailureDescCreateTime.Default.(func() time.Time)
+	// embeddingfailureDescUpdateTime is the schema descriptor for update_time field.
+	embeddingfailureDescUpdateTime := embeddingfailureMixinFields0[1].Descriptor()
+	// embeddingfailure.DefaultUpdateTime holds the default value on creation for the update_time field.
+	embeddingfailure.DefaultUpdateTime = embeddingfailureDescUpdateTime.Default.(func() time.Time)
+	// embeddingfailure.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	embeddingfailure.UpdateDefaultUpdateTime = embeddingfailureDescUpdateTime.UpdateDefault.(func() time.Time)
+	// embeddingfailureDescAttempts is the schema descriptor for attempts field.
+	embeddingfailureDescAttempts := embeddingfailureFields[1].Descriptor()
+	// embeddingfailure.DefaultAttempts holds the default value on creation for the attempts field.
+	embeddingfailure.DefaultAttempts = embeddingfailureDescAttempts.Default.(int)
+	embeddingjobMixin := schema.EmbeddingJob{}.Mixin()
+	embeddingjobMixinFields0 := embeddingjobMixin[0].Fields()
+	_ = embeddingjobMixinFields0
+	embeddingjobFields := schema.EmbeddingJob{}.Fields()
+	_ = embeddingjobFields
+	// embeddingjobDescCreateTime is the schema descriptor for create_time field.
+	embeddingjobDescCreateTime := embeddingjobMixinFields0[0].Descriptor()
+	// embeddingjob.DefaultCreateTime holds the default value on creation for the create_time field.
+	embeddingjob.DefaultCreateTime = embeddingjobDescCreateTime.Default.(func() time.Time)
+	// embeddingjobDescUpdateTime is the schema descriptor for update_time field.
+	embeddingjobDescUpdateTime := embeddingjobMixinFields0[1].Descriptor()
+	// embeddingjob.DefaultUpdateTime holds the default value on creation for the update_time field.
+	embeddingjob.DefaultUpdateTime = embeddingjobDescUpdateTime.Default.(func() time.Time)
+	// embeddingjob.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	embeddingjob.UpdateDefaultUpdateTime = embeddingjobDescUpdateTime.UpdateDefault.(func() time.Time)
+	// embeddingjobDescName is the schema descriptor for name field.
+	embeddingjobDescName := embeddingjobFields[0].Descriptor()
+	// embeddingjob.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	embeddingjob.NameValidator = embeddingjobDescName.Validators[0].(func(string) error)
+	// embeddingjobDescCursor is the schema descriptor for cursor field.
+	embeddingjobDescCursor := embeddingjobFields[2].Descriptor()
+	// embeddingjob.DefaultCursor holds the default value on creation for the cursor field.
+	embeddingjob.DefaultCursor = embeddingjobDescCursor.Default.(int)
+	// embeddingjobDescProcessed is the schema descriptor for processed field.
+	embeddingjobDescProcessed := embeddingjobFields[3].Descriptor()
+	// embeddingjob.DefaultProcessed holds the default value on creation for the processed field.
+	embeddingjob.DefaultProcessed = embeddingjobDescProcessed.Default.(int)
+	// embeddingjobDescFailed is the schema descriptor for failed field.
+	embeddingjobDescFailed := embeddingjobFields[4].Descriptor()
+	// embeddingjob.DefaultFailed holds the default value on creation for the failed field.
+	embeddingjob.DefaultFailed = embeddingjobDescFailed.Default.(int)
 	productMixin := schema.Product{}.Mixin()
 	productHooks := schema.Product{}.Hooks()
 	product.Hooks[0] = productHooks[0]
 	product.Hooks[1] = productHooks[1]
+	product.Hooks[2] = productHooks[2]
+	product.Hooks[3] = productHooks[3]
 	productMixinFields0 := productMixin[0].Fields()
 	_ = productMixinFields0
 	productFields := schema.Product{}.Fields()
@@ -56,23 +114,23 @@ func init() {
 	// product.URLValidator is a validator for the "url" field. It is called by the builders before save.
 	product.URLValidator = productDescURL.Validators[0].(func(string) error)
 	// productDescFeatured is the schema descriptor for featured field.
-	productDescFeatured := productFields[20].Descriptor()
+	productDescFeatured := productFields[24].Descriptor()
 	// product.DefaultFeatured holds the default value on creation for the featured field.
 	product.DefaultFeatured = productDescFeatured.Default.(bool)
 	// productDescViewCount is the schema descriptor for view_count field.
-	productDescViewCount := productFields[21].Descriptor()
+	productDescViewCount := productFields[25].Descriptor()
 	// product.DefaultViewCount holds the default value on creation for the view_count field.
 	product.DefaultViewCount = productDescViewCount.Default.(int)
 	// productDescClickCount is the schema descriptor for click_count field.
-	productDescClickCount := productFields[22].Descriptor()
+	productDescClickCount := productFields[26].Descriptor()
 	// product.DefaultClickCount holds the default value on creation for the click_count field.
 	product.DefaultClickCount = productDescClickCount.Default.(int)
 	// productDescPriceNumeric is the schema descriptor for price_numeric field.
-	productDescPriceNumeric := productFields[23].Descriptor()
+	productDescPriceNumeric := productFields[27].Descriptor()
 	// product.PriceNumericValidator is a validator for the "price_numeric" field. It is called by the builders before save.
 	product.PriceNumericValidator = productDescPriceNumeric.Validators[0].(func(int) error)
 	// productDescRatingNumeric is the schema descriptor for rating_numeric field.
-	productDescRatingNumeric := productFields[24].Descriptor()
+	productDescRatingNumeric := productFields[28].Descriptor()
 	// product.RatingNumericValidator is a validator for the "rating_numeric" field. It is called by the builders before save.
 	product.RatingNumericValidator = func() func(float64) error {
 		validators := productDescRatingNumeric.Validators
@@ -89,6 +147,86 @@ func init() {
 			return nil
 		}
 	}()
+	// productDescActualPriceNumeric is the schema descriptor for actual_price_numeric field.
+	productDescActualPriceNumeric := productFields[29].Descriptor()
+	// product.ActualPriceNumericValidator is a validator for the "actual_price_numeric" field. It is called by the builders before save.
+	product.ActualPriceNumericValidator = productDescActualPriceNumeric.Validators[0].(func(int) error)
+	// productDescDiscountPercent is the schema descriptor for discount_percent field.
+	productDescDiscountPercent := productFields[30].Descriptor()
+	// product.DiscountPercentValidator is a validator for the "discount_percent" field. It is called by the builders before save.
+	product.DiscountPercentValidator = func() func(float64) error {
+		validators := productDescDiscountPercent.Validators
+		fns := [...]func(float64) error{
+			validators[0].(func(float64) error),
+			validators[1].(func(float64) error),
+		}
+		return func(discount_percent float64) error {
+			for _, fn := range fns {
+				if err := fn(discount_percent); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	productattributeFields := schema.ProductAttribute{}.Fields()
+	_ = productattributeFields
+	// productattributeDescKey is the schema descriptor for key field.
+	productattributeDescKey := productattributeFields[1].Descriptor()
+	// productattribute.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	productattribute.KeyValidator = productattributeDescKey.Validators[0].(func(string) error)
+	// productattributeDescValue is the schema descriptor for value field.
+	productattributeDescValue := productattributeFields[2].Descriptor()
+	// productattribute.ValueValidator is a validator for the "value" field. It is called by the builders before save.
+	productattribute.ValueValidator = productattributeDescValue.Validators[0].(func(string) error)
+	refreshtokenMixin := schema.RefreshToken{}.Mixin()
+	refreshtokenMixinFields0 := refreshtokenMixin[0].Fields()
+	_ = refreshtokenMixinFields0
+	refreshtokenFields := schema.RefreshToken{}.Fields()
+	_ = refreshtokenFields
+	// refreshtokenDescCreateTime is the schema descriptor for create_time field.
+	refreshtokenDescCreateTime := refreshtokenMixinFields0[0].Descriptor()
+	// refreshtoken.DefaultCreateTime holds the default value on creation for the create_time field.
+	refreshtoken.DefaultCreateTime = refreshtokenDescCreateTime.Default.(func() time.Time)
+	// refreshtokenDescUpdateTime is the schema descriptor for update_time field.
+	refreshtokenDescUpdateTime := refreshtokenMixinFields0[1].Descriptor()
+	// refreshtoken.DefaultUpdateTime holds the default value on creation for the update_time field.
+	refreshtoken.DefaultUpdateTime = refreshtokenDescUpdateTime.Default.(func() time.Time)
+	// refreshtoken.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	refreshtoken.UpdateDefaultUpdateTime = refreshtokenDescUpdateTime.UpdateDefault.(func() time.Time)
+	schemamigrationFields := schema.SchemaMigration{}.Fields()
+	_ = schemamigrationFields
+	// schemamigrationDescName is the schema descriptor for name field.
+	schemamigrationDescName := schemamigrationFields[1].Descriptor()
+	// schemamigration.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	schemamigration.NameValidator = schemamigrationDescName.Validators[0].(func(string) error)
+	// schemamigrationDescAppliedAt is the schema descriptor for applied_at field.
+	schemamigrationDescAppliedAt := schemamigrationFields[2].Descriptor()
+	// schemamigration.DefaultAppliedAt holds the default value on creation for the applied_at field.
+	schemamigration.DefaultAppliedAt = schemamigrationDescAppliedAt.Default.(func() time.Time)
+	searchruleMixin := schema.SearchRule{}.Mixin()
+	searchruleMixinFields0 := searchruleMixin[0].Fields()
+	_ = searchruleMixinFields0
+	searchruleFields := schema.SearchRule{}.Fields()
+	_ = searchruleFields
+	// searchruleDescCreateTime is the schema descriptor for create_time field.
+	searchruleDescCreateTime := searchruleMixinFields0[0].Descriptor()
+	// searchrule.DefaultCreateTime holds the default value on creation for the create_time field.
+	searchrule.DefaultCreateTime = searchruleDescCreateTime.Default.(func() time.Time)
+	// searchruleDescUpdateTime is the schema descriptor for update_time field.
+	searchruleDescUpdateTime := searchruleMixinFields0[1].Descriptor()
+	// searchrule.DefaultUpdateTime holds the default value on creation for the update_time field.
+	searchrule.DefaultUpdateTime = searchruleDescUpdateTime.Default.(func() time.Time)
+	// searchrule.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	searchrule.UpdateDefaultUpdateTime = searchruleDescUpdateTime.UpdateDefault.(func() time.Time)
+	// searchruleDescBoost is the schema descriptor for boost field.
+	searchruleDescBoost := searchruleFields[4].Descriptor()
+	// searchrule.DefaultBoost holds the default value on creation for the boost field.
+	searchrule.DefaultBoost = searchruleDescBoost.Default.(float64)
+	// searchruleDescEnabled is the schema descriptor for enabled field.
+	searchruleDescEnabled := searchruleFields[5].Descriptor()
+	// searchrule.DefaultEnabled holds the default value on creation for the enabled field.
+	searchrule.DefaultEnabled = searchruleDescEnabled.Default.(bool)
 	userMixin := schema.User{}.Mixin()
 	userMixinFields0 := userMixin[0].Fields()
 	_ = userMixinFields0
@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// EmbeddingFailure records products whose embedding could not be generated.
+// Products that reach the configured number of attempts are dead-lettered
+// and skipped by the backfill job.
+type EmbeddingFailure struct {
+	ent.Schema
+}
+
+// Mixins for EmbeddingFailure
+func (EmbeddingFailure) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+// Fields of the EmbeddingFailure.
+func (EmbeddingFailure) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("product_id").
+			Unique().
+			Comment("Product that failed to embed"),
+		field.Int("attempts").
+			Default(0).
+			Comment("Failed attempts so far"),
+		field.Text("last_error").
+			Optional(),
+	}
+}
+
+// Edges of the EmbeddingFailure.
+func (EmbeddingFailure) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes for EmbeddingFailure
+func (EmbeddingFailure) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("attempts"),
+	}
+}
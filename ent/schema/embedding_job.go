@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+)
+
+// EmbeddingJob holds the persisted progress of a background embedding job.
+type EmbeddingJob struct {
+	ent.Schema
+}
+
+// Mixins for EmbeddingJob
+func (EmbeddingJob) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+// Fields of the EmbeddingJob.
+func (EmbeddingJob) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			Unique().
+			NotEmpty().
+			Comment("Job name, e.g. backfill"),
+		field.Enum("status").
+			Values("idle", "running", "stopped", "completed", "failed").
+			Default("idle"),
+		field.Int("cursor").
+			Default(0).
+			Comment("ID of the last product handled; the job resumes after it"),
+		field.Int("processed").
+			Default(0).
+			Comment("Products embedded in the current run"),
+		field.Int("failed").
+			Default(0).
+			Comment("Embedding failures in the current run"),
+		field.Text("last_error").
+			Optional(),
+		field.Time("started_at").
+			Optional().
+			Nillable(),
+		field.Time("finished_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the EmbeddingJob.
+func (EmbeddingJob) Edges() []ent.Edge {
+	return nil
+}
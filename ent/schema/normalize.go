@@ -0,0 +1,172 @@
+package schema
+
+import (
+	"context"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"entgo.io/ent"
+)
+
+// Attribute keys extracted from product_details into product_attributes.
+const (
+	AttributeColor   = "color"
+	AttributeFabric  = "fabric"
+	AttributePattern = "pattern"
+	AttributeSize    = "size"
+	AttributeClosure = "closure"
+)
+
+// attributeAliases maps normalised product_details keys to attribute keys.
+var attributeAliases = map[string]string{
+	"color":          AttributeColor,
+	"colour":         AttributeColor,
+	"color family":   AttributeColor,
+	"fabric":         AttributeFabric,
+	"material":       AttributeFabric,
+	"outer material": AttributeFabric,
+	"pattern":        AttributePattern,
+	"print":          AttributePattern,
+	"size":           AttributeSize,
+	"closure":        AttributeClosure,
+	"closure type":   AttributeClosure,
+}
+
+// Attribute is one normalised key and value of a product.
+type Attribute struct {
+	Key   string
+	Value string
+}
+
+// NormalizeAttributeKey maps a product_details key, or an attribute key in
+// any case, to its attribute key; it returns "" for keys that are not
+// extracted.
+func NormalizeAttributeKey(key string) string {
+	return attributeAliases[normalizeAttributeText(key)]
+}
+
+// NormalizeAttributeValue lower-cases a value and collapses its whitespace,
+// the form values are stored and filtered in.
+func NormalizeAttributeValue(value string) string {
+	return normalizeAttributeText(value)
+}
+
+func normalizeAttributeText(s string) string {
+	return strings.Join(strings.Fields(strings.ToLower(s)), " ")
+}
+
+// ExtractAttributes picks the known attributes out of product details.
+// Comma-separated values, such as "Black, White", become one attribute each.
+// The result is sorted and free of duplicates.
+func ExtractAttributes(details []map[string]string) []Attribute {
+	seen := make(map[Attribute]bool)
+	var attrs []Attribute
+	for _, detail := range details {
+		for k, v := range detail {
+			key := NormalizeAttributeKey(k)
+			if key == "" {
+				continue
+			}
+			for _, part := range strings.Split(v, ",") {
+				a := Attribute{Key: key, Value: NormalizeAttributeValue(part)}
+				if a.Value != "" && !seen[a] {
+					seen[a] = true
+					attrs = append(attrs, a)
+				}
+			}
+		}
+	}
+	sort.Slice(attrs, func(i, j int) bool {
+		if attrs[i].Key != attrs[j].Key {
+			return attrs[i].Key < attrs[j].Key
+		}
+		return attrs[i].Value < attrs[j].Value
+	})
+	return attrs
+}
+
+// discountPattern finds the percentage in discount strings like "69% off".
+var discountPattern = regexp.MustCompile(`(\d+(?:\.\d+)?)\s*%`)
+
+// DiscountPercent returns the discount of a product: the percentage in the
+// discount text when there is one, otherwise the difference between the
+// actual and selling prices. It reports false when neither is known.
+func DiscountPercent(discount, actualPrice, sellingPrice string) (float64, bool) {
+	if m := discountPattern.FindStringSubmatch(discount); m != nil {
+		if pct, err := strconv.ParseFloat(m[1], 64); err == nil && pct >= 0 && pct <= 100 {
+			return pct, true
+		}
+	}
+
+	actual := extractPriceNumber(actualPrice)
+	selling := extractPriceNumber(sellingPrice)
+	if actual <= 0 || selling <= 0 || selling > actual {
+		return 0, false
+	}
+	pct := float64(actual-selling) / float64(actual) * 100
+	return math.Round(pct*100) / 100, true
+}
+
+// PriceNumber parses a price such as "₹2,999" the way the hooks fill the
+// numeric price columns, returning 0 when it is not a whole number.
+func PriceNumber(price string) int {
+	return extractPriceNumber(price)
+}
+
+// pricingFields feed discount_percent.
+var pricingFields = []string{"discount", "actual_price", "selling_price"}
+
+func pricingChanged(m ent.Mutation) bool {
+	for _, name := range pricingFields {
+		if _, ok := m.Field(name); ok || m.FieldCleared(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// setDiscountPercent recomputes discount_percent from the pricing fields the
+// mutation leaves behind. A bulk update only knows the fields it sets, so it
+// recomputes only when it sets all of them.
+func setDiscountPercent(ctx context.Context, m ent.Mutation) error {
+	var texts [3]string
+	for i, name := range pricingFields {
+		if m.Op().Is(ent.OpUpdate) {
+			v, ok := m.Field(name)
+			if !ok && !m.FieldCleared(name) {
+				return nil
+			}
+			texts[i], _ = v.(string)
+			continue
+		}
+		v, err := mutationValue(ctx, m, name)
+		if err != nil {
+			return err
+		}
+		texts[i], _ = v.(string)
+	}
+
+	pct, ok := DiscountPercent(texts[0], texts[1], texts[2])
+	if !ok {
+		if m.Op().Is(ent.OpCreate) {
+			return nil
+		}
+		return m.ClearField("discount_percent")
+	}
+	return m.SetField("discount_percent", pct)
+}
+
+// mutationValue returns the value a create or update-one mutation leaves in
+// a field, reading fields it does not touch from the stored row.
+func mutationValue(ctx context.Context, m ent.Mutation, name string) (ent.Value, error) {
+	if v, ok := m.Field(name); ok {
+		return v, nil
+	}
+	if m.Op().Is(ent.OpCreate) || m.FieldCleared(name) {
+		return nil, nil
+	}
+	return m.OldField(ctx, name)
+}
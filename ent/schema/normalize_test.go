@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestExtractAttributes(t *testing.T) {
+	details := []map[string]string{
+		{"Colour": "Black, White", "Fabric": "Cotton"},
+		{"Outer Material": " Pure  COTTON ", "Sleeve": "Full"},
+		{"color": "black", "Closure Type": "Button"},
+	}
+	want := []Attribute{
+		{AttributeClosure, "button"},
+		{AttributeColor, "black"},
+		{AttributeColor, "white"},
+		{AttributeFabric, "cotton"},
+		{AttributeFabric, "pure cotton"},
+	}
+	if got := ExtractAttributes(details); !slices.Equal(got, want) {
+		t.Errorf("ExtractAttributes = %v, want %v", got, want)
+	}
+	if got := ExtractAttributes(nil); len(got) != 0 {
+		t.Errorf("ExtractAttributes(nil) = %v", got)
+	}
+}
+
+func TestDiscountPercent(t *testing.T) {
+	tests := []struct {
+		discount, actual, selling string
+		want                      float64
+		ok                        bool
+	}{
+		{"69% off", "₹2,999", "₹999", 69, true},
+		{"12.5 % off", "", "", 12.5, true},
+		// Without a percentage the prices give the discount
+		{"", "₹3,000", "₹1,999", 33.37, true},
+		{"Special price", "₹1,000", "₹1,000", 0, true},
+		{"150% off", "₹1,000", "₹750", 25, true},
+		{"", "₹999", "₹1,999", 0, false},
+		{"", "", "₹999", 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := DiscountPercent(tt.discount, tt.actual, tt.selling)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("DiscountPercent(%q, %q, %q) = %v, %v; want %v, %v", tt.discount, tt.actual, tt.selling, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestPriceNumber(t *testing.T) {
+	tests := map[string]int{
+		"₹2,999":   2999,
+		"1,23,456": 123456,
+		"":         0,
+		"₹99.50":   0,
+	}
+	for price, want := range tests {
+		if got := PriceNumber(price); got != want {
+			t.Errorf("PriceNumber(%q) = %d, want %d", price, got, want)
+		}
+	}
+}
@@ -2,12 +2,20 @@ package schema
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
+	gen "yinni_backend/ent"
 	"yinni_backend/ent/hook"
+	"yinni_backend/ent/productattribute"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	"entgo.io/ent/schema/mixin"
@@ -106,11 +114,23 @@ func (Product) Fields() []ent.Field {
 		field.JSON("embedding", []float32{}).
 			Optional().
 			Comment("Vector embeddings for semantic search"),
+		field.String("embedding_model").
+			Optional().
+			Comment("Embedding model and version that produced embedding"),
+		field.String("embedding_hash").
+			Optional().
+			Comment("content_hash the embedding was generated from"),
+		field.String("content_hash").
+			Optional().
+			Comment("Hash of the embedding inputs, maintained by a hook"),
 
 		// Search index fields
 		field.JSON("search_keywords", []string{}).
 			Optional().
 			Comment("Keywords for full-text search"),
+		field.Text("search_text").
+			Optional().
+			Comment("search_keywords joined by spaces for the FULLTEXT index"),
 
 		// Metadata
 		field.Bool("featured").
@@ -135,17 +155,26 @@ func (Product) Fields() []ent.Field {
 			Min(0).
 			Max(5).
 			Comment("Rating as float for sorting"),
+
+		// Derived pricing, maintained by a hook
+		field.Int("actual_price_numeric").
+			Optional().
+			Min(0).
+			Comment("Original price as integer"),
+		field.Float("discount_percent").
+			Optional().
+			Min(0).
+			Max(100).
+			Comment("Discount off the original price, in percent"),
 	}
 }
 
 // Edges of the Product.
 func (Product) Edges() []ent.Edge {
 	return []ent.Edge{
-		// Add relationships here if needed
-		// For example:
-		// edge.To("category", Category.Type),
-		// edge.To("reviews", Review.Type),
-		// edge.To("similar_products", Product.Type),
+		// Normalised product_details, maintained by a hook
+		edge.To("attributes", ProductAttribute.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
 
@@ -164,6 +193,7 @@ func (Product) Indexes() []ent.Index {
 		// Performance indexes
 		index.Fields("price_numeric"),
 		index.Fields("rating_numeric"),
+		index.Fields("discount_percent"),
 		index.Fields("out_of_stock"),
 		index.Fields("featured"),
 
@@ -173,8 +203,10 @@ func (Product) Indexes() []ent.Index {
 		index.Fields("category", "price_numeric"),
 		index.Fields("category", "rating_numeric"),
 
-		// Full-text search (if supported by your DB)
-		// index.Fields("title", "description", "search_keywords").StorageKey("product_search_idx"),
+		// Full-text search; other dialects get a plain index
+		index.Fields("title", "description", "brand", "search_text").
+			Annotations(entsql.IndexTypes(map[string]string{dialect.MySQL: "FULLTEXT"})).
+			StorageKey("product_search_idx"),
 	}
 }
 
@@ -193,6 +225,17 @@ func (Product) Hooks() []ent.Hook {
 							m.SetField("price_numeric", numericPrice)
 						}
 
+						if price, ok := m.Field("actual_price"); ok {
+							priceStr, _ := price.(string)
+							m.SetField("actual_price_numeric", extractPriceNumber(priceStr))
+						}
+
+						if pricingChanged(m) {
+							if err := setDiscountPercent(ctx, m); err != nil {
+								return nil, err
+							}
+						}
+
 						if rating, ok := m.Field("average_rating"); ok {
 							ratingStr, _ := rating.(string)
 							numericRating := extractRatingNumber(ratingStr)
@@ -215,7 +258,7 @@ func (Product) Hooks() []ent.Hook {
 		hook.On(
 			func(next ent.Mutator) ent.Mutator {
 				return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
-					if m.Op().Is(ent.OpCreate | ent.OpUpdate | ent.OpUpdateOne) {
+					if m.Op().Is(ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne) && keywordSourcesChanged(m) {
 						var keywords []string
 
 						if title, ok := m.Field("title"); ok {
@@ -234,14 +277,214 @@ func (Product) Hooks() []ent.Hook {
 						}
 
 						m.SetField("search_keywords", keywords)
+						m.SetField("search_text", strings.Join(keywords, " "))
+					}
+
+					return next.Mutate(ctx, m)
+				})
+			},
+			ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne,
+		),
+
+		// Mark the product dirty when its embedding inputs change
+		hook.On(
+			func(next ent.Mutator) ent.Mutator {
+				return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+					if !embeddingInputsChanged(m) {
+						return next.Mutate(ctx, m)
 					}
 
+					// A bulk update has no single row to hash, so clear the
+					// hash; the product service recomputes it on re-embedding.
+					if m.Op().Is(ent.OpUpdate) {
+						if err := m.ClearField("content_hash"); err != nil {
+							return nil, err
+						}
+						return next.Mutate(ctx, m)
+					}
+
+					content, err := productContentOf(ctx, m)
+					if err != nil {
+						return nil, err
+					}
+					if err := m.SetField("content_hash", content.Hash()); err != nil {
+						return nil, err
+					}
 					return next.Mutate(ctx, m)
 				})
 			},
 			ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne,
 		),
+
+		// Rewrite the product's attributes when its details change
+		hook.On(
+			func(next ent.Mutator) ent.Mutator {
+				return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+					pm, ok := m.(*gen.ProductMutation)
+					if !ok {
+						return next.Mutate(ctx, m)
+					}
+					details, set := pm.ProductDetails()
+					if !set && !pm.ProductDetailsCleared() {
+						return next.Mutate(ctx, m)
+					}
+
+					// Rows of a bulk update must be found before it runs,
+					// as it may change what its predicates match.
+					var ids []int
+					if m.Op().Is(ent.OpUpdate) {
+						var err error
+						if ids, err = pm.IDs(ctx); err != nil {
+							return nil, err
+						}
+					}
+
+					v, err := next.Mutate(ctx, m)
+					if err != nil {
+						return nil, err
+					}
+					if !m.Op().Is(ent.OpUpdate) {
+						id, ok := pm.ID()
+						if !ok {
+							return v, nil
+						}
+						ids = []int{id}
+					}
+					if err := replaceAttributes(ctx, pm.Client(), ids, ExtractAttributes(details)); err != nil {
+						return nil, err
+					}
+					return v, nil
+				})
+			},
+			ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne,
+		),
+	}
+}
+
+// replaceAttributes sets the attributes of each product to attrs.
+func replaceAttributes(ctx context.Context, client *gen.Client, productIDs []int, attrs []Attribute) error {
+	if len(productIDs) == 0 {
+		return nil
+	}
+	_, err := client.ProductAttribute.Delete().
+		Where(productattribute.ProductIDIn(productIDs...)).
+		Exec(ctx)
+	if err != nil || len(attrs) == 0 {
+		return err
+	}
+
+	builders := make([]*gen.ProductAttributeCreate, 0, len(productIDs)*len(attrs))
+	for _, id := range productIDs {
+		for _, a := range attrs {
+			builders = append(builders, client.ProductAttribute.Create().
+				SetProductID(id).
+				SetKey(a.Key).
+				SetValue(a.Value))
+		}
+	}
+	return client.ProductAttribute.CreateBulk(builders...).Exec(ctx)
+}
+
+// embeddingInputs are the fields ProductContent is built from.
+var embeddingInputs = []string{
+	"title", "brand", "category", "sub_category", "description",
+	"product_details", "actual_price", "selling_price", "average_rating", "seller",
+}
+
+// ProductContent holds the product fields that feed its embedding. It must
+// cover the inputs of the product service's GenerateProductText.
+type ProductContent struct {
+	Title          string
+	Brand          string
+	Category       string
+	SubCategory    string
+	Description    string
+	ProductDetails []map[string]string
+	ActualPrice    string
+	SellingPrice   string
+	AverageRating  string
+	Seller         string
+}
+
+// Hash returns a hex SHA-256 of the content. Product details are hashed in
+// key order so equal details always hash alike.
+func (c *ProductContent) Hash() string {
+	h := sha256.New()
+	for _, v := range []string{
+		c.Title, c.Brand, c.Category, c.SubCategory, c.Description,
+		c.ActualPrice, c.SellingPrice, c.AverageRating, c.Seller,
+	} {
+		h.Write([]byte(v))
+		h.Write([]byte{0})
+	}
+	for _, detail := range c.ProductDetails {
+		keys := make([]string, 0, len(detail))
+		for k := range detail {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			h.Write([]byte(k))
+			h.Write([]byte{1})
+			h.Write([]byte(detail[k]))
+			h.Write([]byte{0})
+		}
+		h.Write([]byte{2})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func keywordSourcesChanged(m ent.Mutation) bool {
+	for _, name := range []string{"title", "brand", "category"} {
+		if _, ok := m.Field(name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func embeddingInputsChanged(m ent.Mutation) bool {
+	for _, name := range embeddingInputs {
+		if _, ok := m.Field(name); ok || m.FieldCleared(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// productContentOf builds the content a create or update-one mutation leaves
+// behind, reading the fields it does not touch from the stored row.
+func productContentOf(ctx context.Context, m ent.Mutation) (*ProductContent, error) {
+	value := func(name string) (ent.Value, error) {
+		return mutationValue(ctx, m, name)
+	}
+
+	var c ProductContent
+	strs := map[string]*string{
+		"title":          &c.Title,
+		"brand":          &c.Brand,
+		"category":       &c.Category,
+		"sub_category":   &c.SubCategory,
+		"description":    &c.Description,
+		"actual_price":   &c.ActualPrice,
+		"selling_price":  &c.SellingPrice,
+		"average_rating": &c.AverageRating,
+		"seller":         &c.Seller,
+	}
+	for name, dst := range strs {
+		v, err := value(name)
+		if err != nil {
+			return nil, err
+		}
+		*dst, _ = v.(string)
+	}
+
+	v, err := value("product_details")
+	if err != nil {
+		return nil, err
 	}
+	c.ProductDetails, _ = v.([]map[string]string)
+	return &c, nil
 }
 
 // Helper functions (add these in a separate helper file)
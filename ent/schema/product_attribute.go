@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ProductAttribute is a normalised attribute of a product, extracted from
+// its product_details by a Product hook, such as color=blue. A product has
+// one row per value.
+type ProductAttribute struct {
+	ent.Schema
+}
+
+// Fields of the ProductAttribute.
+func (ProductAttribute) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("product_id"),
+		field.String("key").
+			NotEmpty().
+			Comment("Attribute key, e.g. color; see ExtractAttributes"),
+		field.String("value").
+			NotEmpty().
+			Comment("Lower-cased attribute value"),
+	}
+}
+
+// Edges of the ProductAttribute.
+func (ProductAttribute) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("product", Product.Type).
+			Ref("attributes").
+			Field("product_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes for ProductAttribute
+func (ProductAttribute) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("key", "value", "product_id").Unique(),
+		index.Fields("product_id"),
+	}
+}
@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// RefreshToken is a refresh token issued at sign-in. Only its hash is
+// stored. Each use rotates it: the token is revoked and a new one of the
+// same family issued, so presenting a rotated token again means it leaked
+// and the whole family is revoked.
+type RefreshToken struct {
+	ent.Schema
+}
+
+// Mixins for RefreshToken
+func (RefreshToken) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+// Fields of the RefreshToken.
+func (RefreshToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id").
+			Immutable().
+			Comment("User the token was issued to"),
+		field.String("token_hash").
+			Unique().
+			Immutable().
+			Sensitive().
+			Comment("SHA-256 of the token, hex encoded"),
+		field.String("family_id").
+			Immutable().
+			Comment("Shared by the tokens rotated from one sign-in"),
+		field.Time("expires_at").
+			Immutable(),
+		field.Time("revoked_at").
+			Optional().
+			Nillable(),
+		field.Enum("revoke_reason").
+			Values("rotated", "sign_out", "reuse").
+			Optional(),
+	}
+}
+
+// Edges of the RefreshToken.
+func (RefreshToken) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes for RefreshToken
+func (RefreshToken) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("family_id"),
+		index.Fields("user_id"),
+	}
+}
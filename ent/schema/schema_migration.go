@@ -0,0 +1,33 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// SchemaMigration records a versioned migration applied to the database.
+type SchemaMigration struct {
+	ent.Schema
+}
+
+// Fields of the SchemaMigration.
+func (SchemaMigration) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").
+			StorageKey("version").
+			Immutable().
+			Comment("Migration version"),
+		field.String("name").
+			NotEmpty(),
+		field.Time("applied_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the SchemaMigration.
+func (SchemaMigration) Edges() []ent.Edge {
+	return nil
+}
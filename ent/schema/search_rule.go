@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// SearchRule is a query rewrite rule applied to search queries before
+// retrieval: a synonym set, a one-way expansion or a category boost.
+type SearchRule struct {
+	ent.Schema
+}
+
+// Mixins for SearchRule
+func (SearchRule) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+// Fields of the SearchRule.
+func (SearchRule) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("kind").
+			Values("two_way", "one_way", "category_boost"),
+		field.JSON("terms", []string{}).
+			Comment("Query terms the rule fires on; for two_way, the whole synonym set"),
+		field.JSON("synonyms", []string{}).
+			Optional().
+			Comment("one_way only: terms added to queries containing one of terms"),
+		field.String("category").
+			Optional().
+			Comment("category_boost only: category or sub-category to boost"),
+		field.Float("boost").
+			Default(1).
+			Comment("category_boost only: score multiplier; below 1 demotes"),
+		field.Bool("enabled").
+			Default(true),
+	}
+}
+
+// Edges of the SearchRule.
+func (SearchRule) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes for SearchRule
+func (SearchRule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("enabled"),
+	}
+}
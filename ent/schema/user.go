@@ -38,6 +38,13 @@ func (User) Fields() []ent.Field {
 			Optional(),
 		field.String("password").
 			NotEmpty(),
+		// role grants the scopes embedded in the user's access tokens. No API
+		// sets it; editors and admins are granted theirs in the database, e.g.
+		//   UPDATE users SET role = 'admin' WHERE email = '...';
+		// and get it with their next access token.
+		field.Enum("role").
+			Values("customer", "editor", "admin").
+			Default("customer"),
 	}
 }
 
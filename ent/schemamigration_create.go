@@ -0,0 +1,232 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"yinni_backend/ent/schemamigration"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SchemaMigrationCreate is the builder for creating a SchemaMigration entity.
+type SchemaMigrationCreate struct {
+	config
+	mutation *SchemaMigrationMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (_c *SchemaMigrationCreate) SetName(v string) *SchemaMigrationCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetAppliedAt sets the "applied_at" field.
+func (_c *SchemaMigrationCreate) SetAppliedAt(v time.Time) *SchemaMigrationCreate {
+	_c.mutation.SetAppliedAt(v)
+	return _c
+}
+
+// SetNillableAppliedAt sets the "applied_at" field if the given value is not nil.
+func (_c *SchemaMigrationCreate) SetNillableAppliedAt(v *time.Time) *SchemaMigrationCreate {
+	if v != nil {
+		_c.SetAppliedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *SchemaMigrationCreate) SetID(v int64) *SchemaMigrationCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the SchemaMigrationMutation object of the builder.
+func (_c *SchemaMigrationCreate) Mutation() *SchemaMigrationMutation {
+	return _c.mutation
+}
+
+// Save creates the SchemaMigration in the database.
+func (_c *SchemaMigrationCreate) Save(ctx context.Context) (*SchemaMigration, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SchemaMigrationCreate) SaveX(ctx context.Context) *SchemaMigration {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SchemaMigrationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SchemaMigrationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SchemaMigrationCreate) defaults() {
+	if _, ok := _c.mutation.AppliedAt(); !ok {
+		v := schemamigration.DefaultAppliedAt()
+		_c.mutation.SetAppliedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SchemaMigrationCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "SchemaMigration.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := schemamigration.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SchemaMigration.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AppliedAt(); !ok {
+		return &ValidationError{Name: "applied_at", err: errors.New(`ent: missing required field "SchemaMigration.applied_at"`)}
+	}
+	return nil
+}
+
+func (_c *SchemaMigrationCreate) sqlSave(ctx context.Context) (*SchemaMigration, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SchemaMigrationCreate) createSpec() (*SchemaMigration, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SchemaMigration{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(schemamigration.Table, sqlgraph.NewFieldSpec(schemamigration.FieldID, field.TypeInt64))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(schemamigration.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.AppliedAt(); ok {
+		_spec.SetField(schemamigration.FieldAppliedAt, field.TypeTime, value)
+		_node.AppliedAt = value
+	}
+	return _node, _spec
+}
+
+// SchemaMigrationCreateBulk is the builder for creating many SchemaMigration entities in bulk.
+type SchemaMigrationCreateBulk struct {
+	config
+	err      error
+	builders []*SchemaMigrationCreate
+}
+
+// Save creates the SchemaMigration entities in the database.
+func (_c *SchemaMigrationCreateBulk) Save(ctx context.Context) ([]*SchemaMigration, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SchemaMigration, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SchemaMigrationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SchemaMigrationCreateBulk) SaveX(ctx context.Context) []*SchemaMigration {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SchemaMigrationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SchemaMigrationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
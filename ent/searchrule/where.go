@@ -0,0 +1,330 @@
+// Code generated by ent, DO NOT EDIT.
+
+package searchrule
+
+import (
+	"time"
+	"yinni_backend/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// Category applies equality check predicate on the "category" field. It's identical to CategoryEQ.
+func Category(v string) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldEQ(FieldCategory, v))
+}
+
+// Boost applies equality check predicate on the "boost" field. It's identical to BoostEQ.
+func Boost(v float64) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldEQ(FieldBoost, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v Kind) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v Kind) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...Kind) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...Kind) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// SynonymsIsNil applies the IsNil predicate on the "synonyms" field.
+func SynonymsIsNil() predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldIsNull(FieldSynonyms))
+}
+
+// SynonymsNotNil applies the NotNil predicate on the "synonyms" field.
+func SynonymsNotNil() predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldNotNull(FieldSynonyms))
+}
+
+// CategoryEQ applies the EQ predicate on the "category" field.
+func CategoryEQ(v string) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldEQ(FieldCategory, v))
+}
+
+// CategoryNEQ applies the NEQ predicate on the "category" field.
+func CategoryNEQ(v string) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldNEQ(FieldCategory, v))
+}
+
+// CategoryIn applies the In predicate on the "category" field.
+func CategoryIn(vs ...string) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldIn(FieldCategory, vs...))
+}
+
+// CategoryNotIn applies the NotIn predicate on the "category" field.
+func CategoryNotIn(vs ...string) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldNotIn(FieldCategory, vs...))
+}
+
+// CategoryGT applies the GT predicate on the "category" field.
+func CategoryGT(v string) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldGT(FieldCategory, v))
+}
+
+// CategoryGTE applies the GTE predicate on the "category" field.
+func CategoryGTE(v string) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldGTE(FieldCategory, v))
+}
+
+// CategoryLT applies the LT predicate on the "category" field.
+func CategoryLT(v string) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldLT(FieldCategory, v))
+}
+
+// CategoryLTE applies the LTE predicate on the "category" field.
+func CategoryLTE(v string) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldLTE(FieldCategory, v))
+}
+
+// CategoryContains applies the Contains predicate on the "category" field.
+func CategoryContains(v string) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldContains(FieldCategory, v))
+}
+
+// CategoryHasPrefix applies the HasPrefix predicate on the "category" field.
+func CategoryHasPrefix(v string) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldHasPrefix(FieldCategory, v))
+}
+
+// CategoryHasSuffix applies the HasSuffix predicate on the "category" field.
+func CategoryHasSuffix(v string) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldHasSuffix(FieldCategory, v))
+}
+
+// CategoryIsNil applies the IsNil predicate on the "category" field.
+func CategoryIsNil() predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldIsNull(FieldCategory))
+}
+
+// CategoryNotNil applies the NotNil predicate on the "category" field.
+func CategoryNotNil() predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldNotNull(FieldCategory))
+}
+
+// CategoryEqualFold applies the EqualFold predicate on the "category" field.
+func CategoryEqualFold(v string) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldEqualFold(FieldCategory, v))
+}
+
+// CategoryContainsFold applies the ContainsFold predicate on the "category" field.
+func CategoryContainsFold(v string) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldContainsFold(FieldCategory, v))
+}
+
+// BoostEQ applies the EQ predicate on the "boost" field.
+func BoostEQ(v float64) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldEQ(FieldBoost, v))
+}
+
+// BoostNEQ applies the NEQ predicate on the "boost" field.
+func BoostNEQ(v float64) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldNEQ(FieldBoost, v))
+}
+
+// BoostIn applies the In predicate on the "boost" field.
+func BoostIn(vs ...float64) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldIn(FieldBoost, vs...))
+}
+
+// BoostNotIn applies the NotIn predicate on the "boost" field.
+func BoostNotIn(vs ...float64) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldNotIn(FieldBoost, vs...))
+}
+
+// BoostGT applies the GT predicate on the "boost" field.
+func BoostGT(v float64) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldGT(FieldBoost, v))
+}
+
+// BoostGTE applies the GTE predicate on the "boost" field.
+func BoostGTE(v float64) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldGTE(FieldBoost, v))
+}
+
+// BoostLT applies the LT predicate on the "boost" field.
+func BoostLT(v float64) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldLT(FieldBoost, v))
+}
+
+// BoostLTE applies the LTE predicate on the "boost" field.
+func BoostLTE(v float64) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldLTE(FieldBoost, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.SearchRule {
+	return predicate.SearchRule(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SearchRule) predicate.SearchRule {
+	return predicate.SearchRule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SearchRule) predicate.SearchRule {
+	return predicate.SearchRule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SearchRule) predicate.SearchRule {
+	return predicate.SearchRule(sql.NotPredicates(p))
+}
@@ -0,0 +1,336 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"yinni_backend/ent/searchrule"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SearchRuleCreate is the builder for creating a SearchRule entity.
+type SearchRuleCreate struct {
+	config
+	mutation *SearchRuleMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *SearchRuleCreate) SetCreateTime(v time.Time) *SearchRuleCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *SearchRuleCreate) SetNillableCreateTime(v *time.Time) *SearchRuleCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *SearchRuleCreate) SetUpdateTime(v time.Time) *SearchRuleCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *SearchRuleCreate) SetNillableUpdateTime(v *time.Time) *SearchRuleCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *SearchRuleCreate) SetKind(v searchrule.Kind) *SearchRuleCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetTerms sets the "terms" field.
+func (_c *SearchRuleCreate) SetTerms(v []string) *SearchRuleCreate {
+	_c.mutation.SetTerms(v)
+	return _c
+}
+
+// SetSynonyms sets the "synonyms" field.
+func (_c *SearchRuleCreate) SetSynonyms(v []string) *SearchRuleCreate {
+	_c.mutation.SetSynonyms(v)
+	return _c
+}
+
+// SetCategory sets the "category" field.
+func (_c *SearchRuleCreate) SetCategory(v string) *SearchRuleCreate {
+	_c.mutation.SetCategory(v)
+	return _c
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (_c *SearchRuleCreate) SetNillableCategory(v *string) *SearchRuleCreate {
+	if v != nil {
+		_c.SetCategory(*v)
+	}
+	return _c
+}
+
+// SetBoost sets the "boost" field.
+func (_c *SearchRuleCreate) SetBoost(v float64) *SearchRuleCreate {
+	_c.mutation.SetBoost(v)
+	return _c
+}
+
+// SetNillableBoost sets the "boost" field if the given value is not nil.
+func (_c *SearchRuleCreate) SetNillableBoost(v *float64) *SearchRuleCreate {
+	if v != nil {
+		_c.SetBoost(*v)
+	}
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *SearchRuleCreate) SetEnabled(v bool) *SearchRuleCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *SearchRuleCreate) SetNillableEnabled(v *bool) *SearchRuleCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// Mutation returns the SearchRuleMutation object of the builder.
+func (_c *SearchRuleCreate) Mutation() *SearchRuleMutation {
+	return _c.mutation
+}
+
+// Save creates the SearchRule in the database.
+func (_c *SearchRuleCreate) Save(ctx context.Context) (*SearchRule, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SearchRuleCreate) SaveX(ctx context.Context) *SearchRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SearchRuleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SearchRuleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SearchRuleCreate) defaults() {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		v := searchrule.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		v := searchrule.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.Boost(); !ok {
+		v := searchrule.DefaultBoost
+		_c.mutation.SetBoost(v)
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := searchrule.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SearchRuleCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "SearchRule.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "SearchRule.update_time"`)}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "SearchRule.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := searchrule.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "SearchRule.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Terms(); !ok {
+		return &ValidationError{Name: "terms", err: errors.New(`ent: missing required field "SearchRule.terms"`)}
+	}
+	if _, ok := _c.mutation.Boost(); !ok {
+		return &ValidationError{Name: "boost", err: errors.New(`ent: missing required field "SearchRule.boost"`)}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "SearchRule.enabled"`)}
+	}
+	return nil
+}
+
+func (_c *SearchRuleCreate) sqlSave(ctx context.Context) (*SearchRule, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SearchRuleCreate) createSpec() (*SearchRule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SearchRule{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(searchrule.Table, sqlgraph.NewFieldSpec(searchrule.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(searchrule.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(searchrule.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(searchrule.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Terms(); ok {
+		_spec.SetField(searchrule.FieldTerms, field.TypeJSON, value)
+		_node.Terms = value
+	}
+	if value, ok := _c.mutation.Synonyms(); ok {
+		_spec.SetField(searchrule.FieldSynonyms, field.TypeJSON, value)
+		_node.Synonyms = value
+	}
+	if value, ok := _c.mutation.Category(); ok {
+		_spec.SetField(searchrule.FieldCategory, field.TypeString, value)
+		_node.Category = value
+	}
+	if value, ok := _c.mutation.Boost(); ok {
+		_spec.SetField(searchrule.FieldBoost, field.TypeFloat64, value)
+		_node.Boost = value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(searchrule.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	return _node, _spec
+}
+
+// SearchRuleCreateBulk is the builder for creating many SearchRule entities in bulk.
+type SearchRuleCreateBulk struct {
+	config
+	err      error
+	builders []*SearchRuleCreate
+}
+
+// Save creates the SearchRule entities in the database.
+func (_c *SearchRuleCreateBulk) Save(ctx context.Context) ([]*SearchRule, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SearchRule, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SearchRuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SearchRuleCreateBulk) SaveX(ctx context.Context) []*SearchRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SearchRuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SearchRuleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,511 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"yinni_backend/ent/predicate"
+	"yinni_backend/ent/searchrule"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// SearchRuleUpdate is the builder for updating SearchRule entities.
+type SearchRuleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SearchRuleMutation
+}
+
+// Where appends a list predicates to the SearchRuleUpdate builder.
+func (_u *SearchRuleUpdate) Where(ps ...predicate.SearchRule) *SearchRuleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *SearchRuleUpdate) SetUpdateTime(v time.Time) *SearchRuleUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *SearchRuleUpdate) SetKind(v searchrule.Kind) *SearchRuleUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *SearchRuleUpdate) SetNillableKind(v *searchrule.Kind) *SearchRuleUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetTerms sets the "terms" field.
+func (_u *SearchRuleUpdate) SetTerms(v []string) *SearchRuleUpdate {
+	_u.mutation.SetTerms(v)
+	return _u
+}
+
+// AppendTerms appends value to the "terms" field.
+func (_u *SearchRuleUpdate) AppendTerms(v []string) *SearchRuleUpdate {
+	_u.mutation.AppendTerms(v)
+	return _u
+}
+
+// SetSynonyms sets the "synonyms" field.
+func (_u *SearchRuleUpdate) SetSynonyms(v []string) *SearchRuleUpdate {
+	_u.mutation.SetSynonyms(v)
+	return _u
+}
+
+// AppendSynonyms appends value to the "synonyms" field.
+func (_u *SearchRuleUpdate) AppendSynonyms(v []string) *SearchRuleUpdate {
+	_u.mutation.AppendSynonyms(v)
+	return _u
+}
+
+// ClearSynonyms clears the value of the "synonyms" field.
+func (_u *SearchRuleUpdate) ClearSynonyms() *SearchRuleUpdate {
+	_u.mutation.ClearSynonyms()
+	return _u
+}
+
+// SetCategory sets the "category" field.
+func (_u *SearchRuleUpdate) SetCategory(v string) *SearchRuleUpdate {
+	_u.mutation.SetCategory(v)
+	return _u
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (_u *SearchRuleUpdate) SetNillableCategory(v *string) *SearchRuleUpdate {
+	if v != nil {
+		_u.SetCategory(*v)
+	}
+	return _u
+}
+
+// ClearCategory clears the value of the "category" field.
+func (_u *SearchRuleUpdate) ClearCategory() *SearchRuleUpdate {
+	_u.mutation.ClearCategory()
+	return _u
+}
+
+// SetBoost sets the "boost" field.
+func (_u *SearchRuleUpdate) SetBoost(v float64) *SearchRuleUpdate {
+	_u.mutation.ResetBoost()
+	_u.mutation.SetBoost(v)
+	return _u
+}
+
+// SetNillableBoost sets the "boost" field if the given value is not nil.
+func (_u *SearchRuleUpdate) SetNillableBoost(v *float64) *SearchRuleUpdate {
+	if v != nil {
+		_u.SetBoost(*v)
+	}
+	return _u
+}
+
+// AddBoost adds value to the "boost" field.
+func (_u *SearchRuleUpdate) AddBoost(v float64) *SearchRuleUpdate {
+	_u.mutation.AddBoost(v)
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *SearchRuleUpdate) SetEnabled(v bool) *SearchRuleUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *SearchRuleUpdate) SetNillableEnabled(v *bool) *SearchRuleUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// Mutation returns the SearchRuleMutation object of the builder.
+func (_u *SearchRuleUpdate) Mutation() *SearchRuleMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SearchRuleUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SearchRuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SearchRuleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SearchRuleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SearchRuleUpdate) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := searchrule.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SearchRuleUpdate) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := searchrule.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "SearchRule.kind": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *SearchRuleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(searchrule.Table, searchrule.Columns, sqlgraph.NewFieldSpec(searchrule.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(searchrule.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(searchrule.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Terms(); ok {
+		_spec.SetField(searchrule.FieldTerms, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTerms(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, searchrule.FieldTerms, value)
+		})
+	}
+	if value, ok := _u.mutation.Synonyms(); ok {
+		_spec.SetField(searchrule.FieldSynonyms, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSynonyms(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, searchrule.FieldSynonyms, value)
+		})
+	}
+	if _u.mutation.SynonymsCleared() {
+		_spec.ClearField(searchrule.FieldSynonyms, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Category(); ok {
+		_spec.SetField(searchrule.FieldCategory, field.TypeString, value)
+	}
+	if _u.mutation.CategoryCleared() {
+		_spec.ClearField(searchrule.FieldCategory, field.TypeString)
+	}
+	if value, ok := _u.mutation.Boost(); ok {
+		_spec.SetField(searchrule.FieldBoost, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBoost(); ok {
+		_spec.AddField(searchrule.FieldBoost, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(searchrule.FieldEnabled, field.TypeBool, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{searchrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SearchRuleUpdateOne is the builder for updating a single SearchRule entity.
+type SearchRuleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SearchRuleMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *SearchRuleUpdateOne) SetUpdateTime(v time.Time) *SearchRuleUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *SearchRuleUpdateOne) SetKind(v searchrule.Kind) *SearchRuleUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *SearchRuleUpdateOne) SetNillableKind(v *searchrule.Kind) *SearchRuleUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetTerms sets the "terms" field.
+func (_u *SearchRuleUpdateOne) SetTerms(v []string) *SearchRuleUpdateOne {
+	_u.mutation.SetTerms(v)
+	return _u
+}
+
+// AppendTerms appends value to the "terms" field.
+func (_u *SearchRuleUpdateOne) AppendTerms(v []string) *SearchRuleUpdateOne {
+	_u.mutation.AppendTerms(v)
+	return _u
+}
+
+// SetSynonyms sets the "synonyms" field.
+func (_u *SearchRuleUpdateOne) SetSynonyms(v []string) *SearchRuleUpdateOne {
+	_u.mutation.SetSynonyms(v)
+	return _u
+}
+
+// AppendSynonyms appends value to the "synonyms" field.
+func (_u *SearchRuleUpdateOne) AppendSynonyms(v []string) *SearchRuleUpdateOne {
+	_u.mutation.AppendSynonyms(v)
+	return _u
+}
+
+// ClearSynonyms clears the value of the "synonyms" field.
+func (_u *SearchRuleUpdateOne) ClearSynonyms() *SearchRuleUpdateOne {
+	_u.mutation.ClearSynonyms()
+	return _u
+}
+
+// SetCategory sets the "category" field.
+func (_u *SearchRuleUpdateOne) SetCategory(v string) *SearchRuleUpdateOne {
+	_u.mutation.SetCategory(v)
+	return _u
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (_u *SearchRuleUpdateOne) SetNillableCategory(v *string) *SearchRuleUpdateOne {
+	if v != nil {
+		_u.SetCategory(*v)
+	}
+	return _u
+}
+
+// ClearCategory clears the value of the "category" field.
+func (_u *SearchRuleUpdateOne) ClearCategory() *SearchRuleUpdateOne {
+	_u.mutation.ClearCategory()
+	return _u
+}
+
+// SetBoost sets the "boost" field.
+func (_u *SearchRuleUpdateOne) SetBoost(v float64) *SearchRuleUpdateOne {
+	_u.mutation.ResetBoost()
+	_u.mutation.SetBoost(v)
+	return _u
+}
+
+// SetNillableBoost sets the "boost" field if the given value is not nil.
+func (_u *SearchRuleUpdateOne) SetNillableBoost(v *float64) *SearchRuleUpdateOne {
+	if v != nil {
+		_u.SetBoost(*v)
+	}
+	return _u
+}
+
+// AddBoost adds value to the "boost" field.
+func (_u *SearchRuleUpdateOne) AddBoost(v float64) *SearchRuleUpdateOne {
+	_u.mutation.AddBoost(v)
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *SearchRuleUpdateOne) SetEnabled(v bool) *SearchRuleUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *SearchRuleUpdateOne) SetNillableEnabled(v *bool) *SearchRuleUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// Mutation returns the SearchRuleMutation object of the builder.
+func (_u *SearchRuleUpdateOne) Mutation() *SearchRuleMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the SearchRuleUpdate builder.
+func (_u *SearchRuleUpdateOne) Where(ps ...predicate.SearchRule) *SearchRuleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SearchRuleUpdateOne) Select(field string, fields ...string) *SearchRuleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SearchRule entity.
+func (_u *SearchRuleUpdateOne) Save(ctx context.Context) (*SearchRule, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SearchRuleUpdateOne) SaveX(ctx context.Context) *SearchRule {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SearchRuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SearchRuleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SearchRuleUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := searchrule.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SearchRuleUpdateOne) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := searchrule.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "SearchRule.kind": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *SearchRuleUpdateOne) sqlSave(ctx context.Context) (_node *SearchRule, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(searchrule.Table, searchrule.Columns, sqlgraph.NewFieldSpec(searchrule.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SearchRule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, searchrule.FieldID)
+		for _, f := range fields {
+			if !searchrule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != searchrule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(searchrule.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(searchrule.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Terms(); ok {
+		_spec.SetField(searchrule.FieldTerms, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTerms(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, searchrule.FieldTerms, value)
+		})
+	}
+	if value, ok := _u.mutation.Synonyms(); ok {
+		_spec.SetField(searchrule.FieldSynonyms, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSynonyms(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, searchrule.FieldSynonyms, value)
+		})
+	}
+	if _u.mutation.SynonymsCleared() {
+		_spec.ClearField(searchrule.FieldSynonyms, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Category(); ok {
+		_spec.SetField(searchrule.FieldCategory, field.TypeString, value)
+	}
+	if _u.mutation.CategoryCleared() {
+		_spec.ClearField(searchrule.FieldCategory, field.TypeString)
+	}
+	if value, ok := _u.mutation.Boost(); ok {
+		_spec.SetField(searchrule.FieldBoost, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBoost(); ok {
+		_spec.AddField(searchrule.FieldBoost, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(searchrule.FieldEnabled, field.TypeBool, value)
+	}
+	_node = &SearchRule{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{searchrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
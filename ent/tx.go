@@ -12,8 +12,20 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// EmbeddingFailure is the client for interacting with the EmbeddingFailure builders.
+	EmbeddingFailure *EmbeddingFailureClient
+	// EmbeddingJob is the client for interacting with the EmbeddingJob builders.
+	EmbeddingJob *EmbeddingJobClient
 	// Product is the client for interacting with the Product builders.
 	Product *ProductClient
+	// ProductAttribute is the client for interacting with the ProductAttribute builders.
+	ProductAttribute *ProductAttributeClient
+	// RefreshToken is the client for interacting with the RefreshToken builders.
+	RefreshToken *RefreshTokenClient
+	// SchemaMigration is the client for interacting with the SchemaMigration builders.
+	SchemaMigration *SchemaMigrationClient
+	// SearchRule is the client for interacting with the SearchRule builders.
+	SearchRule *SearchRuleClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 
@@ -147,7 +159,13 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.EmbeddingFailure = NewEmbeddingFailureClient(tx.config)
+	tx.EmbeddingJob = NewEmbeddingJobClient(tx.config)
 	tx.Product = NewProductClient(tx.config)
+	tx.ProductAttribute = NewProductAttributeClient(tx.config)
+	tx.RefreshToken = NewRefreshTokenClient(tx.config)
+	tx.SchemaMigration = NewSchemaMigrationClient(tx.config)
+	tx.SearchRule = NewSearchRuleClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 }
 
@@ -158,7 +176,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Product.QueryXXX(), the query will be executed
+// applies a query, for example: EmbeddingFailure.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -23,9 +23,26 @@ const (
 )
 
 type Auth struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	JwtSecret     string                 `protobuf:"bytes,1,opt,name=jwt_secret,json=jwtSecret,proto3" json:"jwt_secret,omitempty"`
-	JwtExpire     int64                  `protobuf:"varint,2,opt,name=jwt_expire,json=jwtExpire,proto3" json:"jwt_expire,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	JwtSecret string                 `protobuf:"bytes,1,opt,name=jwt_secret,json=jwtSecret,proto3" json:"jwt_secret,omitempty"`
+	// Lifetime of an access token, in seconds
+	JwtExpire int64 `protobuf:"varint,2,opt,name=jwt_expire,json=jwtExpire,proto3" json:"jwt_expire,omitempty"`
+	// Lifetime of a refresh token; each refresh issues a new one
+	RefreshExpire *durationpb.Duration `protobuf:"bytes,3,opt,name=refresh_expire,json=refreshExpire,proto3" json:"refresh_expire,omitempty"`
+	// Keys of the auth service, all published in its JWKS. Without keys it
+	// signs with jwt_secret (HS256).
+	SigningKeys []*Auth_SigningKey `protobuf:"bytes,4,rep,name=signing_keys,json=signingKeys,proto3" json:"signing_keys,omitempty"`
+	// kid of the key that signs new tokens, the first key by default. Keep
+	// retired keys listed until their tokens have expired.
+	SigningKid string `protobuf:"bytes,5,opt,name=signing_kid,json=signingKid,proto3" json:"signing_kid,omitempty"`
+	// JWKS the other services verify tokens with, e.g.
+	// http://auth:8000/.well-known/jwks.json. Without it they verify with
+	// jwt_secret.
+	JwksUrl             string               `protobuf:"bytes,6,opt,name=jwks_url,json=jwksUrl,proto3" json:"jwks_url,omitempty"`
+	JwksRefreshInterval *durationpb.Duration `protobuf:"bytes,7,opt,name=jwks_refresh_interval,json=jwksRefreshInterval,proto3" json:"jwks_refresh_interval,omitempty"`
+	// Secret shared by the services to sign the principal they pass along
+	// with calls to each other
+	PeerSecret    string `protobuf:"bytes,8,opt,name=peer_secret,json=peerSecret,proto3" json:"peer_secret,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -74,12 +91,56 @@ func (x *Auth) GetJwtExpire() int64 {
 	return 0
 }
 
+func (x *Auth) GetRefreshExpire() *durationpb.Duration {
+	if x != nil {
+		return x.RefreshExpire
+	}
+	return nil
+}
+
+func (x *Auth) GetSigningKeys() []*Auth_SigningKey {
+	if x != nil {
+		return x.SigningKeys
+	}
+	return nil
+}
+
+func (x *Auth) GetSigningKid() string {
+	if x != nil {
+		return x.SigningKid
+	}
+	return ""
+}
+
+func (x *Auth) GetJwksUrl() string {
+	if x != nil {
+		return x.JwksUrl
+	}
+	return ""
+}
+
+func (x *Auth) GetJwksRefreshInterval() *durationpb.Duration {
+	if x != nil {
+		return x.JwksRefreshInterval
+	}
+	return nil
+}
+
+func (x *Auth) GetPeerSecret() string {
+	if x != nil {
+		return x.PeerSecret
+	}
+	return ""
+}
+
 type Bootstrap struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Server        *Server                `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
 	Data          *Data                  `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 	Auth          *Auth                  `protobuf:"bytes,3,opt,name=auth,proto3" json:"auth,omitempty"`
 	Embeddings    *Embeddings            `protobuf:"bytes,4,opt,name=embeddings,proto3" json:"embeddings,omitempty"` // Changed back to Embeddings
+	Chat          *Chat                  `protobuf:"bytes,5,opt,name=chat,proto3" json:"chat,omitempty"`
+	Search        *Search                `protobuf:"bytes,6,opt,name=search,proto3" json:"search,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -142,6 +203,20 @@ func (x *Bootstrap) GetEmbeddings() *Embeddings {
 	return nil
 }
 
+func (x *Bootstrap) GetChat() *Chat {
+	if x != nil {
+		return x.Chat
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetSearch() *Search {
+	if x != nil {
+		return x.Search
+	}
+	return nil
+}
+
 type Server struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Http          *Server_HTTP           `protobuf:"bytes,1,opt,name=http,proto3" json:"http,omitempty"`
@@ -195,11 +270,16 @@ func (x *Server) GetGrpc() *Server_GRPC {
 }
 
 type Data struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Database      *Data_Database         `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
-	Redis         *Data_Redis            `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Database *Data_Database         `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	// Shared store of token revocations, required when more than one service
+	// verifies tokens
+	Redis *Data_Redis `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
+	// Keep token revocations in memory instead, for development with a single
+	// process; the other services never see them
+	MemoryRevocations bool `protobuf:"varint,3,opt,name=memory_revocations,json=memoryRevocations,proto3" json:"memory_revocations,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *Data) Reset() {
@@ -246,6 +326,13 @@ func (x *Data) GetRedis() *Data_Redis {
 	return nil
 }
 
+func (x *Data) GetMemoryRevocations() bool {
+	if x != nil {
+		return x.MemoryRevocations
+	}
+	return false
+}
+
 type Embeddings struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	ApiKey         string                 `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
@@ -254,8 +341,18 @@ type Embeddings struct {
 	BaseUrl        string                 `protobuf:"bytes,4,opt,name=base_url,json=baseUrl,proto3" json:"base_url,omitempty"`
 	TimeoutSeconds int32                  `protobuf:"varint,5,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
 	MaxRetries     int32                  `protobuf:"varint,6,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// "openai" (default) or "local" for the offline hashing embedder
+	Provider string `protobuf:"bytes,7,opt,name=provider,proto3" json:"provider,omitempty"`
+	// Vector size of the local embedder
+	Dimensions int32 `protobuf:"varint,8,opt,name=dimensions,proto3" json:"dimensions,omitempty"`
+	// Backfill rate limit in provider requests per second, with bursts of up
+	// to burst requests
+	RequestsPerSecond float32 `protobuf:"fixed32,9,opt,name=requests_per_second,json=requestsPerSecond,proto3" json:"requests_per_second,omitempty"`
+	Burst             int32   `protobuf:"varint,10,opt,name=burst,proto3" json:"burst,omitempty"`
+	// Failed attempts after which a product is dead-lettered
+	MaxAttempts   int32 `protobuf:"varint,11,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Embeddings) Reset() {
@@ -330,6 +427,288 @@ func (x *Embeddings) GetMaxRetries() int32 {
 	return 0
 }
 
+func (x *Embeddings) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *Embeddings) GetDimensions() int32 {
+	if x != nil {
+		return x.Dimensions
+	}
+	return 0
+}
+
+func (x *Embeddings) GetRequestsPerSecond() float32 {
+	if x != nil {
+		return x.RequestsPerSecond
+	}
+	return 0
+}
+
+func (x *Embeddings) GetBurst() int32 {
+	if x != nil {
+		return x.Burst
+	}
+	return 0
+}
+
+func (x *Embeddings) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+// Search tunes hybrid search. Results are fused with reciprocal rank
+// fusion: each retriever adds weight / (rrf_k + rank) to a product's score.
+type Search struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LexicalWeight float32                `protobuf:"fixed32,1,opt,name=lexical_weight,json=lexicalWeight,proto3" json:"lexical_weight,omitempty"`
+	VectorWeight  float32                `protobuf:"fixed32,2,opt,name=vector_weight,json=vectorWeight,proto3" json:"vector_weight,omitempty"`
+	RrfK          int32                  `protobuf:"varint,3,opt,name=rrf_k,json=rrfK,proto3" json:"rrf_k,omitempty"`
+	// Results fetched from each retriever before fusion
+	Candidates int32 `protobuf:"varint,4,opt,name=candidates,proto3" json:"candidates,omitempty"`
+	// How often the query suggestion index is rebuilt from the catalog
+	SuggestRefreshInterval *durationpb.Duration `protobuf:"bytes,5,opt,name=suggest_refresh_interval,json=suggestRefreshInterval,proto3" json:"suggest_refresh_interval,omitempty"`
+	// How often search rules are reloaded to pick up changes made through
+	// other instances
+	RulesRefreshInterval *durationpb.Duration `protobuf:"bytes,6,opt,name=rules_refresh_interval,json=rulesRefreshInterval,proto3" json:"rules_refresh_interval,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Search) Reset() {
+	*x = Search{}
+	mi := &file_internal_conf_conf_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Search) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Search) ProtoMessage() {}
+
+func (x *Search) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Search.ProtoReflect.Descriptor instead.
+func (*Search) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Search) GetLexicalWeight() float32 {
+	if x != nil {
+		return x.LexicalWeight
+	}
+	return 0
+}
+
+func (x *Search) GetVectorWeight() float32 {
+	if x != nil {
+		return x.VectorWeight
+	}
+	return 0
+}
+
+func (x *Search) GetRrfK() int32 {
+	if x != nil {
+		return x.RrfK
+	}
+	return 0
+}
+
+func (x *Search) GetCandidates() int32 {
+	if x != nil {
+		return x.Candidates
+	}
+	return 0
+}
+
+func (x *Search) GetSuggestRefreshInterval() *durationpb.Duration {
+	if x != nil {
+		return x.SuggestRefreshInterval
+	}
+	return nil
+}
+
+func (x *Search) GetRulesRefreshInterval() *durationpb.Duration {
+	if x != nil {
+		return x.RulesRefreshInterval
+	}
+	return nil
+}
+
+// Chat configures the OpenAI-compatible chat completion endpoint used to
+// answer catalog questions.
+type Chat struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ApiKey         string                 `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	Model          string                 `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`
+	BaseUrl        string                 `protobuf:"bytes,3,opt,name=base_url,json=baseUrl,proto3" json:"base_url,omitempty"`
+	TimeoutSeconds int32                  `protobuf:"varint,4,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	MaxTokens      int32                  `protobuf:"varint,5,opt,name=max_tokens,json=maxTokens,proto3" json:"max_tokens,omitempty"`
+	Temperature    float32                `protobuf:"fixed32,6,opt,name=temperature,proto3" json:"temperature,omitempty"`
+	// "openai" (default) or "fake" for a deterministic offline client
+	Provider      string `protobuf:"bytes,7,opt,name=provider,proto3" json:"provider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Chat) Reset() {
+	*x = Chat{}
+	mi := &file_internal_conf_conf_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Chat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Chat) ProtoMessage() {}
+
+func (x *Chat) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Chat.ProtoReflect.Descriptor instead.
+func (*Chat) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Chat) GetApiKey() string {
+	if x != nil {
+		return x.ApiKey
+	}
+	return ""
+}
+
+func (x *Chat) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *Chat) GetBaseUrl() string {
+	if x != nil {
+		return x.BaseUrl
+	}
+	return ""
+}
+
+func (x *Chat) GetTimeoutSeconds() int32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *Chat) GetMaxTokens() int32 {
+	if x != nil {
+		return x.MaxTokens
+	}
+	return 0
+}
+
+func (x *Chat) GetTemperature() float32 {
+	if x != nil {
+		return x.Temperature
+	}
+	return 0
+}
+
+func (x *Chat) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+// SigningKey is an RSA or Ed25519 private key, PEM encoded, given inline
+// or as a file. RSA keys sign with RS256, Ed25519 keys with EdDSA.
+type Auth_SigningKey struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Kid            string                 `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
+	PrivateKey     string                 `protobuf:"bytes,2,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	PrivateKeyFile string                 `protobuf:"bytes,3,opt,name=private_key_file,json=privateKeyFile,proto3" json:"private_key_file,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Auth_SigningKey) Reset() {
+	*x = Auth_SigningKey{}
+	mi := &file_internal_conf_conf_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Auth_SigningKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Auth_SigningKey) ProtoMessage() {}
+
+func (x *Auth_SigningKey) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Auth_SigningKey.ProtoReflect.Descriptor instead.
+func (*Auth_SigningKey) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Auth_SigningKey) GetKid() string {
+	if x != nil {
+		return x.Kid
+	}
+	return ""
+}
+
+func (x *Auth_SigningKey) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+func (x *Auth_SigningKey) GetPrivateKeyFile() string {
+	if x != nil {
+		return x.PrivateKeyFile
+	}
+	return ""
+}
+
 type Server_HTTP struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
@@ -341,7 +720,7 @@ type Server_HTTP struct {
 
 func (x *Server_HTTP) Reset() {
 	*x = Server_HTTP{}
-	mi := &file_internal_conf_conf_proto_msgTypes[5]
+	mi := &file_internal_conf_conf_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -353,7 +732,7 @@ func (x *Server_HTTP) String() string {
 func (*Server_HTTP) ProtoMessage() {}
 
 func (x *Server_HTTP) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[5]
+	mi := &file_internal_conf_conf_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -401,7 +780,7 @@ type Server_GRPC struct {
 
 func (x *Server_GRPC) Reset() {
 	*x = Server_GRPC{}
-	mi := &file_internal_conf_conf_proto_msgTypes[6]
+	mi := &file_internal_conf_conf_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -413,7 +792,7 @@ func (x *Server_GRPC) String() string {
 func (*Server_GRPC) ProtoMessage() {}
 
 func (x *Server_GRPC) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[6]
+	mi := &file_internal_conf_conf_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -460,7 +839,7 @@ type Data_Database struct {
 
 func (x *Data_Database) Reset() {
 	*x = Data_Database{}
-	mi := &file_internal_conf_conf_proto_msgTypes[7]
+	mi := &file_internal_conf_conf_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -472,7 +851,7 @@ func (x *Data_Database) String() string {
 func (*Data_Database) ProtoMessage() {}
 
 func (x *Data_Database) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[7]
+	mi := &file_internal_conf_conf_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -508,13 +887,15 @@ type Data_Redis struct {
 	Addr          string                 `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
 	ReadTimeout   *durationpb.Duration   `protobuf:"bytes,3,opt,name=read_timeout,json=readTimeout,proto3" json:"read_timeout,omitempty"`
 	WriteTimeout  *durationpb.Duration   `protobuf:"bytes,4,opt,name=write_timeout,json=writeTimeout,proto3" json:"write_timeout,omitempty"`
+	Password      string                 `protobuf:"bytes,5,opt,name=password,proto3" json:"password,omitempty"`
+	Db            int32                  `protobuf:"varint,6,opt,name=db,proto3" json:"db,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Data_Redis) Reset() {
 	*x = Data_Redis{}
-	mi := &file_internal_conf_conf_proto_msgTypes[8]
+	mi := &file_internal_conf_conf_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -526,7 +907,7 @@ func (x *Data_Redis) String() string {
 func (*Data_Redis) ProtoMessage() {}
 
 func (x *Data_Redis) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[8]
+	mi := &file_internal_conf_conf_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -570,24 +951,54 @@ func (x *Data_Redis) GetWriteTimeout() *durationpb.Duration {
 	return nil
 }
 
+func (x *Data_Redis) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *Data_Redis) GetDb() int32 {
+	if x != nil {
+		return x.Db
+	}
+	return 0
+}
+
 var File_internal_conf_conf_proto protoreflect.FileDescriptor
 
 const file_internal_conf_conf_proto_rawDesc = "" +
 	"\n" +
 	"\x18internal/conf/conf.proto\x12\n" +
-	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\"D\n" +
+	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\"\xdd\x03\n" +
 	"\x04Auth\x12\x1d\n" +
 	"\n" +
 	"jwt_secret\x18\x01 \x01(\tR\tjwtSecret\x12\x1d\n" +
 	"\n" +
-	"jwt_expire\x18\x02 \x01(\x03R\tjwtExpire\"\xbb\x01\n" +
+	"jwt_expire\x18\x02 \x01(\x03R\tjwtExpire\x12@\n" +
+	"\x0erefresh_expire\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\rrefreshExpire\x12>\n" +
+	"\fsigning_keys\x18\x04 \x03(\v2\x1b.kratos.api.Auth.SigningKeyR\vsigningKeys\x12\x1f\n" +
+	"\vsigning_kid\x18\x05 \x01(\tR\n" +
+	"signingKid\x12\x19\n" +
+	"\bjwks_url\x18\x06 \x01(\tR\ajwksUrl\x12M\n" +
+	"\x15jwks_refresh_interval\x18\a \x01(\v2\x19.google.protobuf.DurationR\x13jwksRefreshInterval\x12\x1f\n" +
+	"\vpeer_secret\x18\b \x01(\tR\n" +
+	"peerSecret\x1ai\n" +
+	"\n" +
+	"SigningKey\x12\x10\n" +
+	"\x03kid\x18\x01 \x01(\tR\x03kid\x12\x1f\n" +
+	"\vprivate_key\x18\x02 \x01(\tR\n" +
+	"privateKey\x12(\n" +
+	"\x10private_key_file\x18\x03 \x01(\tR\x0eprivateKeyFile\"\x8d\x02\n" +
 	"\tBootstrap\x12*\n" +
 	"\x06server\x18\x01 \x01(\v2\x12.kratos.api.ServerR\x06server\x12$\n" +
 	"\x04data\x18\x02 \x01(\v2\x10.kratos.api.DataR\x04data\x12$\n" +
 	"\x04auth\x18\x03 \x01(\v2\x10.kratos.api.AuthR\x04auth\x126\n" +
 	"\n" +
 	"embeddings\x18\x04 \x01(\v2\x16.kratos.api.EmbeddingsR\n" +
-	"embeddings\"\xb8\x02\n" +
+	"embeddings\x12$\n" +
+	"\x04chat\x18\x05 \x01(\v2\x10.kratos.api.ChatR\x04chat\x12*\n" +
+	"\x06search\x18\x06 \x01(\v2\x12.kratos.api.SearchR\x06search\"\xb8\x02\n" +
 	"\x06Server\x12+\n" +
 	"\x04http\x18\x01 \x01(\v2\x17.kratos.api.Server.HTTPR\x04http\x12+\n" +
 	"\x04grpc\x18\x02 \x01(\v2\x17.kratos.api.Server.GRPCR\x04grpc\x1ai\n" +
@@ -598,18 +1009,21 @@ const file_internal_conf_conf_proto_rawDesc = "" +
 	"\x04GRPC\x12\x18\n" +
 	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
-	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\xdd\x02\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\xb8\x03\n" +
 	"\x04Data\x125\n" +
 	"\bdatabase\x18\x01 \x01(\v2\x19.kratos.api.Data.DatabaseR\bdatabase\x12,\n" +
-	"\x05redis\x18\x02 \x01(\v2\x16.kratos.api.Data.RedisR\x05redis\x1a:\n" +
+	"\x05redis\x18\x02 \x01(\v2\x16.kratos.api.Data.RedisR\x05redis\x12-\n" +
+	"\x12memory_revocations\x18\x03 \x01(\bR\x11memoryRevocations\x1a:\n" +
 	"\bDatabase\x12\x16\n" +
 	"\x06driver\x18\x01 \x01(\tR\x06driver\x12\x16\n" +
-	"\x06source\x18\x02 \x01(\tR\x06source\x1a\xb3\x01\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\x1a\xdf\x01\n" +
 	"\x05Redis\x12\x18\n" +
 	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x12<\n" +
 	"\fread_timeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\vreadTimeout\x12>\n" +
-	"\rwrite_timeout\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\fwriteTimeout\"\xbf\x01\n" +
+	"\rwrite_timeout\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\fwriteTimeout\x12\x1a\n" +
+	"\bpassword\x18\x05 \x01(\tR\bpassword\x12\x0e\n" +
+	"\x02db\x18\x06 \x01(\x05R\x02db\"\xe4\x02\n" +
 	"\n" +
 	"Embeddings\x12\x17\n" +
 	"\aapi_key\x18\x01 \x01(\tR\x06apiKey\x12\x14\n" +
@@ -619,7 +1033,33 @@ const file_internal_conf_conf_proto_rawDesc = "" +
 	"\bbase_url\x18\x04 \x01(\tR\abaseUrl\x12'\n" +
 	"\x0ftimeout_seconds\x18\x05 \x01(\x05R\x0etimeoutSeconds\x12\x1f\n" +
 	"\vmax_retries\x18\x06 \x01(\x05R\n" +
-	"maxRetriesB\"Z yinni_backend/internal/conf;confb\x06proto3"
+	"maxRetries\x12\x1a\n" +
+	"\bprovider\x18\a \x01(\tR\bprovider\x12\x1e\n" +
+	"\n" +
+	"dimensions\x18\b \x01(\x05R\n" +
+	"dimensions\x12.\n" +
+	"\x13requests_per_second\x18\t \x01(\x02R\x11requestsPerSecond\x12\x14\n" +
+	"\x05burst\x18\n" +
+	" \x01(\x05R\x05burst\x12!\n" +
+	"\fmax_attempts\x18\v \x01(\x05R\vmaxAttempts\"\xaf\x02\n" +
+	"\x06Search\x12%\n" +
+	"\x0elexical_weight\x18\x01 \x01(\x02R\rlexicalWeight\x12#\n" +
+	"\rvector_weight\x18\x02 \x01(\x02R\fvectorWeight\x12\x13\n" +
+	"\x05rrf_k\x18\x03 \x01(\x05R\x04rrfK\x12\x1e\n" +
+	"\n" +
+	"candidates\x18\x04 \x01(\x05R\n" +
+	"candidates\x12S\n" +
+	"\x18suggest_refresh_interval\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\x16suggestRefreshInterval\x12O\n" +
+	"\x16rules_refresh_interval\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\x14rulesRefreshInterval\"\xd6\x01\n" +
+	"\x04Chat\x12\x17\n" +
+	"\aapi_key\x18\x01 \x01(\tR\x06apiKey\x12\x14\n" +
+	"\x05model\x18\x02 \x01(\tR\x05model\x12\x19\n" +
+	"\bbase_url\x18\x03 \x01(\tR\abaseUrl\x12'\n" +
+	"\x0ftimeout_seconds\x18\x04 \x01(\x05R\x0etimeoutSeconds\x12\x1d\n" +
+	"\n" +
+	"max_tokens\x18\x05 \x01(\x05R\tmaxTokens\x12 \n" +
+	"\vtemperature\x18\x06 \x01(\x02R\vtemperature\x12\x1a\n" +
+	"\bprovider\x18\a \x01(\tR\bproviderB\"Z yinni_backend/internal/conf;confb\x06proto3"
 
 var (
 	file_internal_conf_conf_proto_rawDescOnce sync.Once
@@ -633,37 +1073,47 @@ func file_internal_conf_conf_proto_rawDescGZIP() []byte {
 	return file_internal_conf_conf_proto_rawDescData
 }
 
-var file_internal_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_internal_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_internal_conf_conf_proto_goTypes = []any{
 	(*Auth)(nil),                // 0: kratos.api.Auth
 	(*Bootstrap)(nil),           // 1: kratos.api.Bootstrap
 	(*Server)(nil),              // 2: kratos.api.Server
 	(*Data)(nil),                // 3: kratos.api.Data
 	(*Embeddings)(nil),          // 4: kratos.api.Embeddings
-	(*Server_HTTP)(nil),         // 5: kratos.api.Server.HTTP
-	(*Server_GRPC)(nil),         // 6: kratos.api.Server.GRPC
-	(*Data_Database)(nil),       // 7: kratos.api.Data.Database
-	(*Data_Redis)(nil),          // 8: kratos.api.Data.Redis
-	(*durationpb.Duration)(nil), // 9: google.protobuf.Duration
+	(*Search)(nil),              // 5: kratos.api.Search
+	(*Chat)(nil),                // 6: kratos.api.Chat
+	(*Auth_SigningKey)(nil),     // 7: kratos.api.Auth.SigningKey
+	(*Server_HTTP)(nil),         // 8: kratos.api.Server.HTTP
+	(*Server_GRPC)(nil),         // 9: kratos.api.Server.GRPC
+	(*Data_Database)(nil),       // 10: kratos.api.Data.Database
+	(*Data_Redis)(nil),          // 11: kratos.api.Data.Redis
+	(*durationpb.Duration)(nil), // 12: google.protobuf.Duration
 }
 var file_internal_conf_conf_proto_depIdxs = []int32{
-	2,  // 0: kratos.api.Bootstrap.server:type_name -> kratos.api.Server
-	3,  // 1: kratos.api.Bootstrap.data:type_name -> kratos.api.Data
-	0,  // 2: kratos.api.Bootstrap.auth:type_name -> kratos.api.Auth
-	4,  // 3: kratos.api.Bootstrap.embeddings:type_name -> kratos.api.Embeddings
-	5,  // 4: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
-	6,  // 5: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
-	7,  // 6: kratos.api.Data.database:type_name -> kratos.api.Data.Database
-	8,  // 7: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
-	9,  // 8: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
-	9,  // 9: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
-	9,  // 10: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
-	9,  // 11: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
-	12, // [12:12] is the sub-list for method output_type
-	12, // [12:12] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	12, // 0: kratos.api.Auth.refresh_expire:type_name -> google.protobuf.Duration
+	7,  // 1: kratos.api.Auth.signing_keys:type_name -> kratos.api.Auth.SigningKey
+	12, // 2: kratos.api.Auth.jwks_refresh_interval:type_name -> google.protobuf.Duration
+	2,  // 3: kratos.api.Bootstrap.server:type_name -> kratos.api.Server
+	3,  // 4: kratos.api.Bootstrap.data:type_name -> kratos.api.Data
+	0,  // 5: kratos.api.Bootstrap.auth:type_name -> kratos.api.Auth
+	4,  // 6: kratos.api.Bootstrap.embeddings:type_name -> kratos.api.Embeddings
+	6,  // 7: kratos.api.Bootstrap.chat:type_name -> kratos.api.Chat
+	5,  // 8: kratos.api.Bootstrap.search:type_name -> kratos.api.Search
+	8,  // 9: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
+	9,  // 10: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
+	10, // 11: kratos.api.Data.database:type_name -> kratos.api.Data.Database
+	11, // 12: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
+	12, // 13: kratos.api.Search.suggest_refresh_interval:type_name -> google.protobuf.Duration
+	12, // 14: kratos.api.Search.rules_refresh_interval:type_name -> google.protobuf.Duration
+	12, // 15: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
+	12, // 16: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
+	12, // 17: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
+	12, // 18: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
+	19, // [19:19] is the sub-list for method output_type
+	19, // [19:19] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_internal_conf_conf_proto_init() }
@@ -677,7 +1127,7 @@ func file_internal_conf_conf_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_conf_conf_proto_rawDesc), len(file_internal_conf_conf_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
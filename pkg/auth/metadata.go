@@ -0,0 +1,135 @@
+package auth
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+// Metadata keys of the principal. The x-md-global- prefix lets Kratos
+// metadata middleware forward them further down a call chain.
+const (
+	userIDKey    = "x-md-global-auth-user-id"
+	rolesKey     = "x-md-global-auth-roles"
+	scopesKey    = "x-md-global-auth-scopes"
+	tokenIDKey   = "x-md-global-auth-token-id"
+	expiresAtKey = "x-md-global-auth-expires-at"
+	signatureKey = "x-md-global-auth-signature"
+)
+
+// signedKeys are the keys covered by the signature, in signing order.
+var signedKeys = []string{userIDKey, rolesKey, scopesKey, tokenIDKey, expiresAtKey}
+
+// ErrInvalidPrincipal is returned by Server for principal metadata that is
+// not signed with its secret, or has expired.
+var ErrInvalidPrincipal = errors.Unauthorized("INVALID_PRINCIPAL", "principal metadata is not signed by a trusted service")
+
+// Client is a client middleware sending the principal of the calling
+// request along with downstream calls, signed with the secret shared by the
+// services. Without a secret nothing is sent.
+func Client(secret string) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req any) (any, error) {
+			p, ok := FromContext(ctx)
+			if !ok || secret == "" {
+				return handler(ctx, req)
+			}
+			if tr, ok := transport.FromClientContext(ctx); ok {
+				toHeader(tr.RequestHeader(), p, secret)
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+// Server is a server middleware restoring the principal sent by Client,
+// unless the context already has one. Anyone can send the metadata, so it
+// is only trusted when signed with secret, the one the calling service's
+// Client uses; calls with metadata that is not fail with
+// ErrInvalidPrincipal, and calls without any stay anonymous. A signed
+// principal can be replayed until it expires, like the access token it came
+// from.
+func Server(secret string) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req any) (any, error) {
+			if _, ok := FromContext(ctx); ok {
+				return handler(ctx, req)
+			}
+			if tr, ok := transport.FromServerContext(ctx); ok {
+				p, err := fromHeader(tr.RequestHeader(), secret)
+				if err != nil {
+					return nil, err
+				}
+				if p != nil {
+					ctx = NewContext(ctx, p)
+				}
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+func toHeader(header transport.Header, p *Principal, secret string) {
+	header.Set(userIDKey, strconv.FormatInt(p.UserID, 10))
+	header.Set(rolesKey, strings.Join(p.Roles, ","))
+	header.Set(scopesKey, strings.Join(p.Scopes, ","))
+	header.Set(tokenIDKey, p.TokenID)
+	header.Set(expiresAtKey, strconv.FormatInt(p.ExpiresAt.Unix(), 10))
+	header.Set(signatureKey, base64.RawURLEncoding.EncodeToString(sign(header, secret)))
+}
+
+// fromHeader decodes the principal, returning nil when there is none.
+func fromHeader(header transport.Header, secret string) (*Principal, error) {
+	present := header.Get(signatureKey) != ""
+	for _, k := range signedKeys {
+		present = present || header.Get(k) != ""
+	}
+	if !present {
+		return nil, nil
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(header.Get(signatureKey))
+	if err != nil || secret == "" || !hmac.Equal(signature, sign(header, secret)) {
+		return nil, ErrInvalidPrincipal
+	}
+	userID, err := strconv.ParseInt(header.Get(userIDKey), 10, 64)
+	if err != nil {
+		return nil, ErrInvalidPrincipal
+	}
+	expiresAt, err := strconv.ParseInt(header.Get(expiresAtKey), 10, 64)
+	if err != nil || time.Now().Unix() >= expiresAt {
+		return nil, ErrInvalidPrincipal
+	}
+
+	p := &Principal{
+		UserID:    userID,
+		TokenID:   header.Get(tokenIDKey),
+		ExpiresAt: time.Unix(expiresAt, 0),
+	}
+	if roles := header.Get(rolesKey); roles != "" {
+		p.Roles = strings.Split(roles, ",")
+	}
+	if scopes := header.Get(scopesKey); scopes != "" {
+		p.Scopes = strings.Split(scopes, ",")
+	}
+	return p, nil
+}
+
+// sign returns the HMAC-SHA256 of the signed keys of header. Values are
+// separated by newlines, which header values cannot contain.
+func sign(header transport.Header, secret string) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	for _, k := range signedKeys {
+		mac.Write([]byte(header.Get(k)))
+		mac.Write([]byte{'\n'})
+	}
+	return mac.Sum(nil)
+}
@@ -0,0 +1,154 @@
+package auth_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	authv1 "yinni_backend/api/auth/v1"
+	"yinni_backend/pkg/auth"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const peerSecret = "peer-secret"
+
+// principalServer records the principal each RevokeUserTokens call carries.
+type principalServer struct {
+	authv1.UnimplementedAuthServer
+	principals chan *auth.Principal
+}
+
+func (s *principalServer) RevokeUserTokens(ctx context.Context, req *authv1.RevokeUserTokensRequest) (*authv1.RevokeUserTokensReply, error) {
+	p, _ := auth.FromContext(ctx)
+	select {
+	case s.principals <- p:
+	default:
+	}
+	return &authv1.RevokeUserTokensReply{}, nil
+}
+
+// startServer serves principalServer behind auth.Server and returns its
+// endpoint.
+func startServer(t *testing.T) (string, *principalServer) {
+	t.Helper()
+	s := &principalServer{principals: make(chan *auth.Principal, 8)}
+	srv := grpc.NewServer(grpc.Address("127.0.0.1:0"), grpc.Middleware(auth.Server(peerSecret)))
+	authv1.RegisterAuthServer(srv, s)
+	endpoint, err := srv.Endpoint()
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() { _ = srv.Start(context.Background()) }()
+	t.Cleanup(func() { _ = srv.Stop(context.Background()) })
+	return endpoint.Host, s
+}
+
+func dial(t *testing.T, endpoint string, m ...middleware.Middleware) authv1.AuthClient {
+	t.Helper()
+	conn, err := grpc.DialInsecure(context.Background(), grpc.WithEndpoint(endpoint), grpc.WithMiddleware(m...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	return authv1.NewAuthClient(conn)
+}
+
+func revoke(ctx context.Context, client authv1.AuthClient) error {
+	_, err := client.RevokeUserTokens(ctx, &authv1.RevokeUserTokensRequest{UserId: 1})
+	return err
+}
+
+func admin(expiresAt time.Time) *auth.Principal {
+	return &auth.Principal{
+		UserID:    7,
+		Roles:     []string{auth.RoleAdmin},
+		Scopes:    auth.RoleScopes(auth.RoleAdmin),
+		TokenID:   "jti-1",
+		ExpiresAt: expiresAt,
+	}
+}
+
+func TestPrincipalRoundTrip(t *testing.T) {
+	endpoint, s := startServer(t)
+	client := dial(t, endpoint, auth.Client(peerSecret))
+
+	want := admin(time.Now().Add(time.Hour).Truncate(time.Second))
+	if err := revoke(auth.NewContext(context.Background(), want), client); err != nil {
+		t.Fatal(err)
+	}
+	got := <-s.principals
+	if got == nil || got.UserID != want.UserID || got.TokenID != want.TokenID || !got.ExpiresAt.Equal(want.ExpiresAt) ||
+		!slices.Equal(got.Roles, want.Roles) || !slices.Equal(got.Scopes, want.Scopes) {
+		t.Errorf("server got principal %+v, want %+v", got, want)
+	}
+
+	// Anonymous calls stay anonymous
+	if err := revoke(context.Background(), client); err != nil {
+		t.Fatal(err)
+	}
+	if got := <-s.principals; got != nil {
+		t.Errorf("anonymous call got principal %+v", got)
+	}
+}
+
+// tamper grants the signed principal the admin scope on the way out.
+func tamper(handler middleware.Handler) middleware.Handler {
+	return func(ctx context.Context, req any) (any, error) {
+		if tr, ok := transport.FromClientContext(ctx); ok {
+			tr.RequestHeader().Set("x-md-global-auth-scopes", auth.ScopeAdmin)
+		}
+		return handler(ctx, req)
+	}
+}
+
+func TestPrincipalForgedRejected(t *testing.T) {
+	endpoint, s := startServer(t)
+	ctx := context.Background()
+	customer := &auth.Principal{UserID: 8, Roles: []string{auth.RoleCustomer}, ExpiresAt: time.Now().Add(time.Hour)}
+
+	// Metadata anyone can send, claiming to be an admin
+	forged := metadata.AppendToOutgoingContext(ctx,
+		"x-md-global-auth-user-id", "7",
+		"x-md-global-auth-roles", auth.RoleAdmin,
+		"x-md-global-auth-scopes", auth.ScopeAdmin,
+		"x-md-global-auth-expires-at", "99999999999",
+	)
+	tests := map[string]struct {
+		ctx    context.Context
+		client authv1.AuthClient
+	}{
+		"unsigned":       {forged, dial(t, endpoint)},
+		"other secret":   {auth.NewContext(ctx, admin(time.Now().Add(time.Hour))), dial(t, endpoint, auth.Client("other-secret"))},
+		"tampered scope": {auth.NewContext(ctx, customer), dial(t, endpoint, auth.Client(peerSecret), tamper)},
+		"expired":        {auth.NewContext(ctx, admin(time.Now().Add(-time.Minute))), dial(t, endpoint, auth.Client(peerSecret))},
+	}
+	for name, tt := range tests {
+		if err := revoke(tt.ctx, tt.client); !kerrors.IsUnauthorized(err) {
+			t.Errorf("%s: err = %v, want unauthorized", name, err)
+		}
+	}
+	select {
+	case p := <-s.principals:
+		t.Errorf("handler called with principal %+v", p)
+	default:
+	}
+}
+
+func TestPrincipalClientWithoutSecret(t *testing.T) {
+	endpoint, s := startServer(t)
+	client := dial(t, endpoint, auth.Client(""))
+
+	// Nothing is sent that the server could not trust
+	if err := revoke(auth.NewContext(context.Background(), admin(time.Now().Add(time.Hour))), client); err != nil {
+		t.Fatal(err)
+	}
+	if got := <-s.principals; got != nil {
+		t.Errorf("server got principal %+v, want none", got)
+	}
+}
@@ -0,0 +1,44 @@
+// Package auth carries the authenticated principal of a request: the user
+// a verified access token was issued to.
+package auth
+
+import (
+	"context"
+	"slices"
+	"time"
+)
+
+// Principal is the user calling an operation, as named by their access
+// token.
+type Principal struct {
+	UserID int64
+	Roles  []string
+	Scopes []string
+	// TokenID is the jti of the access token, for audit logs.
+	TokenID   string
+	ExpiresAt time.Time
+}
+
+// HasRole reports whether the principal has the role.
+func (p *Principal) HasRole(role string) bool {
+	return slices.Contains(p.Roles, role)
+}
+
+// HasScope reports whether the principal's token grants the scope.
+func (p *Principal) HasScope(scope string) bool {
+	return slices.Contains(p.Scopes, scope)
+}
+
+type principalKey struct{}
+
+// NewContext returns a context carrying the principal.
+func NewContext(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// FromContext returns the principal of ctx. It reports false for anonymous
+// requests, such as calls to public operations.
+func FromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(*Principal)
+	return p, ok && p != nil
+}
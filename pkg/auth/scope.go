@@ -0,0 +1,38 @@
+package auth
+
+import "slices"
+
+// Scopes granted by access tokens.
+const (
+	// ScopeCatalogWrite allows creating, updating and deleting products.
+	ScopeCatalogWrite = "catalog:write"
+	// ScopeAdmin allows the operations of the admin API.
+	ScopeAdmin = "admin"
+)
+
+// Roles of users, stored on the user and embedded in their access tokens.
+const (
+	RoleCustomer = "customer"
+	RoleEditor   = "editor"
+	RoleAdmin    = "admin"
+)
+
+// Roles are the roles a user can be given.
+var Roles = []string{RoleCustomer, RoleEditor, RoleAdmin}
+
+// roleScopes are the scopes each role grants.
+var roleScopes = map[string][]string{
+	RoleEditor: {ScopeCatalogWrite},
+	RoleAdmin:  {ScopeCatalogWrite, ScopeAdmin},
+}
+
+// RoleScopes returns the scopes granted by the role, none for customers
+// and unknown roles.
+func RoleScopes(role string) []string {
+	return append([]string(nil), roleScopes[role]...)
+}
+
+// ValidRole reports whether role is one of Roles.
+func ValidRole(role string) bool {
+	return slices.Contains(Roles, role)
+}
@@ -0,0 +1,211 @@
+package jwks
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"yinni_backend/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	defaultRefreshInterval = 5 * time.Minute
+	// minRefreshGap rate-limits the refreshes triggered by unknown kids.
+	minRefreshGap = 30 * time.Second
+	// A failed first fetch is retried after initialRetry, doubling up to
+	// the refresh interval.
+	initialRetry = time.Second
+	fetchTimeout = 10 * time.Second
+	maxJWKSBytes = 1 << 20
+)
+
+// Cache is a JWKS fetched from the auth service and refreshed
+// periodically, and early when a token names a kid it does not know yet.
+// Only its background goroutine fetches; requests never do.
+type Cache struct {
+	url    string
+	client *http.Client
+	log    *log.Helper
+
+	// wake asks the background goroutine for an early refresh.
+	wake chan struct{}
+
+	mu   sync.RWMutex
+	keys map[string]*PublicKey
+	// lastRefresh is when the last fetch started; refreshed is closed when
+	// the next one has finished.
+	lastRefresh time.Time
+	refreshed   chan struct{}
+}
+
+// NewCache fetches the JWKS at the jwks_url of c and keeps it fresh until
+// cleanup. It returns nil when no jwks_url is configured. A failed first
+// fetch is logged and retried with backoff; tokens are rejected until one
+// succeeds.
+func NewCache(c *conf.Auth, logger log.Logger) (*Cache, func(), error) {
+	if c.JwksUrl == "" {
+		return nil, func() {}, nil
+	}
+	interval := c.JwksRefreshInterval.AsDuration()
+	if interval <= 0 {
+		interval = defaultRefreshInterval
+	}
+
+	cache := newCache(c.JwksUrl, logger)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		cache.run(ctx, interval)
+	}()
+
+	cleanup := func() {
+		cancel()
+		<-done
+	}
+	return cache, cleanup, nil
+}
+
+func newCache(url string, logger log.Logger) *Cache {
+	return &Cache{
+		url:       url,
+		client:    &http.Client{Timeout: fetchTimeout},
+		log:       log.NewHelper(logger),
+		wake:      make(chan struct{}, 1),
+		keys:      make(map[string]*PublicKey),
+		refreshed: make(chan struct{}),
+	}
+}
+
+func (c *Cache) run(ctx context.Context, interval time.Duration) {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	retry := initialRetry
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		case <-c.wake:
+		}
+
+		next := interval
+		if !c.refresh(ctx) && c.empty() {
+			next, retry = retry, min(2*retry, interval)
+		}
+		timer.Reset(next)
+	}
+}
+
+// Keyfunc verifies tokens with the cached keys. It never waits: an unknown
+// kid asks for a refresh, at most once per minRefreshGap, and is rejected.
+func (c *Cache) Keyfunc(token *jwt.Token) (any, error) {
+	return c.keyfunc(nil, token)
+}
+
+// KeyfuncContext is Keyfunc for a request: an unknown kid waits for the
+// refresh it asks for until ctx is done, so keys rotated in are picked up
+// without waiting for the next periodic refresh.
+func (c *Cache) KeyfuncContext(ctx context.Context) jwt.Keyfunc {
+	return func(token *jwt.Token) (any, error) {
+		return c.keyfunc(ctx.Done(), token)
+	}
+}
+
+func (c *Cache) keyfunc(cancel <-chan struct{}, token *jwt.Token) (any, error) {
+	kid, _ := token.Header["kid"].(string)
+	k, ok := c.key(kid)
+	if !ok && kid != "" {
+		if refreshed := c.requestRefresh(); refreshed != nil && cancel != nil {
+			select {
+			case <-refreshed:
+				k, ok = c.key(kid)
+			case <-cancel:
+			}
+		}
+	}
+	if !ok {
+		return nil, ErrUnknownKey
+	}
+	return k.verificationKey(token)
+}
+
+func (c *Cache) key(kid string) (*PublicKey, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	k, ok := c.keys[kid]
+	return k, ok
+}
+
+func (c *Cache) empty() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return len(c.keys) == 0
+}
+
+// requestRefresh wakes the background goroutine and returns a channel
+// closed once it has refreshed. It returns nil when the last fetch started
+// within minRefreshGap.
+func (c *Cache) requestRefresh() <-chan struct{} {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if time.Since(c.lastRefresh) < minRefreshGap {
+		return nil
+	}
+	select {
+	case c.wake <- struct{}{}:
+	default:
+	}
+	return c.refreshed
+}
+
+// refresh refetches the JWKS and reports whether it succeeded. On failure
+// the cached keys are kept.
+func (c *Cache) refresh(ctx context.Context) bool {
+	c.mu.Lock()
+	c.lastRefresh = time.Now()
+	c.mu.Unlock()
+
+	keys, err := c.fetch(ctx)
+	if err != nil && ctx.Err() == nil {
+		c.log.Errorf("Refreshing JWKS from %s failed: %v", c.url, err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err == nil {
+		c.keys = make(map[string]*PublicKey, len(keys))
+		for _, k := range keys {
+			c.keys[k.KID] = k
+		}
+	}
+	close(c.refreshed)
+	c.refreshed = make(chan struct{})
+	return err == nil
+}
+
+func (c *Cache) fetch(ctx context.Context) ([]*PublicKey, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxJWKSBytes))
+	if err != nil {
+		return nil, err
+	}
+	return Parse(data)
+}
@@ -0,0 +1,212 @@
+package jwks
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"yinni_backend/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// jwksServer serves a JWKS that tests can swap, failing the first fail
+// requests.
+type jwksServer struct {
+	*httptest.Server
+	mu       sync.Mutex
+	body     []byte
+	fail     int
+	requests atomic.Int32
+	// block, when set, holds requests until it is closed.
+	block chan struct{}
+}
+
+func newJWKSServer(t *testing.T, keys *KeySet, fail int) *jwksServer {
+	t.Helper()
+	s := &jwksServer{body: keys.JWKS(), fail: fail}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := int(s.requests.Add(1))
+		s.mu.Lock()
+		body, block := s.body, s.block
+		s.mu.Unlock()
+		if block != nil {
+			select {
+			case <-block:
+			case <-r.Context().Done():
+				return
+			}
+		}
+		if n <= s.fail {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write(body)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *jwksServer) serve(keys *KeySet) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.body = keys.JWKS()
+}
+
+func newTestCache(t *testing.T, url string) *Cache {
+	t.Helper()
+	c, cleanup, err := NewCache(&conf.Auth{JwksUrl: url, JwksRefreshInterval: durationpb.New(time.Hour)}, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(cleanup)
+	return c
+}
+
+// allowRefresh lets the next unknown kid refresh the cache at once.
+func (c *Cache) allowRefresh() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.lastRefresh = time.Time{}
+}
+
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestCacheVerifies(t *testing.T) {
+	keys := newKeySet(t, "ed-1")
+	c := newTestCache(t, newJWKSServer(t, keys, 0).URL)
+	waitFor(t, "the first fetch", func() bool { return !c.empty() })
+
+	token, err := keys.Sign(testClaims())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := verify(token, c.Keyfunc); err != nil {
+		t.Errorf("verify: %v", err)
+	}
+}
+
+func TestCacheRetriesFirstFetch(t *testing.T) {
+	keys := newKeySet(t, "rsa-1")
+	srv := newJWKSServer(t, keys, 1)
+	c := newTestCache(t, srv.URL)
+	token, err := keys.Sign(testClaims())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	waitFor(t, "the failed first fetch", func() bool { return srv.requests.Load() >= 1 })
+	// Until the retry succeeds tokens are rejected without waiting
+	start := time.Now()
+	if err := verify(token, c.KeyfuncContext(context.Background())); err == nil {
+		t.Error("token verified before the keys were fetched")
+	}
+	if d := time.Since(start); d > 100*time.Millisecond {
+		t.Errorf("rejecting took %v", d)
+	}
+
+	waitFor(t, "the retry", func() bool { return verify(token, c.Keyfunc) == nil })
+	if n := srv.requests.Load(); n != 2 {
+		t.Errorf("fetched %d times, want 2", n)
+	}
+}
+
+func TestCacheRefreshesOnUnknownKid(t *testing.T) {
+	srv := newJWKSServer(t, newKeySet(t, "rsa-1"), 0)
+	c := newTestCache(t, srv.URL)
+	waitFor(t, "the first fetch", func() bool { return !c.empty() })
+
+	// A key rotated in after the first fetch
+	rotated, err := NewKeySet(&conf.Auth{SigningKeys: []*conf.Auth_SigningKey{{Kid: "ed-2", PrivateKey: pemKey(mustEd25519(t))}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv.serve(rotated)
+	token, err := rotated.Sign(testClaims())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Right after a fetch, unknown kids are rejected without fetching again
+	if err := verify(token, c.KeyfuncContext(context.Background())); !errors.Is(err, ErrUnknownKey) {
+		t.Errorf("err = %v, want ErrUnknownKey", err)
+	}
+	if n := srv.requests.Load(); n != 1 {
+		t.Errorf("fetched %d times, want 1", n)
+	}
+
+	c.allowRefresh()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := verify(token, c.KeyfuncContext(ctx)); err != nil {
+		t.Errorf("token of the rotated key: %v", err)
+	}
+	if n := srv.requests.Load(); n != 2 {
+		t.Errorf("fetched %d times, want 2", n)
+	}
+}
+
+func TestCacheUnknownKidDoesNotBlock(t *testing.T) {
+	keys := newKeySet(t, "rsa-1")
+	srv := newJWKSServer(t, keys, 0)
+	c := newTestCache(t, srv.URL)
+	waitFor(t, "the first fetch", func() bool { return !c.empty() })
+
+	// The auth service hangs
+	block := make(chan struct{})
+	defer close(block)
+	srv.mu.Lock()
+	srv.block = block
+	srv.mu.Unlock()
+
+	other, err := NewKeySet(&conf.Auth{SigningKeys: []*conf.Auth_SigningKey{{Kid: "ed-2", PrivateKey: pemKey(mustEd25519(t))}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	unknown, err := other.Sign(testClaims())
+	if err != nil {
+		t.Fatal(err)
+	}
+	known, err := keys.Sign(testClaims())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c.allowRefresh()
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if err := verify(unknown, c.KeyfuncContext(ctx)); !errors.Is(err, ErrUnknownKey) {
+		t.Errorf("err = %v, want ErrUnknownKey", err)
+	}
+	if d := time.Since(start); d > time.Second {
+		t.Errorf("unknown kid waited %v past its request deadline", d)
+	}
+
+	// Other requests are served from the cache meanwhile
+	start = time.Now()
+	if err := verify(known, c.KeyfuncContext(context.Background())); err != nil {
+		t.Errorf("known kid: %v", err)
+	}
+	if err := verify(unknown, c.Keyfunc); !errors.Is(err, ErrUnknownKey) {
+		t.Errorf("err = %v, want ErrUnknownKey", err)
+	}
+	if d := time.Since(start); d > 100*time.Millisecond {
+		t.Errorf("requests waited %v for the hanging refresh", d)
+	}
+}
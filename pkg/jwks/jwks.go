@@ -0,0 +1,142 @@
+// Package jwks signs tokens with asymmetric keys and verifies them against
+// a JSON Web Key Set, the public half of those keys published by the auth
+// service.
+package jwks
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/wire"
+)
+
+// ProviderSet is jwks providers.
+var ProviderSet = wire.NewSet(NewKeySet, NewCache)
+
+// Path is where the auth service publishes its JWKS.
+const Path = "/.well-known/jwks.json"
+
+// ErrUnknownKey is returned for tokens whose kid is not in the key set.
+var ErrUnknownKey = errors.New("unknown signing key")
+
+// Algorithms are the signing algorithms of the key sets.
+var Algorithms = []string{jwt.SigningMethodRS256.Alg(), jwt.SigningMethodEdDSA.Alg()}
+
+// Verifier resolves the public key verifying a token. The key's algorithm
+// is pinned: a token naming another algorithm is rejected, so a public key
+// can never be used as an HMAC secret.
+type Verifier interface {
+	Keyfunc(token *jwt.Token) (any, error)
+}
+
+// PublicKey is a verification key and the algorithm it is used with.
+type PublicKey struct {
+	KID    string
+	Method jwt.SigningMethod
+	Key    any
+}
+
+// jwk is a JSON Web Key of an RSA or Ed25519 public key (RFC 7517, 8037).
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use,omitempty"`
+	Alg string `json:"alg,omitempty"`
+	// RSA
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+	// OKP
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+type jwkSet struct {
+	Keys []*jwk `json:"keys"`
+}
+
+// Marshal encodes the keys as a JWKS document.
+func Marshal(keys []*PublicKey) ([]byte, error) {
+	set := jwkSet{Keys: make([]*jwk, 0, len(keys))}
+	for _, k := range keys {
+		j := &jwk{Kid: k.KID, Use: "sig", Alg: k.Method.Alg()}
+		switch pub := k.Key.(type) {
+		case *rsa.PublicKey:
+			j.Kty = "RSA"
+			j.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+			j.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+		case ed25519.PublicKey:
+			j.Kty = "OKP"
+			j.Crv = "Ed25519"
+			j.X = base64.RawURLEncoding.EncodeToString(pub)
+		default:
+			return nil, fmt.Errorf("key %s: unsupported key type %T", k.KID, k.Key)
+		}
+		set.Keys = append(set.Keys, j)
+	}
+	return json.Marshal(set)
+}
+
+// Parse decodes a JWKS document. Keys that are not RSA or Ed25519 signing
+// keys are skipped.
+func Parse(data []byte) ([]*PublicKey, error) {
+	var set jwkSet
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("decode jwks: %w", err)
+	}
+
+	keys := make([]*PublicKey, 0, len(set.Keys))
+	for _, j := range set.Keys {
+		if j.Kid == "" || (j.Use != "" && j.Use != "sig") {
+			continue
+		}
+		k, err := j.publicKey()
+		if err != nil {
+			return nil, fmt.Errorf("key %s: %w", j.Kid, err)
+		}
+		if k != nil {
+			keys = append(keys, k)
+		}
+	}
+	return keys, nil
+}
+
+func (j *jwk) publicKey() (*PublicKey, error) {
+	switch {
+	case j.Kty == "RSA" && (j.Alg == "" || j.Alg == jwt.SigningMethodRS256.Alg()):
+		n, err := base64.RawURLEncoding.DecodeString(j.N)
+		if err != nil {
+			return nil, fmt.Errorf("bad modulus: %w", err)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(j.E)
+		if err != nil || len(e) == 0 || len(e) > 4 {
+			return nil, errors.New("bad exponent")
+		}
+		pub := &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+		return &PublicKey{KID: j.Kid, Method: jwt.SigningMethodRS256, Key: pub}, nil
+	case j.Kty == "OKP" && j.Crv == "Ed25519" && (j.Alg == "" || j.Alg == jwt.SigningMethodEdDSA.Alg()):
+		x, err := base64.RawURLEncoding.DecodeString(j.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("bad Ed25519 key")
+		}
+		return &PublicKey{KID: j.Kid, Method: jwt.SigningMethodEdDSA, Key: ed25519.PublicKey(x)}, nil
+	}
+	return nil, nil
+}
+
+// verificationKey returns the key verifying the token, checking the token
+// is signed with the key's algorithm.
+func (k *PublicKey) verificationKey(token *jwt.Token) (any, error) {
+	if token.Method == nil || token.Method.Alg() != k.Method.Alg() {
+		return nil, fmt.Errorf("key %s signs with %s", k.KID, k.Method.Alg())
+	}
+	return k.Key, nil
+}
@@ -0,0 +1,81 @@
+package jwks
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/json"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func TestMarshalParse(t *testing.T) {
+	r, e := mustRSA(t), mustEd25519(t)
+	keys := []*PublicKey{
+		{KID: "rsa-1", Method: jwt.SigningMethodRS256, Key: &r.PublicKey},
+		{KID: "ed-1", Method: jwt.SigningMethodEdDSA, Key: e.Public()},
+	}
+
+	data, err := Marshal(keys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := Parse(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(parsed) != 2 {
+		t.Fatalf("parsed %d keys, want 2", len(parsed))
+	}
+	if k := parsed[0]; k.KID != "rsa-1" || k.Method.Alg() != "RS256" || !r.PublicKey.Equal(k.Key.(*rsa.PublicKey)) {
+		t.Errorf("RSA key parsed as %+v", k)
+	}
+	if k := parsed[1]; k.KID != "ed-1" || k.Method.Alg() != "EdDSA" || !e.Public().(ed25519.PublicKey).Equal(k.Key) {
+		t.Errorf("Ed25519 key parsed as %+v", k)
+	}
+
+	// No private key material is published
+	var raw map[string][]map[string]any
+	if err := json.Unmarshal(data, &raw); err != nil {
+		t.Fatal(err)
+	}
+	for _, k := range raw["keys"] {
+		for _, private := range []string{"d", "p", "q", "dp", "dq", "qi"} {
+			if _, ok := k[private]; ok {
+				t.Errorf("key %v publishes %q", k["kid"], private)
+			}
+		}
+	}
+}
+
+func TestParseSkipsKeysItCannotUse(t *testing.T) {
+	data := []byte(`{"keys":[
+		{"kty":"EC","kid":"ec","crv":"P-256","x":"AA","y":"AA"},
+		{"kty":"RSA","kid":"enc","use":"enc","n":"AQAB","e":"AQAB"},
+		{"kty":"RSA","kid":"ps","alg":"PS256","n":"AQAB","e":"AQAB"},
+		{"kty":"OKP","kid":"x","crv":"X25519","x":"AA"},
+		{"kty":"RSA","n":"AQAB","e":"AQAB"},
+		{"kty":"RSA","kid":"ok","n":"AQAB","e":"AQAB"}
+	]}`)
+	keys, err := Parse(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 1 || keys[0].KID != "ok" {
+		t.Errorf("parsed %d keys, want only the RS256 signing key", len(keys))
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := map[string]string{
+		"not JSON":          `keys`,
+		"bad modulus":       `{"keys":[{"kty":"RSA","kid":"a","n":"!!","e":"AQAB"}]}`,
+		"bad exponent":      `{"keys":[{"kty":"RSA","kid":"a","n":"AQAB","e":""}]}`,
+		"short Ed25519 key": `{"keys":[{"kty":"OKP","kid":"a","crv":"Ed25519","x":"AAAA"}]}`,
+	}
+	for name, data := range tests {
+		if _, err := Parse([]byte(data)); err == nil {
+			t.Errorf("%s: no error", name)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package jwks
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+
+	"yinni_backend/internal/conf"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// minRSABits is the smallest RSA key accepted for signing.
+const minRSABits = 2048
+
+// signingKey is a private key of a KeySet.
+type signingKey struct {
+	PublicKey
+	private crypto.Signer
+}
+
+// KeySet holds the signing keys of the auth service. One of them signs new
+// tokens; all of them verify tokens and are published in the JWKS, so keys
+// can be rotated without invalidating the tokens already issued.
+type KeySet struct {
+	keys   map[string]*signingKey
+	active *signingKey
+	jwks   []byte
+}
+
+// NewKeySet loads the signing keys of c. It returns nil when none are
+// configured.
+func NewKeySet(c *conf.Auth) (*KeySet, error) {
+	if len(c.SigningKeys) == 0 {
+		return nil, nil
+	}
+
+	s := &KeySet{keys: make(map[string]*signingKey, len(c.SigningKeys))}
+	public := make([]*PublicKey, 0, len(c.SigningKeys))
+	for _, kc := range c.SigningKeys {
+		k, err := loadSigningKey(kc)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := s.keys[k.KID]; ok {
+			return nil, fmt.Errorf("signing key %s: duplicate kid", k.KID)
+		}
+		s.keys[k.KID] = k
+		public = append(public, &k.PublicKey)
+		if s.active == nil || k.KID == c.SigningKid {
+			s.active = k
+		}
+	}
+	if c.SigningKid != "" && s.active.KID != c.SigningKid {
+		return nil, fmt.Errorf("signing_kid %s is not a signing key", c.SigningKid)
+	}
+
+	jwks, err := Marshal(public)
+	if err != nil {
+		return nil, err
+	}
+	s.jwks = jwks
+	return s, nil
+}
+
+func loadSigningKey(c *conf.Auth_SigningKey) (*signingKey, error) {
+	if c.Kid == "" {
+		return nil, errors.New("signing key without kid")
+	}
+	data := []byte(c.PrivateKey)
+	if c.PrivateKeyFile != "" {
+		var err error
+		if data, err = os.ReadFile(c.PrivateKeyFile); err != nil {
+			return nil, fmt.Errorf("signing key %s: %w", c.Kid, err)
+		}
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("signing key %s: no PEM block", c.Kid)
+	}
+
+	var parsed any
+	var err error
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	default:
+		err = fmt.Errorf("unsupported PEM block %q", block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("signing key %s: %w", c.Kid, err)
+	}
+
+	k := &signingKey{PublicKey: PublicKey{KID: c.Kid}}
+	switch priv := parsed.(type) {
+	case *rsa.PrivateKey:
+		if priv.N.BitLen() < minRSABits {
+			return nil, fmt.Errorf("signing key %s: RSA keys need at least %d bits", c.Kid, minRSABits)
+		}
+		k.Method, k.Key, k.private = jwt.SigningMethodRS256, &priv.PublicKey, priv
+	case ed25519.PrivateKey:
+		k.Method, k.Key, k.private = jwt.SigningMethodEdDSA, priv.Public(), priv
+	default:
+		return nil, fmt.Errorf("signing key %s: unsupported key type %T", c.Kid, parsed)
+	}
+	return k, nil
+}
+
+// Sign signs the claims with the active key, naming it in the kid header.
+func (s *KeySet) Sign(claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(s.active.Method, claims)
+	token.Header["kid"] = s.active.KID
+	return token.SignedString(s.active.private)
+}
+
+// Keyfunc verifies tokens with the public keys of the set.
+func (s *KeySet) Keyfunc(token *jwt.Token) (any, error) {
+	kid, _ := token.Header["kid"].(string)
+	k, ok := s.keys[kid]
+	if !ok {
+		return nil, ErrUnknownKey
+	}
+	return k.verificationKey(token)
+}
+
+// JWKS returns the JWKS document of the public keys.
+func (s *KeySet) JWKS() []byte {
+	return s.jwks
+}
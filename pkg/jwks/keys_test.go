@@ -0,0 +1,210 @@
+package jwks
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"testing"
+	"time"
+
+	"yinni_backend/internal/conf"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// rsaKey and edKey are PKCS#8 PEM private keys, generated once.
+var rsaKey, edKey = func() (string, string) {
+	r, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		panic(err)
+	}
+	_, e, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+	return pemKey(r), pemKey(e)
+}()
+
+func pemKey(key any) string {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		panic(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+}
+
+func newKeySet(t *testing.T, active string) *KeySet {
+	t.Helper()
+	s, err := NewKeySet(&conf.Auth{
+		SigningKeys: []*conf.Auth_SigningKey{
+			{Kid: "rsa-1", PrivateKey: rsaKey},
+			{Kid: "ed-1", PrivateKey: edKey},
+		},
+		SigningKid: active,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func testClaims() *jwt.RegisteredClaims {
+	return &jwt.RegisteredClaims{
+		Subject:   "42",
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}
+}
+
+func verify(token string, keyfunc jwt.Keyfunc) error {
+	_, err := jwt.ParseWithClaims(token, &jwt.RegisteredClaims{}, keyfunc, jwt.WithValidMethods(Algorithms))
+	return err
+}
+
+func TestKeySetSignAndVerify(t *testing.T) {
+	for _, tt := range []struct{ kid, alg string }{{"rsa-1", "RS256"}, {"ed-1", "EdDSA"}} {
+		t.Run(tt.kid, func(t *testing.T) {
+			s := newKeySet(t, tt.kid)
+			token, err := s.Sign(testClaims())
+			if err != nil {
+				t.Fatal(err)
+			}
+			parsed, _, err := jwt.NewParser().ParseUnverified(token, &jwt.RegisteredClaims{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if parsed.Header["kid"] != tt.kid || parsed.Method.Alg() != tt.alg {
+				t.Errorf("signed with kid %v and %s, want %s and %s", parsed.Header["kid"], parsed.Method.Alg(), tt.kid, tt.alg)
+			}
+			if err := verify(token, s.Keyfunc); err != nil {
+				t.Errorf("verify: %v", err)
+			}
+		})
+	}
+}
+
+func TestKeySetVerifiesRetiredKeys(t *testing.T) {
+	// Tokens of the previous key verify after rotating to another
+	old, err := newKeySet(t, "rsa-1").Sign(testClaims())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := verify(old, newKeySet(t, "ed-1").Keyfunc); err != nil {
+		t.Errorf("token of the retired key: %v", err)
+	}
+}
+
+func TestKeySetRejectsForeignTokens(t *testing.T) {
+	s := newKeySet(t, "rsa-1")
+
+	// A key of the same kid that is not in the set
+	other, err := NewKeySet(&conf.Auth{SigningKeys: []*conf.Auth_SigningKey{{Kid: "rsa-1", PrivateKey: pemKey(mustRSA(t))}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	forged, err := other.Sign(testClaims())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := verify(forged, s.Keyfunc); err == nil {
+		t.Error("token signed by another key verified")
+	}
+
+	unknown := jwt.NewWithClaims(jwt.SigningMethodEdDSA, testClaims())
+	unknown.Header["kid"] = "ed-2"
+	token, err := unknown.SignedString(mustEd25519(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := verify(token, s.Keyfunc); !errors.Is(err, ErrUnknownKey) {
+		t.Errorf("unknown kid: err = %v, want ErrUnknownKey", err)
+	}
+}
+
+// TestKeySetPinsAlgorithms checks a token cannot choose how its key is
+// used, e.g. the public RSA key as an HMAC secret.
+func TestKeySetPinsAlgorithms(t *testing.T) {
+	s := newKeySet(t, "rsa-1")
+	var public *PublicKey
+	keys, err := Parse(s.JWKS())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, k := range keys {
+		if k.KID == "rsa-1" {
+			public = k
+		}
+	}
+	der, err := x509.MarshalPKIXPublicKey(public.Key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hmac := jwt.NewWithClaims(jwt.SigningMethodHS256, testClaims())
+	hmac.Header["kid"] = "rsa-1"
+	token, err := hmac.SignedString(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := verify(token, s.Keyfunc); err == nil {
+		t.Error("HS256 token verified with the RSA public key")
+	}
+	// Even when the parser allowed HS256
+	if _, err := jwt.Parse(token, s.Keyfunc); err == nil {
+		t.Error("HS256 token verified with the RSA public key")
+	}
+
+	// The Ed25519 key does not verify RS256 tokens either
+	rs := jwt.NewWithClaims(jwt.SigningMethodRS256, testClaims())
+	rs.Header["kid"] = "ed-1"
+	token, err = rs.SignedString(mustRSA(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := verify(token, s.Keyfunc); err == nil {
+		t.Error("RS256 token verified with the Ed25519 key")
+	}
+}
+
+func TestNewKeySetErrors(t *testing.T) {
+	small, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := map[string]*conf.Auth{
+		"no kid":        {SigningKeys: []*conf.Auth_SigningKey{{PrivateKey: rsaKey}}},
+		"duplicate kid": {SigningKeys: []*conf.Auth_SigningKey{{Kid: "a", PrivateKey: rsaKey}, {Kid: "a", PrivateKey: edKey}}},
+		"not PEM":       {SigningKeys: []*conf.Auth_SigningKey{{Kid: "a", PrivateKey: "secret"}}},
+		"small RSA key": {SigningKeys: []*conf.Auth_SigningKey{{Kid: "a", PrivateKey: pemKey(small)}}},
+		"unknown kid":   {SigningKeys: []*conf.Auth_SigningKey{{Kid: "a", PrivateKey: rsaKey}}, SigningKid: "b"},
+	}
+	for name, c := range tests {
+		if _, err := NewKeySet(c); err == nil {
+			t.Errorf("%s: no error", name)
+		}
+	}
+
+	if s, err := NewKeySet(&conf.Auth{}); s != nil || err != nil {
+		t.Errorf("without keys: got %v, %v, want nil", s, err)
+	}
+}
+
+func mustRSA(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+	k, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return k
+}
+
+func mustEd25519(t *testing.T) ed25519.PrivateKey {
+	t.Helper()
+	_, k, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return k
+}
@@ -3,19 +3,151 @@ package middleware
 import (
 	"context"
 	"errors"
+	"slices"
 	"strings"
+	"time"
 
+	"yinni_backend/pkg/auth"
+	"yinni_backend/pkg/jwks"
+	"yinni_backend/pkg/revocation"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/middleware"
 	"github.com/go-kratos/kratos/v2/transport"
 	"github.com/golang-jwt/jwt/v5"
 )
 
 type Claims struct {
-	UserID int64 `json:"user_id"`
+	UserID int64    `json:"user_id"`
+	Roles  []string `json:"roles,omitempty"`
+	Scopes []string `json:"scopes,omitempty"`
 	jwt.RegisteredClaims
 }
 
-func JWT(secret string) middleware.Middleware {
+// HasScope reports whether the token grants the scope.
+func (c *Claims) HasScope(scope string) bool {
+	return slices.Contains(c.Scopes, scope)
+}
+
+// Option configures the JWT middleware.
+type Option func(*options)
+
+type options struct {
+	store  revocation.Store
+	keys   *jwks.Cache
+	keySet *jwks.KeySet
+	public map[string]bool
+	scopes map[string][]string
+}
+
+// WithPublic lets anonymous requests through to the operations. A token
+// sent to them is still verified, so handlers see the principal of a valid
+// one, but a missing or invalid token is not an error.
+func WithPublic(operations ...string) Option {
+	return func(o *options) {
+		for _, op := range operations {
+			o.public[op] = true
+		}
+	}
+}
+
+// WithScope requires tokens calling the operations to grant the scope.
+// Operations given several scopes require all of them.
+func WithScope(scope string, operations ...string) Option {
+	return func(o *options) {
+		for _, op := range operations {
+			o.scopes[op] = append(o.scopes[op], scope)
+		}
+	}
+}
+
+// WithJWKS verifies tokens with the keys of the auth service's JWKS instead
+// of the shared secret. A nil cache keeps the shared secret.
+func WithJWKS(keys *jwks.Cache) Option {
+	return func(o *options) {
+		o.keys = keys
+	}
+}
+
+// WithKeySet verifies tokens with the public keys of a local key set, for
+// the auth service checking the tokens it signed itself. A nil set keeps
+// the shared secret.
+func WithKeySet(keys *jwks.KeySet) Option {
+	return func(o *options) {
+		o.keySet = keys
+	}
+}
+
+// WithRevocationStore rejects tokens revoked in store. Tokens without a jti
+// cannot be revoked, so they are rejected as well.
+func WithRevocationStore(store revocation.Store) Option {
+	return func(o *options) {
+		o.store = store
+	}
+}
+
+func JWT(secret string, opts ...Option) middleware.Middleware {
+	o := &options{public: make(map[string]bool), scopes: make(map[string][]string)}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	// The signing method is pinned, so a token cannot pick the algorithm
+	// its key is used with.
+	secretKeyfunc := func(token *jwt.Token) (interface{}, error) {
+		return []byte(secret), nil
+	}
+	methods := []string{jwt.SigningMethodHS256.Alg()}
+	if o.keys != nil || o.keySet != nil {
+		methods = jwks.Algorithms
+	}
+
+	// authenticate verifies the bearer token of the authorization header.
+	authenticate := func(ctx context.Context, authorization string) (*Claims, error) {
+		if authorization == "" {
+			return nil, errors.New("missing authorization header")
+		}
+
+		tokenStr := strings.TrimPrefix(authorization, "Bearer ")
+
+		keyfunc := secretKeyfunc
+		switch {
+		case o.keySet != nil:
+			keyfunc = o.keySet.Keyfunc
+		case o.keys != nil:
+			keyfunc = o.keys.KeyfuncContext(ctx)
+		}
+
+		token, err := jwt.ParseWithClaims(
+			tokenStr,
+			&Claims{},
+			keyfunc,
+			jwt.WithValidMethods(methods),
+		)
+		if err != nil || !token.Valid {
+			return nil, errors.New("invalid token")
+		}
+
+		claims := token.Claims.(*Claims)
+		if o.store != nil {
+			if claims.ID == "" {
+				return nil, errors.New("invalid token")
+			}
+			var issuedAt time.Time
+			if claims.IssuedAt != nil {
+				issuedAt = claims.IssuedAt.Time
+			}
+			revoked, err := o.store.Revoked(ctx, claims.ID, claims.UserID, issuedAt)
+			if err != nil {
+				return nil, errors.New("token revocation check failed")
+			}
+			if revoked {
+				return nil, errors.New("token revoked")
+			}
+		}
+		return claims, nil
+	}
+
 	return func(handler middleware.Handler) middleware.Handler {
 		return func(ctx context.Context, req interface{}) (interface{}, error) {
 			tr, ok := transport.FromServerContext(ctx)
@@ -23,27 +155,36 @@ func JWT(secret string) middleware.Middleware {
 				return nil, errors.New("missing transport context")
 			}
 
-			auth := tr.RequestHeader().Get("Authorization")
-			if auth == "" {
-				return nil, errors.New("missing authorization header")
+			// A principal restored by an earlier middleware, e.g. auth.Server
+			// from a trusted service, stands in for the token
+			p, ok := auth.FromContext(ctx)
+			if !ok {
+				claims, err := authenticate(ctx, tr.RequestHeader().Get("Authorization"))
+				if err != nil {
+					if o.public[tr.Operation()] {
+						return handler(ctx, req)
+					}
+					return nil, err
+				}
+				var expiresAt time.Time
+				if claims.ExpiresAt != nil {
+					expiresAt = claims.ExpiresAt.Time
+				}
+				p = &auth.Principal{
+					UserID:    claims.UserID,
+					Roles:     claims.Roles,
+					Scopes:    claims.Scopes,
+					TokenID:   claims.ID,
+					ExpiresAt: expiresAt,
+				}
+				ctx = auth.NewContext(ctx, p)
 			}
-
-			tokenStr := strings.TrimPrefix(auth, "Bearer ")
-
-			token, err := jwt.ParseWithClaims(
-				tokenStr,
-				&Claims{},
-				func(token *jwt.Token) (interface{}, error) {
-					return []byte(secret), nil
-				},
-			)
-			if err != nil || !token.Valid {
-				return nil, errors.New("invalid token")
+			for _, scope := range o.scopes[tr.Operation()] {
+				if !p.HasScope(scope) {
+					return nil, kerrors.Forbidden("INSUFFICIENT_SCOPE", "token lacks the "+scope+" scope")
+				}
 			}
 
-			claims := token.Claims.(*Claims)
-			ctx = context.WithValue(ctx, "user_id", claims.UserID)
-
 			return handler(ctx, req)
 		}
 	}
@@ -0,0 +1,280 @@
+package middleware
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"net/http"
+	"testing"
+	"time"
+
+	"yinni_backend/internal/conf"
+	"yinni_backend/pkg/auth"
+	"yinni_backend/pkg/jwks"
+	"yinni_backend/pkg/revocation"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const testSecret = "test-secret"
+
+type headerCarrier http.Header
+
+func (h headerCarrier) Get(key string) string      { return http.Header(h).Get(key) }
+func (h headerCarrier) Set(key, value string)      { http.Header(h).Set(key, value) }
+func (h headerCarrier) Add(key, value string)      { http.Header(h).Add(key, value) }
+func (h headerCarrier) Values(key string) []string { return http.Header(h).Values(key) }
+func (h headerCarrier) Keys() []string {
+	keys := make([]string, 0, len(h))
+	for k := range h {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// testTransport is a server transport calling operation.
+type testTransport struct {
+	operation string
+	header    headerCarrier
+}
+
+func (t *testTransport) Kind() transport.Kind            { return transport.KindHTTP }
+func (t *testTransport) Endpoint() string                { return "" }
+func (t *testTransport) Operation() string               { return t.operation }
+func (t *testTransport) RequestHeader() transport.Header { return t.header }
+func (t *testTransport) ReplyHeader() transport.Header   { return headerCarrier{} }
+
+func signToken(t *testing.T, secret string, method jwt.SigningMethod, claims *Claims) string {
+	t.Helper()
+	if claims.ID == "" {
+		claims.ID = "jti-" + time.Now().Format(time.RFC3339Nano)
+	}
+	if claims.ExpiresAt == nil {
+		claims.ExpiresAt = jwt.NewNumericDate(time.Now().Add(time.Hour))
+	}
+	if claims.IssuedAt == nil {
+		claims.IssuedAt = jwt.NewNumericDate(time.Now())
+	}
+	token, err := jwt.NewWithClaims(method, claims).SignedString([]byte(secret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+// call runs the middleware for a call of operation with the token and
+// returns the principal the handler saw.
+func call(t *testing.T, opts []Option, operation, token string) (*auth.Principal, error) {
+	t.Helper()
+	tr := &testTransport{operation: operation, header: headerCarrier{}}
+	if token != "" {
+		tr.header.Set("Authorization", "Bearer "+token)
+	}
+	var principal *auth.Principal
+	handler := JWT(testSecret, opts...)(func(ctx context.Context, req any) (any, error) {
+		principal, _ = auth.FromContext(ctx)
+		return nil, nil
+	})
+	_, err := handler(transport.NewServerContext(context.Background(), tr), nil)
+	return principal, err
+}
+
+const (
+	opPublic = "/test.v1.Service/Browse"
+	opWrite  = "/test.v1.Service/Write"
+	opAdmin  = "/test.v1.Service/Admin"
+	opOther  = "/test.v1.Service/Profile"
+)
+
+var testOptions = []Option{
+	WithPublic(opPublic),
+	WithScope(auth.ScopeCatalogWrite, opWrite, opAdmin),
+	WithScope(auth.ScopeAdmin, opAdmin),
+}
+
+func roleToken(t *testing.T, role string) string {
+	return signToken(t, testSecret, jwt.SigningMethodHS256, &Claims{
+		UserID: 7,
+		Roles:  []string{role},
+		Scopes: auth.RoleScopes(role),
+	})
+}
+
+func TestJWTScopes(t *testing.T) {
+	tests := []struct {
+		role      string
+		operation string
+		allowed   bool
+	}{
+		{auth.RoleCustomer, opOther, true},
+		{auth.RoleCustomer, opWrite, false},
+		{auth.RoleCustomer, opAdmin, false},
+		{auth.RoleEditor, opWrite, true},
+		// Operations given several scopes need all of them
+		{auth.RoleEditor, opAdmin, false},
+		{auth.RoleAdmin, opWrite, true},
+		{auth.RoleAdmin, opAdmin, true},
+	}
+	for _, tt := range tests {
+		p, err := call(t, testOptions, tt.operation, roleToken(t, tt.role))
+		if !tt.allowed {
+			if !kerrors.IsForbidden(err) {
+				t.Errorf("%s calling %s: err = %v, want forbidden", tt.role, tt.operation, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s calling %s: %v", tt.role, tt.operation, err)
+			continue
+		}
+		if p == nil || p.UserID != 7 || !p.HasRole(tt.role) {
+			t.Errorf("%s calling %s: principal %+v", tt.role, tt.operation, p)
+		}
+		for _, scope := range auth.RoleScopes(tt.role) {
+			if !p.HasScope(scope) {
+				t.Errorf("%s calling %s: principal lacks %s", tt.role, tt.operation, scope)
+			}
+		}
+	}
+}
+
+func TestJWTRejectsBadTokens(t *testing.T) {
+	valid := &Claims{UserID: 7}
+	expired := &Claims{UserID: 7, RegisteredClaims: jwt.RegisteredClaims{ExpiresAt: jwt.NewNumericDate(time.Now().Add(-time.Minute))}}
+	tests := map[string]string{
+		"no token":          "",
+		"garbage":           "not-a-token",
+		"other secret":      signToken(t, "other-secret", jwt.SigningMethodHS256, valid),
+		"other HMAC method": signToken(t, testSecret, jwt.SigningMethodHS512, &Claims{UserID: 7}),
+		"expired":           signToken(t, testSecret, jwt.SigningMethodHS256, expired),
+	}
+	for name, token := range tests {
+		if _, err := call(t, testOptions, opOther, token); err == nil {
+			t.Errorf("%s: call allowed", name)
+		}
+	}
+}
+
+func TestJWTPublicOperations(t *testing.T) {
+	// Anonymous callers and callers whose token fails get through
+	for _, token := range []string{"", "not-a-token"} {
+		p, err := call(t, testOptions, opPublic, token)
+		if err != nil {
+			t.Errorf("token %q: %v", token, err)
+		}
+		if p != nil {
+			t.Errorf("token %q: principal %+v, want anonymous", token, p)
+		}
+	}
+
+	// A valid token is still parsed
+	p, err := call(t, testOptions, opPublic, roleToken(t, auth.RoleEditor))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p == nil || p.UserID != 7 || !p.HasScope(auth.ScopeCatalogWrite) {
+		t.Errorf("principal %+v, want user 7 with catalog:write", p)
+	}
+}
+
+func TestJWTRevocation(t *testing.T) {
+	store := revocation.NewMemoryStore()
+	opts := append([]Option{WithRevocationStore(store)}, testOptions...)
+	ctx := context.Background()
+
+	revoked := signToken(t, testSecret, jwt.SigningMethodHS256, &Claims{UserID: 7, RegisteredClaims: jwt.RegisteredClaims{ID: "revoked"}})
+	if err := store.Revoke(ctx, "revoked", time.Now().Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := call(t, opts, opOther, revoked); err == nil {
+		t.Error("revoked token allowed")
+	}
+	// On public operations a revoked token leaves the caller anonymous
+	if p, err := call(t, opts, opPublic, revoked); err != nil || p != nil {
+		t.Errorf("public operation: principal %+v, err %v, want anonymous", p, err)
+	}
+
+	// Tokens without a jti cannot be revoked, so they are not accepted
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &Claims{UserID: 7}).SignedString([]byte(testSecret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := call(t, opts, opOther, token); err == nil {
+		t.Error("token without jti allowed")
+	}
+
+	// Tokens issued before a user's watermark are revoked
+	old := signToken(t, testSecret, jwt.SigningMethodHS256, &Claims{UserID: 8, RegisteredClaims: jwt.RegisteredClaims{
+		IssuedAt: jwt.NewNumericDate(time.Now().Add(-time.Minute)),
+	}})
+	if err := store.RevokeIssuedBefore(ctx, 8, time.Now(), time.Now().Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := call(t, opts, opOther, old); err == nil {
+		t.Error("token issued before the watermark allowed")
+	}
+	if _, err := call(t, opts, opOther, roleToken(t, auth.RoleCustomer)); err != nil {
+		t.Errorf("token of another user: %v", err)
+	}
+}
+
+func TestJWTKeySet(t *testing.T) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys, err := jwks.NewKeySet(&conf.Auth{SigningKeys: []*conf.Auth_SigningKey{
+		{Kid: "ed-1", PrivateKey: string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts := append([]Option{WithKeySet(keys)}, testOptions...)
+
+	token, err := keys.Sign(&Claims{UserID: 7, RegisteredClaims: jwt.RegisteredClaims{
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p, err := call(t, opts, opOther, token); err != nil || p == nil || p.UserID != 7 {
+		t.Errorf("token of the key set: principal %+v, err %v", p, err)
+	}
+	// The shared secret no longer verifies tokens
+	if _, err := call(t, opts, opOther, roleToken(t, auth.RoleCustomer)); err == nil {
+		t.Error("HS256 token allowed with a key set")
+	}
+}
+
+func TestJWTTrustsRestoredPrincipal(t *testing.T) {
+	// A principal auth.Server restored from a peer service needs no token,
+	// but its scopes are still checked
+	run := func(p *auth.Principal, operation string) (*auth.Principal, error) {
+		tr := &testTransport{operation: operation, header: headerCarrier{}}
+		var got *auth.Principal
+		handler := JWT(testSecret, testOptions...)(func(ctx context.Context, req any) (any, error) {
+			got, _ = auth.FromContext(ctx)
+			return nil, nil
+		})
+		ctx := auth.NewContext(transport.NewServerContext(context.Background(), tr), p)
+		_, err := handler(ctx, nil)
+		return got, err
+	}
+
+	admin := &auth.Principal{UserID: 7, Roles: []string{auth.RoleAdmin}, Scopes: auth.RoleScopes(auth.RoleAdmin)}
+	if got, err := run(admin, opAdmin); err != nil || got != admin {
+		t.Errorf("admin principal: got %+v, err %v", got, err)
+	}
+	customer := &auth.Principal{UserID: 8, Roles: []string{auth.RoleCustomer}}
+	if _, err := run(customer, opAdmin); !kerrors.IsForbidden(err) {
+		t.Errorf("customer principal: err = %v, want forbidden", err)
+	}
+}
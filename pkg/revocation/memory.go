@@ -0,0 +1,94 @@
+package revocation
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// sweepInterval is how often the memory store drops expired entries.
+const sweepInterval = time.Minute
+
+type memoryWatermark struct {
+	before int64
+	until  time.Time
+}
+
+// MemoryStore is a Store in process memory.
+type MemoryStore struct {
+	mu         sync.Mutex
+	tokens     map[string]time.Time
+	watermarks map[int64]memoryWatermark
+	lastSweep  time.Time
+}
+
+// NewMemoryStore creates an empty memory store.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		tokens:     make(map[string]time.Time),
+		watermarks: make(map[int64]memoryWatermark),
+		lastSweep:  time.Now(),
+	}
+}
+
+func (s *MemoryStore) Revoke(_ context.Context, jti string, until time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sweep()
+	if until.After(s.tokens[jti]) {
+		s.tokens[jti] = until
+	}
+	return nil
+}
+
+func (s *MemoryStore) RevokeIssuedBefore(_ context.Context, userID int64, before, until time.Time) error {
+	if !until.After(time.Now()) {
+		return ErrNoLifetime
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sweep()
+	w := s.watermarks[userID]
+	w.before = max(w.before, watermark(before))
+	if until.After(w.until) {
+		w.until = until
+	}
+	s.watermarks[userID] = w
+	return nil
+}
+
+func (s *MemoryStore) Revoked(_ context.Context, jti string, userID int64, issuedAt time.Time) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if until, ok := s.tokens[jti]; ok && now.Before(until) {
+		return true, nil
+	}
+	if w, ok := s.watermarks[userID]; ok && now.Before(w.until) && issuedAt.Unix() < w.before {
+		return true, nil
+	}
+	return false, nil
+}
+
+// sweep drops the expired entries, at most once per sweepInterval.
+func (s *MemoryStore) sweep() {
+	now := time.Now()
+	if now.Sub(s.lastSweep) < sweepInterval {
+		return
+	}
+	s.lastSweep = now
+	for jti, until := range s.tokens {
+		if !now.Before(until) {
+			delete(s.tokens, jti)
+		}
+	}
+	for userID, w := range s.watermarks {
+		if !now.Before(w.until) {
+			delete(s.watermarks, userID)
+		}
+	}
+}
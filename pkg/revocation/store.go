@@ -0,0 +1,62 @@
+// Package revocation tracks revoked access tokens. A token is revoked on
+// its own by its jti, or together with every other token of its user
+// issued before a watermark.
+package revocation
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"yinni_backend/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/wire"
+)
+
+// ProviderSet is revocation providers.
+var ProviderSet = wire.NewSet(NewStore)
+
+// Store records revocations until the revoked tokens expire. Watermarks
+// have second precision, like the iat claim they are compared with, so a
+// token issued within the second before a watermark stays valid.
+type Store interface {
+	// Revoke revokes the token with the jti until it expires at until.
+	Revoke(ctx context.Context, jti string, until time.Time) error
+	// RevokeIssuedBefore revokes the tokens of the user issued before
+	// before. The watermark is kept until until, when they have all expired,
+	// and only ever moves forward. An until not in the future would revoke
+	// nothing, so it fails with ErrNoLifetime.
+	RevokeIssuedBefore(ctx context.Context, userID int64, before, until time.Time) error
+	// Revoked reports whether the token with the jti, issued to the user at
+	// issuedAt, is revoked.
+	Revoked(ctx context.Context, jti string, userID int64, issuedAt time.Time) (bool, error)
+}
+
+// ErrNoStore is returned by NewStore when neither Redis nor the in-memory
+// store is configured.
+var ErrNoStore = errors.New("revocation: no redis configured; set data.redis.addr, or data.memory_revocations for development")
+
+// ErrNoLifetime is returned by RevokeIssuedBefore for a watermark that
+// would expire before it is set.
+var ErrNoLifetime = errors.New("revocation: watermark expires before it is set")
+
+// NewStore returns a Redis store when c configures a Redis address. The
+// in-memory store only sees the revocations made by its own process, so a
+// token revoked by the auth service stays valid everywhere else; it is only
+// returned when c opts into it with memory_revocations.
+func NewStore(c *conf.Data, logger log.Logger) (Store, func(), error) {
+	if c.GetRedis().GetAddr() != "" {
+		return NewRedisStore(c.Redis, logger)
+	}
+	if !c.GetMemoryRevocations() {
+		return nil, nil, ErrNoStore
+	}
+	log.NewHelper(logger).Error("Token revocations are kept in memory: tokens revoked by one service stay valid in the others. Configure data.redis outside development")
+	return NewMemoryStore(), func() {}, nil
+}
+
+// watermark truncates t to the precision of the iat claim.
+func watermark(t time.Time) int64 {
+	return t.Unix()
+}
@@ -0,0 +1,74 @@
+package revocation
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"yinni_backend/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestNewStore(t *testing.T) {
+	// Without redis, revocations would silently stay in one process
+	if _, _, err := NewStore(&conf.Data{}, log.DefaultLogger); !errors.Is(err, ErrNoStore) {
+		t.Errorf("no redis: err = %v, want ErrNoStore", err)
+	}
+
+	s, cleanup, err := NewStore(&conf.Data{MemoryRevocations: true}, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cleanup()
+	if _, ok := s.(*MemoryStore); !ok {
+		t.Errorf("memory_revocations: got %T, want *MemoryStore", s)
+	}
+
+	s, cleanup, err = NewStore(&conf.Data{
+		Redis:             &conf.Data_Redis{Addr: "127.0.0.1:0"},
+		MemoryRevocations: true,
+	}, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cleanup()
+	if _, ok := s.(*RedisStore); !ok {
+		t.Errorf("redis and memory_revocations: got %T, want *RedisStore", s)
+	}
+}
+
+func TestRevokeIssuedBefore(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemoryStore()
+	now := time.Now()
+
+	// Watermarks live as long as the tokens they revoke, an hour here
+	if err := s.RevokeIssuedBefore(ctx, 7, now, now.Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if revoked, err := s.Revoked(ctx, "old", 7, now.Add(-time.Minute)); err != nil || !revoked {
+		t.Errorf("token issued before the watermark: revoked %v, err %v", revoked, err)
+	}
+	if revoked, err := s.Revoked(ctx, "new", 7, now.Add(time.Second)); err != nil || revoked {
+		t.Errorf("token issued after the watermark: revoked %v, err %v", revoked, err)
+	}
+	if revoked, err := s.Revoked(ctx, "other", 8, now.Add(-time.Minute)); err != nil || revoked {
+		t.Errorf("token of another user: revoked %v, err %v", revoked, err)
+	}
+
+	// A watermark without a lifetime would revoke nothing
+	rs, cleanup, err := NewRedisStore(&conf.Data_Redis{Addr: "127.0.0.1:0"}, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cleanup()
+	for name, store := range map[string]Store{"memory": s, "redis": rs} {
+		for _, until := range []time.Time{now, now.Add(-time.Hour)} {
+			if err := store.RevokeIssuedBefore(ctx, 9, now, until); !errors.Is(err, ErrNoLifetime) {
+				t.Errorf("%s, until %v: err = %v, want ErrNoLifetime", name, until.Sub(now), err)
+			}
+		}
+	}
+}